@@ -32,6 +32,7 @@ require (
 	github.com/stretchr/testify v1.4.0
 	github.com/swaggo/gin-swagger v1.2.0
 	github.com/swaggo/swag v1.6.3
+	go.etcd.io/bbolt v1.3.3
 	golang.org/x/crypto v0.0.0-20191122220453-ac88ee75c92c
 	golang.org/x/net v0.0.0-20191116160921-f9c825593386
 	golang.org/x/sys v0.0.0-20191223224216-5a3cf8467b4e // indirect
@@ -45,6 +46,7 @@ require (
 	k8s.io/apimachinery v0.0.0
 	k8s.io/cli-runtime v0.0.0
 	k8s.io/client-go v1.16.0
+	k8s.io/cluster-bootstrap v0.0.0
 	k8s.io/kubernetes v1.16.3
 	sigs.k8s.io/yaml v1.1.0
 )
@@ -23,5 +23,11 @@ const (
 	DeployItemIngress DeployItem = "ingress"
 	DeployItemNetwork DeployItem = "network"
 
+	// The steps to remove a node
+	DeployItemDrainNode        DeployItem = "drainNode"
+	DeployItemRemoveEtcdMember DeployItem = "removeEtcdMember"
+	DeployItemResetNode        DeployItem = "resetNode"
+	DeployItemDeleteNode       DeployItem = "deleteNode"
+
 	// And eg. dashboard, prometheus-exporter, grafana, elasticsearch, and so on
 )
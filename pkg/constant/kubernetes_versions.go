@@ -0,0 +1,82 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package constant
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// KubernetesRelease is a kubernetes version which can be deployed: the kubelet, kubeadm and kubectl
+// packages of the version are provided by the kubetool package repos, and the control plane images
+// of the version are provided by the image repository.
+type KubernetesRelease struct {
+	Version string
+	// PauseImageTag is the tag of the pause image used by the kubelet of the version.
+	PauseImageTag string
+}
+
+// kubernetesReleases are the supported kubernetes versions, in ascending order.
+var kubernetesReleases = []KubernetesRelease{
+	{Version: "1.15.6", PauseImageTag: "3.1"},
+	{Version: "1.16.3", PauseImageTag: "3.1"},
+	{Version: "1.17.0", PauseImageTag: "3.1"},
+}
+
+// imageRepositoryPattern matches an image repository without the image name and tag,
+// such as "docker.io/kpaas" or "registry.local:5000/kpaas".
+var imageRepositoryPattern = regexp.MustCompile(`^[a-zA-Z0-9]+([.-][a-zA-Z0-9]+)*(:[0-9]+)?(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+
+// SupportedKubernetesVersions returns the supported kubernetes versions in ascending order.
+func SupportedKubernetesVersions() []string {
+	versions := make([]string, 0, len(kubernetesReleases))
+	for _, release := range kubernetesReleases {
+		versions = append(versions, release.Version)
+	}
+	return versions
+}
+
+// GetKubernetesRelease returns the supported kubernetes release of the version,
+// DefaultKubeVersion is used if the version is empty, a "v" prefix of the version is allowed.
+func GetKubernetesRelease(version string) (*KubernetesRelease, error) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if version == "" {
+		version = DefaultKubeVersion
+	}
+
+	for i := range kubernetesReleases {
+		if kubernetesReleases[i].Version == version {
+			release := kubernetesReleases[i]
+			return &release, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported kubernetes version %q, supported versions: %s",
+		version, strings.Join(SupportedKubernetesVersions(), ", "))
+}
+
+// GetImageRepository returns the image repository, DefaultImageRepository is used if it is empty.
+func GetImageRepository(repository string) (string, error) {
+	repository = strings.TrimSuffix(strings.TrimSpace(repository), "/")
+	if repository == "" {
+		return DefaultImageRepository, nil
+	}
+
+	if !imageRepositoryPattern.MatchString(repository) {
+		return "", fmt.Errorf("invalid image repository %q, it should be like %q or \"registry.local:5000/kpaas\"",
+			repository, DefaultImageRepository)
+	}
+	return repository, nil
+}
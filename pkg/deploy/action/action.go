@@ -15,15 +15,32 @@
 package action
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
+	"sync"
 	"time"
 
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
 	"github.com/kpaas-io/kpaas/pkg/utils/idcreator"
 )
 
+// statusChanges notifies the watchers whenever the status or error of an action or a task changed.
+var statusChanges = utils.NewBroadcaster()
+
+// NotifyStatusChanged wakes up the watchers of the action and task status.
+func NotifyStatusChanged() {
+	statusChanges.Notify()
+}
+
+// StatusChanged returns a channel which will be closed at the next status or error change of
+// any action or task.
+func StatusChanged() <-chan struct{} {
+	return statusChanges.Changed()
+}
+
 // Type represents the type of an action
 type Type string
 
@@ -35,6 +52,9 @@ const (
 	ActionDoing   Status = "doing"
 	ActionDone    Status = "done" // means success
 	ActionFailed  Status = "failed"
+	// ActionAborted means the action was not executed or interrupted
+	// because its task had been canceled.
+	ActionAborted Status = "aborted"
 )
 
 // ItemStatus represents the status of an action item
@@ -62,6 +82,15 @@ type Action interface {
 	GetNode() *pb.Node
 	GetExecuteLogBuffer() io.ReadWriter
 	SetExecuteLogBuffer(io.ReadWriter)
+	// GetRetryPolicy returns the policy of timeout and retry of the action, nil means the action
+	// is executed only once without timeout.
+	GetRetryPolicy() *RetryPolicy
+	SetRetryPolicy(*RetryPolicy)
+	// GetAttempts returns how many times the action has been attempted.
+	GetAttempts() int
+	SetAttempts(int)
+
+	getBase() *Base
 }
 
 // Base is the basic metadata of an action
@@ -73,7 +102,13 @@ type Base struct {
 	LogFilePath       string
 	CreationTimestamp time.Time
 	Node              *pb.Node
-	ExecuteLogBuffer  io.ReadWriter
+	ExecuteLogBuffer  io.ReadWriter `json:"-"`
+	RetryPolicy       *RetryPolicy
+	Attempts          int
+
+	// lock protects the fields changed during the execution from being read by the task store
+	// while they are changed.
+	lock sync.RWMutex
 }
 
 func (b *Base) GetName() string {
@@ -81,11 +116,16 @@ func (b *Base) GetName() string {
 }
 
 func (b *Base) GetStatus() Status {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.Status
 }
 
 func (b *Base) SetStatus(status Status) {
+	b.lock.Lock()
 	b.Status = status
+	b.lock.Unlock()
+	NotifyStatusChanged()
 }
 
 func (b *Base) GetType() Type {
@@ -93,19 +133,28 @@ func (b *Base) GetType() Type {
 }
 
 func (b *Base) GetErr() *pb.Error {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.Err
 }
 
 func (b *Base) SetErr(err *pb.Error) {
+	b.lock.Lock()
 	b.Err = err
+	b.lock.Unlock()
+	NotifyStatusChanged()
 }
 
 func (b *Base) GetLogFilePath() string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.LogFilePath
 }
 
 func (b *Base) SetLogFilePath(path string) {
+	b.lock.Lock()
 	b.LogFilePath = path
+	b.lock.Unlock()
 }
 
 func (b *Base) GetCreationTimestamp() time.Time {
@@ -117,11 +166,60 @@ func (b *Base) GetNode() *pb.Node {
 }
 
 func (b *Base) GetExecuteLogBuffer() io.ReadWriter {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.ExecuteLogBuffer
 }
 
 func (b *Base) SetExecuteLogBuffer(buf io.ReadWriter) {
+	b.lock.Lock()
 	b.ExecuteLogBuffer = buf
+	b.lock.Unlock()
+}
+
+func (b *Base) GetRetryPolicy() *RetryPolicy {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.RetryPolicy
+}
+
+func (b *Base) SetRetryPolicy(policy *RetryPolicy) {
+	b.lock.Lock()
+	b.RetryPolicy = policy
+	b.lock.Unlock()
+}
+
+func (b *Base) GetAttempts() int {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.Attempts
+}
+
+func (b *Base) SetAttempts(attempts int) {
+	b.lock.Lock()
+	b.Attempts = attempts
+	b.lock.Unlock()
+}
+
+func (b *Base) getBase() *Base {
+	return b
+}
+
+// baseRecord is the encoded form of the Base only.
+type baseRecord Base
+
+// EncodeAction returns the JSON encoding of the action. It's done under the lock of the action so
+// the status can't be changed meanwhile; only the Base of a doing action is encoded, since its other
+// fields are being changed by the executor.
+func EncodeAction(act Action) ([]byte, error) {
+	b := act.getBase()
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	if b.Status == ActionDoing {
+		return json.Marshal((*baseRecord)(b))
+	}
+	return json.Marshal(act)
 }
 
 // GenActionLogFilePath is a helper to return a file path based on the base path and aciton name
@@ -16,6 +16,7 @@ package action
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -109,7 +110,7 @@ func init() {
 
 type connectivityCheckExecutor struct{}
 
-func (e *connectivityCheckExecutor) Execute(act Action) *pb.Error {
+func (e *connectivityCheckExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	connectivityCheckAction, ok := act.(*ConnectivityCheckAction)
 	if !ok {
 		return errOfTypeMismatched(new(ConnectivityCheckAction), act)
@@ -183,7 +184,8 @@ func (e *connectivityCheckExecutor) Execute(act Action) *pb.Error {
 			dstCommand := command.NewShellCommand(dstMachine,
 				captureCommand[0], captureCommand[1:]...).
 				WithDescription("capture test packet on " + dstNode.Name).
-				WithExecuteLogWriter(dstExecuteLogBuf)
+				WithExecuteLogWriter(dstExecuteLogBuf).
+				WithContext(ctx)
 			_, _, e = dstCommand.Execute()
 			errCh <- e
 		}(captureChan)
@@ -193,7 +195,8 @@ func (e *connectivityCheckExecutor) Execute(act Action) *pb.Error {
 		srcExecuteLogBuf := &bytes.Buffer{}
 		srcCommand := command.NewShellCommand(srcMachine, sendCommand[0], sendCommand[1:]...).
 			WithDescription("send test packet").
-			WithExecuteLogWriter(srcExecuteLogBuf)
+			WithExecuteLogWriter(srcExecuteLogBuf).
+			WithContext(ctx)
 		_, srcStderr, srcErr := srcCommand.Execute()
 		if executeLogBuf != nil {
 			io.Copy(executeLogBuf, srcExecuteLogBuf)
@@ -0,0 +1,89 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeCreateJoinToken Type = "CreateJoinToken"
+
+// CreateJoinTokenActionConfig represents the config for an action to create a join token on a master node
+type CreateJoinTokenActionConfig struct {
+	Node *pb.Node
+	TTL  time.Duration
+	// Token is the token to create, a new token is generated if it's empty.
+	Token string
+	// CertKey is the key to upload the control plane certificates again, so that new masters can
+	// join the cluster with it, the certificates are not uploaded if it's empty.
+	CertKey string
+	// KubernetesVersion is the version which the control plane must be at for the nodes to join,
+	// the version is not checked if it's empty.
+	KubernetesVersion string
+	LogFileBasePath   string
+}
+
+type CreateJoinTokenAction struct {
+	Base
+
+	TTL               time.Duration
+	CertKey           string
+	KubernetesVersion string
+	// RequestedToken is the token to create, a new token is generated if it's empty.
+	RequestedToken string
+	// Token is the result of the action: the created join token.
+	Token string
+}
+
+// NewCreateJoinTokenAction returns a create-join-token action based on the config.
+// User should use this function to create a create-join-token action.
+func NewCreateJoinTokenAction(cfg *CreateJoinTokenActionConfig) (Action, error) {
+	var err error
+	if cfg == nil {
+		err = fmt.Errorf("action config is nil")
+	} else if cfg.Node == nil {
+		err = fmt.Errorf("invalid config: Node is nil")
+	} else if cfg.TTL <= 0 {
+		err = fmt.Errorf("invalid config: TTL must be positive")
+	} else if cfg.Token != "" && !deploy.IsValidBootstrapToken(cfg.Token) {
+		err = fmt.Errorf("invalid config: invalid token")
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	actionName := GenActionName(ActionTypeCreateJoinToken)
+	return &CreateJoinTokenAction{
+		Base: Base{
+			Name:              actionName,
+			ActionType:        ActionTypeCreateJoinToken,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
+			CreationTimestamp: time.Now(),
+			Node:              cfg.Node,
+		},
+		TTL:               cfg.TTL,
+		CertKey:           cfg.CertKey,
+		KubernetesVersion: cfg.KubernetesVersion,
+		RequestedToken:    cfg.Token,
+	}, nil
+}
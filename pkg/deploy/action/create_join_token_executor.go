@@ -0,0 +1,159 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/master"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterExecutor(ActionTypeCreateJoinToken, new(createJoinTokenExecutor))
+}
+
+type createJoinTokenExecutor struct {
+}
+
+func (a *createJoinTokenExecutor) Execute(ctx context.Context, act Action) *pb.Error {
+	tokenAction, ok := act.(*CreateJoinTokenAction)
+	if !ok {
+		return errOfTypeMismatched(new(CreateJoinTokenAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	var pbErr *pb.Error
+
+	defer func() {
+		deploy.PBErrLogger(pbErr, logger).Debug()
+	}()
+
+	logger.Debug("Start to execute action")
+
+	token := tokenAction.RequestedToken
+	if token == "" {
+		var err error
+		if token, err = deploy.NewBootstrapToken(); err != nil {
+			pbErr = &pb.Error{
+				Reason: "failed to generate join token",
+				Detail: err.Error(),
+			}
+			return pbErr
+		}
+	}
+
+	m, err := machine.NewMachine(tokenAction.Node)
+	if err != nil {
+		pbErr = &pb.Error{
+			Reason: "failed to connect to target node",
+			Detail: err.Error(),
+		}
+		return pbErr
+	}
+	defer m.Close()
+
+	if tokenAction.KubernetesVersion != "" {
+		if err := operation.CheckControlPlaneVersion([]*pb.Node{tokenAction.Node}, tokenAction.KubernetesVersion); err != nil {
+			pbErr = &pb.Error{
+				Reason:     "the version of the control plane mismatched",
+				Detail:     err.Error(),
+				FixMethods: "set the kubernetes version of the cluster to the version of the control plane",
+			}
+			return pbErr
+		}
+	}
+
+	// the requested token may exist if the task is resumed, it's deleted to be created again with a new ttl,
+	// the error is ignored since the token may have expired.
+	if tokenAction.RequestedToken != "" {
+		if _, stderr, err := deleteJoinTokenCommand(m, token).WithContext(ctx).Execute(); err != nil {
+			logger.Debugf("failed to delete join token, error: %v, stderr: %s", err, stderr)
+		}
+	}
+
+	_, stderr, err := createJoinTokenCommand(m, token, tokenAction.TTL.String()).WithContext(ctx).Execute()
+	if err != nil {
+		pbErr = &pb.Error{
+			Reason: "failed to create join token",
+			Detail: fmt.Sprintf("%v, stderr: %s", err, stderr),
+		}
+		return pbErr
+	}
+
+	if tokenAction.CertKey != "" {
+		_, stderr, err := master.UploadCertsCommand(m, tokenAction.CertKey).WithContext(ctx).Execute()
+		if err != nil {
+			pbErr = &pb.Error{
+				Reason: "failed to upload control plane certificates",
+				Detail: fmt.Sprintf("%v, stderr: %s", err, stderr),
+			}
+			return pbErr
+		}
+	}
+
+	// Update action
+	tokenAction.Token = token
+
+	logger.Debug("Finish to execute action")
+	return nil
+}
+
+func (a *createJoinTokenExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	tokenAction, ok := act.(*CreateJoinTokenAction)
+	if !ok {
+		return nil, errTypeMismatched(new(CreateJoinTokenAction), act)
+	}
+
+	// the token is generated when the action is executed if it's not specified
+	token := tokenAction.RequestedToken
+	if token == "" {
+		token = "<generated token>"
+	}
+
+	plan := new(pb.ActionPlan)
+	if tokenAction.RequestedToken != "" {
+		plan.Commands = append(plan.Commands, deleteJoinTokenCommand(nil, token).GetCommand())
+	}
+	plan.Commands = append(plan.Commands, createJoinTokenCommand(nil, token, tokenAction.TTL.String()).GetCommand())
+	if tokenAction.CertKey != "" {
+		plan.Commands = append(plan.Commands, master.UploadCertsCommand(nil, tokenAction.CertKey).GetCommand())
+	}
+	return plan, nil
+}
+
+// createJoinTokenCommand returns the command to create the bootstrap token on a master with the ttl,
+// the token expires and is deleted by the cluster after the ttl.
+func createJoinTokenCommand(m machine.IMachine, token, ttl string) *command.ShellCommand {
+	return command.NewShellCommand(m, "kubeadm", "token", "create", token,
+		"--ttl", ttl,
+		"--kubeconfig", consts.KubeConfigPath)
+}
+
+// deleteJoinTokenCommand returns the command to delete the bootstrap token on a master.
+func deleteJoinTokenCommand(m machine.IMachine, token string) *command.ShellCommand {
+	return command.NewShellCommand(m, "kubeadm", "token", "delete", token,
+		"--kubeconfig", consts.KubeConfigPath)
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestNewCreateJoinTokenAction(t *testing.T) {
+	// test invalid paramters
+	tests := []*CreateJoinTokenActionConfig{
+		nil,
+		&CreateJoinTokenActionConfig{TTL: time.Hour},
+		&CreateJoinTokenActionConfig{Node: &pb.Node{}},
+	}
+	for _, test := range tests {
+		_, err := NewCreateJoinTokenAction(test)
+		assert.Error(t, err)
+	}
+
+	cfg := &CreateJoinTokenActionConfig{
+		Node: &pb.Node{},
+		TTL:  time.Hour,
+	}
+	act, err := NewCreateJoinTokenAction(cfg)
+	assert.NoError(t, err)
+	assert.IsType(t, &CreateJoinTokenAction{}, act)
+	assert.Equal(t, ActionTypeCreateJoinToken, act.GetType())
+	assert.Equal(t, ActionPending, act.GetStatus())
+	assert.Equal(t, time.Hour, act.(*CreateJoinTokenAction).TTL)
+}
+
+func TestCreateJoinToken(t *testing.T) {
+	executor := new(createJoinTokenExecutor)
+
+	normalAction, err := NewCreateJoinTokenAction(&CreateJoinTokenActionConfig{
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+		},
+		TTL: time.Hour,
+	})
+	assert.NoError(t, err)
+
+	assert.Nil(t, executor.Execute(context.Background(), normalAction))
+	token := normalAction.(*CreateJoinTokenAction).Token
+	assert.True(t, deploy.IsValidBootstrapToken(token))
+
+	// every token is unique
+	assert.Nil(t, executor.Execute(context.Background(), normalAction))
+	assert.NotEqual(t, token, normalAction.(*CreateJoinTokenAction).Token)
+
+	errorAction, err := NewCreateJoinTokenAction(&CreateJoinTokenActionConfig{
+		Node: &pb.Node{
+			Name: "error",
+			Ip:   "10.10.10.10",
+		},
+		TTL: time.Hour,
+	})
+	assert.NoError(t, err)
+
+	// the requested token is created, and the control plane certificates are uploaded with the key,
+	// the version of a simulated control plane is not checked
+	requestedAction, err := NewCreateJoinTokenAction(&CreateJoinTokenActionConfig{
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+		},
+		TTL:               time.Hour,
+		Token:             "abcdef.0123456789abcdef",
+		CertKey:           "certkey",
+		KubernetesVersion: "1.16.3",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.16.3", requestedAction.(*CreateJoinTokenAction).KubernetesVersion)
+	assert.Nil(t, executor.Execute(context.Background(), requestedAction))
+	assert.Equal(t, "abcdef.0123456789abcdef", requestedAction.(*CreateJoinTokenAction).Token)
+
+	plan, err := executor.Plan(requestedAction)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"kubeadm token delete abcdef.0123456789abcdef --kubeconfig /etc/kubernetes/admin.conf",
+		"kubeadm token create abcdef.0123456789abcdef --ttl 1h0m0s --kubeconfig /etc/kubernetes/admin.conf",
+		"kubeadm init phase upload-certs --upload-certs --config /etc/kubernetes/kubeadm_config.yaml --certificate-key certkey",
+	}, plan.GetCommands())
+
+	_, err = NewCreateJoinTokenAction(&CreateJoinTokenActionConfig{Node: &pb.Node{}, TTL: time.Hour, Token: "invalid"})
+	assert.Error(t, err)
+
+	assert.NotNil(t, executor.Execute(context.Background(), errorAction))
+	assert.Empty(t, errorAction.(*CreateJoinTokenAction).Token)
+	assert.Equal(t, "kubeadm token create abcdef.0123456789abcdef --ttl 1h0m0s --kubeconfig /etc/kubernetes/admin.conf",
+		createJoinTokenCommand(nil, "abcdef.0123456789abcdef", time.Hour.String()).GetCommand())
+}
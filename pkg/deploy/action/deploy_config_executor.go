@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -33,7 +34,7 @@ func init() {
 type deployConfigExecutor struct {
 }
 
-func (e *deployConfigExecutor) Execute(act Action) *pb.Error {
+func (e *deployConfigExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	configAction, ok := act.(*DeployConfigAction)
 	if !ok {
 		return errOfTypeMismatched(new(DeployConfigAction), act)
@@ -148,3 +149,38 @@ func (e *deployConfigExecutor) appendTaint(act *DeployConfigAction, machine mach
 	logger.Info("Finish to append taint")
 	return nil
 }
+
+// Plan returns the commands which will be run on the first master to config the node.
+func (e *deployConfigExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	configAction, ok := act.(*DeployConfigAction)
+	if !ok {
+		return nil, errTypeMismatched(new(DeployConfigAction), act)
+	}
+	if len(configAction.MasterNodes) == 0 {
+		return nil, fmt.Errorf("master nodes are empty")
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+		consts.LogFieldNode:   act.GetNode().GetName(),
+	})
+
+	plan := &pb.ActionPlan{NodeName: configAction.MasterNodes[0].GetName()}
+	plan.Commands = append(plan.Commands, config.NewAppendLabel(&config.AppendLabelConfig{
+		Logger:  logger,
+		Node:    configAction.NodeConfig,
+		Cluster: configAction.ClusterConfig,
+	}).Plan()...)
+	plan.Commands = append(plan.Commands, config.NewAppendAnnotation(&config.AppendAnnotationConfig{
+		Logger:  logger,
+		Node:    configAction.NodeConfig,
+		Cluster: configAction.ClusterConfig,
+	}).Plan()...)
+	plan.Commands = append(plan.Commands, config.NewAppendTaint(&config.AppendTaintConfig{
+		Logger:  logger,
+		Node:    configAction.NodeConfig,
+		Cluster: configAction.ClusterConfig,
+	}).Plan()...)
+
+	return plan, nil
+}
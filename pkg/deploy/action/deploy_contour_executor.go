@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -356,19 +357,21 @@ func init() {
 }
 
 type deployContourExecutor struct {
+	ctx              context.Context
 	logger           *logrus.Entry
 	masterMachine    deployMachine.IMachine
 	action           *DeployContourAction
 	executeLogWriter io.Writer
 }
 
-func (executor *deployContourExecutor) Execute(act Action) *protos.Error {
+func (executor *deployContourExecutor) Execute(ctx context.Context, act Action) *protos.Error {
 
 	action, ok := act.(*DeployContourAction)
 	if !ok {
 		return errOfTypeMismatched(new(DeployContourAction), act)
 	}
 
+	executor.ctx = ctx
 	executor.action = action
 
 	executor.initLogger()
@@ -436,6 +439,7 @@ func (executor *deployContourExecutor) writeYAML() *protos.Error {
 
 	operation := contour.NewWriteFile(
 		&contour.WriteFileConfig{
+			Context:          executor.ctx,
 			Node:             executor.masterMachine,
 			Logger:           executor.logger,
 			ExecuteLogWriter: executor.executeLogWriter,
@@ -474,3 +478,31 @@ func (executor *deployContourExecutor) applyYAML() *protos.Error {
 	executor.logger.Info("Finish to apply yaml action")
 	return nil
 }
+
+// Plan returns the contour yaml which will be put to the first master and the commands which will be run on it.
+func (executor *deployContourExecutor) Plan(act Action) (*protos.ActionPlan, error) {
+
+	action, ok := act.(*DeployContourAction)
+	if !ok {
+		return nil, errTypeMismatched(new(DeployContourAction), act)
+	}
+	if len(action.config.MasterNodes) == 0 {
+		return nil, fmt.Errorf("master nodes are empty")
+	}
+
+	executor.action = action
+	executor.initLogger()
+
+	return &protos.ActionPlan{
+		NodeName: action.config.MasterNodes[0].GetName(),
+		Files: []*protos.PlannedFile{
+			{Path: installContourFilePath, Content: contourYAML},
+		},
+		Commands: contour.NewApplyYAML(
+			&contour.ApplyYAMLConfig{
+				Logger:   executor.logger,
+				FilePath: installContourFilePath,
+			},
+		).Plan(),
+	}, nil
+}
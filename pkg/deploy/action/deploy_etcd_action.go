@@ -39,8 +39,8 @@ type DeployEtcdActionConfig struct {
 type DeployEtcdAction struct {
 	Base
 
-	CACrt        *x509.Certificate
-	CAKey        crypto.Signer
+	CACrt        *x509.Certificate `json:"-"`
+	CAKey        crypto.Signer     `json:"-"`
 	ClusterNodes []*pb.Node
 }
 
@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
@@ -29,7 +30,7 @@ func init() {
 type deployEtcdExecutor struct {
 }
 
-func (a *deployEtcdExecutor) Execute(act Action) *pb.Error {
+func (a *deployEtcdExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	etcdAction, ok := act.(*DeployEtcdAction)
 	if !ok {
 		return errOfTypeMismatched(new(DeployEtcdAction), act)
@@ -42,6 +43,7 @@ func (a *deployEtcdExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Start to execute deploy etcd action")
 
 	config := &etcd.DeployEtcdOperationConfig{
+		Context:      ctx,
 		Logger:       logger,
 		Node:         etcdAction.Node,
 		CACrt:        etcdAction.CACrt,
@@ -71,3 +73,13 @@ func (a *deployEtcdExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Finish to execute deploy etcd action")
 	return nil
 }
+
+// Plan returns the certificates which will be put to the etcd node and the commands which will be run on it.
+func (a *deployEtcdExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	etcdAction, ok := act.(*DeployEtcdAction)
+	if !ok {
+		return nil, errTypeMismatched(new(DeployEtcdAction), act)
+	}
+
+	return etcd.PlanDeployEtcd(etcdAction.Node, etcdAction.ClusterNodes), nil
+}
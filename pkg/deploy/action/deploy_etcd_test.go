@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -48,7 +49,7 @@ func TestDeployEtcd(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, normalAction)
 
-	pbErr := executor.Execute(normalAction)
+	pbErr := executor.Execute(context.Background(), normalAction)
 	assert.Nil(t, pbErr)
 
 	errorAction, err := NewDeployEtcdAction(&DeployEtcdActionConfig{
@@ -62,7 +63,46 @@ func TestDeployEtcd(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, errorAction)
 
-	pbErr = executor.Execute(errorAction)
+	pbErr = executor.Execute(context.Background(), errorAction)
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 }
+
+func TestDeployEtcdReplay(t *testing.T) {
+	defer replayFixture(t, "deploy_etcd_fixture.json")()
+
+	cacert, signer, err := etcd.CreateAsCA(&certutil.Config{
+		CommonName: "etcd-ca",
+	})
+	assert.NoError(t, err)
+
+	newAction := func(name, ip string) Action {
+		node := &pb.Node{
+			Name: name,
+			Ip:   ip,
+		}
+		action, err := NewDeployEtcdAction(&DeployEtcdActionConfig{
+			CaCrt:        cacert,
+			CaKey:        signer,
+			Node:         node,
+			ClusterNodes: []*pb.Node{node},
+		})
+		assert.NoError(t, err)
+		return action
+	}
+
+	executor := new(deployEtcdExecutor)
+
+	// the certificates are not on the node yet, and the etcd container is started
+	assert.Nil(t, executor.Execute(context.Background(), newAction("etcd1", "192.168.1.21")))
+
+	// the etcd image can't be pulled
+	pbErr := executor.Execute(context.Background(), newAction("etcd2", "192.168.1.22"))
+	assert.NotNil(t, pbErr)
+	assert.Equal(t, "failed to do etcd operation", pbErr.GetReason())
+
+	// the node is unreachable
+	pbErr = executor.Execute(context.Background(), newAction("error", "10.10.10.10"))
+	assert.NotNil(t, pbErr)
+	assert.Contains(t, pbErr.GetDetail(), "connection refused")
+}
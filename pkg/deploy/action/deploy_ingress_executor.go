@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -25,7 +26,7 @@ func init() {
 type deployIngressExecutor struct {
 }
 
-func (executor *deployIngressExecutor) Execute(act Action) *protos.Error {
+func (executor *deployIngressExecutor) Execute(ctx context.Context, act Action) *protos.Error {
 	action, ok := act.(*DeployIngressAction)
 	if !ok {
 		return errOfTypeMismatched(new(DeployIngressAction), act)
@@ -33,7 +34,7 @@ func (executor *deployIngressExecutor) Execute(act Action) *protos.Error {
 
 	executor.addIngressMarks(action.config.NodeCfg)
 
-	return new(deployNodeExecutor).Deploy(act, action.config)
+	return new(deployNodeExecutor).Deploy(ctx, act, action.config)
 }
 
 func (executor *deployIngressExecutor) addIngressMarks(node *protos.NodeDeployConfig) {
@@ -42,3 +43,15 @@ func (executor *deployIngressExecutor) addIngressMarks(node *protos.NodeDeployCo
 	}
 	node.Labels["node-role.kubernetes.io/ingress"] = "envoy"
 }
+
+func (executor *deployIngressExecutor) Plan(act Action) (*protos.ActionPlan, error) {
+	action, ok := act.(*DeployIngressAction)
+	if !ok {
+		return nil, errTypeMismatched(new(DeployIngressAction), act)
+	}
+
+	// the ingress marks are appended to the node by the deploy config action later
+	executor.addIngressMarks(action.config.NodeCfg)
+
+	return new(deployNodeExecutor).Plan(act, action.config)
+}
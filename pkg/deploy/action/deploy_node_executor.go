@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -27,6 +28,7 @@ import (
 )
 
 type deployNodeExecutor struct {
+	ctx              context.Context
 	logger           *logrus.Entry
 	machine          deployMachine.IMachine
 	executeLogWriter io.Writer
@@ -38,11 +40,13 @@ type DeployNodeActionConfig struct {
 	NodeCfg         *protos.NodeDeployConfig
 	ClusterConfig   *protos.ClusterConfig
 	MasterNodes     []*protos.Node
+	BootstrapToken  string
 	LogFileBasePath string
 }
 
-func (executor *deployNodeExecutor) Deploy(act Action, config *DeployNodeActionConfig) *protos.Error {
+func (executor *deployNodeExecutor) Deploy(ctx context.Context, act Action, config *DeployNodeActionConfig) *protos.Error {
 
+	executor.ctx = ctx
 	executor.action = act
 	executor.config = config
 
@@ -108,6 +112,7 @@ func (executor *deployNodeExecutor) startKubelet() *protos.Error {
 
 	operation := worker.NewStartKubelet(
 		&worker.StartKubeletConfig{
+			Context:          executor.ctx,
 			Machine:          executor.machine,
 			Node:             executor.config.NodeCfg,
 			Logger:           executor.logger,
@@ -130,11 +135,13 @@ func (executor *deployNodeExecutor) joinCluster() *protos.Error {
 
 	operation := worker.NewJoinCluster(
 		&worker.JoinClusterConfig{
+			Context:          executor.ctx,
 			Machine:          executor.machine,
 			Node:             executor.config.NodeCfg,
 			Logger:           executor.logger,
 			Cluster:          executor.config.ClusterConfig,
 			MasterNodes:      executor.config.MasterNodes,
+			BootstrapToken:   executor.config.BootstrapToken,
 			ExecuteLogWriter: executor.executeLogWriter,
 		},
 	)
@@ -165,3 +172,36 @@ func (executor *deployNodeExecutor) initExecuteLogWriter() {
 
 	executor.executeLogWriter = executor.action.GetExecuteLogBuffer()
 }
+
+// Plan returns the commands which will be run on the node to start kubelet and join the cluster.
+func (executor *deployNodeExecutor) Plan(act Action, config *DeployNodeActionConfig) (*protos.ActionPlan, error) {
+
+	executor.action = act
+	executor.config = config
+	executor.initLogger()
+
+	plan := &protos.ActionPlan{
+		Commands: worker.NewStartKubelet(
+			&worker.StartKubeletConfig{
+				Node:   executor.config.NodeCfg,
+				Logger: executor.logger,
+			},
+		).Plan(),
+	}
+
+	commands, err := worker.NewJoinCluster(
+		&worker.JoinClusterConfig{
+			Node:           executor.config.NodeCfg,
+			Logger:         executor.logger,
+			Cluster:        executor.config.ClusterConfig,
+			MasterNodes:    executor.config.MasterNodes,
+			BootstrapToken: executor.config.BootstrapToken,
+		},
+	).Plan()
+	if err != nil {
+		return nil, err
+	}
+	plan.Commands = append(plan.Commands, commands...)
+
+	return plan, nil
+}
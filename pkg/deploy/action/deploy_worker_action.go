@@ -27,6 +27,7 @@ type DeployWorkerActionConfig struct {
 	NodeCfg         *pb.NodeDeployConfig
 	ClusterConfig   *pb.ClusterConfig
 	MasterNodes     []*pb.Node
+	BootstrapToken  string
 	LogFileBasePath string
 }
 
@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -25,11 +26,20 @@ func init() {
 type deployWorkerExecutor struct {
 }
 
-func (executor *deployWorkerExecutor) Execute(act Action) *protos.Error {
+func (executor *deployWorkerExecutor) Execute(ctx context.Context, act Action) *protos.Error {
 	action, ok := act.(*DeployWorkerAction)
 	if !ok {
 		return errOfTypeMismatched(new(DeployWorkerAction), act)
 	}
 
-	return new(deployNodeExecutor).Deploy(act, action.config)
+	return new(deployNodeExecutor).Deploy(ctx, act, action.config)
+}
+
+func (executor *deployWorkerExecutor) Plan(act Action) (*protos.ActionPlan, error) {
+	action, ok := act.(*DeployWorkerAction)
+	if !ok {
+		return nil, errTypeMismatched(new(DeployWorkerAction), act)
+	}
+
+	return new(deployNodeExecutor).Plan(act, action.config)
 }
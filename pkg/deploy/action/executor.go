@@ -15,23 +15,25 @@ package action
 // limitations under the License.
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
 )
 
 // Executor represents the interface of an action executor.
 // Concrete executors implements the logic of actions.
 type Executor interface {
-	Execute(act Action) *pb.Error
+	Execute(ctx context.Context, act Action) *pb.Error
 }
 
 var _executorRegistry map[Type]Executor
@@ -66,8 +68,9 @@ func NewExecutor(actionType Type) (Executor, error) {
 }
 
 // ExecuteAction creates and run the executor for an action,
-// a *sync.WaitGroup should be passed in.
-func ExecuteAction(act Action, wg *sync.WaitGroup) {
+// a *sync.WaitGroup should be passed in. If ctx is done before or during
+// the execution, the action will be marked as aborted.
+func ExecuteAction(ctx context.Context, act Action, wg *sync.WaitGroup) {
 	defer wg.Done()
 
 	if act == nil {
@@ -81,6 +84,12 @@ func ExecuteAction(act Action, wg *sync.WaitGroup) {
 
 	logger.Debug("Start to execute action")
 
+	if ctx.Err() != nil {
+		abortAction(act, ctx.Err())
+		logger.Info("Action aborted")
+		return
+	}
+
 	executor, err := NewExecutor(act.GetType())
 	if err != nil {
 		act.SetStatus(ActionFailed)
@@ -92,8 +101,6 @@ func ExecuteAction(act Action, wg *sync.WaitGroup) {
 		return
 	}
 
-	act.SetStatus(ActionDoing)
-
 	if err := setup(act); err != nil {
 		act.SetStatus(ActionFailed)
 		act.SetErr(&pb.Error{
@@ -104,9 +111,17 @@ func ExecuteAction(act Action, wg *sync.WaitGroup) {
 		return
 	}
 
+	// The action is set to doing after setup, so its execute log buffer is ready to be followed.
+	act.SetStatus(ActionDoing)
+
 	defer writeExecuteLogs(act)
 
-	if exeErr := executor.Execute(act); exeErr != nil {
+	if exeErr := executeWithRetry(ctx, executor, act); exeErr != nil {
+		if ctx.Err() != nil {
+			abortAction(act, ctx.Err())
+			logger.Info("Action aborted")
+			return
+		}
 		act.SetStatus(ActionFailed)
 		act.SetErr(exeErr)
 		deploy.PBErrLogger(act.GetErr(), logger).Error()
@@ -117,6 +132,87 @@ func ExecuteAction(act Action, wg *sync.WaitGroup) {
 	logger.Debug("Finish to execute action")
 }
 
+// executeWithRetry executes the action following its retry policy until an attempt succeeded, the error
+// can't be retried or ctx is done. The attempts are recorded in the action and its execute log.
+func executeWithRetry(ctx context.Context, executor Executor, act Action) *pb.Error {
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	policy := act.GetRetryPolicy()
+	maxAttempts := policy.GetMaxAttempts()
+	for attempt := 1; ; attempt++ {
+		act.SetAttempts(attempt)
+		if policy != nil {
+			writeAttemptLog(act, "[attempt %d/%d] start at %s\n", attempt, maxAttempts, time.Now().Format("2006-01-02 15:04:05"))
+		}
+
+		exeErr := executeAttempt(ctx, executor, act, policy.GetAttemptTimeout())
+		if exeErr == nil {
+			return nil
+		}
+
+		if ctx.Err() != nil || !policy.ShouldRetry(attempt, exeErr) {
+			if policy != nil {
+				writeAttemptLog(act, "[attempt %d/%d] failed: %s, %s\n", attempt, maxAttempts, exeErr.GetReason(), exeErr.GetDetail())
+			}
+			if attempt > 1 {
+				exeErr = &pb.Error{
+					Reason:     exeErr.GetReason(),
+					Detail:     fmt.Sprintf(consts.MsgActionAttemptsDetail, attempt, exeErr.GetDetail()),
+					FixMethods: exeErr.GetFixMethods(),
+				}
+			}
+			return exeErr
+		}
+
+		backoff := policy.GetBackoff(attempt)
+		writeAttemptLog(act, "[attempt %d/%d] failed: %s, %s, retry in %v\n", attempt, maxAttempts, exeErr.GetReason(), exeErr.GetDetail(), backoff)
+		logger.Warnf("Attempt %d/%d failed: %s, retry in %v", attempt, maxAttempts, exeErr.GetReason(), backoff)
+
+		select {
+		case <-ctx.Done():
+			return exeErr
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// executeAttempt executes the action once, the execution will be interrupted if it doesn't finish in timeout.
+func executeAttempt(ctx context.Context, executor Executor, act Action, timeout time.Duration) *pb.Error {
+	if timeout <= 0 {
+		return executor.Execute(ctx, act)
+	}
+
+	attemptCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	exeErr := executor.Execute(attemptCtx, act)
+	if exeErr != nil && attemptCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		return &pb.Error{
+			Reason:     consts.MsgActionTimedOut,
+			Detail:     fmt.Sprintf(consts.MsgActionTimedOutDetail, timeout),
+			FixMethods: exeErr.GetFixMethods(),
+		}
+	}
+	return exeErr
+}
+
+func writeAttemptLog(act Action, format string, args ...interface{}) {
+	if buf := act.GetExecuteLogBuffer(); buf != nil {
+		fmt.Fprintf(buf, format, args...)
+	}
+}
+
+// abortAction marks the action as aborted with the reason of cause.
+func abortAction(act Action, cause error) {
+	act.SetStatus(ActionAborted)
+	act.SetErr(&pb.Error{
+		Reason: consts.MsgActionAborted,
+		Detail: cause.Error(),
+	})
+}
+
 func errOfTypeMismatched(expected, actual interface{}) *pb.Error {
 	return &pb.Error{
 		Reason: consts.MsgActionTypeMismatched,
@@ -155,9 +251,9 @@ func setup(act Action) error {
 		}
 	}
 
-	// TODO: setup exeute log buffer with a thread safe ReadWriter.
+	// The execute log buffer can be followed by others while the action is executing.
 	if act.GetExecuteLogBuffer() == nil {
-		act.SetExecuteLogBuffer(&bytes.Buffer{})
+		act.SetExecuteLogBuffer(utils.NewLogBuffer())
 	}
 	return nil
 }
@@ -209,6 +305,11 @@ func writeExecuteLogs(act Action) {
 		logrus.Warning(consts.ErrEmptyAction)
 		return
 	}
+	// Nothing will be written into the execute log buffer after the action executed,
+	// so close it to tell its followers.
+	if closer, ok := act.GetExecuteLogBuffer().(io.Closer); ok {
+		defer closer.Close()
+	}
 	logger := logrus.WithFields(logrus.Fields{
 		consts.LogFieldAction: act.GetName(),
 	})
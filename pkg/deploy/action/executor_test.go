@@ -15,11 +15,18 @@
 package action
 
 import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -33,7 +40,7 @@ type actionMockupForExecutorTest struct {
 
 type executorMockupForExecutorTest struct{}
 
-func (e *executorMockupForExecutorTest) Execute(act Action) *pb.Error {
+func (e *executorMockupForExecutorTest) Execute(ctx context.Context, act Action) *pb.Error {
 	mockupAct, ok := act.(*actionMockupForExecutorTest)
 	if !ok {
 		return new(pb.Error)
@@ -96,7 +103,7 @@ func TestExecuteAction(t *testing.T) {
 	for _, tt := range input {
 		var wg sync.WaitGroup
 		wg.Add(1)
-		ExecuteAction(tt.action, &wg)
+		ExecuteAction(context.Background(), tt.action, &wg)
 		wg.Wait()
 
 		assert.Equal(t, tt.wantStatus, tt.action.GetStatus())
@@ -106,3 +113,87 @@ func TestExecuteAction(t *testing.T) {
 	// cleanup
 	_executorRegistry = nil
 }
+
+// flakyExecutorMockup fails the first failures attempts, and blocks until ctx is done if hang is set.
+type flakyExecutorMockup struct {
+	failures int
+	hang     bool
+	attempts int
+}
+
+func (e *flakyExecutorMockup) Execute(ctx context.Context, act Action) *pb.Error {
+	e.attempts++
+	if e.hang {
+		<-ctx.Done()
+		return &pb.Error{Reason: "interrupted"}
+	}
+	if e.attempts <= e.failures {
+		return &pb.Error{Reason: "flaky", Detail: "failed randomly"}
+	}
+	return nil
+}
+
+func TestExecuteWithRetry(t *testing.T) {
+	newAction := func(policy *RetryPolicy) Action {
+		return &actionMockupForExecutorTest{
+			Base: Base{
+				Name:             "action",
+				ActionType:       ActionTypeTestExecutorMockup,
+				ExecuteLogBuffer: &bytes.Buffer{},
+				RetryPolicy:      policy,
+			},
+		}
+	}
+
+	// succeeded after retry
+	act := newAction(&RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond})
+	executor := &flakyExecutorMockup{failures: 2}
+	assert.Nil(t, executeWithRetry(context.Background(), executor, act))
+	assert.Equal(t, 3, act.GetAttempts())
+	log := act.GetExecuteLogBuffer().(*bytes.Buffer).String()
+	assert.Equal(t, 3, strings.Count(log, "start at"))
+	assert.Equal(t, 2, strings.Count(log, "failed: flaky, failed randomly, retry in"))
+
+	// failed after all attempts
+	act = newAction(&RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond})
+	executor = &flakyExecutorMockup{failures: 2}
+	exeErr := executeWithRetry(context.Background(), executor, act)
+	assert.NotNil(t, exeErr)
+	assert.Equal(t, "flaky", exeErr.GetReason())
+	assert.Equal(t, "failed after 2 attempts: failed randomly", exeErr.GetDetail())
+	assert.Equal(t, 2, act.GetAttempts())
+
+	// the reason is not retryable
+	act = newAction(&RetryPolicy{MaxAttempts: 3, RetryableReasons: []string{consts.MsgActionTimedOut}})
+	executor = &flakyExecutorMockup{failures: 2}
+	assert.NotNil(t, executeWithRetry(context.Background(), executor, act))
+	assert.Equal(t, 1, act.GetAttempts())
+
+	// no retry without a policy
+	act = newAction(nil)
+	executor = &flakyExecutorMockup{failures: 1}
+	assert.NotNil(t, executeWithRetry(context.Background(), executor, act))
+	assert.Equal(t, 1, act.GetAttempts())
+	assert.Empty(t, act.GetExecuteLogBuffer().(*bytes.Buffer).String())
+
+	// the hung attempts are interrupted by the timeout
+	act = newAction(&RetryPolicy{MaxAttempts: 2, AttemptTimeout: 10 * time.Millisecond, RetryableReasons: []string{consts.MsgActionTimedOut}})
+	executor = &flakyExecutorMockup{hang: true}
+	exeErr = executeWithRetry(context.Background(), executor, act)
+	assert.NotNil(t, exeErr)
+	assert.Equal(t, consts.MsgActionTimedOut, exeErr.GetReason())
+	assert.Equal(t, 2, executor.attempts)
+}
+
+// replayFixture makes the machines replay the fixture under testdata, the returned function stops replaying.
+func replayFixture(t *testing.T, name string) func() {
+	fixture, err := machine.LoadFixture(filepath.Join("testdata", name))
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	machine.SetReplayFixture(fixture)
+	return func() {
+		machine.SetReplayFixture(nil)
+	}
+}
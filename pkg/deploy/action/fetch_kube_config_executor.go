@@ -16,6 +16,7 @@ package action
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/sirupsen/logrus"
 
@@ -32,7 +33,7 @@ func init() {
 type fetchKubeConfigExecutor struct {
 }
 
-func (a *fetchKubeConfigExecutor) Execute(act Action) *pb.Error {
+func (a *fetchKubeConfigExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	kubeCfgAction, ok := act.(*FetchKubeConfigAction)
 	if !ok {
 		return errOfTypeMismatched(new(FetchKubeConfigAction), act)
@@ -25,6 +25,7 @@ const ActionTypeInitMaster Type = "InitMaster"
 
 type InitMasterActionConfig struct {
 	CertKey         string
+	BootstrapToken  string
 	Node            *pb.Node
 	Roles           []string
 	MasterNodes     []*pb.Node
@@ -35,11 +36,12 @@ type InitMasterActionConfig struct {
 
 type InitMasterAction struct {
 	Base
-	CertKey       string
-	Roles         []string
-	MasterNodes   []*pb.Node
-	EtcdNodes     []*pb.Node
-	ClusterConfig *pb.ClusterConfig
+	CertKey        string
+	BootstrapToken string
+	Roles          []string
+	MasterNodes    []*pb.Node
+	EtcdNodes      []*pb.Node
+	ClusterConfig  *pb.ClusterConfig
 }
 
 func NewInitMasterAction(cfg *InitMasterActionConfig) (Action, error) {
@@ -61,10 +63,11 @@ func NewInitMasterAction(cfg *InitMasterActionConfig) (Action, error) {
 			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
 			CreationTimestamp: time.Now(),
 		},
-		CertKey:       cfg.CertKey,
-		Roles:         cfg.Roles,
-		MasterNodes:   cfg.MasterNodes,
-		EtcdNodes:     cfg.EtcdNodes,
-		ClusterConfig: cfg.ClusterConfig,
+		CertKey:        cfg.CertKey,
+		BootstrapToken: cfg.BootstrapToken,
+		Roles:          cfg.Roles,
+		MasterNodes:    cfg.MasterNodes,
+		EtcdNodes:      cfg.EtcdNodes,
+		ClusterConfig:  cfg.ClusterConfig,
 	}, nil
 }
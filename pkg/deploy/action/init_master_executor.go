@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -31,7 +32,7 @@ func init() {
 type initMasterExecutor struct {
 }
 
-func (a *initMasterExecutor) Execute(act Action) *pb.Error {
+func (a *initMasterExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	action, ok := act.(*InitMasterAction)
 	if !ok {
 		return errOfTypeMismatched(new(InitMasterAction), act)
@@ -49,14 +50,16 @@ func (a *initMasterExecutor) Execute(act Action) *pb.Error {
 		needUntaint = true
 	}
 	config := &master.InitMasterOperationConfig{
-		Logger:        logger,
-		CertKey:       action.CertKey,
-		Node:          action.Node,
-		NeedUntaint:   needUntaint,
-		MasterNodes:   action.MasterNodes,
-		EtcdNodes:     action.EtcdNodes,
-		ClusterConfig: action.ClusterConfig,
-		LogWriter:     action.GetExecuteLogBuffer(),
+		Context:        ctx,
+		Logger:         logger,
+		CertKey:        action.CertKey,
+		BootstrapToken: action.BootstrapToken,
+		Node:           action.Node,
+		NeedUntaint:    needUntaint,
+		MasterNodes:    action.MasterNodes,
+		EtcdNodes:      action.EtcdNodes,
+		ClusterConfig:  action.ClusterConfig,
+		LogWriter:      action.GetExecuteLogBuffer(),
 	}
 
 	op, err := master.NewInitMasterOperation(config)
@@ -80,3 +83,21 @@ func (a *initMasterExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Finish to execute init master action")
 	return nil
 }
+
+// Plan returns the kubeadm config and certificates which will be put to the first master and the commands which
+// will be run on it.
+func (a *initMasterExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	action, ok := act.(*InitMasterAction)
+	if !ok {
+		return nil, errTypeMismatched(new(InitMasterAction), act)
+	}
+
+	return master.PlanInitMaster(&master.InitMasterOperationConfig{
+		CertKey:        action.CertKey,
+		BootstrapToken: action.BootstrapToken,
+		Node:           action.Node,
+		MasterNodes:    action.MasterNodes,
+		EtcdNodes:      action.EtcdNodes,
+		ClusterConfig:  action.ClusterConfig,
+	})
+}
@@ -15,6 +15,12 @@
 package action
 
 import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -56,7 +62,7 @@ func TestInitMaster(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, normalAction)
 
-	pbErr := executor.Execute(normalAction)
+	pbErr := executor.Execute(context.Background(), normalAction)
 	assert.Nil(t, pbErr)
 
 	errorAction, err := NewInitMasterAction(&InitMasterActionConfig{
@@ -69,7 +75,69 @@ func TestInitMaster(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, errorAction)
 
-	pbErr = executor.Execute(errorAction)
+	pbErr = executor.Execute(context.Background(), errorAction)
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 }
+
+func TestInitMasterReplay(t *testing.T) {
+	defer replayFixture(t, "init_master_fixture.json")()
+
+	// the control plane is not ready before kubeadm init, and then it's healthy
+	var healthChecks int
+	apiServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		healthChecks++
+		if healthChecks == 1 {
+			w.Write([]byte("[-]poststarthook/rbac/bootstrap-roles failed: not finished"))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer apiServer.Close()
+	apiServerURL, err := url.Parse(apiServer.URL)
+	assert.NoError(t, err)
+	apiServerPort, err := strconv.Atoi(apiServerURL.Port())
+	assert.NoError(t, err)
+
+	newAction := func(name string) Action {
+		node := &pb.Node{
+			Name: name,
+			Ip:   "192.168.1.11",
+		}
+		action, err := NewInitMasterAction(&InitMasterActionConfig{
+			BootstrapToken: "abcdef.0123456789abcdef",
+			Node:           node,
+			MasterNodes:    []*pb.Node{node},
+			EtcdNodes:      []*pb.Node{node},
+			ClusterConfig: &pb.ClusterConfig{
+				KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+					Type: "loadbalancer",
+					Loadbalancer: &pb.Loadbalancer{
+						Ip:   "127.0.0.1",
+						Port: uint32(apiServerPort),
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		action.SetExecuteLogBuffer(new(bytes.Buffer))
+		return action
+	}
+
+	executor := new(initMasterExecutor)
+
+	action := newAction("master1")
+	assert.Nil(t, executor.Execute(context.Background(), action))
+	assert.Equal(t, 2, healthChecks)
+	assert.Contains(t, action.GetExecuteLogBuffer().(*bytes.Buffer).String(), "control-plane has initialized successfully")
+	assert.Regexp(t, `transferred \d+ bytes to master1:/etc/kubernetes/kubeadm_config\.yaml`, action.GetExecuteLogBuffer().(*bytes.Buffer).String())
+
+	// the preflight of kubeadm init fails
+	healthChecks = 0
+	pbErr := executor.Execute(context.Background(), newAction("master2"))
+	assert.NotNil(t, pbErr)
+	assert.Contains(t, pbErr.GetDetail(), "[ERROR Port-6443]: Port 6443 is in use")
+
+	// the node is unreachable
+	assert.NotNil(t, executor.Execute(context.Background(), newAction("error")))
+}
@@ -25,6 +25,7 @@ const ActionTypeJoinMaster Type = "JoinMaster"
 
 type JoinMasterActionConfig struct {
 	CertKey         string
+	BootstrapToken  string
 	Node            *pb.Node
 	Roles           []string
 	MasterNodes     []*pb.Node
@@ -34,10 +35,11 @@ type JoinMasterActionConfig struct {
 
 type JoinMasterAction struct {
 	Base
-	CertKey       string
-	Roles         []string
-	MasterNodes   []*pb.Node
-	ClusterConfig *pb.ClusterConfig
+	CertKey        string
+	BootstrapToken string
+	Roles          []string
+	MasterNodes    []*pb.Node
+	ClusterConfig  *pb.ClusterConfig
 }
 
 func NewJoinMasterAction(cfg *JoinMasterActionConfig) (Action, error) {
@@ -58,9 +60,10 @@ func NewJoinMasterAction(cfg *JoinMasterActionConfig) (Action, error) {
 			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
 			CreationTimestamp: time.Now(),
 		},
-		CertKey:       cfg.CertKey,
-		Roles:         cfg.Roles,
-		MasterNodes:   cfg.MasterNodes,
-		ClusterConfig: cfg.ClusterConfig,
+		CertKey:        cfg.CertKey,
+		BootstrapToken: cfg.BootstrapToken,
+		Roles:          cfg.Roles,
+		MasterNodes:    cfg.MasterNodes,
+		ClusterConfig:  cfg.ClusterConfig,
 	}, nil
 }
@@ -15,6 +15,7 @@
 package action
 
 import (
+	"context"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -31,7 +32,7 @@ func init() {
 type joinMasterExecutor struct {
 }
 
-func (a *joinMasterExecutor) Execute(act Action) *pb.Error {
+func (a *joinMasterExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	action, ok := act.(*JoinMasterAction)
 	if !ok {
 		return errOfTypeMismatched(new(JoinMasterAction), act)
@@ -50,13 +51,15 @@ func (a *joinMasterExecutor) Execute(act Action) *pb.Error {
 	}
 
 	config := &master.JoinMasterOperationConfig{
-		Logger:        logger,
-		CertKey:       action.CertKey,
-		Node:          action.Node,
-		NeedUntaint:   needUntaint,
-		MasterNodes:   action.MasterNodes,
-		ClusterConfig: action.ClusterConfig,
-		LogWriter:     action.GetExecuteLogBuffer(),
+		Context:        ctx,
+		Logger:         logger,
+		CertKey:        action.CertKey,
+		BootstrapToken: action.BootstrapToken,
+		Node:           action.Node,
+		NeedUntaint:    needUntaint,
+		MasterNodes:    action.MasterNodes,
+		ClusterConfig:  action.ClusterConfig,
+		LogWriter:      action.GetExecuteLogBuffer(),
 	}
 
 	op, err := master.NewJoinMasterOperation(config)
@@ -78,3 +81,19 @@ func (a *joinMasterExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Finish to execute join master action")
 	return nil
 }
+
+// Plan returns the commands which will be run on the master to join the control plane.
+func (a *joinMasterExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	action, ok := act.(*JoinMasterAction)
+	if !ok {
+		return nil, errTypeMismatched(new(JoinMasterAction), act)
+	}
+
+	return master.PlanJoinMaster(&master.JoinMasterOperationConfig{
+		CertKey:        action.CertKey,
+		BootstrapToken: action.BootstrapToken,
+		Node:           action.Node,
+		MasterNodes:    action.MasterNodes,
+		ClusterConfig:  action.ClusterConfig,
+	})
+}
@@ -16,6 +16,7 @@ package action
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"math"
@@ -81,7 +82,7 @@ type nodeCheckExecutor struct {
 }
 
 // due to items, ItemsCheckScripts exec remote scripts and return std, report, error
-func ExecuteCheckScript(item check.ItemEnum, config *pb.NodeCheckConfig, checkItemReport *NodeCheckItem, logChan chan<- *bytes.Buffer) (string, *NodeCheckItem, error) {
+func ExecuteCheckScript(ctx context.Context, item check.ItemEnum, config *pb.NodeCheckConfig, checkItemReport *NodeCheckItem, logChan chan<- *bytes.Buffer) (string, *NodeCheckItem, error) {
 
 	checkItemReport = newNodeCheckItem(item)
 
@@ -97,7 +98,7 @@ func ExecuteCheckScript(item check.ItemEnum, config *pb.NodeCheckConfig, checkIt
 	}
 
 	// create command and run on remote node
-	stdOut, stdErr, err := checkItems.RunCommands(config, logChan)
+	stdOut, stdErr, err := checkItems.RunCommands(ctx, config, logChan)
 	if err != nil {
 		checkItemReport.Status = ItemFailed
 		checkItemReport.Err = new(pb.Error)
@@ -121,7 +122,7 @@ func newNodeCheckItem(item check.ItemEnum) *NodeCheckItem {
 }
 
 // goroutine as executor for check docker
-func CheckDockerExecutor(ncAction *NodeCheckAction, checkChan chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckDockerExecutor(ctx context.Context, ncAction *NodeCheckAction, checkChan chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -134,7 +135,7 @@ func CheckDockerExecutor(ncAction *NodeCheckAction, checkChan chan<- *NodeCheckI
 
 	checkItemReport := newNodeCheckItem(check.Docker)
 
-	comparedDockerVersion, checkItemReport, err := ExecuteCheckScript(check.Docker, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	comparedDockerVersion, checkItemReport, err := ExecuteCheckScript(ctx, check.Docker, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check docker failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -157,7 +158,7 @@ func CheckDockerExecutor(ncAction *NodeCheckAction, checkChan chan<- *NodeCheckI
 }
 
 // goroutine as executor for check CPU
-func CheckCPUExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckCPUExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -170,7 +171,7 @@ func CheckCPUExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logCh
 
 	checkItemReport := newNodeCheckItem(check.CPU)
 
-	cpuCore, checkItemReport, err := ExecuteCheckScript(check.CPU, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	cpuCore, checkItemReport, err := ExecuteCheckScript(ctx, check.CPU, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check cpu failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -210,7 +211,7 @@ func CheckCPUExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logCh
 }
 
 // goroutine as executor for check kernel
-func CheckKernelExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckKernelExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -223,7 +224,7 @@ func CheckKernelExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 
 	checkItemReport := newNodeCheckItem(check.Kernel)
 
-	kernelVersion, checkItemReport, err := ExecuteCheckScript(check.Kernel, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	kernelVersion, checkItemReport, err := ExecuteCheckScript(ctx, check.Kernel, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check kernel failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -246,7 +247,7 @@ func CheckKernelExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 }
 
 // goroutine as executor for check memory
-func CheckMemoryExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckMemoryExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -259,7 +260,7 @@ func CheckMemoryExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 
 	checkItemReport := newNodeCheckItem(check.Memory)
 
-	memoryCap, checkItemReport, err := ExecuteCheckScript(check.Memory, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	memoryCap, checkItemReport, err := ExecuteCheckScript(ctx, check.Memory, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check memory failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -301,7 +302,7 @@ func CheckMemoryExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, lo
 }
 
 // goroutine as executor for check disk
-func CheckRootDiskExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckRootDiskExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -314,7 +315,7 @@ func CheckRootDiskExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem,
 
 	checkItemReport := newNodeCheckItem(check.Disk)
 
-	rootDiskVolume, checkItemReport, err := ExecuteCheckScript(check.Disk, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	rootDiskVolume, checkItemReport, err := ExecuteCheckScript(ctx, check.Disk, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check root disk failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -355,7 +356,7 @@ func CheckRootDiskExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem,
 }
 
 // goroutine as executor for check distribution
-func CheckDistributionExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckDistributionExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -368,7 +369,7 @@ func CheckDistributionExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 
 	checkItemReport := newNodeCheckItem(check.Distribution)
 
-	disName, checkItemReport, err := ExecuteCheckScript(check.Distribution, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	disName, checkItemReport, err := ExecuteCheckScript(ctx, check.Distribution, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check distro failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -392,7 +393,7 @@ func CheckDistributionExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 }
 
 // goroutine as executor for check system preference
-func CheckSysPrefExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckSysPrefExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -405,7 +406,7 @@ func CheckSysPrefExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, l
 
 	checkItemReport := newNodeCheckItem(check.SystemPreference)
 
-	_, checkItemReport, err := ExecuteCheckScript(check.SystemPreference, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	_, checkItemReport, err := ExecuteCheckScript(ctx, check.SystemPreference, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Debugf("%v: %v", CheckFailed, err)
 		checkItemReport.Status = ItemFailed
@@ -422,7 +423,7 @@ func CheckSysPrefExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, l
 }
 
 // goroutine as executor for check system manager
-func CheckSysManagerExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckSysManagerExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -435,7 +436,7 @@ func CheckSysManagerExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem
 
 	checkItemReport := newNodeCheckItem(check.SystemManager)
 
-	systemManager, checkItemReport, err := ExecuteCheckScript(check.SystemManager, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	systemManager, checkItemReport, err := ExecuteCheckScript(ctx, check.SystemManager, ncAction.NodeCheckConfig, checkItemReport, logChan)
 	if err != nil {
 		logger.Errorf("check system manager failed, err: %v", err)
 		checkItemReport.Status = ItemFailed
@@ -458,7 +459,7 @@ func CheckSysManagerExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem
 }
 
 // goroutine as executor for port occupied check
-func CheckPortOccupiedExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+func CheckPortOccupiedExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
 
 	defer wg.Done()
 
@@ -471,7 +472,7 @@ func CheckPortOccupiedExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 
 	checkItemReport := newNodeCheckItem(check.PortOccupied)
 
-	portOccupied, checkItemReport, err := ExecuteCheckScript(check.PortOccupied, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	portOccupied, checkItemReport, err := ExecuteCheckScript(ctx, check.PortOccupied, ncAction.NodeCheckConfig, checkItemReport, logChan)
 
 	// trim can be done whatever error occurs
 	portOccupied = strings.TrimRight(portOccupied, ",")
@@ -496,7 +497,47 @@ func CheckPortOccupiedExecutor(ncAction *NodeCheckAction, ch chan<- *NodeCheckIt
 	ch <- checkItemReport
 }
 
-func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
+// goroutine as executor for root privilege check
+func CheckSudoExecutor(ctx context.Context, ncAction *NodeCheckAction, ch chan<- *NodeCheckItem, logChan chan<- *bytes.Buffer) {
+
+	defer wg.Done()
+
+	logger := logrus.WithFields(logrus.Fields{
+		"node":       ncAction.Node.Name,
+		"check_item": "sudo",
+	})
+
+	logrus.Debug("Start to execute check sudo")
+
+	checkItemReport := newNodeCheckItem(check.Sudo)
+
+	uid, checkItemReport, err := ExecuteCheckScript(ctx, check.Sudo, ncAction.NodeCheckConfig, checkItemReport, logChan)
+	if err != nil {
+		logger.Errorf("check sudo failed, err: %v", err)
+		checkItemReport.Status = ItemFailed
+	}
+
+	err = check.CheckSudo(uid)
+	if err != nil {
+		logger.Debugf("%v: %v", CheckFailed, err)
+		checkItemReport.Status = ItemFailed
+		checkItemReport.Err = new(pb.Error)
+		checkItemReport.Err.Reason = "no root privilege"
+		checkItemReport.Err.Detail = err.Error()
+		if ncAction.Node.GetSsh().GetEscalation().GetMethod() == "" {
+			checkItemReport.Err.FixMethods = "please login as root, or enable the privilege escalation of the node by sudo or su"
+		} else {
+			checkItemReport.Err.FixMethods = "please ensure the login user is allowed to run all commands by sudo, or the password of sudo or su is correct"
+		}
+	} else {
+		logger.Debug(CheckPassed)
+		checkItemReport.Status = ItemDone
+	}
+
+	ch <- checkItemReport
+}
+
+func (a *nodeCheckExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	nodeCheckAction, ok := act.(*NodeCheckAction)
 	if !ok {
 		return errOfTypeMismatched(new(NodeCheckAction), act)
@@ -512,7 +553,7 @@ func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 	executeLogBuf := act.GetExecuteLogBuffer()
 
 	// build items function
-	checkItemFunctions := []func(*NodeCheckAction, chan<- *NodeCheckItem, chan<- *bytes.Buffer){
+	checkItemFunctions := []func(context.Context, *NodeCheckAction, chan<- *NodeCheckItem, chan<- *bytes.Buffer){
 		CheckDockerExecutor,
 		CheckCPUExecutor,
 		CheckKernelExecutor,
@@ -522,16 +563,20 @@ func (a *nodeCheckExecutor) Execute(act Action) *pb.Error {
 		CheckSysPrefExecutor,
 		CheckSysManagerExecutor,
 		CheckPortOccupiedExecutor,
+		CheckSudoExecutor,
 	}
 
+	// clear the items of the previous attempt
+	nodeCheckAction.CheckItems = nil
+
 	// make enough length of check items
 	nodeCheckch := make(chan *NodeCheckItem, len(checkItemFunctions))
 	nodeLogch := make(chan *bytes.Buffer, len(checkItemFunctions))
 
-	// check docker, CPU, kernel, memory, disk, distribution, system preference, system manager, port occupied
+	// check docker, CPU, kernel, memory, disk, distribution, system preference, system manager, port occupied, sudo
 	for _, function := range checkItemFunctions {
 		wg.Add(1)
-		go function(nodeCheckAction, nodeCheckch, nodeLogch)
+		go function(ctx, nodeCheckAction, nodeCheckch, nodeLogch)
 	}
 
 	wg.Wait()
@@ -16,8 +16,11 @@ package action
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 	"sync"
 
@@ -25,6 +28,8 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
 	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -44,7 +49,7 @@ func init() {
 type nodeInitExecutor struct{}
 
 // due to items, ItemInitScripts exec remote scripts and return std, report, error
-func ExecuteInitScript(item it.ItemEnum, action *NodeInitAction, initItemReport *NodeInitItem, logChan chan<- *bytes.Buffer) (string, *NodeInitItem, error) {
+func ExecuteInitScript(ctx context.Context, item it.ItemEnum, action *NodeInitAction, initItemReport *NodeInitItem, logChan chan<- *bytes.Buffer) (string, *NodeInitItem, error) {
 	logger := logrus.WithFields(logrus.Fields{
 		"node":      action.Node.GetName(),
 		"init_item": item,
@@ -68,7 +73,7 @@ func ExecuteInitScript(item it.ItemEnum, action *NodeInitAction, initItemReport
 		return "", initItemReport, fmt.Errorf("fail to construct init %v operation for node %v: ", item, action.Node.Name)
 	}
 
-	stdOut, stdErr, err := initItem.RunCommands(action.Node, initAction, logChan)
+	stdOut, stdErr, err := initItem.RunCommands(ctx, action.Node, initAction, logChan)
 	if err != nil {
 		logger.Errorf("can not execute init %v operation command, err: %v", item, err)
 		initItemReport.Status = ItemFailed
@@ -94,7 +99,7 @@ func newNodeInitItem(item it.ItemEnum) *NodeInitItem {
 }
 
 // goroutine exec item init event and write to channel
-func InitAsyncExecutor(item it.ItemEnum, ncAction *NodeInitAction, ch chan<- *NodeInitItem, logChan chan<- *bytes.Buffer) {
+func InitAsyncExecutor(ctx context.Context, item it.ItemEnum, ncAction *NodeInitAction, ch chan<- *NodeInitItem, logChan chan<- *bytes.Buffer) {
 
 	defer initWg.Done()
 
@@ -106,7 +111,7 @@ func InitAsyncExecutor(item it.ItemEnum, ncAction *NodeInitAction, ch chan<- *No
 	logger.Debugf("Start to execute init")
 
 	initItemReport := newNodeInitItem(item)
-	_, initItemReport, err := ExecuteInitScript(item, ncAction, initItemReport, logChan)
+	_, initItemReport, err := ExecuteInitScript(ctx, item, ncAction, initItemReport, logChan)
 	if err != nil {
 		logger.Errorf("%v: %v", InitFailed, err)
 		initItemReport.Status = ItemFailed
@@ -119,7 +124,7 @@ func InitAsyncExecutor(item it.ItemEnum, ncAction *NodeInitAction, ch chan<- *No
 	ch <- initItemReport
 }
 
-func (a *nodeInitExecutor) Execute(act Action) *pb.Error {
+func (a *nodeInitExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	nodeInitAction, ok := act.(*NodeInitAction)
 	if !ok {
 		return errOfTypeMismatched(new(NodeInitAction), act)
@@ -132,6 +137,28 @@ func (a *nodeInitExecutor) Execute(act Action) *pb.Error {
 
 	executeLogBuf := act.GetExecuteLogBuffer()
 
+	// Connect the node first, so the failure to connect it is told apart from the failures of the init items,
+	// the former is usually temporary and can be retried.
+	m, err := machine.NewMachine(nodeInitAction.Node)
+	var notPinned *mssh.HostKeyNotPinnedError
+	if errors.As(err, &notPinned) {
+		logger.Errorf("failed to connect node: %v", err)
+		return &pb.Error{
+			Reason:     consts.MsgHostKeyNotPinned,
+			Detail:     err.Error(),
+			FixMethods: consts.MsgHostKeyNotPinnedFixMethods,
+		}
+	}
+	if err != nil {
+		logger.Errorf("failed to connect node: %v", err)
+		return &pb.Error{
+			Reason:     consts.MsgNodeConnectionFailed,
+			Detail:     err.Error(),
+			FixMethods: "check the node is reachable and its login is correct",
+		}
+	}
+	m.Close()
+
 	initGroup := constructInitGroup(nodeInitAction)
 	if len(initGroup) == 0 {
 		logger.Error("item initialization group is empty")
@@ -142,13 +169,16 @@ func (a *nodeInitExecutor) Execute(act Action) *pb.Error {
 		}
 	}
 
+	// clear the items of the previous attempt
+	nodeInitAction.InitItems = nil
+
 	// make enough length of init items
 	initChan := make(chan *NodeInitItem, len(initGroup))
 	logChan := make(chan *bytes.Buffer, len(initGroup))
 
 	for item := range initGroup {
 		initWg.Add(1)
-		go InitAsyncExecutor(item, nodeInitAction, initChan, logChan)
+		go InitAsyncExecutor(ctx, item, nodeInitAction, initChan, logChan)
 	}
 
 	initWg.Wait()
@@ -248,3 +278,47 @@ func constructInitGroup(nodeInitAction *NodeInitAction) map[it.ItemEnum]bool {
 
 	return initGroup
 }
+
+// Plan returns the scripts which will be put to the node and the commands which will be run on it for all the init items.
+func (a *nodeInitExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	nodeInitAction, ok := act.(*NodeInitAction)
+	if !ok {
+		return nil, errTypeMismatched(new(NodeInitAction), act)
+	}
+
+	initAction := &operation.NodeInitAction{
+		NodeInitConfig: nodeInitAction.NodeInitConfig,
+		NodesConfig:    nodeInitAction.NodesConfig,
+		ClusterConfig:  nodeInitAction.ClusterConfig,
+	}
+
+	// the init items are executed parallelly, sort them to make the plan stable.
+	var items []string
+	for item := range constructInitGroup(nodeInitAction) {
+		items = append(items, string(item))
+	}
+	sort.Strings(items)
+
+	plan := new(pb.ActionPlan)
+	filePlanned := make(map[string]bool)
+	for _, item := range items {
+		initItem := it.NewInitOperations().CreateOperations(it.ItemEnum(item), initAction)
+		if initItem == nil {
+			return nil, fmt.Errorf("fail to construct init %v operation for node %v", item, nodeInitAction.Node.GetName())
+		}
+		itemPlan, err := initItem.Plan(nodeInitAction.Node, initAction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan init %v: %v", item, err)
+		}
+		// some scripts are shared by the init items
+		for _, file := range itemPlan.GetFiles() {
+			if !filePlanned[file.GetPath()] {
+				filePlanned[file.GetPath()] = true
+				plan.Files = append(plan.Files, file)
+			}
+		}
+		plan.Commands = append(plan.Commands, itemPlan.GetCommands()...)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"fmt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Planner is implemented by the executors which can tell what an action will do without executing it.
+// A planner must not connect to any node.
+type Planner interface {
+	Plan(act Action) (*pb.ActionPlan, error)
+}
+
+// PlanAction returns what the action will do. If the executor of the action is not a Planner,
+// the plan has no files or commands; if the action can't be planned, the plan has an error.
+func PlanAction(act Action) *pb.ActionPlan {
+	var plan *pb.ActionPlan
+	executor, err := NewExecutor(act.GetType())
+	if err == nil {
+		if planner, ok := executor.(Planner); ok {
+			plan, err = planner.Plan(act)
+		}
+	}
+
+	if plan == nil {
+		plan = new(pb.ActionPlan)
+	}
+	if err != nil {
+		plan.Err = &pb.Error{
+			Reason: consts.MsgActionPlanFailed,
+			Detail: err.Error(),
+		}
+	}
+
+	plan.Name = act.GetName()
+	plan.Type = string(act.GetType())
+	// The commands of some actions are run on another node, such as the master.
+	if plan.NodeName == "" {
+		plan.NodeName = act.GetNode().GetName()
+	}
+	return plan
+}
+
+func errTypeMismatched(expected, actual interface{}) error {
+	return fmt.Errorf(consts.MsgActionTypeMismatchedDetail, expected, actual)
+}
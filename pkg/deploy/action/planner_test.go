@@ -0,0 +1,104 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestPlanInitMasterAction(t *testing.T) {
+	master := &pb.Node{
+		Name: "master1",
+		Ip:   "10.1.1.1",
+	}
+	act, err := NewInitMasterAction(&InitMasterActionConfig{
+		BootstrapToken: "abcdef.0123456789abcdef",
+		Node:           master,
+		MasterNodes:    []*pb.Node{master},
+		EtcdNodes:      []*pb.Node{master},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{
+				Type: "firstMasterIP",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	plan, err := new(initMasterExecutor).Plan(act)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, plan.GetCommands())
+
+	var kubeadmConfig string
+	for _, file := range plan.GetFiles() {
+		if strings.Contains(file.GetContent(), "ClusterConfiguration") {
+			kubeadmConfig = file.GetContent()
+		}
+	}
+	assert.Contains(t, kubeadmConfig, "10.1.1.1")
+	assert.Contains(t, kubeadmConfig, "abcdef.0123456789abcdef")
+	assert.Contains(t, kubeadmConfig, "ttl: 24h0m0s")
+}
+
+func TestPlanNodeInitAction(t *testing.T) {
+	node := &pb.Node{
+		Name: "node1",
+		Ip:   "10.1.1.1",
+	}
+	act, err := NewNodeInitAction(&NodeInitActionConfig{
+		NodeInitConfig: &pb.NodeDeployConfig{
+			Node:  node,
+			Roles: []string{"worker"},
+		},
+		NodesConfig:   []*pb.NodeDeployConfig{{Node: node, Roles: []string{"worker"}}},
+		ClusterConfig: &pb.ClusterConfig{},
+	})
+	assert.NoError(t, err)
+
+	plan, err := new(nodeInitExecutor).Plan(act)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, plan.GetCommands())
+
+	paths := make(map[string]bool)
+	for _, file := range plan.GetFiles() {
+		assert.False(t, paths[file.GetPath()], "file %v is planned more than once", file.GetPath())
+		paths[file.GetPath()] = true
+		assert.NotEmpty(t, file.GetContent())
+	}
+}
+
+func TestPlanActionTypeMismatched(t *testing.T) {
+	act, err := NewNodeInitAction(&NodeInitActionConfig{
+		NodeInitConfig: &pb.NodeDeployConfig{Node: &pb.Node{Name: "node1"}},
+	})
+	assert.NoError(t, err)
+
+	_, err = new(initMasterExecutor).Plan(act)
+	assert.Error(t, err)
+
+	// an action type which has no executor
+	act.(*NodeInitAction).ActionType = "unknown"
+	plan := PlanAction(act)
+	if assert.NotNil(t, plan.GetErr()) {
+		assert.Equal(t, consts.MsgActionPlanFailed, plan.GetErr().GetReason())
+	}
+	assert.Equal(t, act.GetName(), plan.GetName())
+	assert.Equal(t, "unknown", plan.GetType())
+	assert.Equal(t, "node1", plan.GetNodeName())
+}
@@ -0,0 +1,86 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// The action types of the steps to remove a node, they share the RemoveNodeAction.
+const (
+	ActionTypeDrainNode        Type = "DrainNode"
+	ActionTypeRemoveEtcdMember Type = "RemoveEtcdMember"
+	ActionTypeResetNode        Type = "ResetNode"
+	ActionTypeDeleteNode       Type = "DeleteNode"
+)
+
+// RemoveNodeActionTypes are the steps to remove a node, in the order they are done.
+var RemoveNodeActionTypes = []Type{ActionTypeDrainNode, ActionTypeRemoveEtcdMember, ActionTypeResetNode, ActionTypeDeleteNode}
+
+type RemoveNodeActionConfig struct {
+	// Step is the action type of the step.
+	Step Type
+	// Node is the node to remove.
+	Node *pb.Node
+	// MasterNodes are the masters which remain in the cluster.
+	MasterNodes []*pb.Node
+	// EtcdNodes are the etcd nodes which remain in the cluster.
+	EtcdNodes       []*pb.Node
+	LogFileBasePath string
+}
+
+// RemoveNodeAction is a step to remove a node from the cluster.
+type RemoveNodeAction struct {
+	Base
+	MasterNodes []*pb.Node
+	EtcdNodes   []*pb.Node
+}
+
+func NewRemoveNodeAction(cfg *RemoveNodeActionConfig) (Action, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("action config is nil")
+	}
+	if cfg.Node == nil {
+		return nil, fmt.Errorf("invalid action config: node is nil")
+	}
+	if !isRemoveNodeActionType(cfg.Step) {
+		return nil, fmt.Errorf("invalid action config: unknown step %q", cfg.Step)
+	}
+
+	actionName := GenActionName(cfg.Step)
+	return &RemoveNodeAction{
+		Base: Base{
+			Name:              actionName,
+			Node:              cfg.Node,
+			ActionType:        cfg.Step,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
+			CreationTimestamp: time.Now(),
+		},
+		MasterNodes: cfg.MasterNodes,
+		EtcdNodes:   cfg.EtcdNodes,
+	}, nil
+}
+
+func isRemoveNodeActionType(actionType Type) bool {
+	for _, step := range RemoveNodeActionTypes {
+		if actionType == step {
+			return true
+		}
+	}
+	return false
+}
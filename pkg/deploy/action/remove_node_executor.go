@@ -0,0 +1,118 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/remove"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	for _, step := range RemoveNodeActionTypes {
+		RegisterExecutor(step, new(removeNodeExecutor))
+	}
+}
+
+// removeNodeExecutor executes all the steps to remove a node, by the action type.
+type removeNodeExecutor struct {
+}
+
+func (a *removeNodeExecutor) Execute(ctx context.Context, act Action) *pb.Error {
+	action, ok := act.(*RemoveNodeAction)
+	if !ok {
+		return errOfTypeMismatched(new(RemoveNodeAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debugf("Start to execute %v action on node:%v", action.GetType(), action.Node.Name)
+
+	config := &remove.RemoveNodeOperationConfig{
+		Logger:      logger,
+		Node:        action.Node,
+		MasterNodes: action.MasterNodes,
+		LogWriter:   action.GetExecuteLogBuffer(),
+		Context:     ctx,
+	}
+
+	var err error
+	switch action.GetType() {
+	case ActionTypeDrainNode:
+		err = remove.DrainNode(config)
+	case ActionTypeRemoveEtcdMember:
+		err = removeEtcdMember(ctx, logger, action)
+	case ActionTypeResetNode:
+		err = remove.ResetNode(config)
+	case ActionTypeDeleteNode:
+		err = remove.DeleteNode(config)
+	default:
+		return errOfTypeMismatched(new(RemoveNodeAction), act)
+	}
+
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to do " + string(action.GetType()) + " operation",
+			Detail:     err.Error(),
+			FixMethods: consts.FixMethodSelfAnalyseIt,
+		}
+	}
+
+	logger.Debugf("Finish to execute %v action", action.GetType())
+	return nil
+}
+
+func removeEtcdMember(ctx context.Context, logger *logrus.Entry, action *RemoveNodeAction) error {
+	op, err := etcd.NewRemoveEtcdMemberOperation(&etcd.RemoveEtcdMemberOperationConfig{
+		Logger:    logger,
+		Node:      action.Node,
+		EtcdNodes: action.EtcdNodes,
+		LogWriter: action.GetExecuteLogBuffer(),
+		Context:   ctx,
+	})
+	if err != nil {
+		return err
+	}
+
+	return op.Do()
+}
+
+// Plan returns the commands which will be run on the node, the steps done by the kubernetes api
+// are planned on the first master without commands.
+func (a *removeNodeExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	action, ok := act.(*RemoveNodeAction)
+	if !ok {
+		return nil, errTypeMismatched(new(RemoveNodeAction), act)
+	}
+
+	switch action.GetType() {
+	case ActionTypeRemoveEtcdMember:
+		return etcd.PlanRemoveEtcdMember(action.Node), nil
+	case ActionTypeResetNode:
+		return remove.PlanResetNode(), nil
+	default:
+		plan := new(pb.ActionPlan)
+		if len(action.MasterNodes) > 0 {
+			plan.NodeName = action.MasterNodes[0].GetName()
+		}
+		return plan, nil
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestRemoveNode(t *testing.T) {
+	executor := new(removeNodeExecutor)
+	masters := []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}}
+	etcdNodes := []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}}
+
+	for _, step := range RemoveNodeActionTypes {
+		normalAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{
+			Step:        step,
+			Node:        &pb.Node{Name: "normal", Ip: "10.10.10.10"},
+			MasterNodes: masters,
+			EtcdNodes:   etcdNodes,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, step, normalAction.GetType())
+
+		pbErr := executor.Execute(context.Background(), normalAction)
+		assert.Nil(t, pbErr, "step %v", step)
+	}
+
+	// the commands to reset can't be run on an unreachable node
+	errorAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{
+		Step:        ActionTypeResetNode,
+		Node:        &pb.Node{Name: "error", Ip: "10.10.10.10"},
+		MasterNodes: masters,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, executor.Execute(context.Background(), errorAction))
+
+	// the member of a dead node is removed by the remaining members, the clean on the node is best-effort
+	deadMemberAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{
+		Step:        ActionTypeRemoveEtcdMember,
+		Node:        &pb.Node{Name: "error", Ip: "10.10.10.10"},
+		MasterNodes: masters,
+		EtcdNodes:   etcdNodes,
+	})
+	assert.NoError(t, err)
+	deadMemberAction.SetExecuteLogBuffer(new(bytes.Buffer))
+	assert.Nil(t, executor.Execute(context.Background(), deadMemberAction))
+	assert.Contains(t, deadMemberAction.GetExecuteLogBuffer().(*bytes.Buffer).String(), "failed to clean it on the node")
+
+	// the last etcd member can't be removed
+	lastMemberAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{
+		Step:        ActionTypeRemoveEtcdMember,
+		Node:        &pb.Node{Name: "master1", Ip: "10.1.1.1"},
+		MasterNodes: masters,
+		EtcdNodes:   etcdNodes,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, executor.Execute(context.Background(), lastMemberAction))
+
+	_, err = NewRemoveNodeAction(&RemoveNodeActionConfig{
+		Step: ActionTypeDeployWorker,
+		Node: &pb.Node{Name: "normal", Ip: "10.10.10.10"},
+	})
+	assert.Error(t, err)
+}
+
+func TestPlanRemoveNode(t *testing.T) {
+	masters := []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}}
+	node := &pb.Node{Name: "node1", Ip: "10.10.10.10"}
+
+	drainAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{Step: ActionTypeDrainNode, Node: node, MasterNodes: masters})
+	assert.NoError(t, err)
+	plan, err := new(removeNodeExecutor).Plan(drainAction)
+	assert.NoError(t, err)
+	// the node is drained by the kubernetes api on the master
+	assert.Equal(t, "master1", plan.GetNodeName())
+	assert.Empty(t, plan.GetCommands())
+
+	resetAction, err := NewRemoveNodeAction(&RemoveNodeActionConfig{Step: ActionTypeResetNode, Node: node, MasterNodes: masters})
+	assert.NoError(t, err)
+	plan, err = new(removeNodeExecutor).Plan(resetAction)
+	assert.NoError(t, err)
+	assert.Contains(t, plan.GetCommands(), "kubeadm reset -f")
+}
@@ -0,0 +1,183 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// RetryPolicy defines how an action is executed: how long an attempt can take and
+// how many times the action is attempted before it is regarded as failed.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of attempts to execute the action, 0 or 1 means no retry.
+	MaxAttempts int
+	// Backoff is the duration to wait before the second attempt, it is doubled after each failed attempt.
+	Backoff time.Duration
+	// MaxBackoff limits the duration to wait between two attempts, 0 means no limit.
+	MaxBackoff time.Duration
+	// AttemptTimeout is the max duration of an attempt, 0 means no timeout.
+	AttemptTimeout time.Duration
+	// RetryableReasons are the reasons of the execution errors which can be retried,
+	// if it is empty, any error can be retried.
+	RetryableReasons []string
+}
+
+// DefaultRetryPolicies are the retry policies of the action types used when a task doesn't specify them,
+// the long-running actions are limited by a timeout, so that a hung command will not block the task forever.
+var DefaultRetryPolicies = map[Type]*RetryPolicy{
+	ActionTypeNodeInit: {
+		// The node initialization is safe to be executed again, it's retried only if it failed because of
+		// the temporary network problems: the node can't be connected or the package downloads hung.
+		MaxAttempts:      2,
+		Backoff:          10 * time.Second,
+		AttemptTimeout:   30 * time.Minute,
+		RetryableReasons: []string{consts.MsgNodeConnectionFailed, consts.MsgActionTimedOut},
+	},
+	ActionTypeDeployEtcd:   {AttemptTimeout: 20 * time.Minute},
+	ActionTypeInitMaster:   {AttemptTimeout: 30 * time.Minute},
+	ActionTypeJoinMaster:   {AttemptTimeout: 30 * time.Minute},
+	ActionTypeDeployWorker: {AttemptTimeout: 30 * time.Minute},
+	// The pods on the node may never be evicted, such as the ones protected by a pod disruption budget.
+	ActionTypeDrainNode: {AttemptTimeout: 10 * time.Minute},
+	ActionTypeResetNode: {AttemptTimeout: 10 * time.Minute},
+	// The packages are installed and the images are pulled when upgrading a node.
+	ActionTypeUpgradeFirstMaster: {AttemptTimeout: 30 * time.Minute},
+	ActionTypeUpgradeMaster:      {AttemptTimeout: 30 * time.Minute},
+	ActionTypeUpgradeWorker:      {AttemptTimeout: 30 * time.Minute},
+}
+
+// retryPolicyConfig is the form of a retry policy in the config file, the durations are like "10s".
+type retryPolicyConfig struct {
+	MaxAttempts      int      `json:"maxAttempts"`
+	Backoff          string   `json:"backoff"`
+	MaxBackoff       string   `json:"maxBackoff"`
+	AttemptTimeout   string   `json:"attemptTimeout"`
+	RetryableReasons []string `json:"retryableReasons"`
+}
+
+// LoadRetryPolicies reads the retry policies keyed by the action types from a YAML or JSON file,
+// a policy in the file replaces the default one of the action type, the other default policies are kept.
+func LoadRetryPolicies(path string) (map[Type]*RetryPolicy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read retry policy file %q: %v", path, err)
+	}
+
+	configs := make(map[Type]*retryPolicyConfig)
+	if err := yaml.Unmarshal(data, &configs); err != nil {
+		return nil, fmt.Errorf("failed to parse retry policy file %q: %v", path, err)
+	}
+
+	policies := make(map[Type]*RetryPolicy, len(DefaultRetryPolicies)+len(configs))
+	for actionType, policy := range DefaultRetryPolicies {
+		policies[actionType] = policy
+	}
+	for actionType, config := range configs {
+		policy, err := config.toRetryPolicy()
+		if err != nil {
+			return nil, fmt.Errorf("invalid retry policy of %v in file %q: %v", actionType, path, err)
+		}
+		policies[actionType] = policy
+	}
+
+	return policies, nil
+}
+
+func (c *retryPolicyConfig) toRetryPolicy() (*RetryPolicy, error) {
+	if c == nil {
+		return nil, fmt.Errorf("policy is empty")
+	}
+
+	policy := &RetryPolicy{
+		MaxAttempts:      c.MaxAttempts,
+		RetryableReasons: c.RetryableReasons,
+	}
+	for _, d := range []struct {
+		value string
+		field *time.Duration
+	}{
+		{c.Backoff, &policy.Backoff},
+		{c.MaxBackoff, &policy.MaxBackoff},
+		{c.AttemptTimeout, &policy.AttemptTimeout},
+	} {
+		if d.value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		*d.field = duration
+	}
+
+	return policy, nil
+}
+
+// GetMaxAttempts returns the max number of attempts, which is at least 1.
+func (p *RetryPolicy) GetMaxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// GetAttemptTimeout returns the max duration of an attempt, 0 means no timeout.
+func (p *RetryPolicy) GetAttemptTimeout() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return p.AttemptTimeout
+}
+
+// GetBackoff returns the duration to wait after the failed attempt, attempt starts from 1.
+func (p *RetryPolicy) GetBackoff(attempt int) time.Duration {
+	if p == nil || attempt < 1 {
+		return 0
+	}
+	backoff := p.Backoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// ShouldRetry returns whether the action should be attempted again after the attempt failed with err.
+func (p *RetryPolicy) ShouldRetry(attempt int, err *pb.Error) bool {
+	if p == nil || err == nil || attempt >= p.GetMaxAttempts() {
+		return false
+	}
+	if len(p.RetryableReasons) == 0 {
+		return true
+	}
+	for _, reason := range p.RetryableReasons {
+		if reason == err.GetReason() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var noPolicy *RetryPolicy
+	assert.Equal(t, 1, noPolicy.GetMaxAttempts())
+	assert.Equal(t, time.Duration(0), noPolicy.GetAttemptTimeout())
+	assert.False(t, noPolicy.ShouldRetry(1, &pb.Error{}))
+
+	policy := &RetryPolicy{
+		MaxAttempts:      4,
+		Backoff:          time.Second,
+		MaxBackoff:       3 * time.Second,
+		RetryableReasons: []string{"retryable"},
+	}
+	assert.Equal(t, 4, policy.GetMaxAttempts())
+	assert.Equal(t, time.Second, policy.GetBackoff(1))
+	assert.Equal(t, 2*time.Second, policy.GetBackoff(2))
+	assert.Equal(t, 3*time.Second, policy.GetBackoff(3))
+	assert.Equal(t, 3*time.Second, policy.GetBackoff(100))
+
+	assert.True(t, policy.ShouldRetry(1, &pb.Error{Reason: "retryable"}))
+	assert.False(t, policy.ShouldRetry(1, &pb.Error{Reason: "other"}))
+	assert.False(t, policy.ShouldRetry(4, &pb.Error{Reason: "retryable"}))
+	assert.False(t, policy.ShouldRetry(1, nil))
+
+	// any error can be retried if the retryable reasons are not specified
+	policy.RetryableReasons = nil
+	assert.True(t, policy.ShouldRetry(1, &pb.Error{Reason: "other"}))
+}
+
+func TestDefaultNodeInitRetryPolicy(t *testing.T) {
+	policy := DefaultRetryPolicies[ActionTypeNodeInit]
+	// only the temporary failures are retried
+	assert.True(t, policy.ShouldRetry(1, &pb.Error{Reason: consts.MsgNodeConnectionFailed}))
+	assert.True(t, policy.ShouldRetry(1, &pb.Error{Reason: consts.MsgActionTimedOut}))
+	assert.False(t, policy.ShouldRetry(1, &pb.Error{Reason: "1 init item(s) failed"}))
+}
+
+func TestLoadRetryPolicies(t *testing.T) {
+	file, err := ioutil.TempFile("", "retry-policies-*.yaml")
+	assert.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`
+NodeInit:
+  maxAttempts: 3
+  backoff: 5s
+  attemptTimeout: 1h
+  retryableReasons: ["failed to connect the node"]
+DeployEtcd:
+  attemptTimeout: 5m
+`)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	policies, err := LoadRetryPolicies(file.Name())
+	assert.NoError(t, err)
+	assert.Equal(t, &RetryPolicy{
+		MaxAttempts:      3,
+		Backoff:          5 * time.Second,
+		AttemptTimeout:   time.Hour,
+		RetryableReasons: []string{consts.MsgNodeConnectionFailed},
+	}, policies[ActionTypeNodeInit])
+	assert.Equal(t, &RetryPolicy{AttemptTimeout: 5 * time.Minute}, policies[ActionTypeDeployEtcd])
+	// the policies not in the file are the default ones
+	assert.Equal(t, DefaultRetryPolicies[ActionTypeInitMaster], policies[ActionTypeInitMaster])
+
+	assert.NoError(t, ioutil.WriteFile(file.Name(), []byte("NodeInit: {backoff: 5 seconds}"), 0600))
+	_, err = LoadRetryPolicies(file.Name())
+	assert.Error(t, err)
+
+	_, err = LoadRetryPolicies(file.Name() + ".not-existed")
+	assert.Error(t, err)
+}
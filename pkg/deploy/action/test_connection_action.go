@@ -33,6 +33,13 @@ type TestConnectionActionConfig struct {
 
 type TestConnectionAction struct {
 	Base
+	// HostKeyFingerprint is the fingerprint of the host key presented by the node, it is set after executed.
+	HostKeyFingerprint string
+	// JumpHostKeyFingerprints are the fingerprints of the host keys presented by the jump hosts in order,
+	// they are set after executed.
+	JumpHostKeyFingerprints []string
+	// FailedHop is the address of the jump host or the node which failed to be connected.
+	FailedHop string
 }
 
 // NewTestConnectionAction returns a test-connection action based on the config.
@@ -15,11 +15,17 @@
 package action
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -30,7 +36,7 @@ func init() {
 type testConnectionExecutor struct {
 }
 
-func (a *testConnectionExecutor) Execute(act Action) *pb.Error {
+func (a *testConnectionExecutor) Execute(ctx context.Context, act Action) *pb.Error {
 	testConnTask, ok := act.(*TestConnectionAction)
 	if !ok {
 		return errOfTypeMismatched(new(TestConnectionAction), act)
@@ -46,13 +52,42 @@ func (a *testConnectionExecutor) Execute(act Action) *pb.Error {
 
 	logger.Debug("Start to execute action")
 
-	// machine.NewMachine() will test if the machine can be connected via ssh.
-	m, err := machine.NewMachine(testConnTask.Node)
+	// The host key is got before any credential is sent: if there is no pinned host key, the scanned one
+	// is trusted on first use, and it is pinned during the test to make sure the credential is sent to the same host.
+	// So are the host keys of the jump hosts.
+	fingerprint, jumpHostFingerprints, err := machine.ScanHostKeyFingerprints(testConnTask.Node)
 	if err != nil {
+		pbErr := a.connectionError(testConnTask, err)
+		deploy.PBErrLogger(pbErr, logger).Debug()
+		return pbErr
+	}
+	testConnTask.HostKeyFingerprint = fingerprint
+	testConnTask.JumpHostKeyFingerprints = jumpHostFingerprints
+
+	node := proto.Clone(testConnTask.Node).(*pb.Node)
+	if node.Ssh == nil {
+		node.Ssh = new(pb.SSH)
+	}
+	if pinned := node.Ssh.HostKeyFingerprint; pinned != "" && pinned != fingerprint {
 		pbErr := &pb.Error{
-			Reason: "failed to test connection",
-			Detail: err.Error(),
+			Reason:     consts.MsgHostKeyMismatched,
+			Detail:     fmt.Sprintf(consts.MsgHostKeyMismatchedDetail, pinned, fingerprint),
+			FixMethods: consts.MsgHostKeyMismatchedFixMethods,
 		}
+		deploy.PBErrLogger(pbErr, logger).Warn()
+		return pbErr
+	}
+	node.Ssh.HostKeyFingerprint = fingerprint
+	for i, jumpHost := range node.Ssh.JumpHosts {
+		if i < len(jumpHostFingerprints) {
+			jumpHost.HostKeyFingerprint = jumpHostFingerprints[i]
+		}
+	}
+
+	// machine.NewMachine() will test if the machine can be connected via ssh.
+	m, err := machine.NewMachine(node)
+	if err != nil {
+		pbErr := a.connectionError(testConnTask, err)
 		deploy.PBErrLogger(pbErr, logger).Debug()
 		return pbErr
 	}
@@ -61,3 +96,22 @@ func (a *testConnectionExecutor) Execute(act Action) *pb.Error {
 	logger.Debug("Finsih to execute action")
 	return nil
 }
+
+// connectionError records the hop which failed to be connected if it's known, and returns the error to report.
+func (a *testConnectionExecutor) connectionError(act *TestConnectionAction, err error) *pb.Error {
+	pbErr := &pb.Error{
+		Reason: "failed to test connection",
+		Detail: err.Error(),
+	}
+
+	var hopErr *mssh.HopError
+	if errors.As(err, &hopErr) {
+		act.FailedHop = hopErr.Address
+		if hopErr.JumpHost {
+			pbErr.Reason = consts.MsgJumpHostConnectionFailed
+			pbErr.FixMethods = fmt.Sprintf(consts.MsgJumpHostConnectionFailedFixMethods, hopErr.Address)
+		}
+	}
+
+	return pbErr
+}
@@ -15,10 +15,12 @@
 package action
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
@@ -40,8 +42,47 @@ func TestTestConnectionExecute(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, normalAction)
 
-	pbErr := executor.Execute(normalAction)
+	pbErr := executor.Execute(context.Background(), normalAction)
 	assert.Nil(t, pbErr)
+	assert.Equal(t, machine.MockHostKeyFingerprint, normalAction.(*TestConnectionAction).HostKeyFingerprint)
+	// the host key is pinned only for the test, the node is not changed.
+	assert.Nil(t, normalAction.GetNode().GetSsh())
+
+	// the host keys of the jump hosts are trusted on first use too
+	jumpedAction, err := NewTestConnectionAction(&TestConnectionActionConfig{
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+			Ssh: &pb.SSH{
+				JumpHosts: []*pb.JumpHost{{Ip: "10.10.10.1", Port: 22}},
+			},
+		},
+		LogFileBasePath: "/tmp",
+	})
+	assert.NoError(t, err)
+
+	pbErr = executor.Execute(context.Background(), jumpedAction)
+	assert.Nil(t, pbErr)
+	assert.Equal(t, []string{machine.MockHostKeyFingerprint}, jumpedAction.(*TestConnectionAction).JumpHostKeyFingerprints)
+	assert.Empty(t, jumpedAction.GetNode().GetSsh().GetJumpHosts()[0].GetHostKeyFingerprint())
+
+	mismatchedAction, err := NewTestConnectionAction(&TestConnectionActionConfig{
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+			Ssh: &pb.SSH{
+				HostKeyFingerprint: "SHA256:c3Bvb2ZlZCBob3N0IGtleQ",
+			},
+		},
+		LogFileBasePath: "/tmp",
+	})
+	assert.NoError(t, err)
+
+	pbErr = executor.Execute(context.Background(), mismatchedAction)
+	if assert.NotNil(t, pbErr) {
+		assert.Equal(t, consts.MsgHostKeyMismatched, pbErr.GetReason())
+	}
+	assert.Equal(t, machine.MockHostKeyFingerprint, mismatchedAction.(*TestConnectionAction).HostKeyFingerprint)
 
 	errorAction, err := NewTestConnectionAction(&TestConnectionActionConfig{
 		Node: &pb.Node{
@@ -52,8 +93,34 @@ func TestTestConnectionExecute(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, normalAction)
 
-	pbErr = executor.Execute(errorAction)
+	pbErr = executor.Execute(context.Background(), errorAction)
 	assert.NoError(t, err)
 	assert.NotNil(t, pbErr)
 	assert.Equal(t, "failed to test connection", pbErr.GetReason())
 }
+
+func TestTestConnectionThroughJumpHosts(t *testing.T) {
+	executor := new(testConnectionExecutor)
+
+	act, err := NewTestConnectionAction(&TestConnectionActionConfig{
+		Node: &pb.Node{
+			Name: "normal",
+			Ip:   "10.10.10.10",
+			Ssh: &pb.SSH{
+				Port: 22,
+				JumpHosts: []*pb.JumpHost{
+					{Ip: "192.168.1.1", Port: 22},
+					{Ip: "error", Port: 2222},
+				},
+			},
+		},
+		LogFileBasePath: "/tmp",
+	})
+	assert.NoError(t, err)
+
+	pbErr := executor.Execute(context.Background(), act)
+	if assert.NotNil(t, pbErr) {
+		assert.Equal(t, consts.MsgJumpHostConnectionFailed, pbErr.GetReason())
+	}
+	assert.Equal(t, "error:2222", act.(*TestConnectionAction).FailedHop)
+}
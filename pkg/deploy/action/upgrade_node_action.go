@@ -0,0 +1,89 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// The action types of the steps to upgrade a cluster, they share the UpgradeNodeAction.
+const (
+	ActionTypeCheckUpgrade       Type = "CheckUpgrade"
+	ActionTypeUpgradeFirstMaster Type = "UpgradeFirstMaster"
+	ActionTypeUpgradeMaster      Type = "UpgradeMaster"
+	ActionTypeUpgradeWorker      Type = "UpgradeWorker"
+)
+
+// UpgradeNodeActionTypes are the steps to upgrade a cluster, in the order they are done.
+var UpgradeNodeActionTypes = []Type{ActionTypeCheckUpgrade, ActionTypeUpgradeFirstMaster, ActionTypeUpgradeMaster, ActionTypeUpgradeWorker}
+
+type UpgradeNodeActionConfig struct {
+	// Step is the action type of the step.
+	Step Type
+	// Node is the node to upgrade, or the first master to check the cluster.
+	Node *pb.Node
+	// MasterNodes are the masters of the cluster, the first one is used to access the cluster.
+	MasterNodes []*pb.Node
+	// Version is the kubernetes version to upgrade to.
+	Version         string
+	LogFileBasePath string
+}
+
+// UpgradeNodeAction is a step to upgrade the cluster.
+type UpgradeNodeAction struct {
+	Base
+	MasterNodes []*pb.Node
+	Version     string
+}
+
+func NewUpgradeNodeAction(cfg *UpgradeNodeActionConfig) (Action, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("action config is nil")
+	}
+	if cfg.Node == nil {
+		return nil, fmt.Errorf("invalid action config: node is nil")
+	}
+	if cfg.Version == "" {
+		return nil, fmt.Errorf("invalid action config: version is empty")
+	}
+	if !isUpgradeNodeActionType(cfg.Step) {
+		return nil, fmt.Errorf("invalid action config: unknown step %q", cfg.Step)
+	}
+
+	actionName := GenActionName(cfg.Step)
+	return &UpgradeNodeAction{
+		Base: Base{
+			Name:              actionName,
+			Node:              cfg.Node,
+			ActionType:        cfg.Step,
+			Status:            ActionPending,
+			LogFilePath:       GenActionLogFilePath(cfg.LogFileBasePath, actionName, cfg.Node.Name),
+			CreationTimestamp: time.Now(),
+		},
+		MasterNodes: cfg.MasterNodes,
+		Version:     cfg.Version,
+	}, nil
+}
+
+func isUpgradeNodeActionType(actionType Type) bool {
+	for _, step := range UpgradeNodeActionTypes {
+		if actionType == step {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/upgrade"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	for _, step := range UpgradeNodeActionTypes {
+		RegisterExecutor(step, new(upgradeNodeExecutor))
+	}
+}
+
+// upgradeNodeExecutor executes all the steps to upgrade a cluster, by the action type.
+type upgradeNodeExecutor struct {
+}
+
+func (a *upgradeNodeExecutor) Execute(ctx context.Context, act Action) *pb.Error {
+	action, ok := act.(*UpgradeNodeAction)
+	if !ok {
+		return errOfTypeMismatched(new(UpgradeNodeAction), act)
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldAction: act.GetName(),
+	})
+
+	logger.Debugf("Start to execute %v action on node:%v", action.GetType(), action.Node.Name)
+
+	config := &upgrade.UpgradeOperationConfig{
+		Logger:      logger,
+		Node:        action.Node,
+		MasterNodes: action.MasterNodes,
+		Version:     action.Version,
+		LogWriter:   action.GetExecuteLogBuffer(),
+		Context:     ctx,
+	}
+
+	var err error
+	switch action.GetType() {
+	case ActionTypeCheckUpgrade:
+		err = upgrade.CheckCluster(config)
+	case ActionTypeUpgradeFirstMaster:
+		err = upgrade.UpgradeFirstMaster(config)
+	case ActionTypeUpgradeMaster:
+		err = upgrade.UpgradeMaster(config)
+	case ActionTypeUpgradeWorker:
+		err = upgrade.UpgradeWorker(config)
+	default:
+		return errOfTypeMismatched(new(UpgradeNodeAction), act)
+	}
+
+	if err != nil {
+		return &pb.Error{
+			Reason:     "failed to do " + string(action.GetType()) + " operation",
+			Detail:     err.Error(),
+			FixMethods: consts.FixMethodSelfAnalyseIt,
+		}
+	}
+
+	logger.Debugf("Finish to execute %v action", action.GetType())
+	return nil
+}
+
+// Plan returns the scripts which will be put to the node and the commands which will be run on it,
+// the check is done by the kubernetes api without commands.
+func (a *upgradeNodeExecutor) Plan(act Action) (*pb.ActionPlan, error) {
+	action, ok := act.(*UpgradeNodeAction)
+	if !ok {
+		return nil, errTypeMismatched(new(UpgradeNodeAction), act)
+	}
+
+	switch action.GetType() {
+	case ActionTypeCheckUpgrade:
+		return new(pb.ActionPlan), nil
+	default:
+		return upgrade.PlanUpgradeNode(action.Version, action.GetType() == ActionTypeUpgradeFirstMaster)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package action
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	machine.IsTesting = true
+}
+
+func TestUpgradeNode(t *testing.T) {
+	executor := new(upgradeNodeExecutor)
+	masters := []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}}
+
+	for _, step := range UpgradeNodeActionTypes {
+		normalAction, err := NewUpgradeNodeAction(&UpgradeNodeActionConfig{
+			Step:        step,
+			Node:        &pb.Node{Name: "normal", Ip: "10.10.10.10"},
+			MasterNodes: masters,
+			Version:     "1.17.0",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, step, normalAction.GetType())
+
+		pbErr := executor.Execute(context.Background(), normalAction)
+		assert.Nil(t, pbErr, "step %v", step)
+	}
+
+	// the commands to upgrade can't be run on an unreachable node
+	errorAction, err := NewUpgradeNodeAction(&UpgradeNodeActionConfig{
+		Step:        ActionTypeUpgradeMaster,
+		Node:        &pb.Node{Name: "error", Ip: "10.10.10.10"},
+		MasterNodes: masters,
+		Version:     "1.17.0",
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, executor.Execute(context.Background(), errorAction))
+
+	_, err = NewUpgradeNodeAction(&UpgradeNodeActionConfig{
+		Step:    ActionTypeDeployWorker,
+		Node:    &pb.Node{Name: "normal", Ip: "10.10.10.10"},
+		Version: "1.17.0",
+	})
+	assert.Error(t, err)
+}
+
+func TestPlanUpgradeNode(t *testing.T) {
+	masters := []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}}
+	node := &pb.Node{Name: "master1", Ip: "10.1.1.1"}
+
+	firstAction, err := NewUpgradeNodeAction(&UpgradeNodeActionConfig{
+		Step: ActionTypeUpgradeFirstMaster, Node: node, MasterNodes: masters, Version: "1.17.0",
+	})
+	assert.NoError(t, err)
+	plan, err := new(upgradeNodeExecutor).Plan(firstAction)
+	assert.NoError(t, err)
+	assert.Contains(t, plan.GetCommands(), "kubeadm upgrade apply v1.17.0 -y")
+
+	masterAction, err := NewUpgradeNodeAction(&UpgradeNodeActionConfig{
+		Step: ActionTypeUpgradeMaster, Node: node, MasterNodes: masters, Version: "1.17.0",
+	})
+	assert.NoError(t, err)
+	plan, err = new(upgradeNodeExecutor).Plan(masterAction)
+	assert.NoError(t, err)
+	assert.Contains(t, plan.GetCommands(), "kubeadm upgrade node")
+}
@@ -25,116 +25,116 @@ var Assets = func() http.FileSystem {
 		},
 		"/scripts": &vfsgen۰DirInfo{
 			name:    "scripts",
-			modTime: time.Date(2020, 1, 7, 8, 23, 48, 582415525, time.UTC),
+			modTime: time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 		},
 		"/scripts/check_port_occupied.sh": &vfsgen۰CompressedFileInfo{
 			name:             "check_port_occupied.sh",
-			modTime:          time.Date(2020, 1, 6, 3, 2, 23, 483900571, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 2536,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x6b\x6f\xdb\x36\x14\xfd\xae\x5f\x71\x26\x0b\xad\x8d\xf9\xdd\xb4\x4b\x5b\x64\xa8\xd7\x07\xe6\xad\x70\x8a\x38\x5d\x51\x14\x05\x4a\x53\xd7\x12\x11\x99\x54\x49\x2a\x8a\xe7\xe4\xbf\x0f\xa4\x64\xf9\x31\xa3\xa8\xbe\x58\xe2\xbd\x3c\x3c\xf7\xdc\x73\xe9\xd6\x2f\x83\xc2\xe8\xc1\x42\xc8\x01\xc9\x5b\x2c\x98\x49\x83\x56\x0b\xaf\x55\xbe\xd6\x22\x49\x2d\xc6\xc3\xd1\x73\xcc\x53\x26\x93\x94\x09\xfc\x25\x64\xf2\xa6\x50\x98\xca\xa5\xd2\x2b\x66\x85\x92\xb8\x26\x9e\x4a\x95\xa9\x64\x0d\xae\xfa\x5d\xbc\xb7\x71\x3f\x68\xb5\x1c\xcc\x7b\xc1\x49\x1a\x8a\x51\xc8\x98\x34\x6c\x4a\x98\xe4\x8c\xa7\xb4\x8d\x74\xf1\x0f\x69\xe3\x50\xc6\xfd\x21\xda\x2e\x21\xac\x43\x61\xe7\xa5\x83\x58\xab\x02\x2b\xb6\x86\x54\x16\x85\x21\xd8\x54\x18\x2c\x45\x46\xa0\x3b\x4e\xb9\x85\x90\xe0\x6a\x95\x67\x82\x49\x4e\x28\x85\x4d\xfd\x39\x35\x8a\x63\x82\xcf\x35\x86\x5a\x58\x26\x24\x18\xb8\xca\xd7\x50\xcb\xfd\x44\x30\x5b\x93\xf6\x4f\x6a\x6d\xfe\x62\x30\x28\xcb\xb2\xcf\x3c\xe3\xbe\xd2\xc9\x20\xab\x72\xcd\xe0\xfd\xf4\xf5\xdb\xd9\xfc\x6d\x6f\xdc\x1f\xd6\xbb\x3e\xca\x8c\x8c\x81\xa6\xef\x85\xd0\x14\x63\xb1\x06\xcb\xf3\x4c\x70\xb6\xc8\x08\x19\x2b\xa1\x34\x58\xa2\x89\x62\x58\xe5\x58\x97\x5a\x58\x21\x93\x2e\x8c\x5a\xda\x92\x69\x72\x30\xb1\x30\x56\x8b\x45\x61\x0f\x44\xdb\x72\x14\xe6\x20\x41\x49\x30\x89\x70\x32\xc7\x74\x1e\xe2\x8f\xc9\x7c\x3a\xef\x3a\x90\x4f\xd3\xeb\x3f\x2f\x3f\x5e\xe3\xd3\xe4\xea\x6a\x32\xbb\x9e\xbe\x9d\xe3\xf2\x0a\xaf\x2f\x67\x6f\xa6\xd7\xd3\xcb\xd9\x1c\x97\xef\x30\x99\x7d\xc6\xdf\xd3\xd9\x9b\x2e\x48\xd8\x94\x34\xe8\x2e\xd7\xae\x02\xa5\x21\x9c\x9c\xe4\xbb\x88\x39\xd1\x01\x85\xa5\xaa\x28\x99\x9c\xb8\x58\x0a\x8e\x8c\xc9\xa4\x60\x09\x21\x51\xb7\xa4\xa5\x90\x09\x72\xd2\x2b\x61\x5c\x5b\x0d\x98\x8c\x1d\x4c\x26\x56\xc2\x7a\xbf\x98\xff\xd7\xd5\x0f\x82\x16\xae\x5d\x63\x0d\xd7\xc2\xf5\xd4\x80\x89\x95\xd3\x89\xa7\xc4\x6f\x20\x96\xc8\x95\xb6\x50\x9c\x17\xb9\xa0\xd8\xe5\x9f\x0f\xbb\x38\x3b\x7b\x02\x21\x13\xc7\x3b\x68\x61\xfc\xe4\xb7\xe7\x5d\x8c\x9f\x9c\x0f\x41\x96\xc7\x41\x0b\xcf\x5c\xc2\x4d\xb1\xa0\x1e\xcb\x85\x21\x7d\x4b\x3a\x68\x61\x34\x1c\x9f\x9d\xfb\xe5\x8c\x2c\xda\x29\xb1\xcc\xa6\xff\xf6\xdc\x09\x9d\x3a\xfc\xbc\xda\x95\x6b\x75\xb7\x3e\x95\xf0\x74\xb8\xdb\x9f\x29\xce\xb2\x5e\x26\x8c\x25\x79\x90\x33\xaa\x40\x0c\x4f\x29\x2e\xb2\xed\xd1\x4f\xc7\xd5\xf2\x8a\x49\x96\x90\x0e\x82\xba\x80\x0f\x4a\xdb\x8b\xf6\xf9\xd0\x17\x55\x51\xac\x98\xf8\xe3\x3a\xc1\x8a\x19\x4b\xba\xca\x7a\x76\x32\xa7\x3e\xd4\x9f\xd1\x09\x9c\x04\x55\xb6\xd3\xa5\x92\xe5\x04\x6c\xa9\xf4\xcd\x16\xf6\x44\x38\xd7\x94\x33\x4d\x55\xbc\x13\x68\x95\x91\xfb\x0d\x96\x85\xe4\x7e\xfa\x57\x4c\xc8\x76\x07\x9b\xc0\x4d\x8d\xc9\x33\x61\xaf\x54\x46\x06\xd1\xc8\xaf\x38\xbb\x10\xe3\xa9\x5b\x74\xa6\x8f\x36\x0e\xe2\xcb\xab\xaf\x0f\x2f\x11\xab\x00\xf5\xe3\x06\x72\xa2\x35\x5b\x23\xda\x66\xfb\x58\xac\x64\xfd\x42\x96\xb8\x75\x34\x10\x6d\xf6\x48\x39\xa4\xe0\x61\x8f\xcf\x8e\x42\xc3\xca\x92\xb1\xe8\x49\x44\x23\xdc\xdf\xa3\x4d\x3c\x55\x08\x1d\x0d\x70\x26\xfd\xc5\xb2\x20\x48\x25\x7b\x74\x27\x8c\x35\x21\xc6\xbf\x3f\x1a\xe1\xd1\x23\xd0\x9d\xb0\x18\x75\x3c\x88\x58\xe2\xcb\x17\x07\x71\x71\x81\x30\xc4\xd7\xaf\x2f\x9d\x83\x65\x53\xc1\x69\x54\x5a\xe5\x76\x0d\x37\xb1\x32\x39\xc6\xad\xf4\x11\x81\xff\xf5\xc2\x86\xd1\x28\xdc\x7d\xb5\x2b\xad\x06\x83\xee\x00\x0f\x9d\x83\x22\x1b\xb9\x7c\x8d\x8d\xd0\xc2\x2b\x3c\xf2\xca\x36\xc4\x6a\xe2\xc2\x13\xaf\x4c\x74\x82\xbe\x7b\x58\x1c\x4f\x97\x33\x65\xa7\xb2\x6e\xc5\x66\xe7\x39\xaf\x73\x53\x6b\x76\x00\x5a\x1b\xf8\xa7\x51\xf7\x0c\xff\x23\x58\x67\xe0\x9f\xc6\xdc\xba\xfd\x47\x80\x95\xd5\x7f\x1a\x72\x37\x19\x47\xa0\x86\x0e\x76\x56\x9d\x2f\xe4\x8d\x54\xa5\xf4\xad\xab\xdd\x4a\x71\xd7\xb7\xba\xdf\xef\x87\x47\x6d\xaf\x5b\xdf\x58\x7c\xbf\xb9\x47\x4c\x1a\x1b\xef\x3a\xfc\xea\x60\x76\x5c\xbe\xb4\xea\xc3\x6e\x28\x10\xfd\x00\xfa\x30\xf5\x04\xfa\xf1\x78\x1d\x1c\xd6\x8c\x41\x8f\xbe\x3b\x5d\x4f\x6a\xa9\xc9\x16\x5a\x9e\x2c\xd4\xbd\xec\xe1\xff\x7a\xd1\x8e\x46\x87\xde\xde\x4d\x7a\xc3\x6d\x7b\xed\xcf\xc9\x5e\x34\x64\xfd\x1f\xc2\x4e\x8d\xbd\xab\xa4\x90\x7e\xfb\xc5\x37\x49\xd6\x58\xe6\x66\x3f\xb3\x39\xee\x91\x68\xca\xd1\xbb\x45\x38\xe1\x56\xdc\x52\xb8\xbf\xf4\x41\x2b\xab\xdc\x0a\x2b\x6f\xf0\x78\x93\x6b\x21\x2d\xa2\xb3\x87\xc7\xf5\x52\xef\x1d\xc2\x17\xe1\x2e\x32\x7b\xe7\x43\xd5\xfe\x12\x91\xa7\x73\x8f\x92\xa3\x97\x7d\x3b\x96\xab\xe1\x84\x9e\x24\x0c\x4f\xab\xb6\x5f\x65\xb4\xf7\x11\x7a\xec\x2e\xc2\x93\x82\x06\x8d\x05\xa3\xcd\xde\xa6\xed\xbd\xe8\xae\x67\x44\xaf\x82\xff\x02\x00\x00\xff\xff\x7b\x73\xe5\x73\xe8\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x7f\x6f\xdb\x36\x10\xfd\x5f\x9f\xe2\x4d\x16\x5a\x1b\xb3\xfc\xab\x69\x97\xb4\xf0\x50\xaf\x3f\x30\x6f\x85\x53\xc4\xe9\x8a\xa2\x28\x50\x9a\x3a\x4b\x44\x64\x52\x25\xa9\x38\x5e\x92\xef\x3e\x90\x92\x65\x3b\x33\x8a\x04\x86\x14\xf1\x8e\x8f\xef\xde\xbd\x63\xeb\x97\x7e\x69\x74\x7f\x21\x64\x9f\xe4\x35\x16\xcc\x64\x41\xab\x85\x37\xaa\xd8\x68\x91\x66\x16\xa3\xc1\xf0\x0c\xf3\x8c\xc9\x34\x63\x02\x7f\x09\x99\xbe\x2d\x15\xa6\x72\xa9\xf4\x8a\x59\xa1\x24\x2e\x89\x67\x52\xe5\x2a\xdd\x80\xab\x5e\x17\x1f\x6c\xd2\x0b\x5a\x2d\x07\xf3\x41\x70\x92\x86\x12\x94\x32\x21\x0d\x9b\x11\x26\x05\xe3\x19\x6d\x23\x5d\xfc\x43\xda\x38\x94\x51\x6f\x80\xb6\x4b\x08\xeb\x50\xd8\x79\xe5\x20\x36\xaa\xc4\x8a\x6d\x20\x95\x45\x69\x08\x36\x13\x06\x4b\x91\x13\xe8\x86\x53\x61\x21\x24\xb8\x5a\x15\xb9\x60\x92\x13\xd6\xc2\x66\xb0\xbb\x03\x1c\x13\x7c\xa9\x31\xd4\xc2\x32\x21\xc1\xc0\x55\xb1\x81\x5a\xee\x27\x82\xd9\x9a\xb4\xff\xcb\xac\x2d\x5e\xf6\xfb\xeb\xf5\xba\xc7\x3c\xe3\x9e\xd2\x69\x3f\xaf\x72\x4d\xff\xc3\xf4\xcd\xbb\xd9\xfc\x5d\x3c\xea\x0d\xea\x5d\x9f\x64\x4e\xc6\x40\xd3\x8f\x52\x68\x4a\xb0\xd8\x80\x15\x45\x2e\x38\x5b\xe4\x84\x9c\xad\xa1\x34\x58\xaa\x89\x12\x58\xe5\x58\xaf\xb5\xb0\x42\xa6\x5d\x18\xb5\xb4\x6b\xa6\xc9\x51\x4d\x84\xb1\x5a\x2c\x4a\x7b\x20\xda\x96\xa3\x30\x07\x09\x4a\x82\x49\x84\x93\x39\xa6\xf3\x10\x7f\x4c\xe6\xd3\x79\xd7\x81\x7c\x9e\x5e\xfe\x79\xfe\xe9\x12\x9f\x27\x17\x17\x93\xd9\xe5\xf4\xdd\x1c\xe7\x17\x78\x73\x3e\x7b\x3b\xbd\x9c\x9e\xcf\xe6\x38\x7f\x8f\xc9\xec\x0b\xfe\x9e\xce\xde\x76\x41\xc2\x66\xa4\x41\x37\x85\x76\x15\x28\x0d\xe1\xe4\x24\xdf\x45\xcc\x89\x0e\x28\x2c\x55\xd5\x47\x53\x10\x17\x4b\xc1\x91\x33\x99\x96\x2c\x25\xa4\xea\x9a\xb4\x14\x32\x45\x41\x7a\x25\x8c\x6b\xab\x01\x93\x89\x83\xc9\xc5\x4a\x58\xef\x17\xf3\xff\xba\x7a\x41\xd0\xc2\xa5\x6b\xac\xe1\x5a\xb8\x9e\x1a\x30\xb1\x72\x3a\xf1\x8c\xf8\x15\xc4\x12\x85\xd2\x16\x8a\xf3\xb2\x10\x94\xb8\xfc\xd3\x41\x17\x27\x27\xcf\x20\x64\xea\x78\x07\x2d\x8c\x9e\xfd\x76\xd6\xc5\xe8\xd9\xe9\x00\x64\x79\x12\xb4\xf0\xc2\x25\x5c\x95\x0b\x8a\x59\x21\x0c\xe9\x6b\xd2\x41\x0b\xc3\xc1\xe8\xe4\xd4\x2f\xe7\x64\xd1\xce\x88\xe5\x36\xfb\x37\x76\x27\x74\xea\xf0\x99\x0f\xc7\x85\x56\x37\x9b\x63\x09\xcf\x07\xbb\xfd\xb9\xe2\x2c\x8f\x73\x61\x2c\xc9\x83\x9c\xa1\xcf\x89\x0d\xcf\x28\x29\xf3\xed\xd1\xcf\x47\xd5\xf2\x8a\x49\x96\x92\x0e\x82\xba\x80\x8f\x4a\xdb\x71\xfb\x74\xe0\x8b\xaa\x28\xba\xe7\x99\xdf\x33\xe8\x04\x2b\x66\x2c\xe9\x2a\xeb\xc5\xd1\x9c\xfa\x50\xf7\x1c\x75\x02\x27\x41\x95\xed\x74\xa9\x64\x39\x02\xbb\x56\xfa\x6a\x0b\x7b\x24\x5c\x68\x2a\x98\xa6\x2a\xde\x09\xb4\xca\xc9\xbd\x83\x65\x29\xb9\xeb\x26\x56\x4c\xc8\x76\x07\xb7\x81\x9b\x1a\x53\xe4\xc2\x5e\xa8\x9c\x0c\xa2\xa1\x5f\x71\x76\x21\xc6\x33\xb7\xe8\x4c\x1f\xdd\x3a\x88\xaf\xaf\xbf\xdd\xbf\x42\xa2\x7c\x8a\xfb\xb9\x81\x9c\x68\xcd\x36\x88\xb6\xd9\x3e\x96\x28\x59\xff\x43\x96\xb8\x75\x34\x10\xdd\xee\x91\x72\x48\xc1\xfd\x1e\x9f\x1d\x85\x86\x95\x25\x63\x11\x4b\x44\x43\xdc\xdd\xa1\x4d\x3c\x53\x08\xb5\x23\xc4\x99\xf4\x17\xcb\x82\x20\x95\x8c\xe9\x46\x18\x6b\x42\x8c\x7e\x7f\x32\xc4\x93\x27\xa0\x1b\x61\x31\xec\x78\x10\xb1\xc4\xd7\xaf\x0e\x62\x3c\x46\x18\xe2\xdb\xb7\x57\xce\xc1\xb2\xa9\xe0\x38\x2a\xad\x0a\xbb\x81\x1b\x69\x99\x3e\xc4\xf5\x5b\x97\x22\xf0\x6f\xb7\x71\x1c\x46\xc3\x70\xf7\xd5\xae\xb4\xea\xf7\xbb\x7d\xdc\x77\x0e\x8a\x6c\xe4\xf2\x35\x36\x42\x0b\xaf\xf0\xd0\x2b\xdb\x10\xab\x89\x0b\x4f\xbc\x32\xd1\x11\xfa\xee\xc7\x92\x64\xba\x9c\x29\x3b\x95\x75\x2b\x6e\x77\x9e\xf3\x3a\x6f\x13\x29\x3f\x00\xad\x0d\xfc\x68\xd4\x3d\xc3\xff\x0c\xd6\x19\xf8\xd1\x98\x5b\xb7\xff\x0c\xb0\xb2\xfa\xa3\x21\x77\x93\xf1\x00\xd4\xd0\xc1\xce\xaa\xf3\xa5\xbc\x92\x6a\x2d\x7d\xeb\x6a\xb7\x52\xd2\xf5\x16\xea\xf5\x7a\xe1\x83\xb6\xd7\xad\x6f\x2c\xbe\xdf\xdc\x07\x4c\x1a\x1b\xef\x3a\xfc\xfa\x60\x76\x5c\xbe\xb4\xea\xe3\x6e\x28\x10\xfd\x04\xfa\x30\xf5\x08\xfa\xc3\xf1\x3a\x38\xac\x16\x74\x88\x98\x7e\x38\x5d\x8f\x6a\xa9\xc9\x96\x5a\x1e\x2d\xd4\x7d\xef\xe1\xff\x3a\x6e\x47\xc3\x43\x6f\xef\x26\xbd\xe1\xb6\xbd\xf6\xe7\x64\xc7\x0d\x59\x77\xd3\xee\xa9\xd1\x9c\xc6\x55\x29\xfd\x45\x31\xfe\x2e\xc9\x1a\xcb\xdc\xec\xe7\xb6\xc0\x1d\x52\x4d\x05\xe2\x6b\x84\x13\x6e\xc5\x35\x85\xfb\x4b\x1f\xb5\xb2\xca\xad\xb0\xf5\x15\x9e\xde\x16\x5a\x48\x8b\xe8\xe4\xfe\x69\xbd\x14\xbf\x47\xf8\x32\xdc\x45\x66\xef\x7d\xa8\x82\x5c\x23\xf2\x74\xee\xb0\xe6\x88\xf3\xef\x0f\xe5\x6a\x38\x21\x96\x84\xc1\x71\xd5\xf6\xab\x8c\xf6\x3e\x42\x8f\xdd\x45\x78\x54\xd0\xa0\xb1\x60\x74\xbb\xb7\x69\x7b\x2f\xae\x98\x90\x88\x5e\x07\xff\x0d\x00\x7b\x73\xe5\x73\xe8\x09\x00\x00"),
 		},
 		"/scripts/check_system_preference.sh": &vfsgen۰CompressedFileInfo{
 			name:             "check_system_preference.sh",
-			modTime:          time.Date(2019, 12, 12, 7, 31, 4, 856135726, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 2010,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x71\x6f\xe2\x46\x10\xc5\xff\xf6\x7e\x8a\x39\x63\x35\x77\x12\x31\x24\xed\x3f\xcd\xc9\xad\x68\x92\xaa\xf4\x22\x90\x42\xae\xa7\xd3\xf5\x84\x96\xf5\xd8\x1e\x65\xd9\x75\x77\xd7\x31\x16\xe5\xbb\x57\x6b\x4c\x02\x09\xea\x5d\x90\x90\xcc\xee\x9b\xdf\xbc\x79\x1e\xd1\x7b\x33\x58\x90\x1a\x2c\xb8\x2d\x58\xaf\x07\x97\xba\x6c\x0c\xe5\x85\x83\xf3\xe1\xd9\xcf\x30\x2b\xb8\xca\x0b\x4e\xf0\x27\xa9\xfc\xaa\xd2\x30\x56\x99\x36\x4b\xee\x48\x2b\xb8\x43\x51\x28\x2d\x75\xde\x80\xd0\x71\x1f\x6e\x5c\x1a\xb3\x5e\xcf\x63\x6e\x48\xa0\xb2\x98\x42\xa5\x52\x34\xe0\x0a\x84\x51\xc9\x45\x81\xbb\x9b\x3e\xfc\x85\xc6\x7a\xca\x79\x3c\x84\xb7\x5e\x10\x76\x57\xe1\xbb\xf7\x1e\xd1\xe8\x0a\x96\xbc\x01\xa5\x1d\x54\x16\xc1\x15\x64\x21\x23\x89\x80\x2b\x81\xa5\x03\x52\x20\xf4\xb2\x94\xc4\x95\x40\xa8\xc9\x15\x6d\x9f\x8e\xe2\x9d\xc0\xe7\x8e\xa1\x17\x8e\x93\x02\x0e\x42\x97\x0d\xe8\x6c\x5f\x08\xdc\x75\xa6\xdb\x4f\xe1\x5c\x79\x31\x18\xd4\x75\x1d\xf3\xd6\x71\xac\x4d\x3e\x90\x5b\xad\x1d\xdc\x8c\x2f\xaf\x27\xb3\xeb\xd3\xf3\x78\xd8\x55\x7d\x54\x12\xad\x05\x83\xff\x54\x64\x30\x85\x45\x03\xbc\x2c\x25\x09\xbe\x90\x08\x92\xd7\xa0\x0d\xf0\xdc\x20\xa6\xe0\xb4\x77\x5d\x1b\x72\xa4\xf2\x3e\x58\x9d\xb9\x9a\x1b\xf4\x98\x94\xac\x33\xb4\xa8\xdc\x41\x68\x3b\x8f\x64\x0f\x04\x5a\x01\x57\x10\x8e\x66\x30\x9e\x85\xf0\xdb\x68\x36\x9e\xf5\x3d\xe4\xd3\xf8\xee\x8f\xe9\xc7\x3b\xf8\x34\xba\xbd\x1d\x4d\xee\xc6\xd7\x33\x98\xde\xc2\xe5\x74\x72\x35\xbe\x1b\x4f\x27\x33\x98\xfe\x0e\xa3\xc9\x67\xf8\x30\x9e\x5c\xf5\x01\xc9\x15\x68\x00\x57\xa5\xf1\x13\x68\x03\xe4\xe3\xc4\xf6\x2d\xc2\x0c\xf1\xc0\x42\xa6\xb7\x96\x6c\x89\x82\x32\x12\x20\xb9\xca\x2b\x9e\x23\xe4\xfa\x01\x8d\x22\x95\x43\x89\x66\x49\xd6\xbf\x56\x0b\x5c\xa5\x1e\x23\x69\x49\xae\xdd\x17\xfb\x72\xae\x98\xb1\xac\x52\xa2\xdd\x26\x51\xa0\xb8\x9f\xdb\xc6\x0a\x27\x61\xcd\x82\xed\xd3\xfc\x1e\x9b\x24\x3a\x63\x01\xae\x4a\x14\x0e\xd3\xf9\x03\x97\x15\x26\xd1\x39\x0b\xba\xa7\xb7\x5d\x4d\xb4\x7e\x2a\xd9\xc0\xbf\xc0\xeb\x7b\x38\x59\x97\x86\x94\x83\xe8\xc7\xcd\xc9\x3b\x16\x50\x06\x5f\xbe\x40\xb4\x6e\x2b\x37\xf0\x26\x81\x68\x7d\x08\xde\xc0\xd7\xaf\xef\xbd\x45\xc5\x82\x00\x45\xa1\x21\x6c\x8d\x41\xc6\x49\x62\x7a\xf1\xac\x0b\xd9\x47\x5a\x1f\x76\xa4\x97\xd0\x90\x05\x8f\xbd\x33\x5a\x75\x53\x6e\x20\x81\xd0\x99\x0a\xc3\xfd\xa6\x5d\x57\x67\x1a\xbf\x2e\x16\xdd\xb3\x96\x4e\x1f\xe7\x77\x81\xc1\x69\x7d\x58\x90\xbc\x50\x7b\x71\x6f\x9b\x77\xfb\x32\xda\x53\xe0\x39\x27\x15\xfb\xbb\xd7\xe7\x1a\xbc\x36\xd9\xdd\x94\xdb\x54\x5f\x39\x68\x60\xd0\x55\x46\xc1\x59\x9b\x96\xb4\xb8\x07\xb4\x95\x10\x68\x6d\x56\x49\xd9\xbc\x86\x99\x11\x7b\x62\xed\xf1\xfd\xb9\xff\x76\x47\x43\xb6\x61\x0c\x8d\x49\xc2\x90\x29\xac\x25\x29\x4c\xa2\x93\xbf\xd5\x09\x63\xbb\x44\x15\xba\x98\xca\x87\x9f\x62\x2a\xe7\x99\x36\x35\x37\x29\xdb\x5f\xa4\x23\xf7\x71\x1c\x87\xec\x60\xff\xc3\x23\xaa\x10\xce\xd8\xd3\xaf\xb9\xbe\x4f\xa2\x5f\x99\xcf\x1d\xa2\xf5\xc1\xf9\x06\x4e\x15\xc2\x10\x1e\xe3\xde\xb6\x3f\x82\xf4\xfb\xeb\xff\x5f\x75\x89\x0a\xd3\x90\x05\xed\x68\xd1\x1a\x8d\xd9\x44\xeb\x6e\xc0\xcd\x9e\x7e\x5f\x9c\xd1\x91\xa1\x85\x56\x59\xcc\xa5\x8c\xbb\x0a\x52\xf9\xf1\xe9\x8f\x08\xff\x2f\x86\x23\x72\x9f\x07\x97\x72\xfe\x74\x72\x98\xc9\x8b\xbb\x6f\xe5\x72\xa4\xc7\x77\xa4\xf3\xdd\xe5\x3e\xaf\xd6\x5a\x87\x08\x21\x49\x20\x0c\x9f\xdb\xe9\x46\xdf\xc6\x35\xfd\xe0\xdb\xae\xc8\xc1\x90\x6d\xb7\xf3\x40\x84\xc6\x68\x63\x2f\x76\xbc\x5f\x7e\x38\xef\xd4\x67\x2c\xa3\xff\x02\x00\x00\xff\xff\xe2\x3d\xaf\xd3\xda\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x94\x71\x6f\xe2\x46\x10\xc5\xff\xf6\x7e\x8a\x77\xc6\x6a\xee\x24\x62\x48\xda\x7f\x9a\x13\xad\x68\x92\xaa\xf4\x22\x90\x42\xae\xa7\xd3\xf5\x14\x2d\xf6\xd8\x1e\xc5\xd9\x75\x77\xd7\x31\x88\xf2\xdd\xab\x05\x93\x40\x82\x7a\x17\x24\x24\xe3\x79\xf3\x9b\x37\x6f\x57\x74\xde\xf4\x66\xac\x7a\x33\x69\x0b\xd1\xe9\xe0\x5c\x57\x0b\xc3\x79\xe1\x70\xda\x3f\xf9\x19\xd3\x42\xaa\xbc\x90\x8c\x3f\x59\xe5\x17\xb5\xc6\x48\x65\xda\xdc\x4b\xc7\x5a\xe1\x86\x92\x42\xe9\x52\xe7\x0b\x24\x3a\xee\xe2\xca\xa5\xb1\xe8\x74\x3c\xe6\x8a\x13\x52\x96\x52\xd4\x2a\x25\x03\x57\x10\x86\x95\x4c\x0a\xda\x56\xba\xf8\x8b\x8c\xf5\x94\xd3\xb8\x8f\xb7\x5e\x10\xb6\xa5\xf0\xdd\x7b\x8f\x58\xe8\x1a\xf7\x72\x01\xa5\x1d\x6a\x4b\x70\x05\x5b\x64\x5c\x12\x68\x9e\x50\xe5\xc0\x0a\x89\xbe\xaf\x4a\x96\x2a\x21\x34\xec\x0a\xb8\xa7\x01\xde\x09\x3e\xb7\x0c\x3d\x73\x92\x15\x24\x12\x5d\x2d\xa0\xb3\x5d\x21\xa4\x6b\x4d\xaf\x3f\x85\x73\xd5\x59\xaf\xd7\x34\x4d\x2c\xd7\x8e\x63\x6d\xf2\x5e\xb9\xd1\xda\xde\xd5\xe8\xfc\x72\x3c\xbd\x3c\x3e\x8d\xfb\x6d\xd7\x47\x55\x92\xb5\x30\xf4\x4f\xcd\x86\x52\xcc\x16\x90\x55\x55\x72\x22\x67\x25\xa1\x94\x0d\xb4\x81\xcc\x0d\x51\x0a\xa7\xbd\xeb\xc6\xb0\x63\x95\x77\x61\x75\xe6\x1a\x69\xc8\x5b\x4d\xd9\x3a\xc3\xb3\xda\xed\x85\xb6\xf5\xc8\x76\x4f\xa0\x15\xa4\x42\x38\x9c\x62\x34\x0d\xf1\xdb\x70\x3a\x9a\x76\x3d\xe4\xd3\xe8\xe6\x8f\xc9\xc7\x1b\x7c\x1a\x5e\x5f\x0f\xc7\x37\xa3\xcb\x29\x26\xd7\x38\x9f\x8c\x2f\x46\x37\xa3\xc9\x78\x8a\xc9\xef\x18\x8e\x3f\xe3\xc3\x68\x7c\xd1\x05\xb1\x2b\xc8\x80\xe6\x95\xf1\x1b\x68\x03\xf6\x71\xd2\xfa\x14\x31\x25\xda\xb3\x90\xe9\xcd\x39\xda\x8a\x12\xce\x38\x41\x29\x55\x5e\xcb\x9c\x90\xeb\x07\x32\x8a\x55\x8e\x8a\xcc\x3d\x5b\x7f\xac\x16\x52\xa5\x1e\x53\xf2\x3d\xbb\xf5\x7d\xb1\x2f\xf7\x8a\x85\xc8\x6a\x95\xf8\x2a\x92\x82\x92\xbb\x5b\xbb\xb0\x89\x2b\xb1\x14\xc1\xe6\xe9\xf6\x8e\x16\x83\xe8\x44\x04\x34\xaf\x28\x71\x94\xde\x3e\xc8\xb2\xa6\x41\x74\x2a\x82\xf6\xe9\x6d\xdb\x13\x2d\x9f\x5a\x56\xf8\x17\xb2\xb9\xc3\xd1\xb2\x32\xac\x1c\xa2\x1f\x57\x47\xef\x44\xc0\x19\xbe\x7c\x41\xb4\x5c\x77\xae\xf0\x66\x80\x68\xb9\x0f\x5e\xe1\xeb\xd7\xf7\xde\xa2\x12\x41\x40\x49\xa1\x11\xae\x8d\x21\x93\x5c\x52\x7a\xf6\x6c\x0a\xdb\x47\x5a\x17\x5b\xd2\x4b\x68\x28\x82\xc7\xd9\x19\xcf\xdb\x2d\x57\x18\x20\x74\xa6\xa6\x70\x77\x68\x3b\xd5\x99\x85\xbf\x2e\x96\xdc\xb3\x91\x4e\x1f\xe6\xb7\x81\xe1\xb8\xd9\x6f\x18\xbc\x50\x8b\x20\x08\x3a\x9b\xbc\xfd\x50\xac\x19\x90\xb9\x64\x15\xfb\xda\xeb\x73\x0d\x5e\x9b\xec\x76\xcb\x4d\xaa\xaf\x5c\x34\x30\xe4\x6a\xa3\x70\xe2\x41\x54\x5a\xda\x01\xda\x3a\x49\xc8\xda\xac\x2e\xcb\xc5\x6b\x98\x19\x8b\x27\xd6\x0e\xdf\xbf\xf7\xdf\xf6\x55\x5f\xac\x84\x20\x63\x06\x61\x28\x14\x35\x25\x2b\x1a\x44\x47\x7f\xab\x23\x21\xb6\x89\x2a\x72\x31\x57\x0f\x3f\xc5\x5c\xdd\x66\xda\x34\xd2\xa4\x62\xf7\x22\x1d\xa8\xc7\x71\x1c\x8a\xbd\xfb\x1f\x1e\x50\x85\x38\x11\x4f\xbf\x6e\xf5\xdd\x20\xfa\x55\xf8\xdc\x11\x2d\xf7\xde\xaf\x70\xac\x08\x7d\x3c\xc6\xbd\x19\x7f\x00\x09\xb6\xeb\xff\x57\x5d\x91\xa2\x34\x14\xc1\x7a\xb5\x68\x49\xc6\xac\xa2\x65\xbb\xe0\x6a\x47\xbf\x2b\xce\xf8\xc0\xd2\x89\x56\x59\x2c\xcb\x32\x6e\x3b\x58\xe5\x87\xb7\x3f\x20\xfc\xbf\x18\x0e\xc8\x7d\x1e\xb2\x2c\xb7\xde\x58\xe5\xfb\x99\xbc\xa8\x7d\x2b\x97\x03\x33\xbe\x23\x9d\xef\x6e\xf7\x79\xad\x8f\xab\x45\x84\x18\x0c\x10\x86\xcf\xed\xb4\xab\x6f\x72\x9d\x7c\xf0\x87\x32\x67\x87\xbe\xd8\xdc\xf4\x3d\x11\x19\xa3\x8d\x3d\xdb\xf2\x7e\xf9\xe1\x54\x04\x34\x67\x87\x13\x91\xf1\x7f\x03\x00\xe2\x3d\xaf\xd3\xda\x07\x00\x00"),
 		},
 		"/scripts/init_change_firewall.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_firewall.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 83881617, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 865,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x51\x6f\xd3\x30\x14\x85\xdf\xf3\x2b\x0e\xad\xc4\x40\xea\xe2\xb2\x37\x40\x42\x2a\xdb\x10\x81\xa9\x95\x96\x8e\x69\x8f\xae\x73\x93\x5c\xc9\xb9\x0e\xb6\xb3\x2c\xff\x1e\xb9\x6b\xa7\x15\x84\x5f\xcf\xf1\xe7\xe3\x73\xef\xfc\x8d\x1a\x82\x57\x3b\x16\x45\xf2\x88\x9d\x0e\x6d\x36\x9f\xe3\xd2\xf5\x93\xe7\xa6\x8d\xb8\x58\x7e\xf8\x88\xb2\xd5\xd2\xb4\x9a\xf1\x83\xa5\xb9\x1a\x1c\x0a\xa9\x9d\xef\x74\x64\x27\xd8\x92\x69\xc5\x59\xd7\x4c\x30\x2e\x5f\xe0\x26\x56\x79\x36\x9f\x27\xcc\x0d\x1b\x92\x40\x15\x06\xa9\xc8\x23\xb6\x84\x55\xaf\x4d\x4b\x47\x65\x81\x5f\xe4\x43\xa2\x5c\xe4\x4b\xbc\x4b\x86\xd9\x41\x9a\xbd\xff\x9c\x10\x93\x1b\xd0\xe9\x09\xe2\x22\x86\x40\x88\x2d\x07\xd4\x6c\x09\xf4\x64\xa8\x8f\x60\x81\x71\x5d\x6f\x59\x8b\x21\x8c\x1c\xdb\xfd\x3b\x07\x4a\x4a\x82\x87\x03\xc3\xed\xa2\x66\x81\x86\x71\xfd\x04\x57\xbf\x36\x42\xc7\x43\xe8\xfd\x69\x63\xec\x3f\x29\x35\x8e\x63\xae\xf7\x89\x73\xe7\x1b\x65\x9f\xbd\x41\xdd\x14\x97\xd7\xeb\xf2\xfa\xfc\x22\x5f\x1e\x6e\xdd\x89\xa5\x10\xe0\xe9\xf7\xc0\x9e\x2a\xec\x26\xe8\xbe\xb7\x6c\xf4\xce\x12\xac\x1e\xe1\x3c\x74\xe3\x89\x2a\x44\x97\x52\x8f\x9e\x23\x4b\xb3\x40\x70\x75\x1c\xb5\xa7\x84\xa9\x38\x44\xcf\xbb\x21\x9e\x94\x76\xcc\xc8\xe1\xc4\xe0\x04\x5a\x30\x5b\x95\x28\xca\x19\xbe\xae\xca\xa2\x5c\x24\xc8\x7d\xb1\xfd\xbe\xb9\xdb\xe2\x7e\x75\x7b\xbb\x5a\x6f\x8b\xeb\x12\x9b\x5b\x5c\x6e\xd6\x57\xc5\xb6\xd8\xac\x4b\x6c\xbe\x61\xb5\x7e\xc0\xcf\x62\x7d\xb5\x00\x71\x6c\xc9\x83\x9e\x7a\x9f\x7e\xe0\x3c\x38\xd5\x49\xfb\x29\xa2\x24\x3a\x89\x50\xbb\xe7\x48\xa1\x27\xc3\x35\x1b\x58\x2d\xcd\xa0\x1b\x42\xe3\x1e\xc9\x0b\x4b\x83\x9e\x7c\xc7\x21\x8d\x35\x40\x4b\x95\x30\x96\x3b\x8e\xfb\x7d\x09\xff\xfe\x2b\xcf\xb2\x39\xb6\x69\xb0\xc1\x78\x4e\x33\x0d\xd0\xdc\xa5\x9e\x8c\x75\xe9\x51\xf6\x34\x6a\x6b\x13\x2d\x35\x90\x3a\xad\x10\x28\x52\xda\x43\x43\x59\x16\xa6\x10\xa9\x33\xd1\x1e\xe5\x97\x3b\x15\xde\x7e\x51\x15\x3d\x2a\x19\xac\x7d\xe5\x0b\xd1\xf5\xff\x33\xbd\x80\xb1\xfc\x4b\xa8\x70\xce\x38\x0b\xea\x59\x67\x69\xd4\x31\x8e\x3a\x83\xa2\x68\x54\x20\xcb\x32\x3c\x29\xe3\xa4\xe6\x26\xfb\x13\x00\x00\xff\xff\xde\x71\x21\x3e\x61\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x51\x6f\xd3\x3e\x14\xc5\xdf\xf3\x29\xce\xbf\x95\xfe\x03\xa9\x8b\xcb\xde\x00\x09\xa9\x6c\x43\x04\xa6\x56\x5a\x3a\xa6\x3d\xba\xce\x4d\x72\x25\xc7\x0e\xf6\xcd\xb2\x7c\x7b\xe4\xae\x1d\x2b\x88\xbc\x9e\xe3\x93\x9f\xcf\xf1\xfc\x3f\x35\xc4\xa0\x76\xec\x14\xb9\x47\xec\x74\x6c\xb3\xf9\x1c\x97\xbe\x9f\x02\x37\xad\xe0\x62\xf9\xee\x3d\xca\x56\xbb\xa6\xd5\x8c\x6f\xec\x9a\xab\xc1\xa3\x70\xb5\x0f\x9d\x16\xf6\x0e\x5b\x32\xad\xf3\xd6\x37\x13\x8c\xcf\x17\xb8\x91\x2a\xcf\xe6\xf3\x14\x73\xc3\x86\x5c\xa4\x0a\x83\xab\x28\x40\x5a\xc2\xaa\xd7\xa6\xa5\xa3\xb2\xc0\x0f\x0a\x31\xa5\x5c\xe4\x4b\xbc\x49\x86\xd9\x41\x9a\xbd\xfd\x98\x22\x26\x3f\xa0\xd3\x13\x9c\x17\x0c\x91\x20\x2d\x47\xd4\x6c\x09\xf4\x64\xa8\x17\xb0\x83\xf1\x5d\x6f\x59\x3b\x43\x18\x59\x5a\xc8\xef\x1f\x24\x12\x3c\x1c\x32\xfc\x4e\x34\x3b\x68\x18\xdf\x4f\xf0\xf5\x6b\x23\xb4\x1c\xa0\xf7\x5f\x2b\xd2\x7f\x50\x6a\x1c\xc7\x5c\xef\x89\x73\x1f\x1a\x65\x9f\xbd\x51\xdd\x14\x97\xd7\xeb\xf2\xfa\xfc\x22\x5f\x1e\x4e\xdd\x39\x4b\x31\x22\xd0\xcf\x81\x03\x55\xd8\x4d\xd0\x7d\x6f\xd9\xe8\x9d\x25\x58\x3d\xc2\x07\xe8\x26\x10\x55\x10\x9f\xa8\xc7\xc0\xc2\xae\x59\x20\xfa\x5a\x46\x1d\x28\xa1\x56\x1c\x25\xf0\x6e\x90\x93\xd2\x8e\x8c\x1c\x4f\x0c\xde\x41\x3b\xcc\x56\x25\x8a\x72\x86\xcf\xab\xb2\x28\x17\x29\xe4\xbe\xd8\x7e\xdd\xdc\x6d\x71\xbf\xba\xbd\x5d\xad\xb7\xc5\x75\x89\xcd\x2d\x2e\x37\xeb\xab\x62\x5b\x6c\xd6\x25\x36\x5f\xb0\x5a\x3f\xe0\x7b\xb1\xbe\x5a\x80\x58\x5a\x0a\xa0\xa7\x3e\xa4\x1b\xf8\x00\x4e\x75\xd2\x7e\x45\x94\x44\x27\x08\xb5\x7f\xde\x31\xf6\x64\xb8\x66\x03\xab\x5d\x33\xe8\x86\xd0\xf8\x47\x0a\x8e\x5d\x83\x9e\x42\xc7\x31\xcd\x1a\xa1\x5d\x95\x62\x2c\x77\x2c\xfb\xf7\x12\xff\xbe\x57\x9e\x65\x73\x6c\xd3\xb0\xd1\x04\x4e\x9b\x46\x68\xee\x52\x4f\xc6\xfa\x48\xa8\x39\xd0\xa8\xad\x4d\x69\xa9\x81\xd4\x69\x85\x48\x42\xe9\x1d\x1a\xca\xb2\x38\x45\xa1\xce\x88\x3d\xca\x2f\x67\x2a\xfc\xff\x49\x55\xf4\xa8\xdc\x60\xed\x2b\x5f\x14\xdf\xff\xcb\xf4\x12\x8c\xe5\x1f\x42\x85\x73\xc6\x59\x54\xcf\x3a\xbb\x46\x1d\x71\xd4\x19\x14\x89\x51\x91\x2c\xbb\xe1\x49\x19\xef\x6a\x6e\xb2\x5f\x03\x00\xde\x71\x21\x3e\x61\x03\x00\x00"),
 		},
 		"/scripts/init_change_hostalias.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_hostalias.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 84146261, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 1121,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x61\x4f\xdb\x30\x10\xfd\x9e\x5f\xf1\xd6\x7e\x60\x93\xda\x84\xf1\x6d\x83\x21\x75\x50\xb4\x6c\xa8\x95\x48\x19\x42\xd3\x04\xae\x73\x89\x4f\xa4\x76\xb0\x1d\x4a\x35\xd8\x6f\x9f\x1c\x5a\x20\x74\xf9\x14\xdf\xbd\x7b\x7e\x7e\xef\xfa\xef\x92\xc6\xd9\x64\xce\x3a\x21\x7d\x87\xb9\x70\x2a\xea\xf7\x71\x64\xea\x95\xe5\x52\x79\xec\xed\x7e\xfc\x84\x4c\x09\x5d\x2a\xc1\xf8\xce\xba\x3c\x6e\x0c\x52\x5d\x18\xbb\x10\x9e\x8d\xc6\x8c\xa4\xd2\xa6\x32\xe5\x0a\xd2\xc4\x03\x9c\xfa\x3c\x8e\xfa\xfd\x40\x73\xca\x92\xb4\xa3\x1c\x8d\xce\xc9\xc2\x2b\xc2\xa8\x16\x52\xd1\xa6\x33\xc0\x4f\xb2\x2e\xb0\xec\xc5\xbb\x78\x1f\x00\xbd\x75\xab\xf7\x61\x3f\x50\xac\x4c\x83\x85\x58\x41\x1b\x8f\xc6\x11\xbc\x62\x87\x82\x2b\x02\xdd\x4b\xaa\x3d\x58\x43\x9a\x45\x5d\xb1\xd0\x92\xb0\x64\xaf\xda\x7b\xd6\x2c\x41\x09\x2e\xd7\x1c\x66\xee\x05\x6b\x08\x48\x53\xaf\x60\x8a\xd7\x40\x08\xbf\x16\xdd\x7e\xca\xfb\xfa\x73\x92\x2c\x97\xcb\x58\xb4\x8a\x63\x63\xcb\xa4\x7a\xc2\xba\xe4\x34\x3d\x1a\x4f\xb2\xf1\x70\x2f\xde\x5d\x4f\x9d\xeb\x8a\x9c\x83\xa5\xdb\x86\x2d\xe5\x98\xaf\x20\xea\xba\x62\x29\xe6\x15\xa1\x12\x4b\x18\x0b\x51\x5a\xa2\x1c\xde\x04\xd5\x4b\xcb\x9e\x75\x39\x80\x33\x85\x5f\x0a\x4b\x81\x26\x67\xe7\x2d\xcf\x1b\xdf\x31\x6d\xa3\x91\x5d\x07\x60\x34\x84\x46\x6f\x94\x21\xcd\x7a\xf8\x3a\xca\xd2\x6c\x10\x48\x2e\xd2\xd9\xb7\xe9\xf9\x0c\x17\xa3\xb3\xb3\xd1\x64\x96\x8e\x33\x4c\xcf\x70\x34\x9d\x1c\xa7\xb3\x74\x3a\xc9\x30\x3d\xc1\x68\x72\x89\x1f\xe9\xe4\x78\x00\x62\xaf\xc8\x82\xee\x6b\x1b\x5e\x60\x2c\x38\xd8\x49\x6d\x8a\xc8\x88\x3a\x12\x0a\xf3\x24\xc9\xd5\x24\xb9\x60\x89\x4a\xe8\xb2\x11\x25\xa1\x34\x77\x64\x35\xeb\x12\x35\xd9\x05\xbb\x10\xab\x83\xd0\x79\xa0\xa9\x78\xc1\xbe\xdd\x17\xb7\xfd\xae\x38\x8a\xfa\x98\x85\x60\x9d\xb4\x1c\x32\x75\x10\xbc\x08\x3e\x39\xf2\x50\xc6\x79\x51\xb1\x70\xe4\xa2\xc8\x93\xf3\x18\x16\xf8\x9b\xc4\x61\x59\xad\xc4\xc3\x03\xbc\x69\xa4\x7a\x29\xbd\x05\x5d\xad\xa7\xb7\xa0\x57\xcf\xb4\xa5\xa5\x1a\xbd\xf6\xd8\xdb\x1a\x1b\xde\x86\xc9\x3f\x51\x58\x0c\x29\x3c\x0e\xdf\x00\x0e\x0e\xc6\xd3\x93\xa8\x3d\xe0\xe6\xcb\xce\x4d\x33\x27\xe9\xab\x9d\x4d\x45\x3e\x97\x30\xd4\xc8\xa9\x10\x4d\xe5\x5f\xba\x37\xae\xd3\x0f\xbf\x43\xb7\x72\x9e\x16\x2f\x18\xea\x40\x6e\x25\xed\x44\xe1\xca\xc7\x8d\xf0\xd7\x6a\x7a\x5d\x6f\x9e\x64\x93\x54\x06\xd7\x11\x17\xf8\xf5\x3f\x63\x7e\xef\x87\x3c\x74\x0b\x8d\xb7\xdc\x29\xf8\x1a\x87\x87\xaf\xfc\x7d\x8c\x22\x67\x1a\x2b\x69\x0b\xda\x2d\x5b\x19\xfd\x0b\x00\x00\xff\xff\xcb\x84\xa6\x2e\x61\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x5d\x4f\x1b\x39\x14\x7d\xf7\xaf\x38\x9b\x3c\xb0\x2b\x25\x33\x2c\x6f\xbb\x50\xa4\x14\x82\x3a\x2d\x4a\x24\x26\x14\xa1\xaa\x2a\x8e\xe7\xce\xf8\x8a\x89\x3d\xd8\x1e\x42\x54\xe8\x6f\xaf\x9c\x0f\x42\x48\xe7\x69\x7c\xcf\xb9\xc7\xc7\xf7\xdc\xee\x5f\x69\xeb\x5d\x3a\x65\x93\x92\x79\xc4\x54\x7a\x2d\xba\x5d\x9c\xd9\x66\xe1\xb8\xd2\x01\x47\x87\xff\xfe\x87\x5c\x4b\x53\x69\xc9\xf8\xcc\xa6\x3a\x6f\x2d\x32\x53\x5a\x37\x93\x81\xad\xc1\x84\x94\x36\xb6\xb6\xd5\x02\xca\x26\x3d\x5c\x86\x22\x11\xdd\x6e\x94\xb9\x64\x45\xc6\x53\x81\xd6\x14\xe4\x10\x34\x61\xd0\x48\xa5\x69\x83\xf4\xf0\x95\x9c\x8f\x2a\x47\xc9\x21\xfe\x8e\x84\xce\x1a\xea\xfc\x73\x1c\x25\x16\xb6\xc5\x4c\x2e\x60\x6c\x40\xeb\x09\x41\xb3\x47\xc9\x35\x81\x9e\x14\x35\x01\x6c\xa0\xec\xac\xa9\x59\x1a\x45\x98\x73\xd0\x08\xdb\x0b\xa2\x13\xdc\xae\x35\xec\x34\x48\x36\x90\x50\xb6\x59\xc0\x96\x6f\x89\x90\x61\x6d\x7a\xf9\xe9\x10\x9a\xff\xd3\x74\x3e\x9f\x27\x72\xe9\x38\xb1\xae\x4a\xeb\x15\xd7\xa7\x97\xd9\xd9\x70\x94\x0f\xfb\x47\xc9\xe1\xba\xeb\xda\xd4\xe4\x3d\x1c\x3d\xb4\xec\xa8\xc0\x74\x01\xd9\x34\x35\x2b\x39\xad\x09\xb5\x9c\xc3\x3a\xc8\xca\x11\x15\x08\x36\xba\x9e\x3b\x0e\x6c\xaa\x1e\xbc\x2d\xc3\x5c\x3a\x8a\x56\x0b\xf6\xc1\xf1\xb4\x0d\x3b\x43\xdb\x78\x64\xbf\x43\xb0\x06\xd2\xa0\x33\xc8\x91\xe5\x1d\x7c\x1c\xe4\x59\xde\x8b\x22\x37\xd9\xe4\xd3\xf8\x7a\x82\x9b\xc1\xd5\xd5\x60\x34\xc9\x86\x39\xc6\x57\x38\x1b\x8f\xce\xb3\x49\x36\x1e\xe5\x18\x5f\x60\x30\xba\xc5\x97\x6c\x74\xde\x03\x71\xd0\xe4\x40\x4f\x8d\x8b\x2f\xb0\x0e\x1c\xc7\x49\xcb\x14\x91\x13\xed\x58\x28\xed\x2a\x47\xdf\x90\xe2\x92\x15\x6a\x69\xaa\x56\x56\x84\xca\x3e\x92\x33\x6c\x2a\x34\xe4\x66\xec\x63\xac\x1e\xd2\x14\x51\xa6\xe6\x19\x87\xe5\xbe\xf8\xfd\x77\x25\x42\x74\x31\x89\xc1\x7a\xe5\x38\x66\xea\x21\x79\x16\xe7\xe4\x29\x40\x5b\x1f\x64\xcd\xd2\x93\x17\x22\x90\x0f\xe8\x97\xf8\x95\x26\x71\x59\x9d\xc2\xf3\x33\x82\x6d\x95\xde\x96\xde\x93\x7e\xac\xbb\xf7\xa8\x1b\x40\x88\xca\x51\x83\xce\xf2\xd8\xd9\x6b\xeb\x3f\xc4\xce\x9f\x22\x2e\x86\x92\x01\xa7\xef\x08\x27\x27\xc3\xf1\x85\x58\x1e\x70\xff\xe1\xe0\xbe\x9d\x92\x0a\xf5\xc1\xa6\xa2\x5e\x4b\xe8\x1b\x14\x54\xca\xb6\x0e\x5b\xf4\xde\xef\xe0\xf1\xb7\xef\x17\x3e\xd0\x6c\xcb\xa1\x1d\xca\x83\xa2\x03\x11\xaf\x7c\xd9\x18\x7f\xeb\xe6\xd5\xff\x6a\x36\x2b\xdb\xa4\xb4\xc5\x9d\xe0\x12\xdf\xfe\x34\x98\xef\xc7\x31\x0f\xb3\xa4\x26\xef\x51\x51\xf2\x1d\x4e\x4f\x37\x65\xa7\xc4\x8b\x10\xde\xb6\x4e\xd1\x1e\x75\xb7\xec\x94\xf8\x3d\x00\xcb\x84\xa6\x2e\x61\x04\x00\x00"),
 		},
 		"/scripts/init_change_network.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_network.sh",
-			modTime:          time.Date(2019, 12, 26, 8, 54, 7, 444452562, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 920,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x5f\x6b\xdb\x30\x14\xc5\xdf\xfd\x29\xce\x12\xe8\x9f\x91\x58\x4d\xd8\xcb\x36\x36\xc8\xda\x8c\x79\x2b\x09\xd4\xe9\x4a\x61\x30\x14\xf9\xda\xbe\x4c\x91\x34\x49\x8e\x1b\xc6\xbe\xfb\x50\x9a\x8e\x96\xd6\x6f\x96\xce\xfd\xe9\xdc\x7b\xee\xf0\x95\xe8\x82\x17\x6b\x36\x82\xcc\x16\x6b\x19\xda\x6c\x38\xc4\xb9\x75\x3b\xcf\x4d\x1b\x31\x3d\x9b\xbc\x45\xd9\x4a\xd3\xb4\x92\xf1\x95\x4d\x73\xd1\x59\x14\xa6\xb6\x7e\x23\x23\x5b\x83\x15\xa9\xd6\x58\x6d\x9b\x1d\x94\xcd\x47\xb8\x8c\x55\x9e\x0d\x87\x09\x73\xc9\x8a\x4c\xa0\x0a\x9d\xa9\xc8\x23\xb6\x84\x99\x93\xaa\xa5\x87\x9b\x11\xbe\x93\x0f\x89\x32\xcd\xcf\x70\x92\x04\x83\xc3\xd5\xe0\xf4\x7d\x42\xec\x6c\x87\x8d\xdc\xc1\xd8\x88\x2e\x10\x62\xcb\x01\x35\x6b\x02\xdd\x29\x72\x11\x6c\xa0\xec\xc6\x69\x96\x46\x11\x7a\x8e\xed\xfe\x9d\x03\x25\x39\xc1\xed\x81\x61\xd7\x51\xb2\x81\x84\xb2\x6e\x07\x5b\x3f\x16\x42\xc6\x83\xe9\xfd\xd7\xc6\xe8\xde\x09\xd1\xf7\x7d\x2e\xf7\x8e\x73\xeb\x1b\xa1\xef\xb5\x41\x5c\x16\xe7\xf3\x45\x39\x1f\x4f\xf3\xb3\x43\xd5\xb5\xd1\x14\x02\x3c\xfd\xee\xd8\x53\x85\xf5\x0e\xd2\x39\xcd\x4a\xae\x35\x41\xcb\x1e\xd6\x43\x36\x9e\xa8\x42\xb4\xc9\x75\xef\x39\xb2\x69\x46\x08\xb6\x8e\xbd\xf4\x94\x30\x15\x87\xe8\x79\xdd\xc5\x27\x43\x7b\xf0\xc8\xe1\x89\xc0\x1a\x48\x83\xc1\xac\x44\x51\x0e\xf0\x69\x56\x16\xe5\x28\x41\x6e\x8a\xd5\x97\xe5\xf5\x0a\x37\xb3\xab\xab\xd9\x62\x55\xcc\x4b\x2c\xaf\x70\xbe\x5c\x5c\x14\xab\x62\xb9\x28\xb1\xfc\x8c\xd9\xe2\x16\xdf\x8a\xc5\xc5\x08\xc4\xb1\x25\x0f\xba\x73\x3e\x75\x60\x3d\x38\x8d\x93\xf6\x29\xa2\x24\x7a\x62\xa1\xb6\xf7\x96\x82\x23\xc5\x35\x2b\x68\x69\x9a\x4e\x36\x84\xc6\x6e\xc9\x1b\x36\x0d\x1c\xf9\x0d\x87\x14\x6b\x80\x34\x55\xc2\x68\xde\x70\xdc\xef\x4b\x78\xde\x57\x9e\x65\x43\xac\x52\xb0\x41\x79\x4e\x99\x06\x48\xde\xa4\x39\xa9\xb4\x79\x94\xf6\x92\x15\x0c\xc5\xde\xfa\x5f\x20\xb3\xcd\xb2\xc6\x93\xc3\xc0\x50\xcc\xd9\x6d\xdf\xe4\xec\x7e\xd6\xd6\xf7\xd2\x57\x03\x08\x8a\x4a\x84\x5d\x50\x51\xe7\xca\x9a\x1a\x47\x47\xf8\x93\xa5\x58\xd3\x2e\x8e\x19\xe3\x39\x8e\x83\x38\xc9\x5f\x9f\xbe\x50\x9f\x8e\xc5\x0b\xe7\x3f\xa6\x62\x72\xfc\x8c\x9d\xfd\xcd\xb2\xfb\x5f\x8c\x7b\xbc\x50\xf6\x61\x82\xc9\x47\x51\xd1\x56\x98\x4e\xeb\xff\x5a\xf7\xdc\xe5\x63\xd9\xbf\x00\x00\x00\xff\xff\xcb\x10\xc5\x1a\x98\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x6f\x6b\xdb\x3c\x14\xc5\xdf\xfb\x53\x9c\x27\x81\xfe\x79\x48\xac\x26\xec\xcd\x36\x36\xc8\xda\x8c\x79\x2b\x09\xd4\xe9\x4a\x61\x30\x14\xf9\xda\xbe\x4c\x91\x34\x49\x8e\x1b\xc6\xbe\xfb\x50\x9a\x6e\x2d\xad\xdf\x59\x3a\xf7\xe8\x77\xef\xb9\xc3\xff\x44\x17\xbc\x58\xb3\x11\x64\xb6\x58\xcb\xd0\x66\xc3\x21\xce\xad\xdb\x79\x6e\xda\x88\xe9\xd9\xe4\x35\xca\x56\x9a\xa6\x95\x8c\xcf\x6c\x9a\x8b\xce\xa2\x30\xb5\xf5\x1b\x19\xd9\x1a\xac\x48\xb5\xc6\x6a\xdb\xec\xa0\x6c\x3e\xc2\x65\xac\xf2\x6c\x38\x4c\x36\x97\xac\xc8\x04\xaa\xd0\x99\x8a\x3c\x62\x4b\x98\x39\xa9\x5a\x7a\xb8\x19\xe1\x2b\xf9\x90\x5c\xa6\xf9\x19\x4e\x92\x60\x70\xb8\x1a\x9c\xbe\x4d\x16\x3b\xdb\x61\x23\x77\x30\x36\xa2\x0b\x84\xd8\x72\x40\xcd\x9a\x40\x77\x8a\x5c\x04\x1b\x28\xbb\x71\x9a\xa5\x51\x84\x9e\x63\x8b\xf8\xef\x81\x44\x82\xdb\x83\x87\x5d\x47\xc9\x06\x12\xca\xba\x1d\x6c\xfd\x58\x08\x19\x0f\xd0\xfb\xaf\x8d\xd1\xbd\x11\xa2\xef\xfb\x5c\xee\x89\x73\xeb\x1b\xa1\xef\xb5\x41\x5c\x16\xe7\xf3\x45\x39\x1f\x4f\xf3\xb3\x43\xd5\xb5\xd1\x14\x02\x3c\xfd\xec\xd8\x53\x85\xf5\x0e\xd2\x39\xcd\x4a\xae\x35\x41\xcb\x1e\xd6\x43\x36\x9e\xa8\x42\xb4\x89\xba\xf7\x1c\xd9\x34\x23\x04\x5b\xc7\x5e\x7a\x4a\xa8\x15\x87\xe8\x79\xdd\xc5\x27\x43\x7b\x60\xe4\xf0\x44\x60\x0d\xa4\xc1\x60\x56\xa2\x28\x07\xf8\x30\x2b\x8b\x72\x94\x4c\x6e\x8a\xd5\xa7\xe5\xf5\x0a\x37\xb3\xab\xab\xd9\x62\x55\xcc\x4b\x2c\xaf\x70\xbe\x5c\x5c\x14\xab\x62\xb9\x28\xb1\xfc\x88\xd9\xe2\x16\x5f\x8a\xc5\xc5\x08\xc4\xb1\x25\x0f\xba\x73\x3e\x75\x60\x3d\x38\x8d\x93\xf6\x29\xa2\x24\x7a\x82\x50\xdb\xfb\x1c\x83\x23\xc5\x35\x2b\x68\x69\x9a\x4e\x36\x84\xc6\x6e\xc9\x1b\x36\x0d\x1c\xf9\x0d\x87\x14\x6b\x80\x34\x55\xb2\xd1\xbc\xe1\xb8\xdf\x97\xf0\xbc\xaf\x3c\xcb\x86\x58\xa5\x60\x83\xf2\x9c\x32\x0d\x90\xbc\x49\x73\x52\x69\xf3\x28\xed\x25\x2b\x18\x8a\xbd\xf5\x3f\x40\x66\x9b\x65\x8d\x27\x87\x81\xa1\x98\xb3\xdb\xbe\xca\xd9\x7d\xaf\xad\xef\xa5\xaf\x06\x10\x14\x95\x08\xbb\xa0\xa2\xce\x95\x35\x35\x8e\x8e\xf0\x2b\x4b\xb1\xa6\x5d\x1c\x33\xc6\x73\x1c\x07\x71\x92\xff\x7f\xfa\x42\x7d\x3a\x16\x2f\x9c\x7f\x9b\x8a\xc9\xf1\x33\xef\xec\x77\x96\xdd\xff\x62\xdc\xe3\x85\xb2\x77\x13\x4c\xde\x8b\x8a\xb6\xc2\x74\x5a\xff\xd5\xba\xe7\x94\x8f\x65\x7f\x06\x00\xcb\x10\xc5\x1a\x98\x03\x00\x00"),
 		},
 		"/scripts/init_change_route.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_route.sh",
-			modTime:          time.Date(2019, 12, 25, 17, 38, 50, 188812825, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 824,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xd3\x4c\x10\x86\xef\xfe\x15\x6f\x93\x4a\xfd\x3e\x29\xd8\x49\x0e\x20\xa8\x7a\x08\x6d\x11\x86\x2a\x91\xea\x94\xaa\xaa\x2a\xba\xb1\xc7\xf6\x08\x67\x76\xd9\x5d\xd7\x8d\x80\xff\x8e\xd6\x71\x11\x11\xbe\xad\x66\xe6\xd9\x67\xe7\xf5\xf8\x28\x69\x9d\x4d\x36\x2c\x09\xc9\x13\x36\xca\xd5\xd1\x78\x8c\x73\x6d\x76\x96\xab\xda\x63\x3e\x9d\xbd\x45\x56\x2b\xa9\x6a\xc5\xf8\xc4\x52\x5d\xb4\x1a\xa9\x94\xda\x6e\x95\x67\x2d\x58\x53\x5e\x8b\x6e\x74\xb5\x43\xae\xe3\x09\xae\x7c\x11\x47\xe3\x71\xc0\x5c\x71\x4e\xe2\xa8\x40\x2b\x05\x59\xf8\x9a\xb0\x30\x2a\xaf\xe9\xa5\x32\xc1\x17\xb2\x2e\x50\xe6\xf1\x14\xff\x85\x86\xd1\x50\x1a\xfd\x7f\x1a\x10\x3b\xdd\x62\xab\x76\x10\xed\xd1\x3a\x82\xaf\xd9\xa1\xe4\x86\x40\xcf\x39\x19\x0f\x16\xe4\x7a\x6b\x1a\x56\x92\x13\x3a\xf6\x75\x7f\xcf\x40\x09\x26\xb8\x1b\x18\x7a\xe3\x15\x0b\x14\x72\x6d\x76\xd0\xe5\xdf\x8d\x50\x7e\x90\xee\xbf\xda\x7b\xf3\x2e\x49\xba\xae\x8b\x55\x6f\x1c\x6b\x5b\x25\xcd\xbe\xd7\x25\x57\xe9\xf9\xe5\x32\xbb\x7c\x35\x8f\xa7\xc3\xd4\x8d\x34\xe4\x1c\x2c\x7d\x6f\xd9\x52\x81\xcd\x0e\xca\x98\x86\x73\xb5\x69\x08\x8d\xea\xa0\x2d\x54\x65\x89\x0a\x78\x1d\xac\x3b\xcb\x9e\xa5\x9a\xc0\xe9\xd2\x77\xca\x52\xc0\x14\xec\xbc\xe5\x4d\xeb\x0f\x96\xf6\xe2\xc8\xee\xa0\x41\x0b\x94\x60\xb4\xc8\x90\x66\x23\xbc\x5f\x64\x69\x36\x09\x90\xdb\x74\xfd\x71\x75\xb3\xc6\xed\xe2\xfa\x7a\xb1\x5c\xa7\x97\x19\x56\xd7\x38\x5f\x2d\x2f\xd2\x75\xba\x5a\x66\x58\x7d\xc0\x62\x79\x87\xcf\xe9\xf2\x62\x02\x62\x5f\x93\x05\x3d\x1b\x1b\x5e\xa0\x2d\x38\xac\x93\xfa\x14\x91\x11\x1d\x28\x94\x7a\xaf\xe4\x0c\xe5\x5c\x72\x8e\x46\x49\xd5\xaa\x8a\x50\xe9\x27\xb2\xc2\x52\xc1\x90\xdd\xb2\x0b\xb1\x3a\x28\x29\x02\xa6\xe1\x2d\xfb\xfe\x7f\x71\xff\xbe\x2b\x8e\xa2\x31\xd6\x21\x58\x97\x5b\x0e\x99\x3a\x28\xde\x86\x3d\x15\xd4\x90\x27\xb0\x3c\xa9\x86\x0b\x58\xdd\x7a\x8a\xa2\xe1\xf8\xb5\x3f\x9e\x3d\xb2\xd9\x17\xf0\x13\xaa\xfb\x86\x93\x64\xf6\x66\x1e\xcf\x5e\xc7\xd3\x78\x9a\xfc\x30\x96\xc5\xe3\x78\xf6\xeb\xe4\x31\xe2\x12\xf7\xf7\x38\x3e\x18\xc7\xd1\x19\x46\x23\x3c\x3c\x9c\x06\x25\x89\x42\xfa\x7f\x80\xc3\xf5\x87\x13\x51\xc9\xd1\xef\x00\x00\x00\xff\xff\xf2\x36\x8f\xf2\x38\x03\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\xd3\x40\x10\x85\xef\xfe\x15\xaf\x49\xa5\x82\x14\xec\x24\x07\x10\x54\x3d\x84\xb6\x08\x43\x95\x48\x75\x4a\x55\x55\x15\xdd\xd8\x63\x7b\xc4\x66\x76\xd9\x5d\xd7\x8d\x80\xff\x8e\x36\x4d\x81\x08\xdf\x56\xf3\xe6\xcd\x37\xf3\x3c\x3c\xc8\x3a\xef\xb2\x15\x4b\x46\xf2\x80\x95\xf2\x6d\x32\x1c\xe2\xd4\xd8\x8d\xe3\xa6\x0d\x98\x8e\x27\x6f\x51\xb4\x4a\x9a\x56\x31\x3e\xb1\x34\x67\x9d\x41\x2e\xb5\x71\x6b\x15\xd8\x08\x96\x54\xb6\x62\xb4\x69\x36\x28\x4d\x3a\xc2\x45\xa8\xd2\x64\x38\x8c\x36\x17\x5c\x92\x78\xaa\xd0\x49\x45\x0e\xa1\x25\xcc\xac\x2a\x5b\x7a\xae\x8c\xf0\x85\x9c\x8f\x2e\xd3\x74\x8c\x17\x51\x30\xd8\x95\x06\x2f\x8f\xa3\xc5\xc6\x74\x58\xab\x0d\xc4\x04\x74\x9e\x10\x5a\xf6\xa8\x59\x13\xe8\xb1\x24\x1b\xc0\x82\xd2\xac\xad\x66\x25\x25\xa1\xe7\xd0\x22\xfc\x1d\x10\x49\x70\xb3\xf3\x30\xab\xa0\x58\xa0\x50\x1a\xbb\x81\xa9\xff\x15\x42\x85\x1d\xf4\xf6\x6b\x43\xb0\xef\xb2\xac\xef\xfb\x54\x6d\x89\x53\xe3\x9a\x4c\x3f\x69\x7d\x76\x91\x9f\x9e\xcf\x8b\xf3\x57\xd3\x74\xbc\xeb\xba\x12\x4d\xde\xc3\xd1\xf7\x8e\x1d\x55\x58\x6d\xa0\xac\xd5\x5c\xaa\x95\x26\x68\xd5\xc3\x38\xa8\xc6\x11\x55\x08\x26\x52\xf7\x8e\x03\x4b\x33\x82\x37\x75\xe8\x95\xa3\x88\x5a\xb1\x0f\x8e\x57\x5d\xd8\x3b\xda\x33\x23\xfb\x3d\x81\x11\x28\xc1\x60\x56\x20\x2f\x06\x78\x3f\x2b\xf2\x62\x14\x4d\xae\xf3\xe5\xc7\xc5\xd5\x12\xd7\xb3\xcb\xcb\xd9\x7c\x99\x9f\x17\x58\x5c\xe2\x74\x31\x3f\xcb\x97\xf9\x62\x5e\x60\xf1\x01\xb3\xf9\x0d\x3e\xe7\xf3\xb3\x11\x88\x43\x4b\x0e\xf4\x68\x5d\xdc\xc0\x38\x70\x3c\x27\x6d\x53\x44\x41\xb4\x87\x50\x9b\xa7\x1c\xbd\xa5\x92\x6b\x2e\xa1\x95\x34\x9d\x6a\x08\x8d\x79\x20\x27\x2c\x0d\x2c\xb9\x35\xfb\x18\xab\x87\x92\x2a\xda\x68\x5e\x73\xd8\xfe\x2f\xfe\xff\xbd\xd2\x24\x19\x62\x19\x83\xf5\xa5\xe3\x98\xa9\x87\xe2\x75\xbc\x53\x45\x9a\x02\x81\xe5\x41\x69\xae\xe0\x4c\x17\x28\x49\x76\xcf\xaf\xdb\xe7\xc9\x3d\xdb\xa7\x02\x7e\x42\xf5\xdf\x70\x94\x4d\xde\x4c\xd3\xc9\xeb\x74\x9c\x8e\xb3\x1f\xd6\xb1\x04\x1c\x4e\x7e\x1d\xdd\x27\x5c\xe3\xf6\x16\x87\x7b\xed\x38\x38\xc1\x60\x80\xbb\xbb\xe3\x88\x24\x49\x4c\xff\x8f\xe1\x6e\xfc\x7e\x47\x52\x73\xf2\x7b\x00\xf2\x36\x8f\xf2\x38\x03\x00\x00"),
 		},
 		"/scripts/init_change_swap.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_change_swap.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 85321665, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 723,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\x9c\x3e\x10\xc5\xef\x7c\x8a\xf7\x5f\x0e\xf9\x57\xda\x40\x9a\x5b\xdb\x13\x4d\x52\x95\x36\x62\xa5\x40\x1a\xe5\x38\x98\x01\x46\x02\xdb\xb5\x4d\x08\xdf\xbe\xf2\x66\x23\x75\x55\x0e\x1c\x3c\xcf\x3f\xbf\x99\x37\xe9\x7f\xf9\xe2\x5d\xde\x8a\xce\x59\xbf\xa0\x25\x3f\x26\x69\x8a\x1b\x63\x37\x27\xc3\x18\x70\x7d\xf5\xf1\x13\xea\x91\xf4\x30\x92\xe0\x87\xe8\xe1\x76\x31\x28\x75\x6f\xdc\x4c\x41\x8c\x46\xc3\x6a\xd4\x66\x32\xc3\x06\x65\xb2\x3d\xee\x43\x97\x25\x69\x1a\x31\xf7\xa2\x58\x7b\xee\xb0\xe8\x8e\x1d\xc2\xc8\x28\x2c\xa9\x91\xdf\x2b\x7b\xfc\x62\xe7\x23\xe5\x3a\xbb\xc2\xff\x51\xb0\x3b\x95\x76\x1f\xbe\x44\xc4\x66\x16\xcc\xb4\x41\x9b\x80\xc5\x33\xc2\x28\x1e\xbd\x4c\x0c\x7e\x55\x6c\x03\x44\x43\x99\xd9\x4e\x42\x5a\x31\x56\x09\xe3\xf1\x9d\x13\x25\x3a\xc1\xf3\x89\x61\xda\x40\xa2\x41\x50\xc6\x6e\x30\xfd\xdf\x42\x50\x38\x99\x3e\x7e\x63\x08\xf6\x73\x9e\xaf\xeb\x9a\xd1\xd1\x71\x66\xdc\x90\x4f\x6f\x5a\x9f\xdf\x97\x37\x77\x55\x7d\x77\x79\x9d\x5d\x9d\x6e\x3d\xea\x89\xbd\x87\xe3\xdf\x8b\x38\xee\xd0\x6e\x20\x6b\x27\x51\xd4\x4e\x8c\x89\x56\x18\x07\x1a\x1c\x73\x87\x60\xa2\xeb\xd5\x49\x10\x3d\xec\xe1\x4d\x1f\x56\x72\x1c\x31\x9d\xf8\xe0\xa4\x5d\xc2\xd9\xd0\xde\x3d\x8a\x3f\x13\x18\x0d\xd2\xd8\x15\x35\xca\x7a\x87\xaf\x45\x5d\xd6\xfb\x08\x79\x2a\x9b\xef\x87\xc7\x06\x4f\xc5\xc3\x43\x51\x35\xe5\x5d\x8d\xc3\x03\x6e\x0e\xd5\x6d\xd9\x94\x87\xaa\xc6\xe1\x1b\x8a\xea\x19\x3f\xcb\xea\x76\x0f\x96\x30\xb2\x03\xbf\x5a\x17\x3b\x30\x0e\x12\xc7\xc9\xc7\x14\x51\x33\x9f\x59\xe8\xcd\x9b\x25\x6f\x59\x49\x2f\x0a\x13\xe9\x61\xa1\x81\x31\x98\x17\x76\x5a\xf4\x00\xcb\x6e\x16\x1f\x63\xf5\x20\xdd\x45\xcc\x24\xb3\x84\xe3\xbe\xf8\x7f\xfb\xca\x92\x24\x45\x13\x83\xf5\xca\x49\xcc\xd4\x83\x64\x8e\x73\x52\x93\xf1\x0c\xbf\x92\x4d\x92\xf8\x37\x7d\x8f\x4b\x4a\xe2\x4e\x5d\x0a\x2e\xf2\x78\x96\x77\x17\xc8\x39\xa8\xbc\xf7\x81\xda\xe4\x4f\x00\x00\x00\xff\xff\x60\xf9\x87\x10\xd3\x02\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6f\x9c\x30\x10\x85\xef\xfc\x8a\xd7\xe5\x90\x56\xda\x40\x9a\x5b\xdb\x13\x4d\x52\x95\x36\x62\xa5\x40\x1a\xe5\x38\x98\x01\x46\x02\xdb\xb5\x4d\x08\xff\xbe\xf2\x66\xa3\x36\x2a\x07\x0e\x9e\xe7\xe7\x6f\xe6\x4d\xfa\x2e\x5f\xbc\xcb\x5b\xd1\x39\xeb\x27\xb4\xe4\xc7\x24\x4d\x71\x65\xec\xe6\x64\x18\x03\x2e\x2f\x3e\x7e\x42\x3d\x92\x1e\x46\x12\xfc\x10\x3d\x5c\x2f\x06\xa5\xee\x8d\x9b\x29\x88\xd1\x68\x58\x8d\xda\x4c\x66\xd8\xa0\x4c\xb6\xc7\x6d\xe8\xb2\x24\x4d\xa3\xcd\xad\x28\xd6\x9e\x3b\x2c\xba\x63\x87\x30\x32\x0a\x4b\x6a\xe4\xd7\xca\x1e\xbf\xd8\xf9\xe8\x72\x99\x5d\xe0\x7d\x14\xec\x4e\xa5\xdd\x87\x2f\xd1\x62\x33\x0b\x66\xda\xa0\x4d\xc0\xe2\x19\x61\x14\x8f\x5e\x26\x06\x3f\x2b\xb6\x01\xa2\xa1\xcc\x6c\x27\x21\xad\x18\xab\x84\x11\xe1\xef\x03\x91\x04\x8f\x27\x0f\xd3\x06\x12\x0d\x82\x32\x76\x83\xe9\xff\x15\x82\xc2\x09\xfa\xf8\x8d\x21\xd8\xcf\x79\xbe\xae\x6b\x46\x47\xe2\xcc\xb8\x21\x9f\x5e\xb4\x3e\xbf\x2d\xaf\x6e\xaa\xfa\xe6\xfc\x32\xbb\x38\xdd\xba\xd7\x13\x7b\x0f\xc7\xbf\x17\x71\xdc\xa1\xdd\x40\xd6\x4e\xa2\xa8\x9d\x18\x13\xad\x30\x0e\x34\x38\xe6\x0e\xc1\x44\xea\xd5\x49\x10\x3d\xec\xe1\x4d\x1f\x56\x72\x1c\x51\x3b\xf1\xc1\x49\xbb\x84\x37\x43\x7b\x65\x14\xff\x46\x60\x34\x48\x63\x57\xd4\x28\xeb\x1d\xbe\x16\x75\x59\xef\xa3\xc9\x43\xd9\x7c\x3f\xdc\x37\x78\x28\xee\xee\x8a\xaa\x29\x6f\x6a\x1c\xee\x70\x75\xa8\xae\xcb\xa6\x3c\x54\x35\x0e\xdf\x50\x54\x8f\xf8\x59\x56\xd7\x7b\xb0\x84\x91\x1d\xf8\xd9\xba\xd8\x81\x71\x90\x38\x4e\x3e\xa6\x88\x9a\xf9\x0d\x42\x6f\x5e\x72\xf4\x96\x95\xf4\xa2\x30\x91\x1e\x16\x1a\x18\x83\x79\x62\xa7\x45\x0f\xb0\xec\x66\xf1\x31\x56\x0f\xd2\x5d\xb4\x99\x64\x96\x70\xdc\x17\xff\x7f\x5f\x59\x92\xa4\x68\x62\xb0\x5e\x39\x89\x99\x7a\x90\xcc\x71\x4e\x6a\x32\x9e\xe1\x57\xb2\x49\x12\xff\xa6\xef\x71\x4e\x49\xdc\xa9\x73\xc1\x59\x1e\xcf\xf2\xee\x0c\x39\x07\x95\xf7\x3e\x50\x9b\xfc\x19\x00\x60\xf9\x87\x10\xd3\x02\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_haproxy.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 85850954, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 1234,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xdd\x6e\xdc\x36\x13\x86\xcf\x79\x15\xef\xa7\x35\x8c\x04\x58\x49\xb6\xcf\xbe\x06\x29\xb0\xf5\xba\x8d\x5a\x63\x55\xac\x36\x4d\x8d\x20\x30\xb8\xd2\x48\x1a\x84\x22\x69\x92\xf2\x5a\x80\x2f\xbe\xe0\xfe\xb4\x76\xda\x1e\x55\xa7\xef\xe8\xe1\xc3\x99\x01\x67\xff\xcb\x47\xef\xf2\x2d\xeb\x9c\xf4\x23\xb6\xd2\xf7\x62\x36\xc3\xb5\xb1\x93\xe3\xae\x0f\xb8\xba\xb8\xfc\x3f\xaa\x5e\xea\xae\x97\x8c\x9f\x59\x77\xcb\xd1\xa0\xd0\xad\x71\x83\x0c\x6c\x34\x36\x54\xf7\xda\x28\xd3\x4d\xa8\x4d\x36\xc7\x6d\x68\x32\x31\x9b\x45\xcc\x2d\xd7\xa4\x3d\x35\x18\x75\x43\x0e\xa1\x27\x2c\xac\xac\x7b\x3a\x25\x73\xfc\x46\xce\x47\xca\x55\x76\x81\x37\xb1\x20\x39\x46\xc9\xdb\x77\x11\x31\x99\x11\x83\x9c\xa0\x4d\xc0\xe8\x09\xa1\x67\x8f\x96\x15\x81\x9e\x6a\xb2\x01\xac\x51\x9b\xc1\x2a\x96\xba\x26\xec\x38\xf4\xfb\x73\x8e\x94\x68\x82\xbb\x23\xc3\x6c\x83\x64\x0d\x89\xda\xd8\x09\xa6\x7d\x59\x08\x19\x8e\xd2\xfb\xaf\x0f\xc1\x7e\x97\xe7\xbb\xdd\x2e\x93\x7b\xe3\xcc\xb8\x2e\x57\x87\x5a\x9f\xdf\x16\xd7\x37\xab\xea\x26\xbd\xca\x2e\x8e\x7f\x7d\xd4\x8a\xbc\x87\xa3\x87\x91\x1d\x35\xd8\x4e\x90\xd6\x2a\xae\xe5\x56\x11\x94\xdc\xc1\x38\xc8\xce\x11\x35\x08\x26\x5a\xef\x1c\x07\xd6\xdd\x1c\xde\xb4\x61\x27\x1d\x45\x4c\xc3\x3e\x38\xde\x8e\xe1\x55\xd3\x4e\x8e\xec\x5f\x15\x18\x0d\xa9\x91\x2c\x2a\x14\x55\x82\x1f\x16\x55\x51\xcd\x23\xe4\x53\xb1\xf9\x50\x7e\xdc\xe0\xd3\x62\xbd\x5e\xac\x36\xc5\x4d\x85\x72\x8d\xeb\x72\xb5\x2c\x36\x45\xb9\xaa\x50\xfe\x88\xc5\xea\x0e\xbf\x14\xab\xe5\x1c\xc4\xa1\x27\x07\x7a\xb2\x2e\xde\xc0\x38\x70\x6c\x27\xed\xa7\x88\x8a\xe8\x95\x42\x6b\x0e\x4a\xde\x52\xcd\x2d\xd7\x50\x52\x77\xa3\xec\x08\x9d\x79\x24\xa7\x59\x77\xb0\xe4\x06\xf6\x71\xac\x1e\x52\x37\x11\xa3\x78\xe0\xb0\xdf\x17\xff\xf7\x7b\x65\x42\xcc\xb0\x89\x83\xf5\xb5\xe3\x38\x53\x0f\xc9\x43\xec\x53\x43\x56\x99\x09\xbd\xb4\xce\x3c\x4d\x42\x64\x50\xbc\xcd\x7c\x2f\x44\x59\xbd\xff\x89\x42\x59\x89\x0f\x8b\x5f\xd7\xe5\xef\x77\x8b\xe5\x72\xfd\xfe\xec\x52\x88\x40\x3e\x20\xd5\x38\x7b\x11\xe0\xf9\x19\x6f\xa8\xee\x0d\x92\x23\x0a\xb2\x69\x5c\x3c\x28\x2e\xd6\xe9\x32\x09\xce\xcf\x41\x4f\x1c\x70\xf9\x56\x08\x6e\xf1\xf9\x33\xce\xca\x0a\x29\x3d\x20\x19\xb7\xa3\x0e\x63\x82\x2f\x5f\xde\x45\x79\x2d\xe2\x9e\x48\x1b\xd2\x8e\xe2\x16\xfa\x20\x95\x42\xfa\x97\x6b\x8c\x1b\xfb\xb5\x43\xaa\xf0\x8c\xce\x91\x45\xfa\x70\x4a\xff\x41\xe8\x84\x68\x25\x2b\x6a\x5e\xa9\x90\xfa\xc6\xa5\x26\x1d\x8c\x8f\x2e\x11\xf4\x32\x71\x3d\xa9\x6f\x1c\xa7\x71\xf8\x37\x3f\x67\x07\xa4\x0f\xf2\xbf\xfb\x79\xda\xf3\x0e\x7f\xf8\xc9\x07\x1a\x0e\x9d\x1d\xad\x35\x2e\x24\x87\x74\x5f\x2e\x5a\x16\x62\xff\xe2\xc4\xd7\x06\x79\x18\x6c\xce\x9a\xc3\xfd\x61\xd6\xf7\xc7\xf3\xee\xbf\x12\x59\xa9\xf8\x91\x9a\xdc\x53\x18\x6d\xe6\x7b\xa4\x23\x92\x97\x73\x4d\xfe\xf4\x75\xa3\xc6\xd5\xf7\xe7\x97\xe2\x8f\x00\x00\x00\xff\xff\xe2\x08\x1a\xcf\xd2\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\x5f\x6f\xdb\x36\x14\xc5\xdf\xf9\x29\xce\xe4\x22\x68\x01\x4b\x4a\xf2\xb6\x15\x1d\xe0\xc5\xd9\xaa\x2d\xb0\x06\xcb\x5d\x17\x14\x45\x40\x4b\x57\xd2\x45\x29\x92\x21\xa9\x38\x02\xf2\xe1\x07\xfa\xcf\x9a\x74\xdb\xd3\xfc\x7a\xae\xcf\xfd\xdd\x7b\xae\x38\xfb\x2e\x1f\xbd\xcb\xb7\xac\x73\xd2\x0f\xd8\x4a\xdf\x8b\xd9\x0c\x57\xc6\x4e\x8e\xbb\x3e\xe0\xf2\xfc\xe2\x7b\x54\xbd\xd4\x5d\x2f\x19\xbf\xb2\xee\x96\xa3\x41\xa1\x5b\xe3\x06\x19\xd8\x68\x6c\xa8\xee\xb5\x51\xa6\x9b\x50\x9b\x6c\x8e\x9b\xd0\x64\x62\x36\x8b\x36\x37\x5c\x93\xf6\xd4\x60\xd4\x0d\x39\x84\x9e\xb0\xb0\xb2\xee\xe9\xa4\xcc\xf1\x07\x39\x1f\x5d\x2e\xb3\x73\xbc\x8e\x05\xc9\x51\x4a\xde\xbc\x8d\x16\x93\x19\x31\xc8\x09\xda\x04\x8c\x9e\x10\x7a\xf6\x68\x59\x11\xe8\xb1\x26\x1b\xc0\x1a\xb5\x19\xac\x62\xa9\x6b\xc2\x8e\x43\x8f\xf0\xb5\x41\x24\xc1\xed\xd1\xc3\x6c\x83\x64\x0d\x89\xda\xd8\x09\xa6\x7d\x5e\x08\x19\x8e\xd0\xfb\x5f\x1f\x82\xfd\x21\xcf\x77\xbb\x5d\x26\xf7\xc4\x99\x71\x5d\xae\x0e\xb5\x3e\xbf\x29\xae\xae\x57\xd5\x75\x7a\x99\x9d\x1f\xff\xf5\x41\x2b\xf2\x1e\x8e\xee\x47\x76\xd4\x60\x3b\x41\x5a\xab\xb8\x96\x5b\x45\x50\x72\x07\xe3\x20\x3b\x47\xd4\x20\x98\x48\xbd\x73\x1c\x58\x77\x73\x78\xd3\x86\x9d\x74\x14\x51\x1b\xf6\xc1\xf1\x76\x0c\x2f\x96\x76\x62\x64\xff\xa2\xc0\x68\x48\x8d\x64\x51\xa1\xa8\x12\xfc\xb4\xa8\x8a\x6a\x1e\x4d\x3e\x16\x9b\xf7\xe5\x87\x0d\x3e\x2e\xd6\xeb\xc5\x6a\x53\x5c\x57\x28\xd7\xb8\x2a\x57\xcb\x62\x53\x94\xab\x0a\xe5\xcf\x58\xac\x6e\xf1\x5b\xb1\x5a\xce\x41\x1c\x7a\x72\xa0\x47\xeb\xe2\x04\xc6\x81\xe3\x3a\x69\x9f\x22\x2a\xa2\x17\x08\xad\x39\xe4\xe8\x2d\xd5\xdc\x72\x0d\x25\x75\x37\xca\x8e\xd0\x99\x07\x72\x9a\x75\x07\x4b\x6e\x60\x1f\x63\xf5\x90\xba\x89\x36\x8a\x07\x0e\xfb\x7b\xf1\xff\x9c\x2b\x13\x62\x86\x4d\x0c\xd6\xd7\x8e\x63\xa6\x1e\x92\x87\xb8\xa7\x86\xac\x32\x13\x7a\x69\x9d\x79\x9c\x84\xc8\xa0\x78\x9b\xf9\x5e\x88\xb2\x7a\xf7\x0b\x85\xb2\x12\xef\x17\xbf\xaf\xcb\x3f\x6f\x17\xcb\xe5\xfa\xdd\xab\x0b\x21\x02\xf9\x80\x54\xe3\xd5\x33\x01\x4f\x4f\x78\x4d\x75\x6f\x90\x1c\xad\x20\x9b\xc6\xc5\x46\xf1\xb0\x4e\xc3\x24\x38\x3b\x03\x3d\x72\xc0\xc5\x1b\x21\xb8\xc5\xa7\x4f\x78\x55\x56\x48\xe9\x1e\xc9\xb8\x1d\x75\x18\x13\x7c\xfe\xfc\x36\xc2\x6b\x11\xef\x44\xda\x90\x76\x14\xaf\xd0\x07\xa9\x14\xd2\xaf\xac\x51\x6e\xec\x97\x0e\xa9\xc2\x13\x3a\x47\x16\xe9\xfd\x49\xfd\x17\xa0\x93\x45\x2b\x59\x51\xf3\x02\x85\xd4\x37\x2c\x35\xe9\x60\x7c\x64\x89\x46\xcf\x15\xd7\x93\xfa\x86\x71\x1a\x87\xff\xe2\x73\x76\x40\x7a\x2f\xff\x3f\x9f\xa7\x7d\xab\xc3\x8a\xfd\xe4\x03\x0d\xfb\x4f\xd6\x8f\xd6\x1a\x17\x92\x83\xba\x1f\x47\xb4\x2c\xc4\xfe\xc5\x89\xaf\x0d\xf2\x30\xd8\x9c\x35\x87\xbb\x43\xd6\x77\xc7\x7e\x77\x5f\x88\xac\x54\xfc\x40\x4d\xee\x29\x8c\x36\xf3\x3d\xd2\x11\xc9\xf3\x5c\x93\xbf\x79\xdd\xa8\x71\xf9\xe3\xd9\x85\xf8\x6b\x00\xe2\x08\x1a\xcf\xd2\x04\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived": &vfsgen۰DirInfo{
 			name:    "init_deploy_haproxy_keepalived",
-			modTime: time.Date(2019, 12, 17, 8, 37, 10, 547039866, time.UTC),
+			modTime: time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/docker.sh": &vfsgen۰CompressedFileInfo{
 			name:             "docker.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86171031, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 2725,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x71\x6f\x9b\xc8\x13\xfd\x9f\x4f\x31\x3f\x3b\x6a\x13\x29\x40\x5a\xfd\xee\xa4\xfa\xe4\x93\x68\x4c\x1b\xae\xa9\x89\x0c\x69\xaf\x3a\x9d\xac\xf5\x32\x86\x55\xd6\xbb\x74\x77\xb1\x63\x59\xfe\xee\xa7\xa5\x24\x01\x6c\x35\x91\xee\xf2\x47\x24\x86\xf7\xde\xbe\xd9\x79\x8c\x87\xff\xf3\x2b\xad\xfc\x05\x13\x3e\x8a\x35\x2c\x88\x2e\x9c\xe1\x10\x2e\x65\xb9\x55\x2c\x2f\x0c\xbc\xbd\x78\xf3\x0e\x92\x82\x88\xbc\x20\x0c\xfe\x60\x22\x9f\x54\x12\x22\xb1\x94\x6a\x45\x0c\x93\x02\x52\xa4\x85\x90\x5c\xe6\x5b\xa0\xd2\x3b\x87\x6b\x93\x79\xce\x70\x68\x65\xae\x19\x45\xa1\x31\x83\x4a\x64\xa8\xc0\x14\x08\x41\x49\x68\x81\x0f\x6f\xce\xe1\x0b\x2a\x6d\x55\xde\x7a\x17\x70\x6a\x01\x83\xe6\xd5\xe0\xec\x37\x2b\xb1\x95\x15\xac\xc8\x16\x84\x34\x50\x69\x04\x53\x30\x0d\x4b\xc6\x11\xf0\x9e\x62\x69\x80\x09\xa0\x72\x55\x72\x46\x04\x45\xd8\x30\x53\xd4\xe7\x34\x2a\xd6\x09\x7c\x6b\x34\xe4\xc2\x10\x26\x80\x00\x95\xe5\x16\xe4\xb2\x0d\x04\x62\x1a\xd3\xf5\x5f\x61\x4c\x39\xf2\xfd\xcd\x66\xe3\x91\xda\xb1\x27\x55\xee\xf3\x1f\x58\xed\x5f\x47\x97\xe1\x34\x09\xdd\xb7\xde\x45\xc3\xba\x15\x1c\xb5\x06\x85\xdf\x2b\xa6\x30\x83\xc5\x16\x48\x59\x72\x46\xc9\x82\x23\x70\xb2\x01\xa9\x80\xe4\x0a\x31\x03\x23\xad\xeb\x8d\x62\x86\x89\xfc\x1c\xb4\x5c\x9a\x0d\x51\x68\x65\x32\xa6\x8d\x62\x8b\xca\x74\x2e\xed\xc1\x23\xd3\x1d\x80\x14\x40\x04\x0c\x82\x04\xa2\x64\x00\xef\x83\x24\x4a\xce\xad\xc8\xd7\x28\xbd\x8a\x6f\x53\xf8\x1a\xcc\x66\xc1\x34\x8d\xc2\x04\xe2\x19\x5c\xc6\xd3\x49\x94\x46\xf1\x34\x81\xf8\x03\x04\xd3\x6f\xf0\x29\x9a\x4e\xce\x01\x99\x29\x50\x01\xde\x97\xca\x76\x20\x15\x30\x7b\x9d\x58\x4f\x11\x12\xc4\x8e\x85\xa5\xfc\x61\x49\x97\x48\xd9\x92\x51\xe0\x44\xe4\x15\xc9\x11\x72\xb9\x46\x25\x98\xc8\xa1\x44\xb5\x62\xda\x8e\x55\x03\x11\x99\x95\xe1\x6c\xc5\x4c\x9d\x17\x7d\xd8\x97\xe7\x38\x1a\x0d\xb8\x12\x50\x29\xbc\x67\xe6\xe1\x51\xc8\x4a\x68\x7c\x7c\x2c\x59\x89\x4b\xc2\xb8\xe3\x5c\x05\x37\xb3\xf8\xcf\x6f\xf3\xe8\x73\xf0\x31\x1c\x33\x91\xe1\xbd\x9b\xe1\xda\xfb\xce\x04\xab\x3c\x26\xfd\x3b\xe4\x6c\xa1\x88\xda\xfa\x05\x29\x95\xbc\xdf\x3a\xc3\x2e\xe7\xa1\xfc\x29\x0c\x6f\x82\xeb\xe8\x4b\x38\x79\x89\xd8\x1d\x62\x49\x38\x5b\x63\xe6\x0c\x0f\x98\x52\xb3\x7b\x46\xda\x98\x87\x23\xbf\x84\xb3\x24\x8a\xa7\xe3\x37\xde\x3b\xef\xd7\xf6\x91\x4f\x2f\xfe\xef\xfd\xe2\x38\x9f\x6e\xdf\x87\xb3\x69\x98\x86\xc9\xfc\x32\x9e\x7e\x88\x3e\xce\x27\xd1\x6c\xec\xa3\xa1\xfe\x5d\xb5\x40\x25\xd0\xa0\x7e\x54\x6d\x41\x4e\x76\x47\xa9\xfb\xc7\xee\xbb\x9c\xf1\xc9\xee\x50\xe4\x11\xec\xd1\x65\xde\x36\xf9\x92\x73\x5a\x4d\x1f\x30\x2d\xeb\x98\x5a\x9b\xe5\x51\x29\x96\x8e\x33\x8b\xe3\x74\x7c\x72\x5a\x4f\x1d\x2e\x27\x37\x41\x7a\x05\xaf\x5e\x01\xcd\xe0\xe4\x34\x63\x4a\x90\x15\xc2\xe0\x64\xf7\x3e\x48\xae\xe6\x49\x7c\x3b\xbb\x0c\xff\xba\xf8\x7b\x3f\x38\xf3\x3d\xcf\xe2\xca\x4d\x76\xe6\x58\xf0\xce\x0a\xed\x1d\x47\xcb\x4a\xd1\x9a\x52\x17\x7c\x26\x98\x99\x67\x58\x72\xb9\x9d\x37\xdd\xce\x9f\x4c\xf8\x9c\x2d\x3c\x5d\x0c\x1c\x27\x93\xf4\x0e\xd5\x68\x44\x0b\xa4\x77\xa7\x67\xb0\x73\xec\x52\xf8\x51\x85\x75\xb3\xb2\x7e\x07\x3f\xc3\xb5\x2f\x2a\xce\x9d\xbd\xe3\x34\x7a\xa3\x91\x36\x44\x99\x3e\x49\x55\x02\xdc\x8c\x19\x70\x5d\x85\x35\x02\x08\xdf\x90\xad\x06\xd7\xad\xdb\x7a\x1a\xb0\x5b\x10\xb7\x11\x03\x97\x83\x5e\xd3\x71\xe7\xa5\x2d\x92\xb2\x1c\x3f\x62\xd6\x70\x74\x9e\xa3\x7a\xb5\x73\x49\x09\xaf\x23\xf4\x60\x50\xc9\x73\xcd\xc9\x1a\xc1\x2d\x5b\xc4\x9b\x78\x96\xee\x47\xbd\xe7\x2e\x24\x49\x83\x34\x39\x00\xb6\xaa\x2d\x6c\xfd\x49\xb4\x61\x4d\xd4\xf7\xbd\xab\x92\xe5\xc1\x4d\xad\xc0\x5d\x1e\xbf\x8e\x0e\x57\x21\x97\x24\xeb\xb3\xef\x18\xe7\xe0\x6a\xb8\xba\xbd\x79\x91\x46\x77\x58\x1d\x63\xfd\x0a\x51\xa6\x3f\x67\x53\xe9\xbe\x01\x26\xec\x6a\x34\xb6\x87\xd7\xbb\x9d\x97\x18\x62\xb0\xfe\x5f\xe9\xfd\xfe\xf5\x4b\x3c\x55\xe2\xd0\x8f\xfd\x3c\x58\xfe\x9c\x23\xca\x91\x1c\x21\x1f\x36\x53\x89\x46\x70\xef\x38\x4f\xf9\xff\x6f\xa2\xfb\xa4\xf7\xd3\xf4\xb6\x61\x36\xc0\x07\x2b\x62\x3f\xf2\xa9\x14\xf6\x17\x1b\x95\xaf\x51\xad\x19\xc5\xd6\xc6\xf0\x89\xd6\x68\x74\x7f\x87\xb4\xd2\xed\x52\x52\xba\x24\xcb\x60\x1a\xa6\xf3\x60\xf2\x39\x9a\x5a\xc3\x68\xa0\x90\xda\x74\x4f\x7c\x4c\xeb\xe1\x6e\xde\x5b\x21\x59\x6e\xdd\xc6\xc2\xe1\x95\xbd\x2c\xc2\xad\x0d\xd9\x53\xe8\x87\xb0\xaf\x7e\xa4\xd8\x0c\xbe\x57\xfc\xb7\x69\xfc\x89\xc5\x56\x26\xdb\xf5\x56\x2c\x9f\x35\xd8\x0d\xe7\xb3\x4d\xb6\x22\xfa\x4f\x00\x00\x00\xff\xff\x06\x9b\x85\x9c\xa5\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x61\x6f\xdb\x36\x10\xfd\xce\x5f\x71\xb3\x83\x36\x01\x22\x29\x2d\xb6\x01\xf5\x90\x01\x6a\xac\x36\x5a\x53\x2b\xb0\x94\x76\xc5\x30\x18\x34\x75\x96\x88\xd0\xa4\x4a\x52\x76\x0c\x43\xff\x7d\x90\xaa\xd8\x92\x9d\xb5\x19\xe6\x0f\x06\x74\x7c\xef\xf1\x1d\xef\x89\xf6\xf0\x27\xaf\x34\xda\x9b\x73\xe9\xa1\x5c\xc1\x9c\x9a\x9c\x0c\x87\x70\xa5\x8a\x8d\xe6\x59\x6e\xe1\xf5\xc5\xab\x37\x10\xe7\x54\x66\x39\xe5\xf0\x07\x97\xd9\xb8\x54\x10\xca\x85\xd2\x4b\x6a\xb9\x92\x90\x20\xcb\xa5\x12\x2a\xdb\x00\x53\xee\x39\xdc\xd8\xd4\x25\xc3\x61\x2d\x73\xc3\x19\x4a\x83\x29\x94\x32\x45\x0d\x36\x47\xf0\x0b\xca\x72\x7c\x5c\x39\x87\x4f\xa8\x4d\xad\xf2\xda\xbd\x80\xd3\x1a\x30\x68\x97\x06\x67\xbf\xd5\x12\x1b\x55\xc2\x92\x6e\x40\x2a\x0b\xa5\x41\xb0\x39\x37\xb0\xe0\x02\x01\x1f\x18\x16\x16\xb8\x04\xa6\x96\x85\xe0\x54\x32\x84\x35\xb7\x39\xd8\xfd\x06\xb5\x13\xf8\xd2\x6a\xa8\xb9\xa5\x5c\x02\x05\xa6\x8a\x0d\xa8\x45\x17\x08\xd4\xb6\xa6\x9b\x4f\x6e\x6d\x31\xf2\xbc\xf5\x7a\xed\xd2\xc6\xb1\xab\x74\xe6\x89\x6f\x58\xe3\xdd\x84\x57\xc1\x24\x0e\x9c\xd7\xee\x45\xcb\xba\x93\x02\x8d\x01\x8d\x5f\x4b\xae\x31\x85\xf9\x06\x68\x51\x08\xce\xe8\x5c\x20\x08\xba\x06\xa5\x81\x66\x1a\x31\x05\xab\x6a\xd7\x6b\xcd\x2d\x97\xd9\x39\x18\xb5\xb0\x6b\xaa\xb1\xb6\x9a\x72\x63\x35\x9f\x97\xb6\x77\x68\x8f\x1e\xb9\xe9\x01\x94\x04\x2a\x61\xe0\xc7\x10\xc6\x03\x78\xeb\xc7\x61\x7c\x5e\x8b\x7c\x0e\x93\xeb\xe8\x2e\x81\xcf\xfe\x74\xea\x4f\x92\x30\x88\x21\x9a\xc2\x55\x34\x19\x87\x49\x18\x4d\x62\x88\xde\x81\x3f\xf9\x02\x1f\xc2\xc9\xf8\x1c\x90\xdb\x1c\x35\xe0\x43\xa1\xeb\x0e\x94\x06\x5e\x1f\x27\x36\x53\x84\x18\xb1\x67\x61\xa1\xbe\xcd\xd1\x14\xc8\xf8\x82\x33\x10\x54\x66\x25\xcd\x10\x32\xb5\x42\x2d\xb9\xcc\xa0\x40\xbd\xe4\xa6\x1e\xab\x01\x2a\xd3\x5a\x46\xf0\x25\xb7\x4d\x5e\xcc\x71\x5f\x2e\x21\x06\x2d\x38\x0a\x50\x6b\x7c\xe0\xf6\xf1\x51\xaa\x52\x1a\xdc\x3d\x16\xbc\xc0\x05\xe5\x82\x90\x6b\xff\x76\x1a\xfd\xf9\x65\x16\x7e\xf4\xdf\x07\x97\x5c\xa6\xf8\xe0\xa4\xb8\x72\xbf\x72\xc9\x4b\x97\x2b\xef\x1e\x05\x9f\x6b\xaa\x37\x5e\x4e\x0b\xad\x1e\x36\x64\xd8\xe7\x3c\x96\x3f\x04\xc1\xad\x7f\x13\x7e\x0a\xc6\xcf\x11\xbb\x47\x2c\xa8\xe0\x2b\x4c\xc9\xf0\x88\xa9\x0c\x7f\xe0\xb4\x8b\x79\xdc\xf2\x53\x30\x8d\xc3\x68\x72\xf9\xca\x7d\xe3\xfe\xda\xdd\x72\xbf\xf0\xb3\xfb\x0b\x21\x1f\xee\xde\x06\xd3\x49\x90\x04\xf1\xec\x2a\x9a\xbc\x0b\xdf\xcf\xc6\xe1\xf4\xd2\x43\xcb\xbc\xfb\x72\x8e\x5a\xa2\x45\xb3\x53\xed\x40\x4e\xb6\x4f\x52\xab\x5d\xf7\x7d\xce\xe5\xc9\xf6\x58\x64\x07\x76\xd9\x22\xeb\x9a\xdc\x43\xfe\x7d\x9f\x4e\xd3\x47\xcc\x9a\xf5\x94\x5a\x97\xe5\x32\x25\x17\x84\x4c\xa3\x28\xb9\x3c\x39\x6d\xa6\x0e\x57\xe3\x5b\x3f\xb9\x86\x17\x2f\x80\xa5\x70\x72\x9a\x72\x2d\xe9\x12\x61\x70\xb2\x7d\xeb\xc7\xd7\xb3\x38\xba\x9b\x5e\x05\x7f\x5d\xfc\x5d\x0d\xce\x3c\xd7\xad\x71\xc5\x3a\x3d\x23\x35\x78\x5b\x0b\x55\x84\x18\x55\x6a\xd6\x50\x9a\x82\xc7\x25\xb7\xb3\x14\x0b\xa1\x36\xb3\xb6\xdb\xd9\xde\x84\x27\xf8\xdc\x35\xf9\x80\x90\x54\xb1\x7b\xd4\xa3\x11\xcb\x91\xdd\x9f\x9e\xc1\x96\xd4\x97\xc2\xb7\x2a\xac\xda\x2b\xeb\x77\xf0\x52\x5c\x79\xb2\x14\x82\x54\x84\xb4\x7a\xa3\x91\xb1\x54\xdb\x43\x92\x2e\x25\x38\x29\xb7\xe0\x38\x1a\x1b\x04\x50\xb1\xa6\x1b\x03\x8e\xd3\xb4\xb5\x1f\xb0\x93\x53\xa7\x15\x03\x47\x80\x59\xb1\xcb\xde\x62\x5d\xa4\x45\xf1\x18\x61\x70\x56\xf0\xe4\x3c\x47\xcd\xd5\x2e\x14\xa3\xa2\x89\x50\x8b\x1f\x69\x75\x6e\x04\x5d\x21\x38\x45\x87\x78\x1b\x4d\x93\x6a\x74\xf0\xdc\x87\xc4\x89\x9f\xc4\x47\xc0\x4e\xb5\x83\x6d\x5e\xa6\x2e\xac\x8d\x7a\x75\x70\x54\xaa\x38\x3a\xa9\x25\x38\x8b\xa7\x8f\xa3\xc7\xd5\x28\x14\x4d\x0f\xd9\xf7\x5c\x08\x70\x0c\x5c\xdf\xdd\x3e\x4b\xa3\x3f\xac\xdd\x82\xb1\xaa\x38\xac\x50\x6d\x7b\x64\x63\xa9\x2d\xcd\xa1\x01\x2e\xeb\xab\xd1\xd6\x3d\xbc\xdc\x6e\xdd\xd8\x52\x8b\xcd\x77\x69\xaa\xea\xe5\x73\x3c\x95\xf2\xd8\x4f\xfd\x7a\xf0\xec\x47\x8e\x98\x40\x2a\x9f\xd3\x4c\x29\x5b\xc1\x8a\x90\x7d\xfe\x5b\xc9\xc3\x8e\xfe\x63\x74\xf7\x7a\xdf\x4d\x6f\x17\xb6\x82\x27\xae\x88\x6a\xe4\x31\x25\xeb\x5f\x6c\xd4\x9e\x41\xbd\xe2\x0c\x3b\x37\x86\x47\x8d\x41\x6b\x0e\xef\x90\x4e\xba\x1d\x46\x0b\x87\xa6\x29\x4c\x82\x64\xe6\x8f\x3f\x86\x13\x70\x1c\x89\x16\x72\x65\x6c\x7f\xc7\x5d\x5a\x8f\xef\xe6\x0a\x1c\xa7\xfe\xbf\xe0\xb4\x16\x8e\x8f\xec\x79\x11\xde\x73\x0e\x15\x0e\x43\xd8\x5d\xdb\x8d\xae\x5f\x6c\x07\x7f\x50\xfc\xbf\x69\xfc\x8e\xc5\x4e\x26\xbb\xf5\x4e\x2c\x7f\x68\xb0\x1f\xce\x1f\x36\x59\x4a\xa6\xe4\x82\x67\xa4\x22\xff\x0c\x00\x06\x9b\x85\x9c\xa5\x0a\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/lib.sh": &vfsgen۰CompressedFileInfo{
 			name:             "lib.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86439848, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 2477,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x6b\x6f\xdb\x3a\x12\xfd\xce\x5f\x71\x6a\x1b\x8b\x76\x1b\x3f\xd3\xed\x6e\xd3\x07\xea\xc6\xce\x56\xdb\xac\x1d\xd8\x69\xba\x45\x36\x30\x68\x69\x24\x0d\x22\x93\xba\x24\x65\xc7\xd7\xf0\x7f\xbf\xa0\xfc\x68\x52\x27\x45\x9d\x0f\x01\xc9\xe1\xe1\x99\x33\x67\x46\xd5\x67\xcd\xc2\x9a\xe6\x94\x55\x93\xd4\x1c\x53\x69\x53\x51\xad\xe2\x54\xe7\x4b\xc3\x49\xea\xd0\x69\xb5\xdf\x60\x9c\x4a\x95\xa4\x92\xf1\x1f\x56\x49\xaf\xd0\x08\x54\xac\xcd\x4c\x3a\xd6\x0a\x97\x14\xa6\x4a\x67\x3a\x59\x22\xd4\x8d\x23\x9c\xbb\xa8\x21\xaa\x55\x0f\x73\xce\x21\x29\x4b\x11\x0a\x15\x91\x81\x4b\x09\xdd\x5c\x86\x29\xed\x4e\x8e\x70\x45\xc6\x7a\x94\x4e\xa3\x85\xe7\x3e\xa0\xb2\x3d\xaa\xbc\x78\xeb\x21\x96\xba\xc0\x4c\x2e\xa1\xb4\x43\x61\x09\x2e\x65\x8b\x98\x33\x02\xdd\x85\x94\x3b\xb0\x42\xa8\x67\x79\xc6\x52\x85\x84\x05\xbb\xb4\x7c\x67\x8b\xe2\x99\xe0\xfb\x16\x43\x4f\x9d\x64\x05\x89\x50\xe7\x4b\xe8\xf8\x7e\x20\xa4\xdb\x92\x2e\x7f\xa9\x73\xf9\x49\xb3\xb9\x58\x2c\x1a\xb2\x64\xdc\xd0\x26\x69\x66\x9b\x58\xdb\x3c\x0f\x4e\xfb\x83\x71\xbf\xde\x69\xb4\xb6\xb7\xbe\xaa\x8c\xac\x85\xa1\x3f\x0a\x36\x14\x61\xba\x84\xcc\xf3\x8c\x43\x39\xcd\x08\x99\x5c\x40\x1b\xc8\xc4\x10\x45\x70\xda\xb3\x5e\x18\x76\xac\x92\x23\x58\x1d\xbb\x85\x34\xe4\x61\x22\xb6\xce\xf0\xb4\x70\x0f\x44\xdb\x71\x64\xfb\x20\x40\x2b\x48\x85\x4a\x77\x8c\x60\x5c\xc1\xa7\xee\x38\x18\x1f\x79\x90\x6f\xc1\xe5\xe7\xe1\xd7\x4b\x7c\xeb\x8e\x46\xdd\xc1\x65\xd0\x1f\x63\x38\xc2\xe9\x70\xd0\x0b\x2e\x83\xe1\x60\x8c\xe1\x19\xba\x83\xef\xf8\x12\x0c\x7a\x47\x20\x76\x29\x19\xd0\x5d\x6e\x7c\x06\xda\x80\xbd\x9c\x54\x56\x11\x63\xa2\x07\x14\x62\xbd\xa1\x64\x73\x0a\x39\xe6\x10\x99\x54\x49\x21\x13\x42\xa2\xe7\x64\x14\xab\x04\x39\x99\x19\x5b\x5f\x56\x0b\xa9\x22\x0f\x93\xf1\x8c\x5d\xe9\x17\x7b\x98\x57\x43\x08\x4b\x0e\x75\x0d\x32\x86\xee\xd8\xed\x96\x4a\x17\xca\xd2\x7e\x99\x73\x4e\xb1\xe4\x4c\x88\x88\xe9\xf9\x0b\xac\x04\xc0\x31\x1c\x59\x87\x5a\x15\xf5\xc4\xa1\xf5\xd6\x03\x2b\xe1\x6b\x48\x61\xaa\x51\xa9\x7d\xac\xa0\xfd\xe1\x6f\x1d\x01\xc4\x2c\x00\x8f\x8f\xb6\x58\x0b\x91\xca\xdc\xe8\xbb\xe5\xc9\x49\xa8\x55\xcc\xc9\x16\x10\x98\xdd\x46\x6c\x50\xcf\x51\x5b\x7d\xee\x5e\x8c\x86\xff\xfb\x3e\x39\x1d\x0e\xce\x82\x7f\x4f\x7a\xc1\x68\x2d\xca\x98\x4c\x87\x32\x43\x91\x5b\x67\x48\xce\xec\xfb\x4a\xa5\xdc\xf6\xea\xb0\x2f\x6e\xa5\xb6\x7a\xf6\xe5\xeb\xa7\xfe\xa4\x7b\x11\x8c\xfb\xa3\xab\xfe\x68\xd2\xed\xf5\x46\xe3\xeb\x8f\x37\xeb\xca\xdb\x32\x36\xd2\x02\xdb\x5f\x6e\x58\xb9\x18\xf5\x39\x32\x56\x84\x0a\x60\xc9\xcc\xc9\x6c\xff\xd5\xae\xf9\x65\xfb\x06\xb5\xd5\xa3\x88\x35\xbe\x59\x63\x26\xef\x42\xad\x14\x3a\xad\x57\xff\x42\x98\x52\x78\x8b\x58\x66\x19\x8e\x61\xd8\x12\x3a\xff\x57\x95\xfd\x6b\x7b\xd6\x2f\xdf\xd7\x56\xfe\xc1\xf5\x96\x8f\xa2\x4d\x72\xa1\x74\xf8\x70\x90\xfd\x1a\xef\xde\xf5\x87\x67\x22\xc9\xf4\x54\x66\x02\xa8\x16\x96\x0c\xb6\x2a\xfa\x75\x62\x74\x91\xdf\xdb\x88\x24\xcd\xb4\xaf\xc5\x8e\xdd\xab\xd6\x9b\xd7\x22\xa2\x58\x16\x99\xb3\x7e\x5f\x47\xe4\x1f\x74\x61\x2e\x80\xa9\xcc\xca\x1e\xce\x48\x5a\xe7\xe3\x05\xe0\x78\x46\xba\x70\x08\x33\x26\xe5\x36\xfc\x8f\x5b\xf6\xde\xc9\x56\xa9\x47\x4e\x3c\x04\x85\xbb\x4b\xfe\xc4\x90\x33\x4c\x16\xc7\x22\x63\xeb\x48\xc1\x3a\x59\x12\x99\xb2\x8a\xd0\x6a\x94\x7f\x27\xed\x37\xc7\xaf\x77\xe4\xfc\x1c\x10\xd8\xc4\x81\x94\x6f\xe6\xfd\xb2\x30\x8c\xa6\x88\x8d\x56\x8e\x54\x84\xdb\x62\x4a\x46\x91\xa3\x03\xc0\x1f\x4a\x5e\x0c\x47\x97\x5e\xed\xad\x06\x93\xa9\x0c\x6f\x77\x77\xeb\x32\xe7\x4d\x32\xe2\x89\xed\xda\x6a\x5f\xba\xb5\xf0\xa5\x38\x34\xf1\xc9\x49\x59\xfc\xbd\x97\x39\xc6\xf5\x35\xea\x7f\x7a\x43\x3e\xe6\x9e\x97\x77\xeb\x0a\x6e\x6e\xee\x75\x4d\xe9\x10\x2b\x13\xda\xaf\x22\x26\x54\x1e\xbb\xec\xe7\xd1\x6e\xe0\x6d\xfd\xcf\x0f\x38\x15\xea\xa7\xd6\x32\x33\xd4\x4d\xfc\x44\x63\xad\x85\xb8\x25\xca\x65\xc6\x73\x8a\x7e\xd5\x95\x5f\xfa\xfd\x8b\xee\x79\x70\xd5\xef\x3d\xd5\x98\x73\x36\xae\x90\xd9\xc4\xe8\xc2\x91\x99\x70\xf4\xbe\xb6\xba\x0a\x2e\xaa\xd5\xbf\x37\xd6\x0f\x2c\x7e\x00\xb5\x73\xf9\xdc\x98\x7c\x62\x43\xc3\xb9\x43\x98\xde\x4e\xca\x8c\x4a\x2a\xdb\xcd\x8a\x0a\x51\x5f\xe0\xd8\x8b\xdb\xee\xfc\xb3\x2c\x75\x1b\x3f\x95\xda\xab\xc2\xca\x91\x99\xcb\x0c\x7e\x04\x2d\xa8\xfc\xaa\xd6\xdb\xad\x96\x1f\x48\x65\x8b\x7a\x63\x96\x4d\xea\x15\x28\xdf\x65\x65\x5d\xd9\x0a\x3f\x3c\x35\x49\xe5\x66\xde\x79\xb4\x58\x86\x84\xda\x2a\x18\x5c\xf6\x47\x67\xdd\xd3\xbe\xcf\xe9\x20\x65\xd4\x56\x07\x7b\x3e\xb0\xaa\x74\x6e\x88\x66\xb9\x13\xe5\xe4\xd1\x86\xdd\x12\x1b\x42\x32\x9a\x93\x71\x13\x56\x7e\x44\x02\xb2\xf0\xb6\x70\x1c\x6e\xbe\xf4\x9b\x42\xf8\xcd\x89\x5b\xe6\x84\xff\xf6\xfe\x21\x80\xfb\x8f\x73\x2e\xa3\xa8\xfc\x8c\x6c\x62\x4b\xd5\xd7\xcd\xe3\xce\x36\xce\x19\x19\xde\xee\x64\xdd\x84\xec\xc5\x2d\x43\xf6\xbe\x3e\x74\xc2\xaf\xac\x7d\x15\x5c\xfc\xb6\x93\xaf\x82\x8b\x47\x8d\xfb\x33\xe6\x5e\xdd\xdf\x46\xde\xdf\x78\xaa\x31\xee\x27\xf5\x74\x6f\x3c\x61\xef\xb5\xf8\x2b\x00\x00\xff\xff\xac\x01\xa9\x85\xad\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xfd\x6f\xdb\x38\x12\xfd\x5d\x7f\xc5\xab\x6c\x1c\xda\x6b\xfc\x99\x5e\xef\x9a\x7e\xa0\x6e\xec\x5c\xb5\xcd\xda\x81\x9d\xa6\x5b\x64\x03\x83\x96\x46\xd2\x20\x32\xa9\x25\x29\x3b\x5e\xc3\xff\xfb\x82\xf2\x47\x9c\x3a\x29\x6a\x0b\x10\x48\x0e\x1f\xdf\xbc\x79\x43\x55\x9e\x35\x0a\xa3\x1b\x13\x96\x0d\x92\x33\x4c\x84\x49\xbd\x4a\x05\xa7\x2a\x5f\x68\x4e\x52\x8b\x76\xb3\xf5\x06\xa3\x54\xc8\x24\x15\x8c\xdf\x58\x26\xdd\x42\x21\x90\xb1\xd2\x53\x61\x59\x49\x5c\x52\x98\x4a\x95\xa9\x64\x81\x50\xd5\x8f\x70\x6e\xa3\xba\x57\xa9\x38\x98\x73\x0e\x49\x1a\x8a\x50\xc8\x88\x34\x6c\x4a\xe8\xe4\x22\x4c\x69\xbb\x72\x84\x2b\xd2\xc6\xa1\xb4\xeb\x4d\x3c\x77\x01\xfe\x66\xc9\x7f\xf1\xd6\x41\x2c\x54\x81\xa9\x58\x40\x2a\x8b\xc2\x10\x6c\xca\x06\x31\x67\x04\xba\x0b\x29\xb7\x60\x89\x50\x4d\xf3\x8c\x85\x0c\x09\x73\xb6\x29\xec\xfd\x01\x8e\x09\xbe\x6f\x30\xd4\xc4\x0a\x96\x10\x08\x55\xbe\x80\x8a\xf7\x03\x21\xec\x86\x74\xf9\x4b\xad\xcd\x4f\x1a\x8d\xf9\x7c\x5e\x17\x25\xe3\xba\xd2\x49\x23\x5b\xc7\x9a\xc6\x79\x70\xda\xeb\x8f\x7a\xb5\x76\xbd\xb9\xd9\xf5\x55\x66\x64\x0c\x34\xfd\x55\xb0\xa6\x08\x93\x05\x44\x9e\x67\x1c\x8a\x49\x46\xc8\xc4\x1c\x4a\x43\x24\x9a\x28\x82\x55\x8e\xf5\x5c\xb3\x65\x99\x1c\xc1\xa8\xd8\xce\x85\x26\x47\x35\x62\x63\x35\x4f\x0a\xfb\x40\xb4\x2d\x47\x36\x0f\x02\x94\x84\x90\xf0\x3b\x23\x04\x23\x1f\x9f\x3a\xa3\x60\x74\xe4\x40\xbe\x05\x97\x9f\x07\x5f\x2f\xf1\xad\x33\x1c\x76\xfa\x97\x41\x6f\x84\xc1\x10\xa7\x83\x7e\x37\xb8\x0c\x06\xfd\x11\x06\x67\xe8\xf4\xbf\xe3\x4b\xd0\xef\x1e\x81\xd8\xa6\xa4\x41\x77\xb9\x76\x19\x28\x0d\x76\x72\x52\x59\x45\x8c\x88\x1e\x50\x88\xd5\xba\x8e\x26\xa7\x90\x63\x0e\x91\x09\x99\x14\x22\x21\x24\x6a\x46\x5a\xb2\x4c\x90\x93\x9e\xb2\x71\x65\x35\x10\x32\x72\x30\x19\x4f\xd9\x96\x7e\x31\x87\x79\xd5\x3d\xcf\x90\x45\x4d\x81\xb4\xa6\x3b\xb6\xdb\xa1\x54\x85\x34\xb4\x1b\xe6\x9c\x53\x2c\x38\xf3\xbc\x88\xe9\xf9\x0b\x2c\x3d\x80\x63\x58\x32\x16\xd5\x0a\x6a\x89\x45\xf3\xad\x03\x96\x9e\xab\x21\x85\xa9\x82\x5f\xfd\xe8\xa3\xf5\xe1\x5f\x6d\x0f\x88\xd9\x03\x1c\x3e\x5a\xde\xca\xf3\x52\x91\x6b\x75\xb7\x38\x39\x09\x95\x8c\x39\xd9\x00\x02\xd3\xdb\x88\x35\x6a\x39\xaa\xcb\xcf\x9d\x8b\xe1\xe0\x8f\xef\xe3\xd3\x41\xff\x2c\xf8\xff\xb8\x1b\x0c\x57\x5e\x19\x93\xa9\x50\x64\x28\x72\x63\x35\x89\xa9\x79\xef\xfb\xe5\xb4\x53\x87\x5d\x71\xfd\xea\xf2\xd9\x97\xaf\x9f\x7a\xe3\xce\x45\x30\xea\x0d\xaf\x7a\xc3\x71\xa7\xdb\x1d\x8e\xae\x3f\xde\xac\xfc\xb7\x65\x6c\xa4\xca\x97\x7b\x72\xcd\xd2\xc6\xa8\xcd\x90\xb1\x24\xf8\x80\x21\x3d\x23\xbd\x79\x55\xaf\xf9\x65\xeb\x06\xd5\xe5\xa3\x88\x55\xbe\x59\x61\x2a\xee\x42\x25\x25\xda\xcd\x57\xff\x43\x98\x52\x78\x8b\x58\x64\x19\x8e\xa1\xd9\x10\xda\x7f\x4a\x7f\x77\xda\x8e\xf5\xcb\xf7\xd5\xa5\x3b\x70\xb5\xe1\x23\x69\x9d\x5c\x28\x2c\x3e\x1c\x64\xbf\xc2\xbb\x77\xbd\xc1\x99\x97\x64\x6a\x22\x32\x0f\xa8\x14\x86\x34\x36\x2a\xba\x71\xa2\x55\x91\xef\x4d\x44\x82\xa6\xca\xd5\x62\xcb\xee\x55\xf3\xcd\x6b\x2f\xa2\x58\x14\x99\x35\x6e\x5e\x45\xe4\x0e\xb4\x61\xee\x01\x13\x91\x95\x3d\x9c\x91\x30\xd6\x65\xe3\x01\x96\xa7\xa4\x0a\x8b\x30\x63\x92\xd6\xc5\x02\xc7\x4d\xb3\xb7\xb2\x51\xea\x91\x15\x07\x41\xe1\x76\x93\xdb\xa3\xc9\x6a\x26\x83\x63\x2f\x63\x63\x49\xc2\x58\x51\x12\x99\xb0\x8c\xd0\xac\x97\xff\x93\xd6\x9b\xe3\xd7\x5b\x72\xee\x1e\xf0\xb0\x8e\x03\x49\xd7\xcc\xbb\x61\xa1\x19\x0d\x2f\xd6\x4a\x5a\x92\x11\x6e\x8b\x09\x69\x49\x96\x0e\x00\xef\x95\xbc\x18\x0c\x2f\x9d\xda\x1b\x0d\xc6\x13\x11\xde\x6e\xf7\xd6\x44\xce\xeb\x64\xbc\x27\xa6\xab\xcb\x5d\xe9\x56\x9e\x2b\xc5\xa1\x89\x4f\x4e\xca\xe2\xef\xbc\xcc\x31\xae\xaf\x51\xfb\x1b\xfe\xe3\xee\x79\x79\xb7\xf2\x71\x73\xb3\xd7\x35\xee\x29\x8c\x48\x68\x37\x8a\x98\xe0\x3f\xb6\x19\x7c\x7f\xe1\x6d\xfc\xcf\x0f\x38\x15\xf2\x87\xd6\xd2\x53\xd4\x74\xfc\x44\x63\xad\x3c\xef\x96\x28\x17\x19\xcf\x28\xfa\x59\x57\x7e\xe9\xf5\x2e\x3a\xe7\xc1\x55\xaf\xfb\x54\x63\xce\x58\xdb\x42\x64\x63\xad\x0a\x4b\x7a\xcc\xd1\xfb\xea\xf2\x2a\xb8\xa8\x54\xfe\x5d\x5f\x3d\xb0\xf8\x01\xd4\xd6\xe5\x33\xad\xf3\xb1\x09\x35\xe7\x16\x61\x7a\x3b\x2e\x33\x2a\xa9\x6c\x26\x7d\x19\xa2\x36\xc7\xb1\x13\xb7\xd5\xfe\xaf\x2b\x74\xbd\xb5\x97\x59\x59\x6a\xa7\x0a\x4b\x4b\x7a\x26\x32\xb8\x2b\x68\x4e\xe5\x57\xb5\xd6\x6a\x36\xdd\x85\x54\xb6\xa8\x33\x66\xd9\xa4\x4e\xbb\xf2\x5c\x96\xc6\x96\xad\x70\xef\xa9\x71\x2a\xca\xd3\x4b\xb4\x58\x84\x84\xea\x32\xe8\x5f\xf6\x86\x67\x9d\xd3\x9e\xcb\xe9\x20\x65\x54\x97\x07\x73\x2e\xb0\x22\x55\xae\x89\xa6\xb9\xf5\xca\x9b\x47\x69\xb6\x0b\xac\x09\x89\x68\x46\xda\x8e\x59\x5a\xb4\x3c\x40\x14\xce\x16\x96\xc3\xf2\xe6\xde\x14\xc2\x4d\x8e\xed\x22\x27\xfc\xde\xfd\x8f\x07\xec\x1f\xce\xb9\x88\xa2\xf2\x33\xb2\x8e\x2d\x55\x5f\x35\x8e\xdb\x9b\x38\xab\x45\x78\xbb\x95\x75\x1d\xb2\x13\xb7\x0c\xd9\xf9\xfa\xd0\x09\x3f\xb3\xf6\x55\x70\xf1\xcb\x4e\xbe\x0a\x2e\x1e\x35\xee\x8f\x98\x3b\x75\x7f\x19\x79\xb7\xe3\xa9\xc6\xd8\x4f\xea\xe9\xde\x78\xc2\xde\x2b\xef\x9f\x01\x00\xac\x01\xa9\x85\xad\x09\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/setup_kubernetes_high_availability.sh": &vfsgen۰CompressedFileInfo{
 			name:             "setup_kubernetes_high_availability.sh",
-			modTime:          time.Date(2019, 12, 17, 8, 37, 10, 547308683, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 2538,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x6d\x4f\xe3\xb8\x16\xfe\xee\x5f\x71\x6e\x5a\x8d\xe8\x08\xd2\x16\xd0\x68\x26\xd0\x7b\x95\xa1\xe5\x92\x9d\xd9\xa6\x6a\xca\xcc\x8e\x58\x84\xdc\xe4\x24\xb1\x48\x6d\xaf\xed\xb4\x54\xc0\x7f\x5f\x39\x2f\xa5\x30\xbb\x5a\x6d\xfa\x21\xf5\xf1\xf3\x3c\x39\x2f\x3e\xc7\x9d\xff\xf4\x4b\xad\xfa\x4b\xc6\xfb\xc8\xd7\xb0\xa4\x3a\x27\x9d\x0e\x5c\x08\xb9\x55\x2c\xcb\x0d\x1c\x0f\x86\x9f\x20\xca\x29\xcf\x72\xca\xe0\x17\xc6\xb3\x71\x29\x20\xe0\xa9\x50\x2b\x6a\x98\xe0\xb0\xc0\x38\xe7\xa2\x10\xd9\x16\x62\xe1\x1e\xc2\x57\x93\xb8\xa4\xd3\xb1\x32\x5f\x59\x8c\x5c\x63\x02\x25\x4f\x50\x81\xc9\x11\x7c\x49\xe3\x1c\xdb\x9d\x43\xf8\x86\x4a\x5b\x95\x63\x77\x00\x07\x16\xe0\x34\x5b\x4e\xef\xcc\x4a\x6c\x45\x09\x2b\xba\x05\x2e\x0c\x94\x1a\xc1\xe4\x4c\x43\xca\x0a\x04\x7c\x88\x51\x1a\x60\x1c\x62\xb1\x92\x05\xa3\x3c\x46\xd8\x30\x93\x57\xdf\x69\x54\xac\x27\xf0\xa3\xd1\x10\x4b\x43\x19\x07\x0a\xb1\x90\x5b\x10\xe9\x3e\x10\xa8\x69\x9c\xae\x9e\xdc\x18\xe9\xf5\xfb\x9b\xcd\xc6\xa5\x95\xc7\xae\x50\x59\xbf\xa8\xb1\xba\xff\x35\xb8\x98\x4c\xa3\xc9\xd1\xb1\x3b\x68\x58\xd7\xbc\x40\xad\x41\xe1\x1f\x25\x53\x98\xc0\x72\x0b\x54\xca\x82\xc5\x74\x59\x20\x14\x74\x03\x42\x01\xcd\x14\x62\x02\x46\x58\xaf\x37\x8a\x19\xc6\xb3\x43\xd0\x22\x35\x1b\xaa\xd0\xca\x24\x4c\x1b\xc5\x96\xa5\x79\x95\xb4\xd6\x47\xa6\x5f\x01\x04\x07\xca\xc1\xf1\x23\x08\x22\x07\x3e\xfb\x51\x10\x1d\x5a\x91\xef\xc1\xe2\x2a\xbc\x5e\xc0\x77\x7f\x3e\xf7\xa7\x8b\x60\x12\x41\x38\x87\x8b\x70\x3a\x0e\x16\x41\x38\x8d\x20\xbc\x04\x7f\xfa\x03\xbe\x04\xd3\xf1\x21\x20\x33\x39\x2a\xc0\x07\xa9\x6c\x04\x42\x01\xb3\xe9\xc4\xaa\x8a\x10\x21\xbe\x72\x21\x15\xb5\x4b\x5a\x62\xcc\x52\x16\x43\x41\x79\x56\xd2\x0c\x21\x13\x6b\x54\x9c\xf1\x0c\x24\xaa\x15\xd3\xb6\xac\x1a\x28\x4f\xac\x4c\xc1\x56\xcc\x54\xe7\x45\xff\x1c\x97\x4b\x88\x46\x03\x47\x02\x50\x29\x7c\x60\xa6\x5d\x72\x51\x72\x8d\xbb\xa5\x64\x12\x53\xca\x0a\x42\x3a\x5f\xae\x3f\x4f\xee\xfc\x59\x10\x4d\xe6\xdf\x26\xf3\x3b\x7f\x3c\x9e\x47\xa3\x03\xd2\x01\x70\x86\x03\xd7\xfe\x3e\x79\x1f\x4e\x4f\x4f\x1c\xd2\xe9\x91\xce\xb7\x60\x36\x6a\xed\x1f\x3f\x3a\xa4\x13\x4c\x17\x93\xf9\xa5\x7f\x31\x19\x39\xc8\xf5\x89\x43\xc8\x95\x3f\x9b\x87\xbf\xfd\xb8\x9b\x85\xf3\xc5\xe8\xf4\xf4\xf4\x64\x67\x89\x16\xfe\x22\xaa\xed\xc3\x4f\x27\x1f\x48\x07\xc6\xfe\xe4\xd7\x70\xea\x81\xa3\xb7\xda\xe0\x2a\x71\x6c\xd2\x9c\x44\xc4\xf7\xa8\x1c\x52\xef\x8e\x9a\x3d\x42\xe6\x61\xb8\x18\x75\x0f\xaa\x48\xe0\x62\x3c\xf3\x17\x57\xf0\xee\x1d\xc4\x09\x74\x0f\x12\xa6\x38\x5d\x21\x38\xdd\xc7\xcf\x7e\x74\x75\x17\x85\xd7\xf3\x8b\xc9\xcd\xe0\xf6\xd9\xe9\xf5\x5d\xd7\xe2\xe4\x26\xe9\x11\x0b\x7e\xb4\x42\xcf\x84\x68\x51\xaa\xb8\xa2\x54\x86\x3e\xe3\xcc\xdc\x25\x28\x0b\xb1\xbd\xcb\xa9\x54\xe2\x61\x7b\x77\x8f\x28\x69\xc1\xd6\x98\xf4\x0b\xb6\x74\x75\xee\xfc\x6b\x5e\xf7\xb1\x0e\xe4\xb9\x66\x97\x9a\x66\x78\xd0\x83\x47\x62\xdb\x23\xa6\x06\xce\xcf\x27\xe1\x25\xb9\xb6\x76\x0f\xba\x03\xb8\x49\x0b\x9a\xe9\x5b\x38\xa7\xb1\xad\xf3\x7f\xe1\x86\x4a\x79\x4b\xc8\xa5\x35\x7b\x15\xed\xa8\x04\xb8\x2f\x97\x78\x44\x25\xd3\xa8\xd6\xa8\xa0\xfa\x2e\x94\x52\x1b\x85\x74\x05\x34\x49\x94\xae\xb1\x1c\x20\xa7\xb0\x66\xb2\x5e\xb2\x6a\xb9\x64\x3c\x01\xc6\x0d\xaa\x94\xc6\x48\x88\x2f\x65\xa3\xdd\x84\x50\xfd\x7f\x09\x83\x10\xbf\xf2\xa6\x01\xa9\x92\x57\xed\x1d\x0b\x9e\xb2\xcc\x1e\x4e\xd0\x86\x2a\x63\x7b\xb5\x0e\xac\x40\x6a\x21\xda\x08\x59\x6d\x97\xbc\xc5\x4a\x49\xc8\x1e\x17\x32\xe4\xa8\xa8\xc1\xd6\x50\x0d\x25\x23\xda\x65\xc2\x54\x05\xaf\xf5\xe1\xcd\x77\x14\x16\x82\x26\xbb\x37\x95\x72\x27\xa3\xc4\x6a\x5f\xb2\x81\xd7\xf4\x9d\x5b\xaf\xd5\xb4\xa1\xa6\xd4\xd6\x25\x63\x43\xac\x9a\xb0\xb1\x89\x74\x0f\x25\xe4\xee\xdd\x1a\x77\xf1\x29\x5c\x89\x35\xee\x79\xdf\x52\xc9\xe4\x81\xae\x64\x81\x4d\x0a\xbb\x03\x5b\xc6\xb6\x9b\x86\x83\xaa\xcd\xa0\x5d\x0e\x5f\x2f\x8f\xeb\x26\x6c\x8b\x63\x9d\xdb\x89\x70\xd8\x6b\x49\x5b\x5f\x34\xf9\x60\xaf\x74\xfb\xe0\x96\x5f\x95\xe7\xa9\x0a\xfe\xa9\x4e\xdd\x53\x1d\xe7\x93\x0d\xea\xa9\x0d\xa6\xa5\xed\x89\xbd\x66\xfe\xb3\x82\x3d\xdb\xcf\x84\x74\x60\x8e\x1a\x8d\x1d\xd4\x10\xce\x16\xc1\x74\x6c\x87\x30\xc7\x18\xb5\xa6\x6a\x0b\x2c\xb5\x49\x17\xd2\x68\xd8\x50\x6d\x6f\xa8\x04\xa4\xc2\x35\x13\xa5\x2e\xb6\x76\xcc\x57\xc3\x32\x56\x4c\x1a\x97\x74\x20\x30\x96\x4f\x21\x13\x22\x01\x96\x20\xb5\x27\x66\x45\xef\xb1\x55\x2f\x44\x4c\x0b\x2b\x6b\x2f\x3e\xa9\x44\x5c\xcd\x64\x59\x4f\xce\xea\xf2\x4a\x4b\x5e\x1d\x69\x97\xd4\x94\xd1\x90\x90\x4d\x6e\x0f\x5f\xeb\x89\xe3\xe5\xa5\xc7\x3d\xe6\x39\x96\x79\x06\x89\x68\x9a\x56\xdb\xf6\x17\xd2\x38\xc0\xea\xd4\xe6\x3d\x02\xcd\x53\x35\xf8\x6e\x75\x76\x56\x9f\x8e\x97\xfd\xbf\x9e\xb8\xdd\x70\xb6\xf0\xe7\xff\xef\xbd\x25\xf2\x17\x8b\x1d\xbe\x0d\xec\x2d\x8a\xbd\xa0\x5e\x26\xf2\xdf\x60\x7f\xff\xdf\x1b\x5f\xc1\x09\xf8\x9a\x16\x2c\x69\xd2\xe3\xc1\x51\x43\x75\xde\x72\xbd\x9f\xa8\x61\x45\xd9\x31\xda\x7b\xdb\xde\x57\x40\x55\x56\xae\x90\x1b\xf7\x27\x1d\xd4\x34\x26\x89\xe0\x48\x74\xce\x52\x03\xdd\x83\x83\xba\x06\x47\xc3\x5e\x8f\x10\x96\xc2\xcd\x0d\x38\xdd\x8e\x03\xe7\x70\x0c\xb7\xb7\x67\xb6\xfa\x75\xa6\x5f\xd2\x9b\x30\x24\x29\x23\xc4\x9f\xcd\x46\xdd\x21\xf1\x2f\xec\x9d\x3c\xea\x1e\xef\xf8\xf5\x09\x7c\x52\x25\x77\x60\x34\x82\xf7\x4e\xf7\xb1\x06\x3d\x3b\xef\xad\x28\xd9\x89\x76\x1f\xfd\xd9\xec\xd9\xf3\x6a\x86\xe7\xc5\x39\xc6\xf7\x95\x78\xbb\xb3\xa3\x92\x3f\x03\x00\x00\xff\xff\x51\x25\x6a\xa1\xea\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xfb\x6f\xdb\x38\x12\xfe\x9d\x7f\xc5\x9c\x6c\x14\x71\x91\xf8\x91\x04\x45\xea\xc4\x77\x50\x6d\xe7\xa2\x6b\xcf\x32\x6c\xa7\xbd\x22\x17\x04\xb4\x34\x92\x88\xc8\x24\x97\xa4\xec\x18\x49\xfe\xf7\x05\xa9\x47\x1e\xdd\xc5\x62\x13\x03\xd2\xcc\x7c\xdf\xa7\x19\x0e\x39\x6c\xfd\xa3\x57\x68\xd5\x5b\x33\xde\x43\xbe\x85\x35\xd5\x19\x69\xb5\x60\x2c\xe4\x5e\xb1\x34\x33\x70\xdc\x1f\x7c\x86\x65\x46\x79\x9a\x51\x06\xff\x61\x3c\x9d\x14\x02\x02\x9e\x08\xb5\xa1\x86\x09\x0e\x2b\x8c\x32\x2e\x72\x91\xee\x21\x12\xdd\x43\xf8\x66\xe2\x2e\x69\xb5\xac\xcc\x37\x16\x21\xd7\x18\x43\xc1\x63\x54\x60\x32\x04\x5f\xd2\x28\xc3\x3a\x72\x08\xdf\x51\x69\xab\x72\xdc\xed\xc3\x81\x05\x78\x55\xc8\xeb\x9c\x5b\x89\xbd\x28\x60\x43\xf7\xc0\x85\x81\x42\x23\x98\x8c\x69\x48\x58\x8e\x80\x0f\x11\x4a\x03\x8c\x43\x24\x36\x32\x67\x94\x47\x08\x3b\x66\x32\x30\x2f\x1f\xb0\x99\xc0\xcf\x4a\x43\xac\x0d\x65\x1c\x28\x44\x42\xee\x41\x24\xaf\x81\x40\x4d\x95\xb4\xfb\xcb\x8c\x91\xc3\x5e\x6f\xb7\xdb\x75\xa9\xcb\xb8\x2b\x54\xda\xcb\x4b\xac\xee\x7d\x0b\xc6\xd3\xd9\x72\x7a\x74\xdc\xed\x57\xac\x6b\x9e\xa3\xd6\xa0\xf0\xb7\x82\x29\x8c\x61\xbd\x07\x2a\x65\xce\x22\xba\xce\x11\x72\xba\x03\xa1\x80\xa6\x0a\x31\x06\x23\x6c\xd6\x3b\xc5\x0c\xe3\xe9\x21\x68\x91\x98\x1d\x55\x68\x53\x8d\x99\x36\x8a\xad\x0b\xf3\x66\xd1\xea\x1c\x99\x7e\x03\x10\x1c\x28\x07\xcf\x5f\x42\xb0\xf4\xe0\x8b\xbf\x0c\x96\x87\x56\xe4\x47\xb0\xba\x0a\xaf\x57\xf0\xc3\x5f\x2c\xfc\xd9\x2a\x98\x2e\x21\x5c\xc0\x38\x9c\x4d\x82\x55\x10\xce\x96\x10\x5e\x82\x3f\xfb\x09\x5f\x83\xd9\xe4\x10\x90\x99\x0c\x15\xe0\x83\x54\xb6\x02\xa1\x80\xd9\xe5\x44\xd7\x45\x58\x22\xbe\x49\x21\x11\x65\x1f\xb5\xc4\x88\x25\x2c\x82\x9c\xf2\xb4\xa0\x29\x42\x2a\xb6\xa8\x38\xe3\x29\x48\x54\x1b\xa6\x6d\x5b\x35\x50\x1e\x5b\x99\x9c\x6d\x98\x71\xfb\x45\xff\x5a\x57\x97\x10\x8d\x06\x8e\x04\xa0\x52\xf8\xc0\x4c\x6d\x72\x51\x70\x8d\x8d\x29\x99\xc4\x84\xb2\x9c\x90\xd6\xd7\xeb\x2f\xd3\x3b\x7f\x1e\x2c\xa7\x8b\xef\xd3\xc5\x9d\x3f\x99\x2c\x96\xa3\x03\xd2\x02\xf0\x06\xfd\xae\xfd\xff\x3c\xfc\x74\x7a\x7a\xe2\x91\x56\x87\xb4\xbe\x07\xf3\x51\xed\x3f\x3b\xf3\x48\x2b\x98\xad\xa6\x8b\x4b\x7f\x3c\x1d\x79\xc8\xf5\x89\x47\xc8\x95\x3f\x5f\x84\xff\xfb\x79\x37\x0f\x17\xab\xd1\xe9\xe9\xe9\x49\xe3\x59\xae\xfc\xd5\xb2\xf4\x0f\x3e\x9f\x7c\x22\x2d\x98\xf8\xd3\xff\x86\xb3\x21\x78\x7a\xaf\x0d\x6e\x62\x0f\x84\x02\x2f\x16\xd1\x3d\x2a\x8f\x94\xd1\x51\x15\x23\x64\x11\x86\xab\x51\xfb\xc0\x55\x02\xe3\xc9\xdc\x5f\x5d\xc1\x87\x0f\x10\xc5\xd0\x3e\x88\x99\xe2\x74\x83\xe0\xb5\x1f\xbf\xf8\xcb\xab\xbb\x65\x78\xbd\x18\x4f\x6f\xfa\xb7\xcf\x5e\xa7\xd7\xed\x5a\x9c\xdc\xc5\x1d\x62\xc1\x8f\x56\xe8\x99\x10\x2d\x0a\x15\x39\x8a\x73\xf4\x18\x67\xe6\x2e\x46\x99\x8b\xfd\x5d\x46\xa5\x12\x0f\xfb\xbb\x7b\x44\x49\x73\xb6\xc5\xb8\x97\xb3\x75\x57\x67\xde\xdf\xe6\xb5\x1f\xcb\x42\x9e\x4b\x76\xa1\x69\x8a\x07\x1d\x78\x24\xf6\x78\x44\xd4\xc0\xc5\xc5\x34\xbc\x24\xd7\xd6\x3f\x84\x76\x1f\x6e\x92\x9c\xa6\xfa\x16\x2e\x68\x64\xfb\xfc\x4f\xb8\xa1\x52\xde\x12\x72\x69\xdd\x43\x47\x3b\x2a\x00\xee\x8b\x35\x1e\x51\xc9\x34\xaa\x2d\x2a\x70\xdf\x85\x42\x6a\xa3\x90\x6e\x80\xc6\xb1\xd2\x25\x96\x03\x64\x14\xb6\x4c\x96\x26\x73\xe6\x9a\xf1\x18\x18\x37\xa8\x12\x1a\x21\x21\xbe\x94\x95\x76\x55\x82\x7b\x7f\x29\x83\x10\xdf\x65\x53\x81\x54\xc1\x6d\x1c\x22\xc1\x13\x96\xda\xcd\x09\xda\x50\x65\xec\x59\x75\x80\x28\x47\x6a\x21\xda\x08\xe9\xc2\x05\xaf\xb1\x52\x12\xf2\x8a\x0b\x29\x72\x54\xd4\x60\xed\x70\x43\xc9\x88\xda\x8c\x99\x72\xf0\x52\x1f\xde\x7d\x47\x61\x2e\x68\xdc\x3c\xa9\x94\x8d\x8c\x12\x9b\xe6\x9d\xe5\x58\xc1\x4b\x7a\x93\xd6\x5b\x35\x6d\xa8\x29\x34\x40\x8a\x06\x54\xc1\xdd\x21\xac\x7c\x22\x79\x85\x12\xb2\x79\xd6\xce\xa6\x3e\x85\x1b\xb1\x6d\x8a\x89\x99\xaa\xa9\x64\xfa\x40\x37\x32\xc7\x6a\x09\xdb\x7d\x38\x2a\x9a\x53\x36\xe8\xbb\x63\x06\xb5\x39\x78\x6b\x1e\x3b\xd3\xab\x9b\x63\x93\x6b\x44\x78\x23\x72\x76\xe6\xd9\xfe\xa2\xc9\xfa\xaf\x5a\xf7\x1a\x5c\xf3\x5d\x7b\x9e\x5c\xf1\x4f\xe5\xd2\x59\xc3\x14\xfa\xc9\x16\xf5\x54\x17\x53\xd3\x5e\x89\xbd\x65\xfe\xb5\x82\xdd\xdb\xcf\x84\xb4\x60\x81\x1a\x8d\x1d\xd4\x10\xce\x57\xc1\x6c\x02\x4c\x03\xc7\x08\xb5\xa6\x6a\x0f\x2c\xb1\x8b\x2e\xa4\xd1\xb0\xa3\xda\xde\x50\x31\x48\x85\x5b\x26\x0a\x9d\xef\xed\x98\x77\xc3\x32\x52\x4c\x9a\x2e\x69\x41\x60\x2c\x9f\x42\x2a\x44\x0c\x2c\x46\x6a\x2f\x83\x0d\xbd\xc7\x5a\x3d\x17\x11\xcd\xad\xac\xbd\xf8\xa4\x12\xf6\x43\x20\xa4\xdb\xc3\x56\x8e\x42\x52\x70\xb7\xa5\xbb\xa4\xa4\x8c\x06\x84\xec\x32\xbb\xf9\xea\x4c\xbc\x61\x56\x0c\xf9\x90\x0d\x3d\xcb\x3c\x87\x58\x54\x87\x56\xdb\xb1\x21\xa4\xf1\x80\x71\xe7\xca\x3a\xee\x61\x7f\xee\x80\x37\xd6\xf9\xb9\x7b\x2d\x5e\xe2\x7f\x3c\x71\xdb\xe1\x7c\xe5\x2f\xfe\xdd\x79\x4f\xe4\x2f\x1e\x3b\x7c\x2b\xd8\x7b\x14\x7b\x41\xbd\x4c\xe4\x3f\xc1\xfe\xff\x5f\x2f\x60\x97\x2b\x78\x01\xdf\xd2\x9c\xc5\xd5\xf2\x0c\xe1\xa8\xa2\x7a\xef\xb9\xc3\x5f\xa8\xa1\xa3\x34\x8c\xfa\xde\xb6\xf7\x15\x50\x95\x16\x1b\xe4\xa6\xfb\x8b\x0e\x6a\x1a\x91\x58\x70\x24\x3a\x63\x89\x81\xf6\xc1\x41\xd9\x83\xa3\x41\xa7\x43\x08\x4b\xe0\xe6\x06\xbc\x76\xcb\x83\x0b\x38\x86\xdb\xdb\x73\x7b\xcb\x71\xf2\x76\x79\x63\x86\x24\x61\x84\xf8\xf3\xf9\xa8\x3d\x20\xfe\xd8\xde\xc9\xa3\xf6\x71\xc3\x2f\xf7\xf0\x93\x2a\xb8\x07\xa3\x11\x7c\xf4\xda\x8f\x25\xe8\xd9\xfb\x68\x45\x49\x23\xda\x7e\xf4\xe7\xf3\xe7\xe1\xb0\x64\x0c\x87\x51\x86\xd1\xbd\x13\xaf\x23\xed\x47\x7f\xbc\x0a\xc2\xd9\x33\xf9\x7d\x00\x51\x25\x6a\xa1\xea\x09\x00\x00"),
 		},
 		"/scripts/init_deploy_haproxy_keepalived/systemd.sh": &vfsgen۰CompressedFileInfo{
 			name:             "systemd.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 86987615, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 3382,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\xef\x6f\xda\xca\x12\xfd\xee\xbf\x62\x1e\xa0\x36\x91\x82\x1d\xd2\x1f\xef\xb5\x15\x4f\xa2\x81\x36\x7e\xa5\x10\x61\xda\xbe\xe8\xea\x0a\x2d\xeb\xc1\x5e\x75\xd9\x75\x77\xd7\x21\x88\xf0\xbf\x5f\xad\x31\xc4\x06\x43\xae\xd4\x2f\xd7\x9f\xec\x99\x73\x66\xce\xce\x9e\x5d\xd7\xff\xe5\xa5\x5a\x79\x53\x26\x3c\x14\xf7\x30\x25\x3a\x76\xea\x75\xb8\x96\xc9\x52\xb1\x28\x36\x70\x75\xd9\x7a\x07\x41\x4c\x44\x14\x13\x06\xff\x63\x22\xea\xa6\x12\x7c\x31\x93\x6a\x4e\x0c\x93\x02\xc6\x48\x63\x21\xb9\x8c\x96\x40\xa5\x7b\x01\x7d\x13\xba\x4e\xbd\x6e\xcb\xf4\x19\x45\xa1\x31\x84\x54\x84\xa8\xc0\xc4\x08\x9d\x84\xd0\x18\xb7\x99\x0b\xf8\x8e\x4a\xdb\x2a\x57\xee\x25\x9c\x59\x40\x2d\x4f\xd5\xce\x3f\xd8\x12\x4b\x99\xc2\x9c\x2c\x41\x48\x03\xa9\x46\x30\x31\xd3\x30\x63\x1c\x01\x1f\x28\x26\x06\x98\x00\x2a\xe7\x09\x67\x44\x50\x84\x05\x33\x71\xd6\x27\xaf\x62\x95\xc0\x5d\x5e\x43\x4e\x0d\x61\x02\x08\x50\x99\x2c\x41\xce\x8a\x40\x20\x26\x17\x9d\x3d\xb1\x31\xc9\x7b\xcf\x5b\x2c\x16\x2e\xc9\x14\xbb\x52\x45\x1e\xdf\x60\xb5\xd7\xf7\xaf\x7b\x83\xa0\xd7\xbc\x72\x2f\x73\xd6\x37\xc1\x51\x6b\x50\xf8\x2b\x65\x0a\x43\x98\x2e\x81\x24\x09\x67\x94\x4c\x39\x02\x27\x0b\x90\x0a\x48\xa4\x10\x43\x30\xd2\xaa\x5e\x28\x66\x98\x88\x2e\x40\xcb\x99\x59\x10\x85\xb6\x4c\xc8\xb4\x51\x6c\x9a\x9a\xd2\xd0\xb6\x1a\x99\x2e\x01\xa4\x00\x22\xa0\xd6\x09\xc0\x0f\x6a\xf0\xb1\x13\xf8\xc1\x85\x2d\xf2\xc3\x1f\xdf\x0c\xbf\x8d\xe1\x47\x67\x34\xea\x0c\xc6\x7e\x2f\x80\xe1\x08\xae\x87\x83\xae\x3f\xf6\x87\x83\x00\x86\x9f\xa0\x33\xb8\x83\x2f\xfe\xa0\x7b\x01\xc8\x4c\x8c\x0a\xf0\x21\x51\x76\x05\x52\x01\xb3\xe3\xc4\x6c\x17\x21\x40\x2c\x49\x98\xc9\x8d\x24\x9d\x20\x65\x33\x46\x81\x13\x11\xa5\x24\x42\x88\xe4\x3d\x2a\xc1\x44\x04\x09\xaa\x39\xd3\x76\x5b\x35\x10\x11\xda\x32\x9c\xcd\x99\xc9\xfc\xa2\x0f\xd7\xe5\x3a\x8e\x46\x03\x4d\x09\xa8\x14\x3e\x30\xb3\xfd\x14\x32\x15\x1a\x77\x9f\x09\x4b\x70\x46\x18\x77\x9c\xfa\x4d\xe7\x76\x34\xfc\xff\xdd\xe4\x7b\x6f\x14\xf8\xc3\x41\xbb\xe5\xbe\x73\xdf\x3a\xf5\x2f\xbd\xde\x6d\xa7\xef\x7f\xef\x75\x0b\x99\xd7\xee\x1b\xa7\x0e\x9c\x18\xd4\x06\xee\x73\xbf\xc9\x19\xc4\x24\x51\xf2\x61\x69\x25\xc2\x4f\xc4\x84\x70\x76\x8f\xe1\x7b\xa7\x0e\x8f\x50\x7a\x1e\x77\xd0\xc7\x02\x10\x1e\x33\x64\xb3\xf0\x3c\x7d\x96\x12\x39\x32\x9d\xa6\xc2\xa4\xd0\x7a\xeb\x5e\xbe\x86\x47\x68\xb9\x6f\xdd\x57\x59\xf5\x96\x7b\xe5\x5e\xbd\xde\xb4\xca\x90\x14\x85\x91\x1a\xfe\x9d\x77\x6f\xb9\x6f\xdc\xd6\x7f\x36\x6f\xaf\xdc\x37\x50\x40\xaa\x18\x79\x41\x67\xd6\xad\xf4\x96\x21\x9d\xed\xb8\xae\x87\x83\x4f\xfe\xe7\x49\xd7\x1f\xb5\x3d\x34\xd4\xcb\xd7\xb5\x97\x6f\x37\x56\x87\x84\xf5\x16\xec\xd2\x59\xe4\x14\x06\xbd\x5f\xf3\x69\x42\x87\xa8\x76\x63\x55\xc9\x5c\x17\x58\x2e\x95\x62\xe6\x38\xa3\xe1\x70\xdc\x6e\x9c\x65\x06\x80\xeb\xee\x6d\x67\x7c\x03\x2f\x5e\x00\x0d\xa1\x71\x16\x32\x25\xc8\x1c\xa1\xd6\x58\x7d\xec\x04\x37\x93\x60\xf8\x6d\x74\xdd\xfb\xe3\xf2\xcf\x75\xed\xdc\x73\x5d\x8b\x4b\x16\xe1\xb9\x63\xc1\x2b\x5b\x68\xed\x38\x5a\xa6\x8a\x66\x94\x2c\xe0\x31\xc1\xcc\x24\xc4\x84\xcb\xe5\x24\x5f\xd9\xe4\x49\x84\xc7\xd9\xd4\xd5\x71\xcd\x71\x6e\xbf\x7c\x9e\x7c\xfd\x3c\x6a\x67\x2f\xfe\x20\x18\x77\xfa\xfd\xc9\xf0\x36\x3b\x48\x9b\x60\xee\xb4\x49\x70\xf7\xf5\xe3\xb0\xdf\x76\x1c\x5b\xfa\xec\x1c\x56\x8e\x9d\x3e\x97\x94\xf0\xec\xd8\xb6\x1b\x67\x2e\x64\x23\x92\xba\xa9\x90\x23\xd1\x68\xa5\x22\x8d\x25\x34\xfc\xee\x79\x86\xa7\x36\xda\x58\x59\xc2\x1a\x98\xc8\x62\x1b\xe7\x6c\xf2\xf6\xd9\x6a\x22\x89\x29\xc5\xf6\xe5\xbd\x6c\x2e\xa1\xd9\x24\x9c\xcb\x45\x33\x15\x24\x35\x31\x0a\xc3\x28\x31\x18\xbe\x2c\x11\xf7\x96\xf0\xb2\xbd\x49\x7f\xf8\xb0\x91\x94\xd9\xf1\xb0\xfd\x32\x9d\xff\x8d\xf6\x1a\x8d\x4c\x4c\x5b\x4e\xb5\xe4\x68\x50\xb7\x2f\xa1\xd9\x14\x32\x4a\x22\x1a\x23\xfd\x79\x5a\x48\xb3\x24\xc4\xba\xfd\x1f\x20\x03\x35\xa1\xce\xda\x71\x0a\x97\xc6\x7b\x26\xb4\x21\x9c\xef\xb6\xbd\xb1\xca\xe5\xd9\x4d\xcc\x52\x60\x65\xfc\xca\x13\x7b\x1a\xd7\xc5\x7b\xe5\xc5\x7f\xbd\x10\xef\x3d\x91\x72\xbe\xdf\x25\x15\x27\xfa\x90\xd4\x48\x85\x73\x79\x8f\xcf\x37\xb1\x85\x73\xd7\xff\xbe\xf6\xed\xed\xb8\x27\x7c\x57\xff\x37\x55\x6f\x2f\xa9\x62\x49\x6d\x88\x7a\x3a\x63\x7a\xa9\x0d\xce\xa9\xe1\x90\xc5\x2b\x19\x28\xec\x9f\xb8\x82\xb2\x49\x54\x72\x42\xa6\x8f\x90\xf2\xcc\x11\x6d\x32\xa9\x94\x26\x93\x4a\xbc\x42\x2e\x49\x58\xc1\xd8\x24\x8e\x70\x8e\x4d\x20\xcf\x1c\x9b\x9a\x49\x75\xf5\xd8\x4c\xaa\xab\x3b\xa5\x62\x47\xd8\x05\xed\x15\xcd\xa2\x72\x2c\xdf\xe3\x72\x30\xd3\x52\x0e\x6d\xe6\x5d\xea\x41\x39\x92\x8a\x2e\x76\x62\xe5\x48\x3e\xf6\x72\x70\x67\xaf\xfd\x70\xae\x72\xef\x0c\x9d\xb6\x4e\xf9\x88\x14\x79\xcf\x19\xa8\xf9\xeb\x04\xf9\x79\x27\x1d\xe7\x9e\xf2\xd3\x71\xd6\xf3\x0e\x39\xd5\xf1\xb4\x4f\x4e\x74\x2d\xb8\xa5\x18\x2f\x18\xa6\xe2\xd6\x3c\x88\x3f\xd9\xe6\x70\x07\xf6\x5b\x96\xcd\xb3\x3f\xb8\x83\x60\xd1\x42\x95\x57\x6b\x45\xe6\xc9\x48\xf6\xd7\xee\x38\x7f\x05\x00\x00\xff\xff\xe1\xb6\x09\xce\x36\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x55\x6f\x6f\xda\xc8\x13\x7e\xbf\x9f\x62\x7e\x01\xb5\x89\x14\xec\x90\xfe\xf9\x5d\x53\x71\x12\x0d\xb4\xf1\x95\xe2\x08\xd3\xf6\xa2\xd3\x09\x2d\xf6\x60\xaf\xba\xec\xba\xbb\xeb\x10\x44\xf8\xee\xa7\x35\x06\x6c\xe2\x24\x27\xf5\xcd\x39\x2f\x82\x9f\x99\x67\xe6\xd9\x99\x67\xa1\xf1\x3f\x37\xd3\xca\x9d\x32\xe1\xa2\xb8\x85\x29\xd5\x09\x69\x34\xe0\x52\xa6\x4b\xc5\xe2\xc4\xc0\xf9\x59\xfb\x1d\x04\x09\x15\x71\x42\x19\xfc\xc1\x44\xdc\xcb\x24\x78\x62\x26\xd5\x9c\x1a\x26\x05\x8c\x31\x4c\x84\xe4\x32\x5e\x42\x28\x9d\x53\x18\x98\xc8\x21\x8d\x86\x2d\x33\x60\x21\x0a\x8d\x11\x64\x22\x42\x05\x26\x41\xe8\xa6\x34\x4c\x70\x1b\x39\x85\x6f\xa8\xb4\xad\x72\xee\x9c\xc1\xb1\x4d\x38\x2a\x42\x47\x27\xef\x6d\x89\xa5\xcc\x60\x4e\x97\x20\xa4\x81\x4c\x23\x98\x84\x69\x98\x31\x8e\x80\x77\x21\xa6\x06\x98\x80\x50\xce\x53\xce\xa8\x08\x11\x16\xcc\x24\x60\xf6\x0d\xac\x12\xb8\x29\x6a\xc8\xa9\xa1\x4c\x00\x85\x50\xa6\x4b\x90\xb3\x72\x22\x50\x53\x88\xce\x9f\xc4\x98\xf4\xc2\x75\x17\x8b\x85\x43\x73\xc5\x8e\x54\xb1\xcb\x37\xb9\xda\x1d\x78\x97\xfd\x61\xd0\x6f\x9d\x3b\x67\x05\xeb\xab\xe0\xa8\x35\x28\xfc\x99\x31\x85\x11\x4c\x97\x40\xd3\x94\xb3\x90\x4e\x39\x02\xa7\x0b\x90\x0a\x68\xac\x10\x23\x30\xd2\xaa\x5e\x28\x66\x98\x88\x4f\x41\xcb\x99\x59\x50\x85\x56\x6a\xc4\xb4\x51\x6c\x9a\x99\xca\xd0\xb6\x1a\x99\xae\x24\x48\x01\x54\xc0\x51\x37\x00\x2f\x38\x82\x0f\xdd\xc0\x0b\x4e\x6d\x91\xef\xde\xf8\xca\xff\x3a\x86\xef\xdd\xd1\xa8\x3b\x1c\x7b\xfd\x00\xfc\x11\x5c\xfa\xc3\x9e\x37\xf6\xfc\x61\x00\xfe\x47\xe8\x0e\x6f\xe0\xb3\x37\xec\x9d\x02\x32\x93\xa0\x02\xbc\x4b\x95\x3d\x81\x54\xc0\xec\x38\x31\xdf\x22\x04\x88\x15\x09\x33\xb9\xd9\xa3\x4e\x31\x64\x33\x16\x02\xa7\x22\xce\x68\x8c\x10\xcb\x5b\x54\x82\x89\x18\x52\x54\x73\xa6\xed\x5a\x35\x50\x11\xd9\x32\x9c\xcd\x99\xc9\xfd\xa2\x1f\x9e\xcb\x21\x44\xa3\x81\x96\x04\x54\x0a\xef\x98\xd9\xbe\x0a\x99\x09\x8d\xbb\xd7\x94\xa5\x38\xa3\x8c\x13\xd2\xb8\xea\x5e\x8f\xfc\x3f\x6f\x26\xdf\xfa\xa3\xc0\xf3\x87\x9d\xb6\xf3\xce\x79\x4b\x1a\x9f\xfb\xfd\xeb\xee\xc0\xfb\xd6\xef\x95\x22\xaf\x9d\x37\xa4\x01\x9c\x1a\xd4\x06\x6e\x0b\xbf\xc9\x19\x24\x34\x55\xf2\x6e\x69\x25\xc2\x0f\xc4\x94\x72\x76\x8b\xd1\x05\x69\xc0\x3d\x54\x9e\xfb\x5d\xea\x7d\x29\x11\xee\xf3\xcc\x56\xe9\xd9\xbf\x56\x02\x45\x66\x36\xcd\x84\xc9\xa0\xfd\xd6\x39\x7b\x0d\xf7\xd0\x76\xde\x3a\xaf\xf2\xea\x6d\xe7\xdc\x39\x7f\x6d\x3b\x15\x99\x21\x0a\x23\x35\xfc\xbf\xe8\xde\x76\xde\x38\xed\xdf\x36\x99\xaf\x9c\x37\x85\xa8\x3c\x53\x25\xc8\xb7\x32\x8b\x9e\xd5\x4f\xf6\x85\x90\xed\xb8\x2e\xfd\xe1\x47\xef\xd3\xa4\xe7\x8d\x3a\x2e\x9a\xd0\x2d\xce\x75\x10\xef\x34\x57\x0f\x09\xeb\x6d\xb2\x13\xce\x62\x52\x1a\xf4\x61\xcd\xfd\x84\x1e\x66\x75\x9a\xab\x5a\xe6\xba\xc4\x72\x42\x29\x66\x84\x8c\x7c\x7f\xdc\x69\x1e\xe7\x06\x80\xcb\xde\x75\x77\x7c\x05\x2f\x5e\x40\x18\x41\xf3\x38\x62\x4a\xd0\x39\xc2\x51\x73\xf5\xa1\x1b\x5c\x4d\x02\xff\xeb\xe8\xb2\xff\xd7\xd9\xdf\xeb\xa3\x13\xd7\x71\x6c\x5e\xba\x88\x4e\x88\x4d\x5e\xd9\x42\x6b\x42\xb4\xcc\x54\x98\x53\x72\xc0\x65\x82\x99\x49\x84\x29\x97\xcb\x49\x71\xb2\xc9\x5e\x84\xcb\xd9\xd4\xd1\xc9\x11\x21\xd7\x9f\x3f\x4d\xbe\x7c\x1a\x75\xf2\x0f\xde\x30\x18\x77\x07\x83\x89\x7f\x9d\x5f\xa4\x0d\x58\x38\x6d\x12\xdc\x7c\xf9\xe0\x0f\x3a\x84\xd8\xd2\xc7\x27\xb0\x22\x76\xfc\x5c\x86\x94\xe7\xd7\xb6\xd3\x3c\x76\x20\x1f\xbb\xd4\x2d\x85\x1c\xa9\x46\x2b\x15\xc3\x44\x42\xd3\xeb\x9d\xe4\xf9\xa1\x45\x9b\x2b\x4b\x58\x03\x13\x39\xb6\x71\xce\x26\x6e\xff\xb6\x9a\x68\x6a\x2a\xd8\xa1\xbc\x97\xad\x25\xb4\x5a\x94\x73\xb9\x68\x65\x82\x66\x26\x41\x61\x58\x48\x0d\x46\x2f\x2b\xc4\x83\x23\xbc\xec\x6c\xc2\xef\xdf\xe7\xff\x36\x76\x7c\xd8\x7e\x99\xcd\xff\x45\x7b\x8d\x46\xa6\xa6\x23\xa7\x5a\x72\x34\xa8\x3b\x67\xd0\x6a\x09\x19\xa7\x71\x98\x60\xf8\xe3\x69\x21\xad\x8a\x10\xeb\xf6\xff\x80\x0c\xd4\x34\x24\x6b\x42\xf6\x76\xb9\xb8\x60\x42\x1b\xca\xf9\x6e\xed\xcd\x55\x21\xcf\x2e\x31\x0f\x81\x9d\xc6\x4f\x68\xae\x6a\x34\xae\xcb\xdf\x2b\x2f\x7e\x77\x23\xbc\x75\x45\xc6\xf9\x61\x97\x4c\x3c\xd1\x87\x66\x46\x2a\x9c\xcb\x5b\x7c\xbe\x89\x2d\x5c\xb8\xfe\xd7\xb5\x17\x85\x0e\x85\xef\xea\xff\xa2\xea\xa2\x4e\xa5\xa4\x36\x54\xed\xef\x98\x5e\x6a\x83\xf3\xd0\x70\xc8\xf1\x5a\x06\x0a\xfb\x4b\x5c\x43\xd9\x04\x6a\x39\x11\xd3\x8f\x90\x8a\x48\x2d\x4b\x1b\x99\xd6\x50\x2c\x5c\x9b\xaf\x90\x4b\x1a\xd5\x30\x36\x81\x47\x38\x8f\x4d\xa0\x88\xd4\xb2\xb4\xa1\x26\xd3\x35\xa4\x4d\xa0\xbe\x53\x26\x76\x84\x1d\x68\xbf\xa2\x59\x5c\xc5\x8a\x1d\x57\xc1\x5c\x4b\x15\xda\xcc\xbb\xd2\x23\xe4\x48\x6b\xba\xd8\x89\x55\x91\x62\xec\x55\x70\x67\xaf\x43\xb8\x50\x79\x70\x87\x9e\xb6\x4e\xf5\x8a\x94\x79\xcf\x19\xa8\xf5\xf3\x09\x72\x21\xbc\x86\x5d\x44\x9e\xe0\x3e\xe5\xa7\xc7\x59\xcf\x3b\xe4\x71\xee\x73\x3e\x79\x9c\x59\x76\x4b\x19\x2f\x19\xa6\x0c\x97\x17\x57\xc6\xf7\xb6\x29\xa3\x7b\xe7\x94\xd1\xaa\x79\xca\x91\x9d\x7f\x6a\x36\xf1\x00\xaf\xba\xa8\x1a\xd9\x1b\x89\x09\x66\x08\xf9\x67\x00\xe1\xb6\x09\xce\x36\x0d\x00\x00"),
 		},
 		"/scripts/init_deploy_keepalived.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_keepalived.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 87237477, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 1388,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x5d\x6f\xdb\x36\x14\x86\xef\xf5\x2b\xde\xc9\x41\xd0\x02\xb6\x94\xf8\x6e\x2b\x32\xc0\x8b\xbd\x45\x6b\x60\x0f\x96\xda\xa2\x28\x8a\x80\x96\x8e\xa4\x83\x52\x24\x43\x52\x71\x04\xe4\xc7\x0f\xb4\x1d\xd4\xce\x82\x5c\x4d\x97\xe2\xab\x87\xcf\xf9\x80\x46\xbf\xa4\xbd\xb3\xe9\x86\x55\x4a\xea\x01\x1b\xe1\xda\x68\x34\xc2\xb5\x36\x83\xe5\xa6\xf5\x98\x5e\x5c\xfe\x8a\xbc\x15\xaa\x69\x05\xe3\x6f\x56\xcd\xbc\xd7\xc8\x54\xad\x6d\x27\x3c\x6b\x85\x82\xca\x56\x69\xa9\x9b\x01\xa5\x4e\xc6\xb8\xf5\x55\x12\x8d\x46\x01\x73\xcb\x25\x29\x47\x15\x7a\x55\x91\x85\x6f\x09\x33\x23\xca\x96\x9e\x4f\xc6\xf8\x4c\xd6\x05\xca\x34\xb9\xc0\xbb\x10\x88\x0f\x47\xf1\xfb\x0f\x01\x31\xe8\x1e\x9d\x18\xa0\xb4\x47\xef\x08\xbe\x65\x87\x9a\x25\x81\x1e\x4b\x32\x1e\xac\x50\xea\xce\x48\x16\xaa\x24\x6c\xd9\xb7\xbb\x7b\x0e\x94\x60\x82\xaf\x07\x86\xde\x78\xc1\x0a\x02\xa5\x36\x03\x74\x7d\x1c\x84\xf0\x07\xe9\xdd\xd3\x7a\x6f\x7e\x4b\xd3\xed\x76\x9b\x88\x9d\x71\xa2\x6d\x93\xca\x7d\xd6\xa5\xb7\xd9\xf5\x62\x99\x2f\x26\xd3\xe4\xe2\xf0\xd5\x27\x25\xc9\x39\x58\xba\xef\xd9\x52\x85\xcd\x00\x61\x8c\xe4\x52\x6c\x24\x41\x8a\x2d\xb4\x85\x68\x2c\x51\x05\xaf\x83\xf5\xd6\xb2\x67\xd5\x8c\xe1\x74\xed\xb7\xc2\x52\xc0\x54\xec\xbc\xe5\x4d\xef\x4f\x9a\xf6\xec\xc8\xee\x24\xa0\x15\x84\x42\x3c\xcb\x91\xe5\x31\xfe\x98\xe5\x59\x3e\x0e\x90\x2f\x59\x71\xb3\xfa\x54\xe0\xcb\x6c\xbd\x9e\x2d\x8b\x6c\x91\x63\xb5\xc6\xf5\x6a\x39\xcf\x8a\x6c\xb5\xcc\xb1\xfa\x13\xb3\xe5\x57\x7c\xcc\x96\xf3\x31\x88\x7d\x4b\x16\xf4\x68\x6c\xa8\x40\x5b\x70\x68\x27\xed\xa6\x88\x9c\xe8\x44\xa1\xd6\x7b\x25\x67\xa8\xe4\x9a\x4b\x48\xa1\x9a\x5e\x34\x84\x46\x3f\x90\x55\xac\x1a\x18\xb2\x1d\xbb\x30\x56\x07\xa1\xaa\x80\x91\xdc\xb1\xdf\xed\x8b\xfb\x6f\x5d\x49\x14\x8d\x50\x84\xc1\xba\xd2\x72\x98\xa9\x83\xe0\x2e\xf4\xa9\x22\x23\xf5\x80\x1f\x44\x46\x48\x7e\xa0\x2a\x8a\x12\x48\xde\x24\xae\x8d\xa2\x55\x7e\xf5\x17\xf9\x55\x1e\x7d\x5c\x2c\xfe\x99\xdd\x66\x9f\x17\xf3\xd9\x7a\x3d\xbf\x3a\xbb\x3c\x7a\xb3\x28\x6e\xae\xce\xa6\x51\xe4\xc9\x79\x4c\x14\xce\x4e\xc3\x78\x7a\xc2\x3b\x2a\x5b\x8d\xf8\xe7\x25\x10\x55\x65\x83\x45\xd8\xba\xe7\x4a\x63\x9c\x9f\x83\x1e\xd9\xe3\xf2\xfd\x6b\xb4\x45\x71\xf3\x3a\x8c\x42\x7f\x15\xf9\x37\x81\x11\xd7\xf8\xf6\x0d\x67\xab\x1c\x13\xba\x47\xdc\x6f\x7a\xe5\xfb\x18\xdf\xbf\x7f\x08\xad\x52\x51\xd8\x4a\x61\xfc\xa4\x09\x20\xe5\xbc\x90\x12\x93\x93\xce\x84\x44\x65\x7e\x34\x98\x48\x3c\xa1\xb1\x64\x30\xb9\x3f\x0a\xbc\x6e\xf7\xcc\xaa\x05\x4b\xaa\x4e\x9c\x48\xbe\x90\x2a\x49\x79\xed\x82\x54\x60\x1d\x9f\xd8\x96\xe4\x0b\xd9\xa1\xef\xde\x10\xb5\xa6\xc3\xe4\x5e\xfc\x5f\xa2\x8e\x76\xd4\xfd\x47\x6e\x70\x9e\xba\x7d\xaf\x7b\x63\xb4\xf5\xf1\xfe\x74\x17\x8f\x6a\x8e\xa2\xdd\x1f\x2f\xfc\xed\x90\xfa\xce\xa4\xac\xd8\xdf\xed\x77\xed\xae\x15\xc6\xea\xc7\xe1\xee\xe7\xd5\xa9\x23\xdf\x9b\xc4\xb5\x61\xe2\xf1\x8b\x05\x8a\x31\xe1\x93\x97\x8b\xe2\x26\x3e\x2e\xc6\xf6\x0a\xd3\xdf\xcf\x2f\xa3\x7f\x03\x00\x00\xff\xff\x94\x1c\x31\xc6\x6c\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x94\x51\x6f\xdb\x36\x14\x85\xdf\xf5\x2b\xce\xe4\xa0\x68\x01\x5b\x4a\xfc\xb6\x15\x19\xe0\xc5\xda\xa2\x35\xb0\x07\x4b\x6d\x51\x14\x45\x40\x4b\x57\xd2\x45\x29\x92\x21\xa9\x38\x02\xf2\xe3\x07\xda\x09\x62\x77\x41\x9f\xa6\x47\xdd\xc3\xc3\xef\x9e\x7b\xc1\xc9\x2f\xe9\xe0\x6c\xba\x65\x95\x92\xba\xc7\x56\xb8\x2e\x9a\x4c\x70\xa5\xcd\x68\xb9\xed\x3c\xe6\xe7\x17\xbf\xa2\xe8\x84\x6a\x3b\xc1\xf8\x9b\x55\xbb\x1c\x34\x72\xd5\x68\xdb\x0b\xcf\x5a\xa1\xa4\xaa\x53\x5a\xea\x76\x44\xa5\x93\x29\x6e\x7c\x9d\x44\x93\x49\xb0\xb9\xe1\x8a\x94\xa3\x1a\x83\xaa\xc9\xc2\x77\x84\x85\x11\x55\x47\xcf\x95\x29\x3e\x91\x75\xc1\x65\x9e\x9c\xe3\x6d\x10\xc4\x4f\xa5\xf8\xdd\xfb\x60\x31\xea\x01\xbd\x18\xa1\xb4\xc7\xe0\x08\xbe\x63\x87\x86\x25\x81\x1e\x2a\x32\x1e\xac\x50\xe9\xde\x48\x16\xaa\x22\xec\xd8\x77\xf0\x2f\x17\x04\x12\x7c\x79\xf2\xd0\x5b\x2f\x58\x41\xa0\xd2\x66\x84\x6e\x8e\x85\x10\xfe\x09\x7a\xff\x75\xde\x9b\xdf\xd2\x74\xb7\xdb\x25\x62\x4f\x9c\x68\xdb\xa6\xf2\xa0\x75\xe9\x4d\x7e\x95\xad\x8a\x6c\x36\x4f\xce\x9f\x4e\x7d\x54\x92\x9c\x83\xa5\xbb\x81\x2d\xd5\xd8\x8e\x10\xc6\x48\xae\xc4\x56\x12\xa4\xd8\x41\x5b\x88\xd6\x12\xd5\xf0\x3a\x50\xef\x2c\x7b\x56\xed\x14\x4e\x37\x7e\x27\x2c\x05\xd4\x9a\x9d\xb7\xbc\x1d\xfc\x49\x68\xcf\x8c\xec\x4e\x04\x5a\x41\x28\xc4\x8b\x02\x79\x11\xe3\x8f\x45\x91\x17\xd3\x60\xf2\x39\x2f\xaf\xd7\x1f\x4b\x7c\x5e\x6c\x36\x8b\x55\x99\x67\x05\xd6\x1b\x5c\xad\x57\xcb\xbc\xcc\xd7\xab\x02\xeb\x3f\xb1\x58\x7d\xc1\x87\x7c\xb5\x9c\x82\xd8\x77\x64\x41\x0f\xc6\x86\x0e\xb4\x05\x87\x38\x69\x3f\x45\x14\x44\x27\x08\x8d\x3e\xcc\xd1\x19\xaa\xb8\xe1\x0a\x52\xa8\x76\x10\x2d\xa1\xd5\xf7\x64\x15\xab\x16\x86\x6c\xcf\x2e\x8c\xd5\x41\xa8\x3a\xd8\x48\xee\xd9\xef\xf7\xc5\xfd\xb7\xaf\x24\x8a\x26\x28\xc3\x60\x5d\x65\x39\xcc\xd4\x41\x70\x1f\x72\xaa\xc9\x48\x3d\xe2\x3b\x91\x11\x92\xef\xa9\x8e\xa2\x04\x92\xb7\x89\xeb\xa2\x68\x5d\x5c\xfe\x45\x7e\x5d\x44\x1f\xb2\xec\x9f\xc5\x4d\xfe\x29\x5b\x2e\x36\x9b\xe5\xe5\xd9\xc5\xd1\x9f\xac\xbc\xbe\x3c\x9b\x47\x91\x27\xe7\x31\x53\x38\x3b\x15\xe3\xf1\x11\x6f\xa9\xea\x34\xe2\x97\x4b\x20\xea\xda\x06\x8a\xb0\x75\xcf\x9d\xc6\x78\xf3\x06\xf4\xc0\x1e\x17\xef\x5e\x73\xcb\xca\xeb\xd7\xcd\x28\xe4\xab\xc8\xff\xd4\x30\xe2\x06\x5f\xbf\xe2\x6c\x5d\x60\x46\x77\x88\x87\xed\xa0\xfc\x10\xe3\xdb\xb7\xf7\x21\x2a\x15\x85\xad\x14\xc6\xcf\xda\x60\xa4\x9c\x17\x52\x62\x76\x92\x4c\x50\xd4\xe6\x7b\x8b\x99\xc4\x23\x5a\x4b\x06\xb3\xbb\x23\xc1\xeb\x74\xcf\x5e\x8d\x60\x49\xf5\x09\x13\xc9\x1f\xa0\x2a\x52\x5e\xbb\x00\x15\xbc\x8e\x2b\xb6\x23\xf9\x03\xec\x38\xf4\x3f\x01\xb5\xa6\xc7\xec\x4e\xfc\x5f\xa0\x8e\xf6\xae\x87\x43\x6e\x74\x9e\xfa\x43\xd6\x83\x31\xda\xfa\xf8\x50\xdd\xf7\x15\x35\x1c\x45\xfb\x17\x2f\xbc\x76\x48\x7d\x6f\x52\x56\xec\x6f\x0f\xbb\x76\xdb\x09\x63\xf5\xc3\x78\xfb\x72\x75\xea\xc8\x0f\x26\x71\x5d\xd8\x9f\xf8\x68\xe4\x61\x81\x62\xcc\xf8\xe4\x67\x56\x5e\xc7\xc7\xcd\xd8\x41\x61\xfe\xfb\x9b\x8b\xe8\xdf\x01\x00\x94\x1c\x31\xc6\x6c\x05\x00\x00"),
 		},
 		"/scripts/init_deploy_kubetool.sh": &vfsgen۰CompressedFileInfo{
 			name:             "init_deploy_kubetool.sh",
-			modTime:          time.Date(2019, 12, 30, 1, 40, 5, 334767630, time.UTC),
-			uncompressedSize: 15032,
+			modTime:          time.Date(2026, 10, 17, 7, 21, 5, 866781297, time.UTC),
+			uncompressedSize: 16472,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xff\x73\xe2\xb8\x92\xff\x9d\xbf\xa2\x1f\xf1\x0e\xc9\xec\x08\x03\xc9\xcb\x64\x98\xf5\xde\x32\xc1\xc9\xf2\x26\x09\x14\x90\x99\x9b\xcb\xcb\xf2\x84\x2d\x40\x17\x23\x79\x65\x39\x09\x9b\x70\x7f\xfb\x95\xe4\x2f\xd8\x06\xb2\xc3\xbb\x4a\xd5\xfd\xb0\x54\xcd\xc4\xd6\x97\x8f\xba\x5b\xdd\xad\x6e\x49\xde\xfb\x1b\x98\x61\x20\xcc\x31\x65\x26\x61\xf7\x30\xc6\xc1\xac\xb4\xb7\x07\xa7\xdc\x5f\x08\x3a\x9d\x49\x68\xd4\xea\x1f\x60\x30\xc3\x6c\x3a\xc3\x14\xfe\x41\xd9\xb4\x1d\x72\xe8\xb0\x09\x17\x73\x2c\x29\x67\x30\x24\xce\x8c\x71\x8f\x4f\x17\xe0\xf0\xea\x3b\xb8\x90\x6e\xb5\xb4\xb7\xa7\x60\x2e\xa8\x43\x58\x40\x5c\x08\x99\x4b\x04\xc8\x19\x81\x96\x8f\x9d\x19\x49\x6a\xde\xc1\x17\x22\x02\x85\xd2\xa8\xd6\x60\x5f\x35\x28\xc7\x55\xe5\x83\x8f\x0a\x62\xc1\x43\x98\xe3\x05\x30\x2e\x21\x0c\x08\xc8\x19\x0d\x60\x42\x3d\x02\xe4\xd1\x21\xbe\x04\xca\xc0\xe1\x73\xdf\xa3\x98\x39\x04\x1e\xa8\x9c\xe9\x71\x62\x14\x45\x09\x7c\x8b\x31\xf8\x58\x62\xca\x00\x83\xc3\xfd\x05\xf0\x49\xb6\x21\x60\x19\x13\xad\x7f\x33\x29\xfd\xa6\x69\x3e\x3c\x3c\x54\xb1\xa6\xb8\xca\xc5\xd4\xf4\xa2\xb6\x81\x79\xd1\x39\xb5\xaf\x06\x36\x6a\x54\x6b\x71\xaf\x6b\xe6\x91\x20\x00\x41\x7e\x0f\xa9\x20\x2e\x8c\x17\x80\x7d\xdf\xa3\x0e\x1e\x7b\x04\x3c\xfc\x00\x5c\x00\x9e\x0a\x42\x5c\x90\x5c\x51\xfd\x20\xa8\xa4\x6c\xfa\x0e\x02\x3e\x91\x0f\x58\x10\x05\xe3\xd2\x40\x0a\x3a\x0e\x65\x4e\x68\x09\x8d\x34\xc8\x35\xe0\x0c\x30\x83\x72\x6b\x00\x9d\x41\x19\x3e\xb5\x06\x9d\xc1\x3b\x05\xf2\xb5\x33\xfc\xb5\x7b\x3d\x84\xaf\xad\x7e\xbf\x75\x35\xec\xd8\x03\xe8\xf6\xe1\xb4\x7b\xd5\xee\x0c\x3b\xdd\xab\x01\x74\xcf\xa0\x75\xf5\x0d\x3e\x77\xae\xda\xef\x80\x50\x39\x23\x02\xc8\xa3\x2f\x14\x07\x5c\x00\x55\xe2\x24\x7a\x16\x61\x40\x48\x8e\x84\x09\x8f\x48\x0a\x7c\xe2\xd0\x09\x75\xc0\xc3\x6c\x1a\xe2\x29\x81\x29\xbf\x27\x82\x51\x36\x05\x9f\x88\x39\x0d\xd4\xb4\x06\x80\x99\xab\x60\x3c\x3a\xa7\x52\xeb\x4b\xb0\xce\x57\xb5\x54\x0a\x88\x04\x64\x93\x90\x83\x4f\x7d\x32\xc1\xd4\x2b\x95\xfa\xdd\xee\xd0\x32\xf6\x43\xa6\x2a\x4f\xdb\xbd\xd6\xf0\x57\x78\xf3\x06\x1c\x17\x8c\x7d\x97\x0a\x86\xe7\x04\xca\xc6\xd3\xa7\xd6\xe0\xd7\xd1\xa0\x7b\xdd\x3f\xb5\x6f\x6a\xb7\xcb\xf2\x81\x6a\xe4\x3f\xb8\x07\x25\xd5\x52\x81\x94\xda\xf6\xa7\xeb\x73\x6b\x82\xbd\x80\x94\x2e\x06\x9f\x46\xed\xce\x60\x68\x95\xd4\xff\xa3\x2f\x76\x7f\xd0\xe9\x5e\x59\xa5\xd6\xe9\x50\xff\x3d\xed\x5e\xf6\xba\x57\xf6\xd5\xd0\x2a\xa5\x75\x57\xdd\xb6\xdd\xe9\x59\xa5\xce\x65\xeb\xdc\x1e\xf5\xed\x5e\x77\xd0\x19\x76\xfb\xdf\x2c\x97\x3b\x77\x44\x54\x29\x37\xef\x7c\x8c\x83\x52\xdb\xfe\xd2\x39\xb5\x47\x97\xdd\xeb\xab\xe1\xc0\x2a\x95\xf6\xe0\x2e\x1c\x13\x8f\xc8\x54\x5e\xa5\xcf\xd7\x9f\xec\x0b\x3b\x33\xf0\xe9\xc5\xf5\x60\x68\xf7\x47\xed\xab\x81\x95\xd6\xf6\x3e\x9f\xa7\xdd\xb1\x3b\x5f\x75\xff\x47\xb7\x73\x35\x3a\xed\x5e\x0d\xfb\xdd\x8b\x51\xef\xa2\x75\x65\x5b\xa5\xce\x55\x67\xa8\xca\xce\x3a\xe7\x96\x49\xa4\x63\xaa\x5e\x82\x11\x49\x02\x33\x06\x18\x39\x9c\x4d\xe8\xb4\xba\xc0\x73\x4f\xe1\xfa\xd8\xb9\x53\x93\x96\xe2\xf6\x3e\x9f\x8f\x2e\xcf\xfb\x0a\x6c\x30\x6c\x5d\x5c\x8c\xba\x3d\xad\x2b\xa9\x14\x46\x83\x6f\x97\x9f\xba\x17\x56\xe9\xa2\x7b\xda\xba\x50\x32\x18\xb5\xda\xed\xbe\x55\xb2\xff\x73\xd8\x6f\xf5\x3e\x9f\x0f\xac\x08\xa4\xd3\xef\x77\xfb\xd6\x9c\x0a\xc1\x45\x50\xc5\x1e\x5d\x84\xac\xea\xf0\xb9\x1a\x96\x48\xc7\x5d\x8d\x69\x0f\x4f\xdb\x23\x25\xd7\x56\xaf\x33\xb0\xfb\x5f\xec\xfe\xb7\xd6\xe5\xc5\x1a\x0b\x73\xcc\xe8\x84\x04\x32\x62\x06\x61\x9f\x06\x44\xdc\x13\x11\x31\xa3\x41\xfe\xa4\x9f\x1a\x36\x61\x7d\x0f\x02\x1e\x0a\x87\x80\x47\xc7\xd5\x60\x56\xaa\x26\x0f\x25\x87\xcf\xe7\x98\xb9\xcd\x26\x79\xa4\x81\x0c\xf6\x0f\xe0\xa9\xa4\x7c\x41\x5c\x0e\xe8\x1e\xca\xc6\x2f\x65\xf8\x19\x4c\x97\xdc\x9b\x2c\xf4\x3c\x68\xfc\xfc\xa6\x5e\x5a\xe6\xfa\x12\x27\xed\x69\x68\xc5\x53\xfa\x18\xbd\xab\x9f\xc7\xa7\xcd\xa6\x4b\x7c\x8f\x2f\xa0\x0d\xc6\x2f\x69\x05\xb9\xc7\x5e\xf6\x5d\x10\x19\x0a\xa6\x5f\x97\x25\xfd\x67\x2f\xdb\xb7\x93\xb4\x25\x42\x58\xc6\x3e\x3c\x25\x00\x59\xfa\x3e\xc2\x52\x93\x78\x00\xcf\xcf\xb9\x91\x6d\x28\x2b\x4a\x55\x73\x65\x6c\xc4\x7d\xa7\x70\x9a\x60\x10\x21\xca\x8a\xa1\x30\xc0\x53\x32\x22\x8f\x54\xa6\xdc\xe4\x47\x8f\x44\xf1\xa6\xa1\xab\x74\xeb\x88\x9a\x47\x2a\x41\x8b\x24\xd3\x3c\x03\xe1\x60\x0f\x3c\x72\x4f\x3c\xcb\xa8\x67\x8a\x02\x49\x7c\xcb\x68\x64\x1b\xf1\xa9\x0c\x2c\x63\xdf\xc5\x92\x40\xe5\xc7\x1f\xe6\x3f\xb8\xf0\xc3\xb0\x72\x90\x69\x32\xe3\x81\x54\x5e\xc0\x32\xf6\x93\xc7\x83\x48\x52\x92\x04\x12\xd0\x1f\x50\x36\xf4\x58\x65\x35\x05\x44\x29\xa4\xe6\x08\xca\x67\xc6\x45\xf7\x7c\x38\x80\x1b\x23\xe9\x78\x9b\x63\x4f\xf7\xd2\x6b\x4e\xac\xac\xc4\x2d\x47\xc8\x0e\x0e\xc8\x0a\x96\xb2\x74\xba\xda\x07\xe9\xa3\x96\x83\x33\xe3\xca\xdb\x33\x28\xb7\x0d\xcd\x4b\x6e\x30\xe3\xe9\x97\x66\x63\x59\x4e\xbb\x7c\xfc\x98\x3e\x76\xd6\x81\xa0\xdc\xd9\x0d\xe3\xeb\x3a\xc6\x82\x78\x1e\x7f\x80\xf2\xd7\xdd\x90\xec\x02\x52\x46\x88\xf6\x6e\x48\x67\xdb\x91\xce\x76\x43\x7a\xbb\x1b\x52\xc8\xee\x18\x7f\x60\x1b\x26\x38\x9e\xc6\xe2\x18\x24\xc0\x8e\xd2\xe0\x3d\x10\x64\x42\x04\x51\x81\xc5\x44\xf0\xb9\x8e\x0a\x82\xa6\x69\x06\x12\x3b\x77\x6a\xb9\x9b\x78\xfc\x41\xf9\x36\xf3\xf7\x90\x04\x7a\x75\x33\x8f\x6a\x8d\xc3\x93\xc3\x9a\x39\xe3\x0f\x48\x72\xa4\x62\x13\x2c\x08\x92\x0f\x1c\xa9\x95\x9b\x4d\x03\x44\x19\x72\xb9\x44\x01\xf1\xb1\xc0\x92\xb8\xe8\x3e\x0a\x82\x50\x14\x54\xa9\x7a\x1d\x88\xdd\x13\xa1\xba\xa7\xd6\x43\x27\x70\x73\x03\x46\x1d\x2c\x0b\x8c\x06\xdc\xde\x46\xba\x3e\x23\xac\xe0\x34\xa0\xa6\x0b\x26\x34\x63\x2c\x9d\xb3\x81\x55\xcd\xbc\x53\xb8\x27\xa2\x6e\xed\x1b\xf5\x03\xf5\xd4\xb0\xf6\x8d\x46\x24\xd7\x3d\x15\x5f\x79\x40\xe6\xbe\x5c\xc0\x84\x12\xcf\x0d\x54\xbc\xa2\x9a\x47\xf1\xd5\x1f\x44\xf0\x20\x1a\x81\x0b\xd8\xdf\xa7\x96\xf1\xb4\xa7\xaa\x6f\x7e\xb9\x5d\x7e\x04\xfa\x53\xf4\xda\x88\x5f\x7f\xfc\xf1\x20\x02\x76\x79\x4a\xa7\x6e\x4d\x6f\xad\x5a\x5c\xc1\x48\x0e\xaf\xb6\x42\xa9\xbf\x80\x12\x09\x04\xfd\x01\xc6\x93\x1e\x8f\xde\x2e\x13\xa9\xac\x49\xe6\x65\xce\x1a\x45\xce\x32\x84\x36\x56\x84\x66\xa4\x1a\x8f\xbf\xbf\x5f\xaf\xed\xe9\xe1\xeb\x7a\xf8\x9f\x21\x79\xd7\xe4\x1c\x1c\x6c\xa7\x26\x9e\xab\xfa\x77\x22\xff\xb4\x33\x72\xa3\x88\x9c\xca\x39\x55\x93\x65\xa9\x24\x88\xcf\x83\x66\x33\x20\x32\xf4\xb7\xf9\x7a\x5d\x99\x06\x0d\xba\x87\x8a\xec\x20\xf4\xb5\x7b\x76\x54\x84\x5c\x8e\x91\x57\x68\xcd\xa6\xf1\x94\x84\x5b\xcb\xe2\x50\xcd\x66\x38\x0e\x99\x0c\x0b\x6b\x43\xb4\x38\xbb\x54\x44\xcb\x39\xf6\xa5\x19\x15\x05\x55\x8f\x06\xb2\xea\xc6\x5e\x58\xaa\x65\x6e\x53\x0b\xf8\xe9\x27\xbb\x7b\x56\x72\xc9\x38\x09\xe2\x8d\x55\x58\x62\x46\x63\x9a\x60\x3c\x65\xa3\xbf\x25\xcc\x55\x62\x20\x88\xb2\x50\x27\x8a\xbd\xa9\x32\x4a\x02\xf3\xd0\x93\xd1\xe3\x8e\x90\x28\x20\x4e\x28\xa8\x5c\xbc\x06\x76\x24\xf7\xe0\x35\xa0\x7d\xc1\x7d\xae\x72\xb6\x57\xc0\x1e\x63\xe7\xce\xe7\x42\x7e\x37\xe1\x28\x10\xce\x2b\x4c\xe3\x8e\xb0\x3b\x4f\xe5\xae\xf8\x3b\x4e\xe7\xae\xf0\xbb\x4e\xe9\xae\xf8\xbb\x4d\xab\xb2\xce\x95\x0d\x1b\xa9\xc1\x67\x42\xf7\x4d\x86\x1c\x14\x88\xc9\x04\xfa\xca\x05\xc0\xea\x1d\x6d\xd2\x8a\xd5\xb0\xd9\x48\x1d\xb0\x2f\xd1\x1d\x59\x00\x76\xef\x01\x21\x41\x9c\x7b\xf5\x1a\x00\xd2\x7f\x74\x9a\x01\xe9\x53\x35\x12\x80\x5a\xf0\xe1\xb8\x55\x3b\xac\x7d\x6a\xd4\x3f\xb5\x6a\xef\xcf\x8e\xce\x3e\x81\x7d\x72\xd4\x3a\x6d\x9c\xd6\x8e\x8e\x6b\x67\x87\x1f\x3e\x1c\xc1\x7b\xbb\x55\x6b\x7d\x38\x3d\x3c\x6b\xbc\x3f\x3c\x3b\x6d\x9f\xc0\xd9\xfb\xe3\x46\xa3\xfe\xf7\xf7\x8d\xd3\xbf\x37\x8e\x6b\x1f\xda\x9b\xc9\x01\xc7\x23\x98\x6d\xa9\x8b\x14\x65\xdd\x95\x3a\x84\x49\x1e\x14\x5c\xa9\x6a\x92\x3a\xd2\x45\x38\xaf\xea\x3e\x55\xb7\x54\xca\xaf\x9d\xf9\x84\x0e\x6e\x6f\x3f\xe6\x57\x94\x42\x5e\x04\x8b\x70\x8e\xa2\x74\x12\xcd\x31\xc3\x53\x22\x54\x76\xf1\x94\x5b\x81\xf2\xa4\x97\x8d\x38\xbd\x04\xca\x02\x89\x3d\x0f\x8c\x42\x9a\xa9\x41\x43\x49\xbd\x60\x15\x8d\xc5\x59\x4f\x46\x57\x62\x8e\x4c\xe2\x13\x4f\x73\x13\xeb\xc8\x8d\x2a\xb8\x2d\xe9\x5c\xc0\x7e\x94\x02\x43\x2f\x5a\xaa\x02\x1d\x51\xd8\x4c\x12\xe1\x0b\x1a\x10\xb8\xa0\x2c\x7c\x84\xf7\x80\xe0\x9f\xc6\x18\x07\x04\x0b\x67\x56\x52\x0f\xa1\xf0\xac\x0d\x2a\xaf\x80\xcd\xf7\x66\xa6\xb1\x4a\x97\x54\xe8\x37\x27\x72\xc6\x5d\xcb\x17\x94\x2b\xd7\x50\x22\x0c\x8f\x3d\xe2\x5a\xf5\xd2\xd4\x9f\x3a\x33\xe2\xdc\x59\x35\xf5\x78\x47\x16\xd6\x84\x7a\xa4\x69\x9a\x7a\x22\xfc\x3b\x6a\x0a\x7f\x8e\xa6\xfe\xd4\xec\xf7\x2e\xd1\x79\xef\x1c\x7d\xb6\xbf\x21\xbb\x67\x5f\xa0\xf7\xa9\x9a\x6e\xe0\xfa\xee\x24\xc8\x31\xbd\xd2\xf8\x88\x75\xb0\xe0\xee\x24\x48\xb8\x01\x6b\x93\x09\x67\xac\x66\x11\xce\x4d\xad\x11\x99\x42\x44\xbc\xf7\xe8\xf1\xe4\x78\x74\x7c\x64\x26\x1c\x81\x05\x2b\x9e\xc0\x82\x5a\x4a\x23\xf1\x02\xb2\x0a\x42\x74\xca\xe5\x42\x51\xdb\xcc\x31\xbe\x53\xfa\x31\xbf\x73\xa9\xd8\x58\x9b\x42\xcc\xef\x01\x4d\xd6\x9b\xbc\x8d\xb8\xde\x08\xfc\x26\x9b\x8c\x3f\x3f\x83\x14\x21\x29\xc8\x6f\xad\x9f\xb6\x8e\xbc\x24\x7d\x8c\x03\x14\x85\x3b\xb1\x1a\xe9\x46\x68\x11\xce\x53\xed\x28\xd8\xc9\xe6\x09\x4f\x44\x33\xa1\xeb\x46\x2a\x66\xc4\xfb\xcb\x44\xff\x32\xd1\xbf\x4c\xf4\xff\x91\x89\xc6\xfb\xb3\xcd\xe6\x3d\xf6\xa8\x5a\x5c\xb7\xa5\x40\x49\x7d\xba\xa3\x1b\xdb\x89\xde\xd4\x2e\x67\x6c\x3a\xae\x1f\xc5\x49\xbd\xa5\xab\x92\x7d\xdd\xd8\xa6\xec\x76\xbc\x1b\x5d\x5c\xe7\xb5\xf5\x26\x23\xe4\xf6\x0d\x8b\xb0\xc6\x7e\xd2\x0c\x25\xfb\x07\xf0\x0c\x2a\xca\xab\x04\x26\x32\x47\x66\x05\x9e\x01\x3f\xdc\x01\x3a\xbb\x87\xca\x93\x2f\x28\x93\x60\x34\x96\x95\x83\x95\xcd\xde\xdc\x80\x51\xd8\x8f\x86\xbf\x59\x60\x14\xc6\x82\xdb\x5b\x45\x4b\x61\xff\x10\x83\x4b\x27\x7a\x7b\x44\x42\xd2\x30\x21\x09\x7b\x82\x60\x77\x91\xc8\x28\x3a\xab\x98\xf1\x40\xbe\x03\xdf\x23\x38\x20\x2a\x32\x8c\xfd\x0c\x95\x3a\x95\x94\x62\x01\x78\x8a\x29\x2b\x2b\x25\x59\x97\x57\xaa\x36\xcb\x54\x89\xb2\xd3\x17\xa3\x6d\x9b\xbd\xb8\x9a\xb2\x69\x42\xa3\xf1\x94\xdf\xd8\x5e\x1a\x4f\x05\x51\x2c\x8b\xb3\x8a\xdd\x79\x46\xfc\x7a\x63\x6e\x4d\x7c\x89\xcc\x2b\x37\x23\x74\x5b\x59\x09\xbe\x9e\x0a\xde\x58\xe3\x2d\xef\x9b\xff\xd4\x2f\x3f\x15\x1c\xf3\x72\x07\x96\xde\x96\x57\xb3\xbf\xda\x9d\xce\xef\x49\x17\x85\xe5\x48\x6f\x03\x72\x41\x20\x4b\x3d\x89\x71\xe1\x77\x34\x2f\xff\x5f\xf9\xfd\x3e\xaa\xde\xee\x40\xd2\xdb\x72\xbc\xd9\x9e\xd5\xab\x68\x15\x4d\xd5\x6a\x0f\x86\xdd\x76\xb7\x09\x82\xcc\xf9\x7d\x7c\x1a\xe9\x51\x46\xe0\x61\x46\x54\xe6\xa3\x95\x5b\xb7\x8c\xcf\x8c\xfe\x45\x7d\xe5\x31\x29\x53\x56\x91\xd1\x0e\x30\x6f\x7f\xac\x40\xc5\x7c\x77\xdd\x7b\x67\x3e\x4d\x89\x54\x28\x1f\xf5\xbe\x8f\x71\x08\xff\x03\xe6\x6f\xf5\x5a\xd5\x54\x9a\x91\xbc\x7e\x68\x54\xeb\xc7\x27\xf9\xb2\xf7\x8d\xea\x7e\xfd\xe6\x18\x7d\xb8\x7d\x6e\xdc\xd4\xd4\x9f\xc3\x9b\x5a\xfd\xf6\xa0\x6a\x1e\x40\xa2\x79\x87\x1f\xf5\xd6\x68\x6d\xb9\xac\xfc\x6b\x93\x69\x4c\x09\x23\x42\xef\xe2\x68\x56\xf5\x72\xbc\xab\x8d\xa8\x30\x25\x5e\x57\x82\x45\x20\xc9\xdc\x8d\xff\x9a\x31\x52\x55\x65\x50\xd4\x21\x55\xd7\x54\xde\x24\xbf\xd8\xfc\x69\x97\x48\x67\xb5\xc1\x55\x6e\x06\x51\x71\xb4\xcd\x67\xb3\x7b\x2a\x38\x9b\x13\x26\xad\x72\x42\xdb\xe9\x79\xbf\x7b\xdd\x1b\xb5\xfb\x9d\x2f\x76\xdf\x42\xc8\x99\x0a\x1e\xfa\xc8\x15\x2a\x03\xb5\xa2\xb7\x49\x1c\xc5\x6c\x04\x50\x7f\xa3\xf3\xb4\x51\xab\x7f\x3e\xb0\x10\x1a\x73\x2e\x03\x29\xb0\x8f\xb4\xee\x69\x49\xad\x1d\x38\xe5\x1b\x29\x16\x54\x43\x95\x4b\x6e\xef\x53\x68\xc9\xb8\x4b\x10\xf5\xad\x8a\x11\xe9\x4f\xe5\x05\x2a\x07\xdf\x06\x43\xfb\x72\xd4\xeb\xb6\x07\x09\x99\x3e\x77\x51\x72\xec\x85\x7c\x2c\x67\xdb\x0f\xc5\x5e\x00\xbe\xb2\x87\x5f\xbb\xfd\xcf\x09\x28\x23\xf2\x81\x8b\x3b\xe4\x7b\xe1\x94\x32\xcb\x61\x14\x10\x72\x18\xd5\x11\x26\x4a\xc3\x57\x87\x51\x93\x11\x59\x75\xe3\xda\x31\x65\x51\x25\xf7\xa5\xae\x1c\x53\xf6\xc2\xa0\xed\xab\x94\x0b\xc7\x0b\x03\x49\x04\x72\x59\x60\x55\x8c\xcc\xf9\x68\x05\x32\x95\x5c\xa5\xf5\x56\xfc\x5a\xd5\x5e\xfa\x05\xf8\xd6\xf5\xf0\xd7\xff\x4a\x06\xc0\xa1\x9c\x71\x41\xff\xd0\x6b\x37\x9a\x73\x97\x58\x5f\xc9\x78\xc6\xf9\x9d\x1e\x80\x12\x26\x91\x83\x91\x8a\x09\xd7\x04\xa8\x82\x43\x07\x57\x1d\x21\xa3\xd1\xf6\x36\xab\x60\xab\xfd\xa5\x33\xe8\xf6\x53\x96\xb0\x7b\x4f\x03\x2e\x90\xcf\x85\xb4\x6a\x2f\x10\x7a\x6a\xf7\x87\x9d\xb3\xce\x69\x6b\x68\x27\x9d\x05\x97\x58\x12\xe4\x10\x21\xe9\x84\x3a\x58\x92\x40\x2f\x87\x8a\x58\x22\x64\x24\xe5\x7b\x2c\x4c\x8f\x8e\x13\x85\x52\x74\xbe\x30\x4a\xaf\xdb\x1e\x75\xae\xce\xfa\xad\xac\xe6\x50\x36\x11\x58\xcd\xaa\xc4\x94\x11\x81\xe8\x1c\x4f\x89\x55\x31\x9e\x8a\xe7\xde\x3f\xbc\x35\x97\x15\xd3\xc7\x61\x40\x9a\x87\xd5\xfa\x0b\xe3\x9c\xd9\xad\xe1\x75\xdf\x1e\x9d\xb7\x86\xb6\x1a\x66\x42\xb0\x0c\x05\x41\x53\xcd\x44\x9b\x28\x4b\xee\x69\xbd\x8a\x58\x7a\x01\xea\xa2\x7b\x3e\xba\xb0\xbf\xd8\x17\x16\xba\xb7\x8e\xe2\x86\x8f\xc4\x19\x48\x2c\xa4\x55\x78\x4d\x6f\xb5\x24\x11\x89\xb1\xd1\x39\xac\x8a\x0b\x26\xbf\xaa\x28\x5a\xd9\xaa\x26\x6b\x26\xab\xd2\x44\x8f\x57\x25\x2b\xd5\xcb\x50\x91\xd5\x8f\x4c\x71\x61\xf2\x57\x35\xfa\x94\xbd\x50\x96\x9f\xc4\x0c\x61\xdd\xb6\x3d\xea\xf4\x0a\xa5\xb9\xa9\x58\x15\xa7\x62\x5d\x15\xf5\x6d\x7d\x1a\x3f\x3a\xed\x5e\xf6\xae\x87\x6a\xe2\xa3\xeb\x15\x1a\x50\x0b\xba\x92\x04\xed\x7f\xea\xba\xeb\x35\x14\xaf\xb3\xda\xc5\xe5\xd6\x56\x11\xb2\x6d\xf1\x9a\x08\xd3\x60\xb2\xbc\x61\x2f\x2c\x1a\xce\x91\x1e\xb8\x98\xcc\x39\x43\x82\x78\x1c\xbb\x2f\xb6\x8c\x92\x01\xb5\x18\xc7\xc0\x2f\xb6\x16\x24\x50\x8a\x94\xb6\xcd\xd2\x9d\x3f\x2a\x59\xcb\x20\xf2\xa5\x71\x18\x93\x2f\x8c\x16\x83\x7c\x99\x08\x99\x1a\xe5\xbf\x39\xdd\x2a\x15\x55\x07\x6a\x89\x00\xc9\x21\xf6\x7c\xd9\x20\x35\xb8\xa3\xfe\xc8\xc1\x56\xba\x7d\x90\x5c\x1f\xd1\x1d\x11\x9a\x11\xcf\x87\x67\x98\x0a\xe2\x03\xfa\x1d\x2a\xbf\xfd\x33\x78\x8b\x90\x4b\x03\x47\xa5\xcb\x0b\x24\xf9\x1d\x61\x28\x64\x01\x9e\x10\xa4\xc0\x94\x17\xbc\x27\x22\xf2\x3a\x94\xb3\xca\xfa\x89\x23\x79\x54\x2e\x2d\x1d\x7a\x27\xb8\x24\x0f\x8b\x1c\x69\x81\x58\xdd\x1b\x8c\x61\xf7\xb3\x7d\x05\xc6\x65\x4b\x2d\x02\x9d\x1e\xec\x34\x00\xdc\x20\x44\x1e\x7d\x22\xa8\xf2\x25\xd8\xd3\xce\x4d\x70\x0f\xf9\x1e\x66\x71\x08\x91\xd7\x80\xef\x20\x02\x8c\x98\x59\x30\xd6\x6f\xe3\xa4\x77\x27\xf4\x1c\xaa\xf4\x36\x4a\x60\xaf\x55\x59\x33\x4a\x00\x6a\x10\x9d\xa7\x45\xe7\x68\x37\x08\x45\x99\xac\x7a\x45\xd8\x75\x45\xb2\x2b\x50\xaf\x55\xeb\xb5\x6a\xad\x5a\x6f\x9e\x9c\x9c\xd4\xa2\xa4\x58\xe7\xc4\x08\xf9\x77\x53\x14\x5d\xb1\x81\xf5\x9b\x36\xb7\x0a\xd3\x25\xe3\x70\x7a\x9b\x1f\x70\x95\x31\x66\x96\x58\xa8\x1f\x7f\xa8\xaa\x7f\x6a\xb4\x4c\x32\x59\xaf\xd6\xeb\x55\x55\xa0\x97\x01\x4d\x5d\x40\x25\x17\x0b\x28\xdc\x7a\x5a\x1f\x2d\x27\xbc\x93\xa3\xbf\x93\xc3\xe3\xea\xd8\x39\x3a\x3e\x3e\x3a\xa9\xe1\xf1\x71\xa3\x7e\x78\xf2\x1e\x10\x9a\x63\x45\x03\xac\xd8\x3c\x3e\x3a\x3a\x54\x68\xf9\x59\x5a\xc7\xd7\x5b\xe4\x99\x62\x25\xe0\x65\xa9\xa4\x02\x82\xd4\x74\x54\x48\x1a\xc7\x97\x3c\x40\x71\x64\x1e\x67\xb2\xe9\x1d\x30\x63\xbf\xba\xd6\xe4\xcd\x9b\x28\xd2\x34\x3a\xf1\x95\x8e\x64\x0f\x2e\xee\xb4\x21\x1b\x3e\x83\x72\x74\x8b\x46\xd9\xa5\x4b\x24\x71\xa4\xca\x0a\xc2\xc7\xe8\xba\x1e\xcf\xde\x1d\x59\xe1\xc4\x97\x47\xa2\x43\x85\xd5\x69\x6e\x72\x13\x0b\xfb\x32\x2d\x2b\x5e\xca\xaa\x00\x5a\x00\x42\xd8\xf3\xf8\x03\x0a\x99\x8a\x67\x08\x93\x3a\x34\x70\x2b\x69\xaf\xc2\xcd\xad\x8a\xb5\xaa\xca\xdd\x7d\x7b\x51\x0a\xd7\x9f\xae\xaf\x86\xd7\xa3\xd3\x6e\xdb\xbe\x6a\x5d\xc6\xd7\x41\xe2\x8b\x12\xd1\xb1\xc3\xb3\x98\x11\x6f\x9d\xfe\x45\x38\xff\x33\xfa\x03\x22\xb9\x2f\x2d\x3e\x0e\xb8\xa7\x82\x2b\xab\xa6\x43\xe0\x64\xb3\x66\x3b\x27\xe8\xdf\xe1\x24\x01\x49\xe6\x35\x66\x22\x73\x9b\xa4\x30\xa7\x21\x13\xc4\xe1\x53\x46\xff\x20\x6e\xbc\x2d\x19\xcd\x67\x73\x35\x8b\xef\xc0\x09\x85\x20\x4c\x7a\x0b\xe0\xcc\x5b\x40\x10\xfa\xda\x21\x46\xb2\x89\x4e\xc9\xf5\x0c\x97\xb3\x83\xea\x2b\x26\xfa\xc9\xc7\x42\xdf\x28\xfa\x25\x56\x92\xad\x59\xf9\x16\x02\xc0\xc8\x4a\x20\xd6\x3e\xe2\x46\xa3\x19\xd1\xad\x46\x65\x1c\x7a\x9c\xd4\x3a\x1e\x66\xd4\x23\x70\x03\xc6\x1e\xa0\xa9\x84\x1a\xdc\x7e\xcc\x5e\xac\x88\x6f\x39\xd5\x73\x37\x9c\xd4\x4f\xfb\x91\xfc\xf5\x1b\xf5\x8b\x2f\x4f\xea\xda\x5c\x65\xe6\xd6\x8e\xfa\x29\xc7\xb0\xb5\xb7\xaa\x7c\xa9\xb3\xb6\xfa\xad\xbd\x57\xc7\x66\x5b\xba\x6b\x07\xb6\xde\x7d\x75\xdf\x53\x37\x78\x09\x21\x76\x9f\x2f\x61\x64\x43\x8b\x2d\x28\x39\xe7\xbb\x8e\xa5\xbc\x0d\x03\xe3\xa9\xf1\xe3\xe3\x32\x76\x35\x7f\x8b\x15\xb8\x91\x59\xbb\x7f\x43\xf9\xed\xc1\x1c\x39\x99\x1b\xa5\x65\xa3\x51\xde\xd8\x28\x98\xd1\x89\x5c\xab\x59\xae\x9f\x13\x24\xbf\xd5\x8d\x40\x28\xb3\x34\xfc\x00\xb5\x82\x50\x1f\xa6\xf4\x9e\x30\xbd\x77\x90\xe3\x6f\x7d\xec\xe5\xcb\xb2\x89\xd6\x84\x57\x11\x4b\xb4\x7a\xbf\x9e\x44\xe2\xe5\x4c\xad\xe0\x24\x08\xf6\x3b\xbd\x66\xaf\xdb\x1f\x1e\xe4\x44\x13\xb5\xd9\x59\x2a\x7a\x25\x7d\x15\xa1\xe8\xc8\xe6\xf5\x64\x12\x85\x00\x59\x09\xe8\x92\x9d\x05\x10\x07\x25\xaf\x22\x82\x64\xf1\x78\x35\x21\x24\x11\x55\x56\x0c\x71\xd9\xce\x82\x88\x37\x88\x5e\x45\x10\xf1\x96\xe5\xab\xc9\x41\x67\x2e\x05\x5f\x11\xf3\xb3\xb3\x1c\x8a\x41\xe9\xab\x08\x64\xed\xbe\xff\xab\x89\x26\x0a\xa9\x41\x73\x05\x99\x50\x3b\x2b\xaa\x22\xcb\x3b\xcb\x2c\xca\x3a\x5f\x47\x52\x99\x4f\x10\x5e\x4d\x48\x49\x6e\x46\x19\x95\xc9\x6e\x75\x6e\xdd\xd1\x45\x3b\x8b\x85\x48\xc7\x7d\x2d\x93\x4a\x3e\x71\x78\x35\x99\xe8\x2f\x2a\x0a\x36\x15\x33\xb4\xb3\x20\x56\x49\xe5\xab\xc8\x22\xf3\x75\xc8\xab\x89\x23\xb9\xa2\x1a\xe7\xc6\x59\xa9\xac\xb8\xfb\x77\x0c\x67\x95\x8f\xae\xcb\x66\xc3\x37\x39\x2f\x6d\x3b\xbc\x3c\x96\x4e\x69\xd7\xc7\x88\x3e\x61\xca\x9d\x9d\x6f\xea\x3e\x7b\x8e\x76\x79\xd6\x01\x56\x9f\x68\x64\x7f\xd1\xd1\xd0\x4b\x90\x6f\xb7\x40\xc5\x32\xa7\x4c\x6f\x7b\x01\xf7\x25\xe5\xac\x09\x46\xbd\xbc\x0d\x4d\xe7\x3c\xc9\x8b\x9e\x68\x30\xf6\xf7\x55\x0a\xf2\x33\xd4\xe0\x3f\xa0\x0e\x4d\xa8\x41\x7a\xcb\x9b\x91\xdc\x17\x17\x51\x98\x9f\x4b\x48\x36\x24\x23\x71\xe3\x34\x20\x5f\x4b\x60\x60\x7b\x26\x00\xab\x64\x22\x7b\x71\x67\xad\x5d\x41\x40\xf0\x52\x66\x90\xc1\xcc\x6f\x1f\x6e\x6c\x59\xfc\x36\x2c\x49\x59\xbf\x87\x84\x0d\xf3\x04\x5b\xe6\xca\xe1\x73\x9f\x33\xc2\xe4\xba\x21\x14\x80\x73\x53\x96\xa9\x53\x1e\xf8\x59\xe5\x6b\xcf\x85\xa4\x6c\xfb\x57\x1a\x9b\x08\xc1\x4e\xac\x34\xf1\xe4\x16\x61\x56\x1f\x62\xe8\x2b\x9c\xfa\x76\xa9\xce\x94\xff\x37\x00\x00\xff\xff\xad\xc9\x90\xbc\xb8\x3a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x6f\x5b\xe3\x38\x92\x7f\x9f\x4f\x51\x1b\x3c\x13\xe8\x69\xc5\x49\xe8\x05\x3a\x3d\x9e\x9b\x34\x31\x4c\xb6\x69\x92\x27\x09\x3d\xd7\xc7\xb2\x59\x61\x2b\x89\x0e\xc7\xf6\xca\x32\x90\x01\xee\xb3\xdf\x53\xb2\xec\xd8\xf9\xd7\x9d\xbd\xe3\x9e\x7b\xb1\x34\x33\xd8\x52\xe9\xa7\xaa\x52\x49\xaa\x2a\xc9\x7b\x7f\x02\x33\x8e\x84\x79\xcb\x7d\x93\xf9\xf7\x70\x4b\xa3\x69\x69\x6f\x0f\x4e\x83\x70\x2e\xf8\x64\x2a\xa1\x51\xab\xbf\x87\xc1\x94\xfa\x93\x29\xe5\xf0\x17\xee\x4f\xda\x71\x00\x1d\x7f\x1c\x88\x19\x95\x3c\xf0\x61\xc8\x9c\xa9\x1f\x78\xc1\x64\x0e\x4e\x50\x7d\x0b\x17\xd2\xad\x96\xf6\xf6\x10\xe6\x82\x3b\xcc\x8f\x98\x0b\xb1\xef\x32\x01\x72\xca\xa0\x15\x52\x67\xca\xd2\x9a\xb7\xf0\x85\x89\x08\x51\x1a\xd5\x1a\xec\x23\x41\x59\x57\x95\x0f\x3e\x20\xc4\x3c\x88\x61\x46\xe7\xe0\x07\x12\xe2\x88\x81\x9c\xf2\x08\xc6\xdc\x63\xc0\x1e\x1d\x16\x4a\xe0\x3e\x38\xc1\x2c\xf4\x38\xf5\x1d\x06\x0f\x5c\x4e\x41\x2e\x3a\x40\x4e\xe0\xab\xc6\x08\x6e\x25\xe5\x3e\x50\x70\x82\x70\x0e\xc1\x38\x4f\x08\x54\x6a\xa6\xd5\xcf\x54\xca\xb0\x69\x9a\x0f\x0f\x0f\x55\xaa\x38\xae\x06\x62\x62\x7a\x09\x6d\x64\x5e\x74\x4e\xed\xcb\x81\x4d\x1a\xd5\x9a\x6e\x75\xe5\x7b\x2c\x8a\x40\xb0\x7f\xc4\x5c\x30\x17\x6e\xe7\x40\xc3\xd0\xe3\x0e\xbd\xf5\x18\x78\xf4\x01\x02\x01\x74\x22\x18\x73\x41\x06\xc8\xf5\x83\xe0\x92\xfb\x93\xb7\x10\x05\x63\xf9\x40\x05\x43\x56\x5d\x1e\x49\xc1\x6f\x63\x59\x50\x5a\xca\x23\x8f\x0a\x04\x81\x0f\xd4\x87\x72\x6b\x00\x9d\x41\x19\x3e\xb6\x06\x9d\xc1\x5b\x04\xf9\xbd\x33\xfc\xad\x7b\x35\x84\xdf\x5b\xfd\x7e\xeb\x72\xd8\xb1\x07\xd0\xed\xc3\x69\xf7\xb2\xdd\x19\x76\xba\x97\x03\xe8\x9e\x41\xeb\xf2\x2b\x7c\xea\x5c\xb6\xdf\x02\xe3\x72\xca\x04\xb0\xc7\x50\xa0\x04\x81\x00\x8e\xea\x64\x6a\x14\x61\xc0\x58\x81\x85\x71\x90\x8c\x63\x14\x32\x87\x8f\xb9\x03\x1e\xf5\x27\x31\x9d\x30\x98\x04\xf7\x4c\xf8\xdc\x9f\x40\xc8\xc4\x8c\x47\x38\xac\x11\x50\xdf\x45\x18\x8f\xcf\xb8\x54\xf6\x12\xad\xca\x55\x2d\x95\x22\x26\x81\xd8\x2c\x0e\x20\xe4\x21\x1b\x53\xee\x95\x4a\xfd\x6e\x77\x68\x19\xfb\xb1\x8f\x95\xa7\xed\x5e\x6b\xf8\x1b\xfc\xf8\x23\x38\x2e\x18\xfb\x2e\x17\x3e\x9d\x31\x28\x1b\x4f\x1f\x5b\x83\xdf\x46\x83\xee\x55\xff\xd4\xbe\xae\xdd\xbc\x94\x0f\x90\x28\x7c\x70\x0f\x4a\x48\x89\x20\xa5\xb6\xfd\xf1\xea\xdc\x1a\x53\x2f\x62\xa5\x8b\xc1\xc7\x51\xbb\x33\x18\x5a\x25\xfc\xff\xe8\x8b\xdd\x1f\x74\xba\x97\x56\xa9\x75\x8a\xba\xb1\x4a\xa7\xdd\xcf\xbd\xee\xa5\x7d\x39\xb4\x4a\x59\xdd\x65\xb7\x6d\x77\x7a\x56\xa9\xf3\xb9\x75\x6e\x8f\xfa\x76\xaf\x3b\xe8\x0c\xbb\xfd\xaf\x96\x1b\x38\x77\x4c\x54\x79\x60\xde\x85\x94\x46\xa5\x5e\xeb\x6a\x60\x8f\x12\xb2\x61\xeb\xdc\x3a\xac\xd6\x4b\x6d\xfb\x4b\xe7\xd4\x1e\x7d\xee\x5e\x5d\x0e\x07\x56\xa9\xb4\x07\x77\xf1\x2d\xf3\x98\xcc\x74\x58\xfa\x74\xf5\xd1\xbe\xb0\x73\xcc\x9c\x5e\x5c\x0d\x86\x76\x7f\xd4\xbe\x1c\x58\x59\x6d\xef\xd3\x79\xd6\x9c\xba\xb3\x45\xf3\xbf\x74\x3b\x97\xa3\xd3\xee\xe5\xb0\xdf\xbd\x18\xf5\x2e\x5a\x97\xb6\x55\xea\x5c\x76\x86\x58\x76\xd6\x39\xb7\x4c\x26\x1d\x13\x3b\x15\x3e\x93\x2c\x32\x35\xc0\xc8\x09\xfc\x31\x9f\x54\xe7\x74\xe6\x21\x6e\x48\x9d\x3b\x1c\xc8\x0c\xb7\xf7\xe9\x7c\xf4\xf9\xbc\x8f\x60\x83\x61\xeb\xe2\x62\xd4\xed\xa1\x8e\x06\x99\x66\x46\x83\xaf\x9f\x3f\x76\x2f\xac\xd2\x45\xf7\xb4\x75\x81\x7a\x19\xb5\xda\xed\xbe\x55\xb2\xff\x7d\xd8\x6f\xf5\x3e\x9d\x0f\xac\x04\xa4\xd3\xef\x77\xfb\xd6\x8c\x0b\x11\x88\xa8\x4a\x3d\x3e\x8f\xfd\xaa\x13\xcc\xb0\x5b\x26\x1d\x77\xd1\xa7\x3d\x3c\x6d\x8f\x50\xd7\xad\x5e\x67\x60\xf7\xbf\xd8\xfd\xaf\xad\xcf\x17\x2b\x22\xcc\xa8\xcf\xc7\x2c\x92\x89\x30\x84\x86\x3c\x62\xe2\x9e\x89\x44\x18\x05\xf2\x8d\x76\xd8\x6d\x2a\xfa\x1e\x44\x41\x2c\x1c\x06\x1e\xbf\xad\x46\xd3\x52\x35\x7d\x28\x39\xc1\x6c\x46\x7d\xb7\xd9\x64\x8f\x3c\x92\xd1\xfe\x01\x3c\x95\x70\x7d\xd0\xe5\x40\xee\xa1\x6c\xfc\x5a\x86\x5f\xc0\x74\xd9\xbd\xe9\xc7\x9e\x07\x8d\x5f\x7e\xac\x97\x5e\x0a\x6d\x99\x93\xb5\x34\x94\x31\xa2\x8d\x26\x48\xf8\xcf\x0b\x26\xcd\xa6\xcb\x42\x2f\x98\x43\x1b\x8c\x5f\xb3\x0a\x76\x4f\xbd\xfc\xbb\x60\x32\x16\xbe\xaa\x7e\x29\xa9\x3f\x7b\xf9\xb6\x9d\x94\x96\x09\x61\x19\xfb\xf0\x94\x02\xe4\xf9\xfb\x00\x2f\x8a\xc5\x03\x78\x7e\x2e\xf4\x6c\x43\x99\x3d\x32\x07\xc9\x71\x02\x32\xf7\x2d\x30\x21\x9a\x60\x30\x21\xca\x28\x50\x1c\xd1\x09\x1b\xb1\x47\x2e\x33\x69\x8a\xbd\x27\xaa\xf8\xb1\xa1\xaa\x14\xb5\x7a\xc2\x16\xa0\x54\x92\x23\xcf\x41\x38\xd4\x03\x8f\xdd\x33\xcf\x32\xea\xb9\xa2\x48\xb2\xd0\x32\x1a\x79\xa2\x60\x22\x23\xcb\xd8\x77\xa9\x64\x50\xf9\xe9\x87\xd9\x0f\x2e\xfc\x30\xac\x1c\xe4\x48\xa6\x41\x24\x71\x65\xb0\x8c\xfd\xf4\xf1\x20\xd1\x94\x64\x91\x04\xf2\x07\x94\x0d\xd5\x57\x19\x87\x80\xa1\x41\x2a\x89\xa0\x7c\x66\x5c\x74\xcf\x87\x03\xb8\x36\xd2\x86\x37\x05\xf5\xa8\x56\x6a\x1f\xd2\xc6\xca\xdc\x72\x82\xec\xd0\x88\x2d\x60\xb9\x9f\x0d\x57\xfb\x20\x7b\xc4\x7f\xcc\x99\x06\xb8\x03\xf8\x50\x6e\x1b\x4a\x96\x42\x67\xc6\xd3\xaf\xcd\xc6\x4b\x39\x6b\xf2\xe1\x43\xf6\xd8\x59\x05\x82\x72\x67\x37\x8c\xdf\x57\x31\xe6\xcc\xf3\x82\x07\x28\xff\xbe\x1b\x92\xbd\x84\x94\x53\xa2\xbd\x1b\xd2\xd9\x66\xa4\xb3\xdd\x90\xde\xec\x86\x14\xfb\x77\x7e\xf0\xe0\xaf\x19\x60\x3d\x8c\xcb\x7d\xb0\x88\x3a\x68\xc1\x7b\x20\xd8\x98\x09\x86\xce\xc6\x58\x04\x33\xe5\x29\x44\x4d\xd3\x8c\x24\x75\xee\x70\x0b\x1c\x7b\xc1\x03\xae\x6d\xe6\x3f\x62\x16\xa9\x1d\xcf\x7c\x57\x6b\x1c\x9e\x1c\xd6\xcc\x69\xf0\x40\x64\x40\xd0\x5f\xa1\x82\x11\xf9\x10\x10\xdc\xee\xfd\x49\x44\xb8\x4f\xdc\x40\x92\x88\x85\x54\x50\xc9\x5c\x72\x9f\x38\x46\x24\x71\xb4\xb0\x5e\x39\x67\xf7\x4c\x60\xf3\x6c\xf6\xf0\x31\x5c\x5f\x83\x51\x07\xcb\x02\xa3\x01\x37\x37\xaa\x54\x4e\xd9\xc2\x0a\x93\x45\x03\x6a\xaa\x60\xcc\x73\x93\xa5\x73\x36\xb0\xaa\xb9\x77\x0e\xf7\x4c\xd4\xad\x7d\xa3\x7e\x80\x4f\x0d\x6b\xdf\x68\x24\x7a\xdd\x43\x9f\xcb\x03\x36\x0b\xe5\x1c\xc6\x9c\x79\x6e\x84\x3e\x0c\x92\x27\x3e\xd7\x1f\x4c\x04\x91\x22\x45\x0f\x61\x7f\x9f\x5b\xc6\xd3\x1e\x56\x5f\xff\x7a\xf3\xf2\x01\xf8\xcf\xc9\x6b\x43\xbf\xfe\xf4\xd3\x41\x02\xec\x06\x19\x9f\x8a\x9a\xdf\x58\x35\x5d\xe1\xb3\x02\x5e\x6d\x81\x52\xdf\x82\x92\x28\x84\xfc\x01\xc6\x13\x8a\x70\xcd\x6f\x5e\x52\xad\xac\x68\x66\xbb\x64\x8d\x65\xc9\xd2\x1f\x8d\xab\x19\xcd\x69\x55\xf7\xbf\xbf\x5f\xaf\xed\xa9\xee\xeb\xaa\xfb\x5f\x20\x7d\x6f\xe0\xfb\xc1\xc1\x66\x6e\xf4\x58\xd5\xbf\x13\xf9\xe7\x9d\x91\x1b\xcb\xc8\x99\x9e\x35\x41\x0d\xad\x5c\xb0\x30\x88\x9a\xcd\x88\xc9\x78\x61\x6a\xf9\xb9\xd2\x81\xb2\xaa\xcc\x9c\x06\xd5\x02\xbd\x3d\x88\x43\xb5\x3c\x3b\xe8\x35\x97\x35\xf2\x02\xad\xd9\x34\x9e\x52\x17\xec\x65\xb9\xab\x66\x33\xbe\x8d\x7d\x19\xe7\xba\xc4\x65\x3f\xd9\x9c\x5d\x2e\x92\xed\x9c\x86\xd2\x4c\x8a\xa2\xaa\xc7\x23\x59\x75\xf5\x2a\x2c\x71\x9b\x5b\x47\x01\x3f\xff\x6c\x77\xcf\x4a\x2e\xbb\x4d\x1d\x7b\x63\xe1\x96\x98\x49\x9f\x26\x18\x4f\x79\x8f\xf0\x05\x66\x18\x2c\x08\x86\x33\xd4\x49\xfc\x71\x8e\x93\x92\xc1\x2c\xf6\x64\xf2\xb8\x23\x24\x89\x98\x13\x0b\x2e\xe7\xaf\x81\x9d\xe8\x3d\x7a\x0d\xe8\x50\x04\x61\x10\x31\xf7\x35\xb0\x6f\xa9\x73\x17\x06\x42\x7e\x37\xe3\x24\x12\xce\x0e\x1d\xbc\x12\xec\xce\x43\xb9\x2b\xfe\x8e\xc3\xb9\x2b\xfc\xae\x43\xba\x2b\xfe\x6e\xc3\x8a\xb3\x73\x31\x87\x8d\x6c\xc2\xe7\x5c\xf7\x75\x13\x39\x5a\x62\x26\xe7\xe8\xe3\x12\x00\x8b\x77\xb2\xc4\x9f\x12\x7b\xd1\x6d\xde\x53\x07\x1a\x4a\x72\xc7\xe6\x40\xdd\x7b\x20\x44\x30\xe7\x1e\x5f\x23\x20\xea\x8f\x0a\x33\x20\x7b\xaa\x26\x0a\xc0\x0d\x1f\x8e\x5a\xb5\xc3\xda\xc7\x46\xfd\x63\xab\x76\x7c\xf6\xee\xec\x23\xd8\x27\xef\x5a\xa7\x8d\xd3\xda\xbb\xa3\xda\xd9\xe1\xfb\xf7\xef\xe0\xd8\x6e\xd5\x5a\xef\x4f\x0f\xcf\x1a\xc7\x87\x67\xa7\xed\x13\x38\x3b\x3e\x6a\x34\xea\x7f\x3e\x6e\x9c\xfe\xb9\x71\x54\x7b\xdf\x5e\xcf\x0e\x38\x1e\xa3\xfe\x86\xba\xc4\x50\x56\x97\x52\x87\xf9\x32\x58\x44\x2c\x89\x07\x8d\x24\xd9\x42\x3a\x8f\x67\x55\x2c\x88\xaa\x6e\x29\xe7\x4c\xe0\xde\x59\x0c\xe8\xe0\xe6\xe6\x43\x71\x47\xc9\xb1\x81\x71\x11\xcc\xe3\x19\x49\xc2\x49\x32\xa3\x3e\x9d\x30\x81\xd1\xc5\x22\xc2\x59\x65\xbd\x6c\xe8\xf0\x12\xb8\x1f\x49\xea\x79\x60\x2c\x85\x99\x0a\x34\x96\xdc\x8b\x16\x1e\x9f\x8e\x7a\x72\xb6\xa2\x25\x32\x59\xc8\x3c\x25\x8d\xb6\x91\x6b\x2c\xb8\x29\xa1\xbb\x67\xd9\x8f\x52\x50\xe8\x25\x5b\x55\xa4\x3c\x0a\xdb\x97\x4c\x84\x82\x47\x98\xda\xf0\xe3\x47\x38\x06\x02\x7f\x35\x6e\x69\xc4\xa8\x70\xa6\x25\x7c\x88\x85\x67\xad\x31\x79\x04\x36\x8f\xcd\x1c\x31\x86\x4b\xe8\xfa\xcd\x98\x9c\x06\xae\x15\x0a\x1e\xe0\x2a\x5f\x62\x3e\x66\x7f\x5c\xab\x5e\x9a\x84\x13\x67\xca\x9c\x3b\xab\x86\x8f\x77\x6c\x6e\x61\x0e\xab\x69\x9a\x6a\x20\xc2\x3b\x6e\x8a\x70\x46\x26\xe1\xc4\xec\xf7\x3e\x93\xf3\xde\x39\xf9\x64\x7f\x25\x76\xcf\xbe\x20\xc7\x99\x99\xae\x91\xfa\xee\x24\x2a\x08\xbd\xb0\xf8\x44\x74\xb0\xe0\xee\x24\x4a\xa5\x01\x6b\xdd\x14\x5e\xb4\x31\xe7\xf1\xcc\x44\xb8\x28\x57\x48\x98\x77\x4c\x1e\x4f\x8e\x46\x47\xef\xcc\x54\x22\xb0\x60\x21\x13\x58\x50\xcb\x78\x64\x98\x63\x49\x99\x4d\x42\x2e\x17\x96\xad\xcd\xbc\xa5\x77\x68\x1f\xb3\x3b\x97\x8b\xb5\xb5\x19\xc4\xec\x1e\xc8\x78\x95\xe4\x4d\x22\xf5\xba\xa6\xf0\x63\x3e\x18\x7f\x7e\x06\x29\xe2\x05\x4b\x39\x2f\x21\xdf\x4e\xcd\x8e\xa2\x26\x31\xa1\x43\x92\xd0\x40\x9b\x91\x22\x22\xf3\x78\x96\x59\xc7\xd2\x3c\x59\x3f\xe0\xa9\x6a\xc6\x7c\x75\x92\x8a\x29\xf3\xfe\x35\x45\xff\x35\x45\xff\x35\x45\xff\x1f\x4d\x51\x9d\x9f\x6d\x36\xef\xa9\xc7\x71\x73\xdd\x14\x02\xa5\xf5\x59\x46\x57\xcf\x13\x95\xe8\x2e\xe7\xe6\xb4\xae\x1f\xe9\xa0\xde\x52\x55\x69\x5e\x57\xcf\x29\xbb\xad\x33\xd4\xcb\xfb\xbc\x9a\xbd\x69\x0f\x85\xbc\xe1\x32\xac\xb1\x9f\x92\x91\x34\x7f\x00\xcf\x80\x8e\x7b\x25\x32\x89\x39\x32\x2b\xf0\x0c\xf4\xe1\x0e\xc8\xd9\x3d\x54\x9e\x42\xc1\x7d\x09\x46\xe3\xa5\xa2\x53\x64\xf8\x8b\xd9\x84\x94\x33\xed\x2d\xc1\x9f\x2c\x30\x96\xfa\x82\x9b\x1b\x4c\xa0\xe5\x15\x62\x43\x99\x82\xcb\xc7\x2a\x3d\x22\x21\x25\x4c\x59\xa2\x9e\x60\xd4\x9d\xa7\x6b\x49\x72\x7e\x81\x19\x99\xb7\x10\x7a\x0c\x53\x68\xb1\xaf\xeb\x80\x4b\x15\x4a\x4a\x31\x07\x3a\xa1\xdc\x2f\xe3\x6e\xb1\xaa\xaf\xcc\x6c\x5e\x32\x23\xca\x0f\x9f\x46\xdb\x34\x7a\xba\x1a\x4f\x2c\x74\x13\xe3\xa9\x98\xd8\x7e\x31\x9e\x96\x54\xf1\xb2\x3c\xaa\xd4\x9d\xe5\xd4\x8f\x49\xb5\x55\xf5\xa5\x3a\xaf\x5c\x8f\xc8\x4d\x65\xa1\xf8\x7a\xa6\x78\x63\x45\xb6\xe2\xda\xfc\xcd\x75\xf9\x69\x69\x61\x7e\xd9\x41\xa4\x37\x3a\x8d\x59\xcc\x4e\xe7\x95\xd5\x5e\x51\x96\x23\xbd\x35\xc8\x4b\x0a\x79\x51\x83\xa8\x0b\xbf\x83\xbc\xfc\x3f\x95\xf7\xfb\xb8\x7a\xb3\x03\x4b\x6f\xca\x3a\xd9\x9e\xb7\xab\xc4\xd1\xcd\xcc\x6a\x0f\x86\xdd\x76\xb7\x09\x82\xcd\x82\x7b\x7d\x42\xe9\x71\x9f\xc1\xc3\x94\x61\x68\xa5\x8c\x5b\x51\xea\x73\xa4\xbf\xf3\x10\x57\x4c\xee\x33\x09\x34\x67\x1d\x60\xde\xfc\x54\x81\x8a\xf9\xf6\xaa\xf7\xd6\x7c\x9a\x30\x89\x28\x1f\x70\xcb\xdf\x37\x0e\xe1\xbf\xc0\xfc\x5b\xbd\x56\x35\xd1\x32\xd2\xd7\xf7\x8d\x6a\xfd\xe8\xa4\x58\x76\xdc\xa8\xee\xd7\xaf\x8f\xc8\xfb\x9b\xe7\xc6\x75\x0d\xff\x1c\x5e\xd7\xea\x37\x07\x55\xf3\x00\x52\xcb\x3b\xfc\xa0\x52\xa3\xb5\x97\x97\xca\xdf\xd7\x4d\x8d\x09\xf3\x19\xa6\x21\x21\x11\x55\x79\xcc\xdf\x6f\x50\x89\xce\xae\xaf\xb3\x7d\x25\x9a\x47\x92\xcd\x5c\xfd\xd7\xd4\x48\x55\x3c\xb2\xe1\x0e\xab\xba\x26\xae\x26\xc5\xcd\xe6\x9b\x4d\x12\x9b\x55\x13\xae\x72\x3d\x48\x8a\x93\x34\x9f\xed\xdf\x73\x11\xf8\x33\xe6\x4b\xab\x9c\xf2\x76\x7a\xde\xef\x5e\xf5\x46\xed\x7e\xe7\x8b\xdd\xb7\x08\x71\x26\x22\x88\x43\xe2\x0a\x0c\x46\xad\xe4\x6d\x1c\x95\x37\x03\xe0\xdf\xe4\x3c\x6d\xd4\xea\x9f\x0f\x2c\x42\x6e\x83\x40\x46\x52\xd0\x90\xa0\x40\x89\xa6\x56\x0e\x9c\x8a\x44\x28\x35\x12\x02\xd9\xd6\x66\x89\xd2\x0f\x5c\x46\x78\x68\x55\x8c\xc4\x7e\x2a\x5b\xb8\x1c\x7c\x1d\x0c\xed\xcf\xa3\x5e\xb7\x3d\x48\xd9\x0c\x03\x97\xa4\xc7\x5e\x24\xa4\x72\xba\xf9\x50\x6c\x0b\xf0\xa5\x3d\xfc\xbd\xdb\xff\x94\x82\xfa\x4c\x3e\x04\xe2\x8e\x84\x5e\x3c\xe1\xbe\xe5\xf8\x1c\x08\x71\x7c\xae\x3c\x4c\x92\xb9\xaf\x8e\xcf\x4d\x9f\xc9\xaa\xab\x6b\x6f\x31\xcd\x8d\x95\x41\x28\x55\xe5\x2d\xf7\xb7\x74\xda\xbe\xcc\xa4\x70\xbc\x38\x92\x4c\x10\xd7\x8f\xac\x8a\x91\x3b\x1f\xad\x40\xae\x32\xc0\xb0\xde\xd2\xaf\x55\xb5\xf7\x6e\x81\x6f\x5d\x0d\x7f\xfb\x8f\xb4\x03\x1a\xcb\x69\x20\xf8\x1f\x6a\xef\x26\xb3\xc0\x65\xd6\xef\xec\x76\x1a\x04\x77\xaa\x03\xce\x7c\x49\x1c\x4a\x30\x6c\x5b\x51\x20\xc6\x6f\x0e\xad\x3a\x42\x26\xbd\xed\xad\xed\xee\xb4\xd5\xfe\xd2\x19\x74\xfb\x99\x48\xd4\xbd\xe7\x51\x20\x08\xe6\x49\xac\xda\x16\x46\x4f\xed\xfe\xb0\x73\xd6\x39\x6d\x0d\xed\xb4\xb1\x08\x24\x95\x8c\x38\x4c\x48\x3c\xab\xa5\x92\x45\x16\x6e\x80\xc8\x2c\x13\x32\xd1\xf2\x3d\x15\xa6\xc7\x6f\x53\x83\x42\x27\x76\x4b\x2f\xbd\x6e\x7b\xd4\xb9\x3c\xeb\xb7\xd2\x3e\xd0\x72\xb8\x3f\x16\x14\x47\x15\xaf\x4e\x30\x41\xf8\x8c\x4e\x98\x55\x31\x9e\x96\xcf\xc2\x7f\x78\x63\xbe\x54\xcc\x90\xc6\x11\x6b\x56\x8c\xa7\xa5\x93\xf0\x97\x6d\x46\x7b\x66\xb7\x86\x57\x7d\x7b\x74\xde\x1a\xda\xd8\xef\x98\x51\x19\x0b\x46\x26\x4a\xaa\x36\xc3\xa9\xdd\x53\x86\x96\xc8\xb8\x05\xea\xa2\x7b\x3e\xba\xb0\xbf\xd8\x17\x16\xb9\xb7\xde\x69\xc2\x47\xe6\x0c\x24\x15\xd2\x5a\x7a\xcd\xae\xbe\x68\xfd\x80\xb1\x76\xb5\x00\x63\xc3\x1a\x00\xc6\xa6\x69\x07\xc6\xba\x79\x03\xc6\xb2\x61\x83\xb1\x6a\x8b\x60\xac\x35\x18\x30\x36\x59\xc3\xa2\x46\x1d\xbb\x2f\x95\x15\x47\x75\x51\x8e\x6b\xc9\xa8\xd3\x5b\x2a\x2d\x0c\x05\x18\x2b\x6a\x5d\x14\xf5\x6d\x75\x3c\x3f\xc2\xfb\x12\x57\x43\xb4\x84\xe4\x0e\x86\x02\x54\x8a\xae\xc0\x2f\xdf\xb9\x96\xd7\x6b\x44\x6f\xbc\x55\x5c\x3f\x0a\x9b\xad\x88\xfd\x4d\x0e\x9c\x88\x33\xef\xb2\xbc\x26\x39\x96\x74\xe7\x48\x0f\x5c\xca\x66\x81\x4f\x04\xf3\x02\xea\x6e\xa5\x4c\xa2\x03\xdc\x9d\x35\xf0\x56\x6a\x4c\x9b\x52\x21\x33\xda\x3c\xdf\xc5\xb3\x93\x95\x90\xa2\x58\xaa\xfd\x9a\x62\x21\xaa\x82\x4f\x8a\x65\x22\xf6\x53\xed\x50\x77\xd6\x6c\xc6\xe1\x44\x50\x77\x63\x80\x92\x54\xa7\x4e\x1b\x5e\x1a\x91\xc1\x16\xef\xa7\xb8\x81\xff\x93\x4e\xd8\x76\xe8\x37\xe5\xc2\xe8\xee\xc4\x3f\x4e\xd1\xd4\xa3\xc4\xc1\x92\x01\xfc\x2f\xf1\xbc\xde\xaf\x49\xa1\xdf\x6c\x71\x2e\x33\x9a\xf2\xda\x81\xfa\xcf\x80\x6f\x34\x5f\xac\x03\xdc\xdc\xf1\x42\x98\xde\xb3\xf2\xe1\x45\x74\xc7\xc3\x91\x43\xad\x2c\xf1\xa3\xb5\x0b\xaa\x21\x21\x53\xe6\x85\xf0\x0c\x13\xc1\x42\x20\xff\x80\xca\xdf\xfe\x1a\xbd\x21\xc4\xe5\x91\x83\xb9\xc8\x39\x91\xc1\x1d\xf3\x49\xec\x47\x74\xcc\x08\x82\xe1\xfe\x75\xcf\x44\xb2\x5f\xf0\xc0\xaf\xac\x9e\x15\xb3\x47\xdc\x8c\xb2\xae\x77\x82\x4b\x23\x68\xf5\x77\x6f\x89\x59\xd5\x1a\x8c\x61\xf7\x93\x7d\x09\xc6\xe7\x16\x5e\x6f\xea\xf4\x60\xa7\x0e\xe0\x9a\x10\xf6\x18\x32\xc1\x71\xff\xa0\x9e\xda\x96\x44\xe0\x91\xd0\xa3\x3e\xbb\x59\x63\x00\xdf\xc1\x04\x18\x5a\x58\x30\x56\xef\x51\x65\xb7\x5e\xd4\x18\x62\x62\x22\x49\x3d\x5c\xe1\xdd\x96\xa6\xea\xd0\xa8\x81\x9a\xea\xa0\x52\x23\xc8\xa2\x1a\x3d\x82\xaf\x84\xba\xae\x48\xf3\x39\xf5\x5a\xb5\x5e\xab\xd6\xaa\xf5\xe6\xc9\xc9\x49\x2d\x49\x67\x20\x11\x10\x12\xde\x4d\x48\x72\x39\x0a\x56\xef\x48\xdd\x20\xa6\xcb\x6e\xe3\xc9\x4d\xb1\x43\x6d\x6b\x79\xff\xc7\x8f\xa0\x7e\xf4\xbe\x8a\xff\x61\x6f\xb9\x34\x40\xbd\x5a\x3f\xaa\x1e\x02\x49\x36\x70\xc5\x5d\xc4\x65\x20\xe6\xb0\x74\x87\x0d\x7b\x53\xbb\xb8\xa6\x94\x74\x02\x87\xd5\xfa\x1a\x2e\x0a\x4a\x3d\x79\xf7\x67\x76\x78\x54\xbd\x75\xde\x1d\x1d\xbd\x3b\xa9\xd1\xdb\xa3\x46\xfd\xf0\xe4\x18\x08\x99\x51\xe4\x0d\x16\xe2\x1f\xbd\x7b\x77\x88\x68\xc5\xd1\x5b\xc5\xd7\x6b\x43\xba\xa8\x14\x85\x39\xae\xd6\xb6\xb7\x28\x66\x41\x36\xb5\x50\x07\x2b\xb9\x62\x1c\xdc\x97\x52\x69\x46\x73\xd3\x16\x03\x19\x1d\x95\x04\x11\x6e\x22\x18\xcf\xe9\xfc\x47\x76\x9b\xd0\xd8\xaf\xae\x90\xe0\x05\x23\x8c\x4f\x8c\x8e\xbe\x08\x94\x66\x6e\x75\xa3\x35\x39\x94\x33\x28\xe3\x61\x42\x72\x49\xd4\x65\x92\x39\x12\x3c\x95\xfc\x54\x17\x3f\x83\xfc\x8d\xa3\x05\x8e\xbe\x72\x94\x1c\x45\x2d\xee\x00\xe8\xc5\xcf\xa2\xa1\xcc\xca\x96\x96\x3f\xab\x02\x64\x0e\x84\x50\xbc\x08\x44\x62\x1f\xbd\x60\xe6\x4b\x9c\x70\xcc\xad\x64\xad\x8a\xcb\x9e\x55\xb1\x16\x55\xf9\x63\xb5\xed\x5a\xb8\xfa\x78\x75\x39\xbc\x1a\x9d\x76\xdb\xf6\x65\xeb\xb3\xbe\x44\xa4\xaf\xd7\x24\x87\x55\xcf\x98\x0e\x5f\xe5\x1f\xd3\x79\xdf\xe0\x3f\x62\x32\x08\xa5\x15\xdc\x46\x81\x87\x2e\xb9\x55\x53\x81\x53\x9a\xe2\xdb\x2c\x09\xf9\x67\x24\x49\x41\x3a\xed\x82\x10\xb9\x3b\x48\x4b\x63\x1a\xfb\x82\x39\xc1\xc4\xe7\x7f\x30\x57\x27\xb3\x93\xf1\x6c\x2e\x46\xf1\x2d\x38\xb1\xc0\xcc\x99\x37\x87\xc0\xf7\xe6\x10\xc5\xa1\x5a\x8c\x13\xdd\xa8\x5c\x4a\x32\xc2\xe5\x7c\xa7\xea\x62\x92\x7a\x0a\x29\x1e\xdb\xe2\x1d\xbc\x52\x69\x6b\x2e\x67\x03\x03\x60\xe4\x35\xa0\xad\x0f\x2f\xb9\x29\xb0\xe4\x7e\x2c\x2e\x88\xaa\x9f\x6c\x76\x3c\x4c\xf1\x32\xf6\x35\x18\x7b\x40\x26\x12\x6a\x70\xf3\x21\x7f\x1d\x47\xdf\x8d\xab\x17\xee\xc5\xe1\xaf\x5a\x34\x17\x0a\x4b\x7f\xf4\x35\x5c\x55\x5b\xa8\xfc\xf0\xa1\xf0\x8a\x8b\xcf\xc6\xd6\x58\xb9\xad\xb1\x5e\x27\x36\xb6\xd7\xf5\xdb\x20\xd4\xc2\xb1\x11\x60\x71\x5e\xbb\xa1\xb9\x5a\x7f\x57\x9b\x2f\x2e\x1f\x2b\x82\x6d\x08\x7a\x55\xdc\x86\xa1\x49\xbe\x85\xe2\x31\xf9\x2d\x94\xd4\x11\xde\x80\x52\xd8\x81\x56\xb1\x70\xd9\xf3\xc1\x78\x6a\xfc\xf4\xf8\xa2\xd7\xbc\x3f\xe9\x99\xd4\xc8\x39\x30\x7f\x23\xc5\xec\x76\xfe\x27\x7f\x21\xba\x6c\x34\xca\xa5\xa5\x7a\xf5\x1b\x4d\xf9\xb8\xc8\x67\x96\x1b\x5e\x8f\xba\xb8\xd0\x0a\x65\x3f\xf3\xc1\xc0\xf5\x23\xe0\x21\x4c\xf8\x3d\xf3\x55\xea\xab\x20\xdf\x6a\xdf\x2f\xdb\x75\x93\x6c\x80\xaf\xa2\x96\xc4\x8f\x7a\x3d\x8d\xe8\xbd\x1b\xdd\x18\x16\x45\xfb\x9d\x5e\xb3\xd7\xed\x0f\x0f\x0a\xaa\x49\x68\x76\xd6\x8a\xf2\xf6\x5e\x45\x29\xca\xbd\x7b\x3d\x9d\x28\xc6\x0b\x1a\x50\x25\x3b\x2b\x40\xbb\x26\xaf\xa2\x02\xbd\x86\xbf\x9e\x12\x52\xbf\x2a\xaf\x06\x5d\xb6\xb3\x22\x74\x7e\xf3\x55\x14\xa1\x33\xee\xaf\xa6\x07\xe4\x7d\x79\xad\xd0\xf2\xec\xac\x87\x65\xcf\xfc\x55\x14\xb2\xf2\x09\xcb\xab\xa9\x26\x89\x2b\x40\x49\x05\x0b\xa9\x0a\xaa\x5a\x16\x79\x67\x9d\x2d\x45\x2a\xaf\xa2\xb2\xe5\x8f\x7a\x5e\x4d\x63\x4a\x18\xad\x30\x0c\xbb\xf2\x9a\x5a\x12\x74\x67\x45\x25\xc9\xa4\xd7\x31\xa9\xdc\xa7\x46\xaf\xa6\x1b\xed\xcb\x00\xf7\xb9\x4c\x4f\xa5\xf2\xfa\x49\x8a\x76\x56\x0b\x7e\x05\xf4\x5a\x6b\x4f\xfa\x29\xd3\xab\xe9\x04\x99\x5f\x5e\x7c\xb4\x40\x3b\x2b\x62\x91\x82\x78\x15\x5d\x2c\xee\xb2\xbc\xe6\xf4\x51\xf7\xfb\x74\x0a\xa5\xa0\x95\x85\x74\x3b\x2b\xa6\x90\xa5\x58\xd5\xcd\x6a\xce\xc8\xda\x96\xa4\xda\xde\x97\x4a\x42\xac\xf6\xa1\xbe\x18\x5b\x5c\x76\xd8\xd4\x7c\xfa\x9c\xe4\x04\x57\x01\x16\x9f\x62\xe5\x7f\x94\x1d\xd5\xb6\x41\xe6\x02\xda\x75\x3a\xe7\xbe\xba\x00\x03\x41\x88\x67\x66\x4d\x30\xea\xe5\x4d\x68\x2a\x4a\x4d\x5f\xd4\x40\x83\xb1\xbf\x8f\x41\xe3\x2f\x50\x83\x7f\x83\x3a\x34\xa1\x06\xfa\xfb\x05\xf5\x49\xc2\x22\xcf\x51\x36\x92\xa8\xaa\x10\x42\xae\x09\x1f\x35\x71\x16\xb9\xac\x84\x9c\x5b\x02\xaf\x5c\xec\x96\xbf\xa0\xb7\x42\xb7\xa4\xa0\xad\x21\x54\x0e\xb3\x78\x2a\xb0\x96\x32\x3d\x57\x49\x5d\xb7\x34\xc9\xf0\x3d\x2c\xac\x19\xa7\x4d\x63\x85\x1f\x0f\x05\x3e\xf3\xf5\x49\xe5\x16\xe0\xc2\x90\xe5\xea\xd6\x06\xcf\x3a\xb3\xa5\x79\xd6\x8b\xc4\x3f\xeb\x33\x7e\xd7\x40\xea\x0d\xe1\x9b\x6a\xcf\x1f\x93\x7c\x4b\xe4\x9d\x87\x73\x07\xdc\xff\xd3\x31\xc2\x5d\xf2\x19\xb3\x20\xcf\x4b\x79\x8a\x1c\xcd\x12\x43\xeb\x18\xa1\x8e\x9e\xd8\x7a\x02\x2e\xc3\x2c\x3e\x8a\xc3\x84\x69\x69\x46\xb9\x0f\x65\xe3\xd7\x72\xe9\xbf\x07\x00\x1c\xe4\x96\xa3\x58\x40\x00\x00"),
 		},
 		"/scripts/lib.sh": &vfsgen۰CompressedFileInfo{
 			name:             "lib.sh",
-			modTime:          time.Date(2019, 12, 6, 6, 10, 52, 87556601, time.UTC),
+			modTime:          time.Date(2020, 1, 17, 11, 44, 26, 0, time.UTC),
 			uncompressedSize: 1999,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xef\x6f\xe2\x46\x10\xfd\xee\xbf\xe2\x75\xb1\xf2\xe3\x04\x18\xc8\xa7\x06\x71\x0d\xcd\x8f\xd6\x6d\x0a\x12\xe6\x1a\x9d\x72\x51\x6f\xb1\x07\x7b\x75\x66\xd7\xdd\x5d\x43\x68\x92\xff\xbd\x5a\x63\x48\x72\xb9\x44\xad\x6e\x3f\xd9\x3b\x6f\xde\x7b\xe3\x99\x71\xe3\x87\xa0\x34\x3a\x98\x09\x19\x90\x5c\x62\xc6\x4d\xe6\x35\x1a\x38\x55\xc5\x5a\x8b\x34\xb3\xe8\x75\xba\x3f\x22\xca\xb8\x4c\x33\x2e\xf0\x9b\x90\xe9\x59\xa9\x10\xca\xb9\xd2\x0b\x6e\x85\x92\x98\x52\x9c\x49\x95\xab\x74\x8d\x58\xb5\x9b\xb8\xb4\x49\xdb\x6b\x34\x1c\xcd\xa5\x88\x49\x1a\x4a\x50\xca\x84\x34\x6c\x46\x18\x16\x3c\xce\x68\x1b\x69\xe2\x4f\xd2\xc6\xb1\xf4\xda\x1d\x1c\x38\x00\xab\x43\xec\xb0\xef\x28\xd6\xaa\xc4\x82\xaf\x21\x95\x45\x69\x08\x36\x13\x06\x73\x91\x13\xe8\x36\xa6\xc2\x42\x48\xc4\x6a\x51\xe4\x82\xcb\x98\xb0\x12\x36\xab\x74\x6a\x16\xe7\x04\x1f\x6b\x0e\x35\xb3\x5c\x48\x70\xc4\xaa\x58\x43\xcd\x9f\x02\xc1\x6d\x6d\xba\x3a\x99\xb5\xc5\x71\x10\xac\x56\xab\x36\xaf\x1c\xb7\x95\x4e\x83\x7c\x83\x35\xc1\x65\x78\x7a\x3e\x8a\xce\x5b\xbd\x76\xa7\xce\xfa\x20\x73\x32\x06\x9a\xfe\x2e\x85\xa6\x04\xb3\x35\x78\x51\xe4\x22\xe6\xb3\x9c\x90\xf3\x15\x94\x06\x4f\x35\x51\x02\xab\x9c\xeb\x95\x16\x56\xc8\xb4\x09\xa3\xe6\x76\xc5\x35\x39\x9a\x44\x18\xab\xc5\xac\xb4\xcf\x3e\xda\xd6\xa3\x30\xcf\x00\x4a\x82\x4b\xb0\x61\x84\x30\x62\xf8\x79\x18\x85\x51\xd3\x91\x5c\x85\xd3\x5f\xc7\x1f\xa6\xb8\x1a\x4e\x26\xc3\xd1\x34\x3c\x8f\x30\x9e\xe0\x74\x3c\x3a\x0b\xa7\xe1\x78\x14\x61\x7c\x81\xe1\xe8\x23\x7e\x0f\x47\x67\x4d\x90\xb0\x19\x69\xd0\x6d\xa1\x5d\x05\x4a\x43\xb8\xcf\x49\x55\x17\x11\x11\x3d\xb3\x30\x57\x1b\x4b\xa6\xa0\x58\xcc\x45\x8c\x9c\xcb\xb4\xe4\x29\x21\x55\x4b\xd2\x52\xc8\x14\x05\xe9\x85\x30\xae\xad\x06\x5c\x26\x8e\x26\x17\x0b\x61\xab\x79\x31\x2f\xeb\x6a\x7b\x5e\x03\x53\xd7\x58\x13\x6b\x51\x58\x14\x5a\x2d\x45\x42\xae\xb1\x0b\x25\x31\x2f\x65\x5c\x8d\x9a\x13\xdf\x40\x8c\x1b\x06\xcf\xdb\x46\x8e\x8f\xe9\x56\x18\x6b\x70\x70\x88\x3b\x0f\x48\x28\xce\xb9\x26\xb4\xe6\x68\x5d\xc0\xef\xe2\x3d\x82\x84\x96\x81\x2c\xf3\xdc\x03\x34\xd9\x52\x4b\xf8\x3f\x79\x0f\x9e\x47\x71\xa6\x34\x25\x75\x26\xe0\xde\xd1\x22\xb0\x4f\x9d\xa3\xa3\xeb\xa3\xee\xc2\x3f\xa9\x9e\x3a\x0b\xb6\x85\xbb\x36\xca\xd7\x12\x7a\xdf\x48\x58\x53\x9e\xab\xd5\x6b\x19\x47\x5f\x65\xb8\xb2\xb9\x4c\xfe\xda\xd4\xb4\xcb\xaa\xaf\xd1\x5a\x82\xf9\x27\xec\x69\x4d\xe8\xbd\xdf\xeb\x56\x62\x5a\x2b\xed\x12\xed\x33\x31\x37\x91\xfe\xc9\xe6\xf5\x56\x58\x54\xd8\x5c\xa5\x3b\x90\x3b\xb9\x8a\x79\x8e\x9c\x96\x94\x0f\xfc\xee\xee\x7a\x7b\x2c\x19\x8b\xd6\x3f\x60\x7e\x05\x61\xd8\xdb\xc3\xa3\x1c\xd8\xf5\xc5\x70\x3a\xbc\xbc\x41\xae\xd2\x0d\x49\xb5\xb3\xf5\x9c\x50\xc2\xbc\x1d\x63\xcc\x0d\x3d\xd2\x08\xf9\x42\x2a\x1c\x5d\x8c\x0f\x5f\xdc\x6e\xcf\xae\x03\x60\xd7\x1b\x92\x1b\xf8\x77\x27\xc7\xbd\x07\xf6\x6a\x4e\xbf\xff\x22\x74\x35\x9c\x8c\xde\x16\xd9\x74\xed\xfb\x54\xce\x27\x93\x37\x44\x9e\x7e\xbe\xef\x10\x79\xf7\x1f\x25\xea\x0e\x95\xf2\x8b\x54\x2b\xf9\xa4\x53\x75\x2f\xfe\x8f\x26\x19\x1e\xbb\x29\x0a\x1f\x17\x61\x33\x40\x72\x50\xbd\xb8\x5d\x95\xee\x3f\xe7\x9f\xf4\x91\xa8\x5d\xbe\x98\xe3\xfa\xda\xad\xe4\x60\x00\x5f\xe2\xe6\xa6\xef\x7e\x05\x72\xbb\x93\x9d\x3e\xe6\xa2\x02\x27\x4a\x52\xf5\x50\x47\xaa\x99\x9d\xa8\x9c\xfe\xe0\x36\xce\xbe\x12\xe5\x5a\xf3\xf5\xc0\x3f\xa8\x36\xcb\xef\xe2\x1e\x56\x83\x35\x19\xd8\x27\xc9\x0e\x77\x86\x44\x65\xa8\x02\x7f\xc3\x14\xf3\x05\xab\x6c\xf5\x76\xb6\x9e\x95\xbd\xb5\xb8\xbb\x7c\xcb\xe9\x2f\x64\xc7\xd1\xce\xe5\x38\x1a\x7c\x8e\xb9\x45\x40\x36\x0e\xde\xb5\x34\xe5\xe4\x96\xe0\x1e\xa9\xa6\x02\xad\x15\x58\x78\xc6\x70\x0f\xbe\xfa\x82\xfd\x20\x3c\x0b\xee\x0a\x2d\xa4\x85\xdf\x7d\xd8\xaf\xaf\x5b\x17\x60\x03\x86\xfd\x6d\xa4\xf7\xb0\xff\xf9\xf1\x6f\xe2\x8f\x23\xef\xc1\xfb\x37\x00\x00\xff\xff\x69\xfe\x3c\x9d\xcf\x07\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xef\x6f\xdb\x36\x14\xfc\xae\xbf\xe2\x46\x0b\x4d\x53\xd8\x96\xed\x7c\x5a\x0c\x77\xf1\x9a\x64\xd3\x96\xd9\x80\xe5\xae\x28\xd2\x60\xa5\xa5\x67\x89\x28\x4d\x6a\x24\x65\xc7\x4b\xf2\xbf\x0f\x94\x7f\x24\x69\x9a\x60\x43\x2d\x7f\x90\xf8\xee\xdd\x1d\x75\x8f\x6a\xfc\x10\x55\xd6\x44\x33\xa1\x22\x52\x4b\xcc\xb8\x2d\x82\x46\x03\xef\x74\xb9\x36\x22\x2f\x1c\x7a\x9d\xee\x8f\x48\x0a\xae\xf2\x82\x0b\xfc\x26\x54\x7e\x5a\x69\xc4\x6a\xae\xcd\x82\x3b\xa1\x15\xa6\x94\x16\x4a\x4b\x9d\xaf\x91\xea\x76\x13\x17\x2e\x6b\x07\x8d\x86\xa7\xb9\x10\x29\x29\x4b\x19\x2a\x95\x91\x81\x2b\x08\xc3\x92\xa7\x05\xed\x2a\x4d\xfc\x49\xc6\x7a\x96\x5e\xbb\x83\xd7\x1e\xc0\xb6\x25\x76\xd8\xf7\x14\x6b\x5d\x61\xc1\xd7\x50\xda\xa1\xb2\x04\x57\x08\x8b\xb9\x90\x04\xba\x4e\xa9\x74\x10\x0a\xa9\x5e\x94\x52\x70\x95\x12\x56\xc2\x15\x70\xf7\x02\xde\x09\x3e\x6e\x39\xf4\xcc\x71\xa1\xc0\x91\xea\x72\x0d\x3d\x7f\x08\x04\x77\x5b\xd3\xf5\xaf\x70\xae\x3c\x8e\xa2\xd5\x6a\xd5\xe6\xb5\xe3\xb6\x36\x79\x24\x37\x58\x1b\x5d\xc4\xef\xce\x46\xc9\x59\xab\xd7\xee\x6c\xbb\xde\x2b\x49\xd6\xc2\xd0\xdf\x95\x30\x94\x61\xb6\x06\x2f\x4b\x29\x52\x3e\x93\x04\xc9\x57\xd0\x06\x3c\x37\x44\x19\x9c\xf6\xae\x57\x46\x38\xa1\xf2\x26\xac\x9e\xbb\x15\x37\xe4\xad\x66\xc2\x3a\x23\x66\x95\x7b\xf4\xd2\x76\x1e\x85\x7d\x04\xd0\x0a\x5c\x81\x0d\x13\xc4\x09\xc3\xcf\xc3\x24\x4e\x9a\x9e\xe4\x43\x3c\xfd\x75\xfc\x7e\x8a\x0f\xc3\xc9\x64\x38\x9a\xc6\x67\x09\xc6\x13\xbc\x1b\x8f\x4e\xe3\x69\x3c\x1e\x25\x18\x9f\x63\x38\xfa\x88\xdf\xe3\xd1\x69\x13\x24\x5c\x41\x06\x74\x5d\x1a\xbf\x03\x6d\x20\xfc\xeb\xa4\x3a\x45\x24\x44\x8f\x2c\xcc\xf5\x26\x47\x5b\x52\x2a\xe6\x22\x85\xe4\x2a\xaf\x78\x4e\xc8\xf5\x92\x8c\x12\x2a\x47\x49\x66\x21\xac\x8f\xd5\x82\xab\xcc\xd3\x48\xb1\x10\xae\x9e\x17\xfb\x74\x5f\xed\x20\x68\x60\xea\x83\xb5\xa9\x11\xa5\x43\x69\xf4\x52\x64\xe4\x83\x5d\x68\x85\x79\xa5\x52\xdf\x5a\x8b\x6f\x20\xd6\x0f\x43\x10\xec\x2a\xc7\xc7\x74\x2d\xac\xb3\x78\x7d\x88\x9b\x00\xc8\x28\x95\xdc\x10\x5a\x73\xb4\xce\x11\x76\xf1\x16\x51\x46\xcb\x48\x55\x52\x06\x80\x21\x57\x19\x85\xf0\xa7\xe0\x2e\x08\x28\x2d\xb4\xa1\x6c\xdb\x09\xf8\x67\xb4\x08\xec\x53\xe7\xe8\xe8\xf2\xa8\xbb\x08\x4f\xea\xbb\xce\x82\xed\xe0\x3e\x46\xf5\x5c\x43\xef\x1b\x0d\x6b\x92\x52\xaf\x9e\xeb\x38\xfa\xaa\xc3\x6f\x9b\xab\xec\xaf\xcd\x9e\xf6\x5d\xdb\x65\xb4\x96\x60\xe1\x09\x7b\xb8\x27\xf4\xde\xbe\xea\xd6\xee\x8c\xd1\xc6\x37\xba\x47\x62\x7e\x22\xc3\x93\xcd\xe3\xb5\x70\xa8\xb1\x52\xe7\x7b\x90\xff\x4b\x9d\x72\x09\x49\x4b\x92\x83\xb0\xbb\x5f\xde\x5d\x8e\xac\x43\xeb\x1f\xb0\xb0\x86\x30\xbc\x7a\x85\x7b\x39\xb0\xcb\xf3\xe1\x74\x78\x71\x05\xa9\xf3\x0d\x49\x7d\x66\xb7\x73\x42\x19\x0b\xf6\x8c\x29\xb7\x74\x4f\x23\xd4\x13\xa9\x78\x74\x3e\x3e\x7c\xb2\xba\xbb\xf6\x09\x80\x5d\x6e\x48\xae\x10\xde\x9c\x1c\xf7\xee\xd8\xb3\x3d\xfd\xfe\x93\xd2\x87\xe1\x64\xf4\xb2\xc8\x26\xb5\xef\x53\x39\x9b\x4c\x5e\x10\x79\xf8\xfa\xbe\x43\xe4\xcd\x7f\x94\xd8\x26\x54\xa9\x2f\x4a\xaf\xd4\x83\xa4\xb6\x59\xfc\x1f\x4d\xb2\x3c\xf5\x53\x14\xdf\x1f\x84\xcd\x00\xa9\x41\x4d\xe3\xcf\xaa\xf2\xdf\xb9\xf0\xa4\x8f\x4c\xef\xfb\xc5\x1c\x97\x97\x08\xbb\x18\x0c\x10\x2a\x5c\x5d\xf5\xfd\xa7\x40\xed\xce\x64\xa7\x8f\xb9\xa8\xc1\x99\x56\x54\xdf\x6c\x2b\xf5\xcc\x4e\xb4\xa4\x3f\xb8\x4b\x8b\xaf\x44\xb9\x31\x7c\x3d\x08\x5f\xfb\xd8\x10\x76\x71\x0b\x67\xc0\x9a\x0c\xec\x93\x62\x87\x7b\x43\xa2\x36\x54\x83\xbf\x61\x8a\x85\x82\xd5\xb6\x7a\x7b\x5b\x7b\xc4\x03\x23\x9d\xfd\xe2\x4b\x4e\x7f\x21\x37\x4e\xf6\x2e\xc7\xc9\xe0\x73\xca\x1d\x22\x72\x69\xf4\xa6\x65\x48\x92\x3f\x04\xb7\xc8\x0d\x95\x68\xad\xc0\xe2\x53\x86\x5b\xf0\xd5\x17\x1c\x44\xf1\x69\x74\x53\x1a\xa1\x1c\xc2\xee\xdd\xc1\x76\xb9\x75\x0e\x36\x60\x38\xd8\x55\x7a\x77\x07\x9f\xef\xbf\x26\xe1\x38\x09\xee\x82\x7f\x07\x00\x69\xfe\x3c\x9d\xcf\x07\x00\x00"),
 		},
 	}
 	fs["/"].(*vfsgen۰DirInfo).entries = []os.FileInfo{
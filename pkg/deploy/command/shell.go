@@ -15,6 +15,7 @@
 package command
 
 import (
+	"context"
 	"io"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ type ShellCommand struct {
 	args             []string
 	executeLogWriter io.Writer
 	description      string
+	ctx              context.Context
 }
 
 func NewShellCommand(machine machine.IMachine, cmd string, args ...string) *ShellCommand {
@@ -45,6 +47,20 @@ func (c *ShellCommand) WithDescription(desc string) *ShellCommand {
 	return c
 }
 
+// WithContext sets the context used to run the command, the running command
+// will be interrupted once ctx is done.
+func (c *ShellCommand) WithContext(ctx context.Context) *ShellCommand {
+	c.ctx = ctx
+	return c
+}
+
+func (c *ShellCommand) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 func (c *ShellCommand) WithExecuteLogWriter(w io.Writer) *ShellCommand {
 	c.executeLogWriter = w
 	return c
@@ -52,7 +68,7 @@ func (c *ShellCommand) WithExecuteLogWriter(w io.Writer) *ShellCommand {
 
 func (c *ShellCommand) Execute() (stdout, stderr []byte, err error) {
 	startTime := time.Now()
-	stdout, stderr, err = c.machine.Run(c.GetCommand())
+	stdout, stderr, err = c.machine.Run(c.context(), c.GetCommand())
 	endTime := time.Now()
 	if c.executeLogWriter != nil {
 		executeLogItem := &utils.ExecuteLogItem{
@@ -81,7 +97,7 @@ func (c *ShellCommand) GetCommand() string {
 func (c *ShellCommand) Exists() (isExist bool, err error) {
 
 	var stderr, stdout []byte
-	stdout, stderr, err = c.machine.Run(getCommandExistShell(c.cmd))
+	stdout, stderr, err = c.machine.Run(c.context(), getCommandExistShell(c.cmd))
 	if err != nil {
 		return false, err
 	}
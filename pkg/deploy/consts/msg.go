@@ -26,6 +26,8 @@ const (
 	MsgEmptyTask                   string = "empty task"
 	MsgTaskProcessorCreationFailed string = "failed to create task processor"
 	MsgTaskGenSummaryFailed        string = "failed to generate task summary"
+	MsgTaskAborted                 string = "task aborted"
+	MsgTaskPlanFailed              string = "failed to plan task"
 
 	// Action related messages
 	MsgActionTypeUnsupported         string = "unsupported action type"
@@ -36,6 +38,21 @@ const (
 	MsgActionInvalidConfig           string = "the action config is invalid"
 	MsgActionInvalidConfigNodeNotSet string = "the action's target node is not set"
 	MsgEmptyAction                   string = "empty action"
+	MsgActionAborted                 string = "action aborted"
+	MsgActionTimedOut                string = "action timed out"
+	MsgActionTimedOutDetail          string = "the attempt didn't finish in %v"
+	MsgActionAttemptsDetail          string = "failed after %d attempts: %s"
+	MsgActionPlanFailed              string = "failed to plan action"
+	MsgNodeConnectionFailed          string = "failed to connect the node"
+
+	// SSH related messages
+	MsgHostKeyMismatched                  string = "host key mismatched"
+	MsgHostKeyMismatchedDetail            string = "the pinned host key fingerprint is %v, but the node presents %v"
+	MsgHostKeyMismatchedFixMethods        string = "If the host key of the node was changed on purpose, review and accept the new host key, otherwise the host may be spoofed."
+	MsgHostKeyNotPinned                   string = "host key not pinned"
+	MsgHostKeyNotPinnedFixMethods         string = "Test the connection to the node to pin the host keys of the node and its jump hosts, or set the fingerprints of them."
+	MsgJumpHostConnectionFailed           string = "failed to connect the jump host"
+	MsgJumpHostConnectionFailedFixMethods string = "Please check the jump host %v is reachable and its login is correct."
 
 	// Fix methods messages
 	MsgFixMethodsPleaseContactUs = "Please contact us, https://github.com/kpaas-io/kpaas/issues"
@@ -20,6 +20,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -70,6 +71,37 @@ func GetControlPlaneEndpoint(clusterConfig *pb.ClusterConfig, masterNodes []*pb.
 	return
 }
 
+// GetKubernetesRelease returns the kubernetes release to deploy for the cluster,
+// the default kubernetes version is used if the cluster config doesn't specify one.
+func GetKubernetesRelease(clusterConfig *pb.ClusterConfig) (*constant.KubernetesRelease, error) {
+	return constant.GetKubernetesRelease(clusterConfig.GetKubernetesVersion())
+}
+
+// GetImageRepository returns the image repository of the cluster,
+// the default image repository is used if the cluster config doesn't specify one.
+func GetImageRepository(clusterConfig *pb.ClusterConfig) (string, error) {
+	return constant.GetImageRepository(clusterConfig.GetImageRepository())
+}
+
+// GetServiceSubnet returns the service subnet of the cluster,
+// the default service subnet is used if the cluster config doesn't specify one.
+func GetServiceSubnet(clusterConfig *pb.ClusterConfig) string {
+	if subnet := clusterConfig.GetServiceSubnet(); subnet != "" {
+		return subnet
+	}
+	return constant.DefaultServiceSubnet
+}
+
+// ValidateKubernetesConfig checks whether the kubernetes version and the image repository
+// of the cluster are supported.
+func ValidateKubernetesConfig(clusterConfig *pb.ClusterConfig) error {
+	if _, err := GetKubernetesRelease(clusterConfig); err != nil {
+		return err
+	}
+	_, err := GetImageRepository(clusterConfig)
+	return err
+}
+
 // PBErrLogger creates a new logging entry with the content of a pb.Error added as struct info,
 // the new entry is set based on the passed in logging entry.
 func PBErrLogger(pbErr *pb.Error, entry *logrus.Entry) *logrus.Entry {
@@ -0,0 +1,186 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+// Privilege escalation methods supported by pb.Escalation.Method
+const (
+	EscalationMethodSudo = "sudo"
+	EscalationMethodSu   = "su"
+)
+
+const (
+	// suPasswordPrompt is the prompt of su in the C locale, it's trimmed from the output.
+	suPasswordPrompt = "Password: "
+	// escalationUploadDir is where the files are uploaded before they are copied to the destination as root.
+	escalationUploadDir = "/tmp"
+	// escalationStagingFileMode is the mode of the uploaded file, it's set before the content is written,
+	// so the content which may be a private key is not readable by the other users.
+	escalationStagingFileMode os.FileMode = 0600
+	// escalationFileMode is the mode of the destination, which is owned by root.
+	escalationFileMode = "0600"
+)
+
+// escalatedCommand is a command wrapped to run as root.
+type escalatedCommand struct {
+	cmd string
+	// stdin is written to the command, it feeds the password to sudo or su
+	stdin string
+	// pty is required by su, which reads the password from the terminal only
+	pty bool
+}
+
+// getEscalation returns nil if the privilege escalation is not enabled.
+func getEscalation(node *pb.Node) *pb.Escalation {
+	escalation := node.GetSsh().GetEscalation()
+	if escalation.GetMethod() == "" {
+		return nil
+	}
+	return escalation
+}
+
+// escalateCommand wraps the command to run as root by the escalation, the command is not
+// changed if there is no escalation.
+func escalateCommand(escalation *pb.Escalation, cmd string) (*escalatedCommand, error) {
+	password := escalation.GetPassword()
+	if escalation.GetSealed() {
+		var err error
+		if password, err = vault.Open(password); err != nil {
+			return nil, fmt.Errorf("failed to open privilege escalation password, error: %v", err)
+		}
+	}
+
+	switch escalation.GetMethod() {
+	case "":
+		return &escalatedCommand{cmd: cmd}, nil
+	case EscalationMethodSudo:
+		if password == "" {
+			// -n makes sudo fail rather than wait for a password
+			return &escalatedCommand{cmd: "sudo -n -- sh -c " + shellQuote(cmd)}, nil
+		}
+		return &escalatedCommand{
+			cmd:   "sudo -S -p '' -- sh -c " + shellQuote(cmd),
+			stdin: password + "\n",
+		}, nil
+	case EscalationMethodSu:
+		return &escalatedCommand{
+			cmd:   "LANG=C su root -c " + shellQuote(cmd),
+			stdin: password + "\n",
+			pty:   true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unrecognized privilege escalation method: %v", escalation.GetMethod())
+	}
+}
+
+// prepare sets up the session to feed the password.
+func (c *escalatedCommand) prepare(session *ssh.Session) error {
+	if c.stdin != "" {
+		session.Stdin = strings.NewReader(c.stdin)
+	}
+	if c.pty {
+		// the password is not echoed, and the output is not converted to CRLF by the terminal
+		modes := ssh.TerminalModes{ssh.ECHO: 0, ssh.ONLCR: 0}
+		if err := session.RequestPty("dumb", 40, 80, modes); err != nil {
+			return fmt.Errorf("failed to request pty, error: %v", err)
+		}
+	}
+	return nil
+}
+
+// trimOutput removes the password prompt from the output, the stderr is merged into the stdout if a pty is used.
+func (c *escalatedCommand) trimOutput(stdout []byte) []byte {
+	if c.pty {
+		return bytes.TrimPrefix(stdout, []byte(suPasswordPrompt))
+	}
+	return stdout
+}
+
+// shellQuote quotes the string as a single argument of sh.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'"'"'`, -1) + "'"
+}
+
+// putFileWithEscalation uploads the content to a temporary file as the login user, and installs it to
+// the remote path as root, so the file is owned by root and only readable by root.
+func (m *Machine) putFileWithEscalation(content io.Reader, remotePath string) error {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return fmt.Errorf("failed to generate temporary file name, error: %v", err)
+	}
+	tmpPath := path.Join(escalationUploadDir, fmt.Sprintf(".kpaas-%s-%s", hex.EncodeToString(suffix), path.Base(remotePath)))
+
+	if err := m.putStagingFile(content, tmpPath); err != nil {
+		return err
+	}
+
+	if _, stderr, err := m.Run(context.Background(), installStagingFileCommand(tmpPath, remotePath)); err != nil {
+		return fmt.Errorf("install %v to %v as root failed, stderr: %s, error: %v", tmpPath, remotePath, stderr, err)
+	}
+
+	return nil
+}
+
+// putStagingFile creates the temporary file with escalationStagingFileMode before the content is written,
+// the file must not exist, so a file planted by another user is not written.
+func (m *Machine) putStagingFile(content io.Reader, tmpPath string) error {
+	release, err := acquireSession(context.Background(), m.Ip)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	tmpFile, err := m.SFTPClient.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL)
+	if err != nil {
+		return fmt.Errorf("create file %v failed: %v", tmpPath, err)
+	}
+	defer tmpFile.Close()
+
+	if err = tmpFile.Chmod(escalationStagingFileMode); err == nil {
+		_, err = io.Copy(tmpFile, content)
+	}
+	if err != nil {
+		if removeErr := m.SFTPClient.Remove(tmpPath); removeErr != nil {
+			logrus.Debugf("failed to remove %v, error: %v", tmpPath, removeErr)
+		}
+		return fmt.Errorf("copy content to remote file %v failed: %v", tmpPath, err)
+	}
+
+	return nil
+}
+
+// installStagingFileCommand returns the command to install the temporary file to the remote path
+// with escalationFileMode, the temporary file is removed in any case.
+func installStagingFileCommand(tmpPath, remotePath string) string {
+	return fmt.Sprintf("mkdir -p %s && install -m %s %s %s; ret=$?; rm -f %s; exit $ret",
+		shellQuote(path.Dir(remotePath)), escalationFileMode, shellQuote(tmpPath), shellQuote(remotePath), shellQuote(tmpPath))
+}
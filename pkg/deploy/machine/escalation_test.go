@@ -0,0 +1,114 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+func TestEscalateCommand(t *testing.T) {
+	tests := []struct {
+		escalation *pb.Escalation
+		want       *escalatedCommand
+		wantErr    bool
+	}{
+		{
+			escalation: nil,
+			want:       &escalatedCommand{cmd: "echo 'a b'"},
+		},
+		{
+			escalation: &pb.Escalation{Method: EscalationMethodSudo},
+			want:       &escalatedCommand{cmd: `sudo -n -- sh -c 'echo '"'"'a b'"'"''`},
+		},
+		{
+			escalation: &pb.Escalation{Method: EscalationMethodSudo, Password: "123456"},
+			want: &escalatedCommand{
+				cmd:   `sudo -S -p '' -- sh -c 'echo '"'"'a b'"'"''`,
+				stdin: "123456\n",
+			},
+		},
+		{
+			escalation: &pb.Escalation{Method: EscalationMethodSu, Password: "123456"},
+			want: &escalatedCommand{
+				cmd:   `LANG=C su root -c 'echo '"'"'a b'"'"''`,
+				stdin: "123456\n",
+				pty:   true,
+			},
+		},
+		{
+			escalation: &pb.Escalation{Method: "doas"},
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := escalateCommand(test.escalation, "echo 'a b'")
+		if test.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.want, got)
+	}
+}
+
+func TestEscalateCommandWithSealedPassword(t *testing.T) {
+	masterKey, err := vault.GenerateMasterKey()
+	assert.NoError(t, err)
+	v, err := vault.New(masterKey)
+	assert.NoError(t, err)
+	sealedPassword, err := v.Seal("123456")
+	assert.NoError(t, err)
+
+	escalation := &pb.Escalation{Method: EscalationMethodSudo, Password: sealedPassword, Sealed: true}
+	_, err = escalateCommand(escalation, "id -u")
+	assert.Error(t, err)
+
+	vault.SetDefault(v)
+	defer vault.SetDefault(nil)
+
+	got, err := escalateCommand(escalation, "id -u")
+	assert.NoError(t, err)
+	assert.Equal(t, "123456\n", got.stdin)
+}
+
+func TestEscalatedCommandTrimOutput(t *testing.T) {
+	su := &escalatedCommand{pty: true}
+	assert.Equal(t, []byte("0\n"), su.trimOutput([]byte("Password: 0\n")))
+
+	sudo := &escalatedCommand{}
+	assert.Equal(t, []byte("Password: 0\n"), sudo.trimOutput([]byte("Password: 0\n")))
+}
+
+func TestGetEscalation(t *testing.T) {
+	assert.Nil(t, getEscalation(&pb.Node{}))
+	assert.Nil(t, getEscalation(&pb.Node{Ssh: &pb.SSH{Escalation: &pb.Escalation{}}}))
+
+	escalation := &pb.Escalation{Method: EscalationMethodSudo}
+	assert.Equal(t, escalation, getEscalation(&pb.Node{Ssh: &pb.SSH{Escalation: escalation}}))
+}
+
+func TestInstallStagingFileCommand(t *testing.T) {
+	assert.Equal(t,
+		`mkdir -p '/etc/etcd/pki' && install -m 0600 '/tmp/.kpaas-01-ca.key' '/etc/etcd/pki/ca.key'; ret=$?; rm -f '/tmp/.kpaas-01-ca.key'; exit $ret`,
+		installStagingFileCommand("/tmp/.kpaas-01-ca.key", "/etc/etcd/pki/ca.key"))
+	assert.Equal(t, os.FileMode(0600), escalationStagingFileMode)
+}
@@ -0,0 +1,35 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// ScanHostKeyFingerprints returns the SHA256 fingerprint of the ssh host key presented by the node,
+// no credential of the node is sent. The jump hosts without pinned host key are trusted on first use,
+// and the fingerprints presented by them are returned in order. It's empty for a local node, which is
+// not connected via ssh.
+func ScanHostKeyFingerprints(node *pb.Node) (string, []string, error) {
+	if IsLocal(node) {
+		return "", nil, nil
+	}
+
+	if IsTesting {
+		return scanMockHostKeyFingerprints(node)
+	}
+
+	return mssh.ScanHostKeyFingerprints(node.GetIp(), node.GetSsh())
+}
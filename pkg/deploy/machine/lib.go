@@ -15,6 +15,7 @@
 package machine
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,8 +31,25 @@ import (
 	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
 )
 
-// Run will run command on remote machine
-func (m *Machine) Run(cmd string) (stdout, stderr []byte, err error) {
+// Run will run command on remote machine, the command will be interrupted
+// and ctx.Err() returned if ctx is done before the command finished.
+// The command is run as root if the privilege escalation of the node is enabled.
+func (m *Machine) Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	escalated, err := escalateCommand(getEscalation(m.Node), cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	release, err := acquireSession(ctx, m.Ip)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
 	session, err := mssh.NewSession(m.SSHClient)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to get session of machine(%v), error: %v", m.Name, err)
@@ -39,6 +57,10 @@ func (m *Machine) Run(cmd string) (stdout, stderr []byte, err error) {
 
 	defer session.Close()
 
+	if err = escalated.prepare(session); err != nil {
+		return nil, nil, fmt.Errorf("unable to prepare session for cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
+	}
+
 	errReader, err := session.StderrPipe()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to pipe stderr for cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
@@ -49,21 +71,46 @@ func (m *Machine) Run(cmd string) (stdout, stderr []byte, err error) {
 		return nil, nil, fmt.Errorf("failed to pipe stdout for cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
 	}
 
-	if err = session.Start(cmd); err != nil {
+	if err = session.Start(escalated.cmd); err != nil {
 		return nil, nil, fmt.Errorf("unable to run cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
 	}
 
-	stderr, err = ioutil.ReadAll(errReader)
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to read stderr message for cmd(%v) returned from machine(%v), error: %v", cmd, m.Name, err)
+	type result struct {
+		stdout, stderr []byte
+		err            error
 	}
+	done := make(chan result, 1)
+	go func() {
+		var r result
+		defer func() { done <- r }()
+
+		r.stderr, r.err = ioutil.ReadAll(errReader)
+		if r.err != nil {
+			r.err = fmt.Errorf("unable to read stderr message for cmd(%v) returned from machine(%v), error: %v", cmd, m.Name, r.err)
+			return
+		}
 
-	stdout, err = ioutil.ReadAll(outReader)
-	if err != nil {
-		return nil, nil, fmt.Errorf("unable to read stderr message for cmd(%v) returned from machine(%v), error: %v", cmd, m.Name, err)
+		r.stdout, r.err = ioutil.ReadAll(outReader)
+		if r.err != nil {
+			r.err = fmt.Errorf("unable to read stdout message for cmd(%v) returned from machine(%v), error: %v", cmd, m.Name, r.err)
+			return
+		}
+
+		r.err = session.Wait()
+	}()
+
+	var r result
+	select {
+	case r = <-done:
+	case <-ctx.Done():
+		// try to kill the remote process, closing the session (deferred) unblocks the reader
+		if sigErr := session.Signal(ssh.SIGKILL); sigErr != nil {
+			logrus.Debugf("failed to send kill signal for cmd(%v) on machine(%v), error: %v", cmd, m.Name, sigErr)
+		}
+		return nil, nil, ctx.Err()
 	}
 
-	err = session.Wait()
+	stdout, stderr, err = escalated.trimOutput(r.stdout), r.stderr, r.err
 	if err != nil {
 		if exitErr, ok := err.(*ssh.ExitError); ok {
 			return stdout, stderr, fmt.Errorf("command exited with error: %v", exitErr)
@@ -78,7 +125,23 @@ func (m *Machine) Run(cmd string) (stdout, stderr []byte, err error) {
 	return
 }
 
+// PutFile writes the content to the remote path, the file is written as root
+// if the privilege escalation of the node is enabled.
 func (m *Machine) PutFile(content io.Reader, remotePath string) error {
+	if getEscalation(m.Node) != nil {
+		return m.putFileWithEscalation(content, remotePath)
+	}
+
+	return m.putFile(content, remotePath)
+}
+
+func (m *Machine) putFile(content io.Reader, remotePath string) error {
+	release, err := acquireSession(context.Background(), m.Ip)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	// create parent dir if not exists
 	remoteDir := path.Dir(remotePath)
 	if err := m.SFTPClient.MkdirAll(remoteDir); err != nil {
@@ -121,6 +184,25 @@ func (m *Machine) FetchFile(dst io.Writer, remotePath string) error {
 	if dst == nil {
 		return fmt.Errorf("the destination is nil")
 	}
+
+	if getEscalation(m.Node) != nil {
+		// the file may be readable by root only
+		stdout, stderr, err := m.Run(context.Background(), "cat "+shellQuote(remotePath))
+		if err != nil {
+			return fmt.Errorf("read remote file %v as root failed, stderr: %s, error: %v", remotePath, stderr, err)
+		}
+		if _, err = dst.Write(stdout); err != nil {
+			return fmt.Errorf("copy from remote file %v failed, error: %v", remotePath, err)
+		}
+		return nil
+	}
+
+	release, err := acquireSession(context.Background(), m.Ip)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	remoteFile, err := m.SFTPClient.Open(remotePath)
 	if err != nil {
 		return fmt.Errorf("open remote file %v failed, error: %v", remotePath, err)
@@ -186,50 +268,11 @@ func (m *Machine) FetchDir(localDir, remoteDir string, fileNeeded func(path stri
 	return nil
 }
 
+// PutDir puts the needed files in the local directory into the remote directory, the files already present are
+// skipped and the interrupted transfers are resumed, see TransferDir, which is called directly by the executors to
+// report the progress in the action log.
 func (m *Machine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
 	logrus.Debugf("copy %v to %v:%v", localDir, m.Name, remoteDir)
 
-	localDir = strings.TrimPrefix(strings.TrimSuffix(localDir, "/"), "./")
-	remoteDir = strings.TrimSuffix(remoteDir, "/") + "/" + filepath.Base(localDir)
-
-	if !deploy.FileExist(localDir) {
-		return fmt.Errorf("local directory:%v doesn't exist", localDir)
-	}
-
-	if err := filepath.Walk(localDir, func(localPath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return fmt.Errorf("walk %v:%v failed, error: %v", m.Name, localPath, err)
-		}
-
-		remotePath := remoteDir + strings.TrimPrefix(localPath, localDir)
-		logrus.Debugf("copy %v to %v:%v", localPath, m.Name, remotePath)
-
-		// create directory
-		if info.IsDir() {
-			if _, err := m.SFTPClient.Stat(remotePath); os.IsNotExist(err) {
-				if err := m.SFTPClient.MkdirAll(remotePath); err != nil {
-					return fmt.Errorf("creating %v:%v failed. error: %v", m.Name, remotePath, err)
-				}
-			}
-		} else {
-			if fileNeeded(localPath) {
-				// copy file
-				localFile, err := os.Open(localPath)
-				if err != nil {
-					return fmt.Errorf("open %v failed, error: %v", localPath, err)
-				}
-
-				if err := m.PutFile(localFile, remotePath); err != nil {
-					return fmt.Errorf("failed to copy file:%v to %v:%v, error: %v", localPath, m.Name, remotePath, err)
-				}
-			}
-		}
-
-		return nil
-
-	}); err != nil {
-		return fmt.Errorf("walk %v failed. error: %v", localDir, err)
-	}
-
-	return nil
+	return TransferDir(context.Background(), m, localDir, remoteDir, fileNeeded, nil)
 }
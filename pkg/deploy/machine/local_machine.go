@@ -0,0 +1,220 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Connection types supported by pb.Node.ConnectionType
+const (
+	ConnectionTypeSSH   = "ssh"
+	ConnectionTypeLocal = "local"
+)
+
+// LocalMachine runs commands and copies files on the host of deploy controller, it's used
+// for the node of an all-in-one cluster or the deploy controller running in the node.
+type LocalMachine struct {
+	*pb.Node
+}
+
+// IsLocal returns whether the node is the host of deploy controller.
+func IsLocal(node *pb.Node) bool {
+	return node.GetConnectionType() == ConnectionTypeLocal
+}
+
+func newLocalMachine(node *pb.Node) (IMachine, error) {
+	if getEscalation(node) != nil {
+		return nil, fmt.Errorf("privilege escalation is not supported by local machine: %v, please run deploy controller as root", node.GetName())
+	}
+
+	return &LocalMachine{
+		Node: node,
+	}, nil
+}
+
+func (m *LocalMachine) GetName() string {
+	return m.Name
+}
+
+func (m *LocalMachine) GetIp() string {
+	return m.Ip
+}
+
+func (m *LocalMachine) GetNode() *pb.Node {
+	return m.Node
+}
+
+func (m *LocalMachine) Close() {}
+
+// Run runs the command by sh on the local host, the command is killed and ctx.Err()
+// returned if ctx is done before the command finished.
+func (m *LocalMachine) Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var outBuffer, errBuffer bytes.Buffer
+	command := exec.Command("sh", "-c", cmd)
+	command.Stdout = &outBuffer
+	command.Stderr = &errBuffer
+	// the command is run in its own process group, so its children are killed with it
+	command.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	if err = command.Start(); err != nil {
+		return nil, nil, fmt.Errorf("unable to run cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- command.Wait()
+	}()
+
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		if killErr := syscall.Kill(-command.Process.Pid, syscall.SIGKILL); killErr != nil {
+			logrus.Debugf("failed to kill cmd(%v) on machine(%v), error: %v", cmd, m.Name, killErr)
+		}
+		<-done
+		return nil, nil, ctx.Err()
+	}
+
+	stdout, stderr = outBuffer.Bytes(), errBuffer.Bytes()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return stdout, stderr, fmt.Errorf("command exited with error: %v", exitErr)
+		}
+		return stdout, stderr, fmt.Errorf("unable to run cmd(%v) on machine(%v), error: %v", cmd, m.Name, err)
+	}
+
+	return
+}
+
+func (m *LocalMachine) PutFile(content io.Reader, remotePath string) error {
+	// create parent dir if not exists
+	remoteDir := filepath.Dir(remotePath)
+	if err := os.MkdirAll(remoteDir, 0755); err != nil {
+		return fmt.Errorf("mkdirall %v failed, error: %v", remoteDir, err)
+	}
+
+	remoteFile, err := os.Create(remotePath)
+	if err != nil {
+		return fmt.Errorf("create file %v failed: %v", remotePath, err)
+	}
+	defer remoteFile.Close()
+
+	if _, err = io.Copy(remoteFile, content); err != nil {
+		return fmt.Errorf("copy content to file %v failed: %v", remotePath, err)
+	}
+
+	logrus.Debugf("put file to: %v", remotePath)
+
+	return nil
+}
+
+func (m *LocalMachine) FetchFile(dst io.Writer, remotePath string) error {
+	if dst == nil {
+		return fmt.Errorf("the destination is nil")
+	}
+
+	remoteFile, err := os.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("open file %v failed, error: %v", remotePath, err)
+	}
+	defer remoteFile.Close()
+
+	if _, err = io.Copy(dst, remoteFile); err != nil {
+		return fmt.Errorf("copy from file %v failed, error: %v", remotePath, err)
+	}
+
+	logrus.Debugf("fetch file from %s on %s", remotePath, m.Name)
+
+	return nil
+}
+
+func (m *LocalMachine) FetchFileToLocalPath(localPath, remotePath string) error {
+	remoteFile, err := os.Open(remotePath)
+	if err != nil {
+		return fmt.Errorf("open file %v failed, error: %v", remotePath, err)
+	}
+	defer remoteFile.Close()
+
+	return m.PutFile(remoteFile, localPath)
+}
+
+// FetchDir copies the remote directory into the local directory like Machine.FetchDir.
+func (m *LocalMachine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	remoteDir = strings.TrimSuffix(remoteDir, "/")
+	localDir = strings.TrimSuffix(localDir, "/") + "/" + filepath.Base(remoteDir)
+
+	if !deploy.FileExist(remoteDir) {
+		return fmt.Errorf("%v:%v does not exist", m.Name, remoteDir)
+	}
+
+	return copyDir(remoteDir, localDir, fileNeeded, m.FetchFileToLocalPath)
+}
+
+// PutDir copies the local directory into the remote directory like Machine.PutDir.
+func (m *LocalMachine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	localDir = strings.TrimPrefix(strings.TrimSuffix(localDir, "/"), "./")
+	remoteDir = strings.TrimSuffix(remoteDir, "/") + "/" + filepath.Base(localDir)
+
+	if !deploy.FileExist(localDir) {
+		return fmt.Errorf("local directory:%v doesn't exist", localDir)
+	}
+
+	return copyDir(localDir, remoteDir, fileNeeded, m.FetchFileToLocalPath)
+}
+
+// copyDir copies the needed files in srcDir to dstDir by copyFile, the directories are always created.
+func copyDir(srcDir, dstDir string, fileNeeded func(path string) bool, copyFile func(dstPath, srcPath string) error) error {
+	return filepath.Walk(srcDir, func(srcPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk %v failed, error: %v", srcPath, err)
+		}
+
+		dstPath := dstDir + strings.TrimPrefix(srcPath, srcDir)
+		if info.IsDir() {
+			if err := os.MkdirAll(dstPath, 0755); err != nil {
+				return fmt.Errorf("failed to mkdir %v, error: %v", dstPath, err)
+			}
+			return nil
+		}
+
+		if !fileNeeded(srcPath) {
+			return nil
+		}
+
+		logrus.Debugf("copy %v to %v", srcPath, dstPath)
+		if err := copyFile(dstPath, srcPath); err != nil {
+			return fmt.Errorf("failed to copy file from %v to %v, error: %v", srcPath, dstPath, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newTestLocalMachine(t *testing.T) IMachine {
+	m, err := NewMachine(&pb.Node{Name: "local", Ip: "127.0.0.1", ConnectionType: ConnectionTypeLocal})
+	assert.NoError(t, err)
+	assert.IsType(t, new(LocalMachine), m)
+	return m
+}
+
+func TestNewLocalMachineWithEscalation(t *testing.T) {
+	_, err := NewMachine(&pb.Node{
+		Name:           "local",
+		ConnectionType: ConnectionTypeLocal,
+		Ssh:            &pb.SSH{Escalation: &pb.Escalation{Method: EscalationMethodSudo}},
+	})
+	assert.Error(t, err)
+}
+
+func TestLocalMachineRun(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	stdout, stderr, err := m.Run(context.Background(), "echo out; echo err >&2")
+	assert.NoError(t, err)
+	assert.Equal(t, "out\n", string(stdout))
+	assert.Equal(t, "err\n", string(stderr))
+
+	_, stderr, err = m.Run(context.Background(), "echo failed >&2; exit 3")
+	assert.Error(t, err)
+	assert.Equal(t, "failed\n", string(stderr))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, _, err = m.Run(ctx, "sleep 10")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestLocalMachineFiles(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	dir, err := ioutil.TempDir("", "local-machine")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	remotePath := filepath.Join(dir, "remote", "file")
+	assert.NoError(t, m.PutFile(strings.NewReader("content"), remotePath))
+
+	buffer := new(bytes.Buffer)
+	assert.NoError(t, m.FetchFile(buffer, remotePath))
+	assert.Equal(t, "content", buffer.String())
+
+	localPath := filepath.Join(dir, "local", "file")
+	assert.NoError(t, m.FetchFileToLocalPath(localPath, remotePath))
+	content, err := ioutil.ReadFile(localPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	// the files which are not needed are skipped
+	assert.NoError(t, m.PutFile(strings.NewReader("skipped"), filepath.Join(dir, "remote", "sub", "skipped")))
+	fileNeeded := func(path string) bool {
+		return !strings.HasSuffix(path, "skipped")
+	}
+
+	assert.NoError(t, m.FetchDir(filepath.Join(dir, "fetched"), filepath.Join(dir, "remote"), fileNeeded))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "fetched", "remote", "file"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+	assert.DirExists(t, filepath.Join(dir, "fetched", "remote", "sub"))
+	assert.False(t, deploy.FileExist(filepath.Join(dir, "fetched", "remote", "sub", "skipped")))
+
+	assert.NoError(t, m.PutDir(filepath.Join(dir, "remote"), filepath.Join(dir, "put"), fileNeeded))
+	content, err = ioutil.ReadFile(filepath.Join(dir, "put", "remote", "file"))
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(content))
+
+	assert.Error(t, m.FetchDir(dir, filepath.Join(dir, "not-exist"), fileNeeded))
+	assert.Error(t, m.PutDir(filepath.Join(dir, "not-exist"), dir, fileNeeded))
+}
+
+func TestScanLocalHostKeyFingerprint(t *testing.T) {
+	fingerprint, jumpHostFingerprints, err := ScanHostKeyFingerprints(&pb.Node{ConnectionType: ConnectionTypeLocal})
+	assert.NoError(t, err)
+	assert.Empty(t, fingerprint)
+	assert.Empty(t, jumpHostFingerprints)
+}
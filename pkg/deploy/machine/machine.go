@@ -15,6 +15,7 @@
 package machine
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -27,7 +28,7 @@ type IMachine interface {
 	GetNode() *pb.Node
 	Close()
 
-	Run(cmd string) (stdout, stderr []byte, err error)
+	Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error)
 	FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error
 	FetchFile(dst io.Writer, remotePath string) error
 	FetchFileToLocalPath(localPath, remotePath string) error
@@ -38,10 +39,31 @@ type IMachine interface {
 type Machine struct {
 	*ExecClient
 	*pb.Node
+	// conn is the pooled connection which the ExecClient belongs to
+	conn *pooledConn
 }
 
-func NewMachine(node *pb.Node) (IMachine, error) {
-	if IsTesting {
+// NewMachine returns a machine of the node, its ssh connection is drawn from the connection pool,
+// so the machines of the same node share one connection. Close should be called to return the
+// connection to the pool. A LocalMachine is returned if the connection type of the node is local.
+// The machine is wrapped to record the interactions if a recorder is set.
+func NewMachine(node *pb.Node) (m IMachine, err error) {
+	if recorder := _recorder; recorder != nil {
+		defer func() {
+			if err != nil {
+				recorder.recordError(node, err)
+				return
+			}
+			m = recorder.wrap(m)
+		}()
+	}
+
+	switch {
+	case IsLocal(node):
+		return newLocalMachine(node)
+	case _replayer != nil:
+		return newReplayMachine(node)
+	case IsTesting:
 		return newMockMachine(node)
 	}
 
@@ -49,21 +71,25 @@ func NewMachine(node *pb.Node) (IMachine, error) {
 }
 
 func newMachine(node *pb.Node) (IMachine, error) {
-	client, err := NewExecClient(node)
+	conn, err := _connectionPool.get(node)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create execution client for machine: %v(%v), error: %v", node.Name, node.Ip, err)
+		return nil, fmt.Errorf("failed to create execution client for machine: %v(%v), error: %w", node.Name, node.Ip, err)
 	}
 
 	return &Machine{
-		ExecClient: client,
+		ExecClient: conn.client,
 		Node:       node,
+		conn:       conn,
 	}, nil
 }
 
+// Close returns the connection to the pool, the machine should not be used after closed.
 func (m *Machine) Close() {
 
-	if m.ExecClient != nil {
-		m.ExecClient.Close()
+	if m.conn != nil {
+		_connectionPool.release(m.conn)
+		m.conn = nil
+		m.ExecClient = nil
 	}
 
 }
@@ -15,12 +15,14 @@
 package machine
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
 
 	dockerclient "github.com/docker/docker/client"
 
+	mssh "github.com/kpaas-io/kpaas/pkg/deploy/machine/ssh"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -46,6 +48,28 @@ func newMockMachine(node *pb.Node) (IMachine, error) {
 	}, nil
 }
 
+// MockHostKeyFingerprint is the host key fingerprint of all the mock machines.
+const MockHostKeyFingerprint = "SHA256:bW9jayBob3N0IGtleQ"
+
+func scanMockHostKeyFingerprints(node *pb.Node) (string, []string, error) {
+	if node.Name == "error" {
+		return "", nil, errMachineErr
+	}
+	jumpHostFingerprints := make([]string, 0, len(node.GetSsh().GetJumpHosts()))
+	for _, jumpHost := range node.GetSsh().GetJumpHosts() {
+		if jumpHost.GetIp() == "error" {
+			return "", nil, &mssh.HopError{
+				Address:  fmt.Sprintf("%v:%v", jumpHost.GetIp(), jumpHost.GetPort()),
+				JumpHost: true,
+				Err:      errMachineErr,
+			}
+		}
+		jumpHostFingerprints = append(jumpHostFingerprints, MockHostKeyFingerprint)
+	}
+
+	return MockHostKeyFingerprint, jumpHostFingerprints, nil
+}
+
 func (m *MockMachine) GetName() string {
 	return m.Name
 }
@@ -61,7 +85,11 @@ func (m *MockMachine) StartDockerTunnel() error {
 }
 
 // Run return different response by node name
-func (m *MockMachine) Run(cmd string) (stdout, stderr []byte, err error) {
+func (m *MockMachine) Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	if m.Name == "error" {
 		err = fmt.Errorf("this is an error machine")
 	}
@@ -83,6 +111,8 @@ func (m *MockMachine) Run(cmd string) (stdout, stderr []byte, err error) {
 		return []byte("systemd"), nil, nil
 	case strings.HasPrefix(cmd, "cat /etc/*-release"):
 		return []byte("ubuntu"), nil, nil
+	case strings.HasPrefix(cmd, "id -u"):
+		return []byte("0"), nil, nil
 	}
 
 	return []byte(""), []byte(""), nil
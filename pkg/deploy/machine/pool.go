@@ -0,0 +1,263 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"expvar"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// DefaultConnectionIdleTimeout is how long an unused ssh connection is kept in the pool by default.
+const DefaultConnectionIdleTimeout = 5 * time.Minute
+
+// PoolMetrics are the statistics of the ssh connection pool.
+type PoolMetrics struct {
+	// Dials is how many ssh connections have been dialed.
+	Dials int64 `json:"dials"`
+	// Reuses is how many times a pooled connection has been reused instead of dialing a new one.
+	Reuses int64 `json:"reuses"`
+	// HealthCheckFailures is how many pooled connections have been found broken when reused.
+	HealthCheckFailures int64 `json:"healthCheckFailures"`
+	// IdleClosed is how many connections have been closed because they were not used for the idle timeout.
+	IdleClosed int64 `json:"idleClosed"`
+	// Open is the number of the connections in the pool.
+	Open int64 `json:"open"`
+	// InUse is the number of the connections in the pool which are being used.
+	InUse int64 `json:"inUse"`
+}
+
+// pooledConn is a connection in the pool, it is shared by the machines of the same node.
+type pooledConn struct {
+	key      string
+	client   *ExecClient
+	refs     int
+	lastUsed time.Time
+	// evicted means the connection has been removed from the pool, it will be closed once it's not used.
+	evicted bool
+}
+
+// connectionPool keeps the ssh connections keyed by node, the machines of the same node share a
+// connection and open their sessions over it, instead of dialing a new one each time.
+type connectionPool struct {
+	lock        sync.Mutex
+	idleTimeout time.Duration
+	conns       map[string]*pooledConn
+	reaperOnce  sync.Once
+
+	// dial and isHealthy are replaced in tests
+	dial      func(node *pb.Node) (*ExecClient, error)
+	isHealthy func(client *ExecClient) bool
+
+	dials, reuses, healthCheckFailures, idleClosed int64
+}
+
+var _connectionPool = newConnectionPool(DefaultConnectionIdleTimeout)
+
+func init() {
+	expvar.Publish("sshConnectionPool", expvar.Func(func() interface{} {
+		return GetPoolMetrics()
+	}))
+}
+
+func newConnectionPool(idleTimeout time.Duration) *connectionPool {
+	return &connectionPool{
+		idleTimeout: idleTimeout,
+		conns:       make(map[string]*pooledConn),
+		dial:        NewExecClient,
+		isHealthy:   isExecClientHealthy,
+	}
+}
+
+// SetConnectionIdleTimeout sets how long an unused ssh connection is kept in the pool,
+// 0 means the connections are closed once they are not used, that is no pooling.
+func SetConnectionIdleTimeout(idleTimeout time.Duration) {
+	_connectionPool.lock.Lock()
+	defer _connectionPool.lock.Unlock()
+
+	_connectionPool.idleTimeout = idleTimeout
+}
+
+// GetPoolMetrics returns the statistics of the ssh connection pool.
+func GetPoolMetrics() PoolMetrics {
+	return _connectionPool.metrics()
+}
+
+// connectionKey identifies the connections which can be shared: the same node with the same
+// login and host key, reached through the same jump hosts.
+func connectionKey(node *pb.Node) string {
+	ssh := node.GetSsh()
+	key := hopKey(node.GetIp(), ssh.GetPort(), ssh.GetAuth(), ssh.GetHostKeyFingerprint())
+	for _, jumpHost := range ssh.GetJumpHosts() {
+		key += "|" + hopKey(jumpHost.GetIp(), jumpHost.GetPort(), jumpHost.GetAuth(), jumpHost.GetHostKeyFingerprint())
+	}
+	return key
+}
+
+func hopKey(ip string, port uint32, auth *pb.Auth, hostKeyFingerprint string) string {
+	credential := sha256.Sum256([]byte(auth.GetCredential() + "\x00" + auth.GetPassphrase() + "\x00" + auth.GetCertificate()))
+	return fmt.Sprintf("%s:%d/%s/%s/%s/%s", ip, port, auth.GetUsername(), auth.GetType(),
+		hex.EncodeToString(credential[:]), hostKeyFingerprint)
+}
+
+// isExecClientHealthy sends a keepalive request to check the connection is still alive.
+func isExecClientHealthy(client *ExecClient) bool {
+	if client.SSHClient == nil {
+		return false
+	}
+	// the server replies false to the unknown request, but it fails only if the connection is broken.
+	_, _, err := client.SSHClient.SendRequest("keepalive@openssh.com", true, nil)
+	return err == nil
+}
+
+// get returns a connection to the node, a pooled one is reused if it is healthy.
+// release should be called when the connection is not used anymore.
+func (p *connectionPool) get(node *pb.Node) (*pooledConn, error) {
+	p.reaperOnce.Do(func() {
+		go p.reapIdleConns()
+	})
+
+	key := connectionKey(node)
+
+	p.lock.Lock()
+	conn, ok := p.conns[key]
+	if ok {
+		conn.refs++
+	}
+	p.lock.Unlock()
+
+	if ok {
+		if p.isHealthy(conn.client) {
+			atomic.AddInt64(&p.reuses, 1)
+			return conn, nil
+		}
+
+		logrus.Debugf("The pooled ssh connection to %v is broken, dial a new one", node.GetIp())
+		atomic.AddInt64(&p.healthCheckFailures, 1)
+		p.lock.Lock()
+		p.evict(conn)
+		p.lock.Unlock()
+		p.release(conn)
+	}
+
+	client, err := p.dial(node)
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt64(&p.dials, 1)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	// another connection may be dialed at the same time, replace it and it will be closed once it's not used.
+	if existing, ok := p.conns[key]; ok {
+		p.evict(existing)
+	}
+	conn = &pooledConn{
+		key:      key,
+		client:   client,
+		refs:     1,
+		lastUsed: time.Now(),
+	}
+	p.conns[key] = conn
+	return conn, nil
+}
+
+// release returns the connection to the pool, it's closed if it's not pooled anymore.
+func (p *connectionPool) release(conn *pooledConn) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	conn.refs--
+	conn.lastUsed = time.Now()
+	if conn.refs > 0 {
+		return
+	}
+
+	if conn.evicted {
+		conn.client.Close()
+		return
+	}
+	if p.idleTimeout <= 0 {
+		p.evict(conn)
+	}
+}
+
+// evict removes the connection from the pool, it should be called with the lock held.
+func (p *connectionPool) evict(conn *pooledConn) {
+	if conn.evicted {
+		return
+	}
+	conn.evicted = true
+	if p.conns[conn.key] == conn {
+		delete(p.conns, conn.key)
+	}
+	if conn.refs <= 0 {
+		conn.client.Close()
+	}
+}
+
+// reapIdleConns closes the connections which have not been used for the idle timeout.
+func (p *connectionPool) reapIdleConns() {
+	for {
+		p.lock.Lock()
+		interval := p.idleTimeout / 2
+		p.lock.Unlock()
+		if interval <= 0 || interval > time.Minute {
+			interval = time.Minute
+		}
+		time.Sleep(interval)
+
+		p.closeIdleConns()
+	}
+}
+
+func (p *connectionPool) closeIdleConns() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for _, conn := range p.conns {
+		if conn.refs == 0 && time.Since(conn.lastUsed) >= p.idleTimeout {
+			p.evict(conn)
+			atomic.AddInt64(&p.idleClosed, 1)
+		}
+	}
+}
+
+func (p *connectionPool) metrics() PoolMetrics {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	metrics := PoolMetrics{
+		Dials:               atomic.LoadInt64(&p.dials),
+		Reuses:              atomic.LoadInt64(&p.reuses),
+		HealthCheckFailures: atomic.LoadInt64(&p.healthCheckFailures),
+		IdleClosed:          atomic.LoadInt64(&p.idleClosed),
+		Open:                int64(len(p.conns)),
+	}
+	for _, conn := range p.conns {
+		if conn.refs > 0 {
+			metrics.InUse++
+		}
+	}
+	return metrics
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newTestConnectionPool(idleTimeout time.Duration, healthy *bool) *connectionPool {
+	pool := newConnectionPool(idleTimeout)
+	pool.dial = func(node *pb.Node) (*ExecClient, error) {
+		if node.GetName() == "error" {
+			return nil, fmt.Errorf("failed to dial")
+		}
+		return new(ExecClient), nil
+	}
+	pool.isHealthy = func(client *ExecClient) bool {
+		return *healthy
+	}
+	return pool
+}
+
+func TestConnectionPool(t *testing.T) {
+	healthy := true
+	pool := newTestConnectionPool(time.Hour, &healthy)
+	node := &pb.Node{
+		Name: "node1",
+		Ip:   "10.1.1.1",
+		Ssh:  &pb.SSH{Port: 22, Auth: &pb.Auth{Type: "password", Username: "root", Credential: "123456"}},
+	}
+
+	// the connections to the same node are shared
+	conn1, err := pool.get(node)
+	assert.NoError(t, err)
+	conn2, err := pool.get(node)
+	assert.NoError(t, err)
+	assert.Equal(t, conn1, conn2)
+	assert.Equal(t, PoolMetrics{Dials: 1, Reuses: 1, Open: 1, InUse: 1}, pool.metrics())
+
+	// a different login is not shared
+	otherLogin := &pb.Node{
+		Name: "node1",
+		Ip:   "10.1.1.1",
+		Ssh:  &pb.SSH{Port: 22, Auth: &pb.Auth{Type: "password", Username: "root", Credential: "654321"}},
+	}
+	conn3, err := pool.get(otherLogin)
+	assert.NoError(t, err)
+	assert.NotEqual(t, conn1, conn3)
+	pool.release(conn3)
+
+	pool.release(conn1)
+	pool.release(conn2)
+	assert.Equal(t, PoolMetrics{Dials: 2, Reuses: 1, Open: 2}, pool.metrics())
+
+	// a broken connection is replaced
+	healthy = false
+	conn4, err := pool.get(node)
+	assert.NoError(t, err)
+	assert.NotEqual(t, conn1, conn4)
+	assert.True(t, conn1.evicted)
+	assert.Equal(t, PoolMetrics{Dials: 3, Reuses: 1, HealthCheckFailures: 1, Open: 2, InUse: 1}, pool.metrics())
+	pool.release(conn4)
+
+	_, err = pool.get(&pb.Node{Name: "error"})
+	assert.Error(t, err)
+
+	// idle connections are closed
+	pool.closeIdleConns()
+	assert.Equal(t, int64(2), pool.metrics().Open)
+	pool.idleTimeout = time.Nanosecond
+	pool.closeIdleConns()
+	assert.Equal(t, PoolMetrics{Dials: 3, Reuses: 1, HealthCheckFailures: 1, IdleClosed: 2}, pool.metrics())
+}
+
+func TestConnectionPoolWithoutIdleTimeout(t *testing.T) {
+	healthy := true
+	pool := newTestConnectionPool(0, &healthy)
+	node := &pb.Node{Name: "node1", Ip: "10.1.1.1"}
+
+	conn1, err := pool.get(node)
+	assert.NoError(t, err)
+	conn2, err := pool.get(node)
+	assert.NoError(t, err)
+	assert.Equal(t, conn1, conn2)
+
+	// the connection is closed once it's not used
+	pool.release(conn1)
+	assert.False(t, conn1.evicted)
+	pool.release(conn2)
+	assert.True(t, conn1.evicted)
+	assert.Equal(t, int64(0), pool.metrics().Open)
+}
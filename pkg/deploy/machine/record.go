@@ -0,0 +1,396 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// Operations of a machine which are recorded
+const (
+	OpRun                  = "run"
+	OpPutFile              = "putFile"
+	OpFetchFile            = "fetchFile"
+	OpFetchFileToLocalPath = "fetchFileToLocalPath"
+	OpPutDir               = "putDir"
+	OpFetchDir             = "fetchDir"
+)
+
+// Interaction is a recorded call to a machine and its result.
+type Interaction struct {
+	Op string `json:"op"`
+	// Command is the command of run
+	Command string `json:"command,omitempty"`
+	// Path is the remote path of a file or a directory
+	Path string `json:"path,omitempty"`
+	// LocalPath is the local path of a file or a directory
+	LocalPath string `json:"localPath,omitempty"`
+	Stdout    string `json:"stdout,omitempty"`
+	Stderr    string `json:"stderr,omitempty"`
+	// Content is the content of the file which is put or fetched
+	Content string `json:"content,omitempty"`
+	Err     string `json:"err,omitempty"`
+}
+
+// redactedSecret replaces the secrets in the recorded interactions.
+const redactedSecret = "[redacted]"
+
+var (
+	privateKeyPattern     = regexp.MustCompile(`(?s)(-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----).*?(-----END [A-Z0-9 ]*PRIVATE KEY-----)`)
+	bootstrapTokenPattern = regexp.MustCompile(`\b[a-z0-9]{6}\.[a-z0-9]{16}\b`)
+	certificateKeyPattern = regexp.MustCompile(`(?i)(certificate[- ]key[:=]?\s*)[0-9a-f]{64}\b`)
+	kubeConfigKeyPattern  = regexp.MustCompile(`((?:client-key-data|token):[ \t]*)\S+`)
+)
+
+// redact replaces the private keys, the bootstrap tokens, the certificate keys of kubeadm and the keys in
+// kubeconfig with redactedSecret, so that no secret is written to a fixture. It's applied to the commands
+// both when they're recorded and replayed, so the commands with secrets still match. A fixture replaying
+// an executor which loads the fetched keys needs throwaway keys and certificates put back.
+func redact(s string) string {
+	s = privateKeyPattern.ReplaceAllString(s, "${1}\n"+redactedSecret+"\n${2}")
+	s = bootstrapTokenPattern.ReplaceAllString(s, redactedSecret)
+	s = certificateKeyPattern.ReplaceAllString(s, "${1}"+redactedSecret)
+	return kubeConfigKeyPattern.ReplaceAllString(s, "${1}"+redactedSecret)
+}
+
+func (i *Interaction) redact() *Interaction {
+	i.Command = redact(i.Command)
+	i.Stdout = redact(i.Stdout)
+	i.Stderr = redact(i.Stderr)
+	i.Content = redact(i.Content)
+	i.Err = redact(i.Err)
+	return i
+}
+
+// NodeFixture is the recorded interactions with the machine of a node in order.
+type NodeFixture struct {
+	// Err is the error of creating the machine, no interaction is recorded if it's not empty
+	Err          string         `json:"err,omitempty"`
+	Interactions []*Interaction `json:"interactions,omitempty"`
+}
+
+// Fixture is the recorded interactions with the machines, by node name.
+type Fixture struct {
+	Nodes map[string]*NodeFixture `json:"nodes"`
+}
+
+// fixtureRecord is a line appended to a fixture file by the recorder, it's either the error of creating
+// the machine of a node or an interaction with it.
+type fixtureRecord struct {
+	Node        string       `json:"node"`
+	Err         string       `json:"err,omitempty"`
+	Interaction *Interaction `json:"interaction,omitempty"`
+}
+
+func newFixture() *Fixture {
+	return &Fixture{Nodes: make(map[string]*NodeFixture)}
+}
+
+// LoadFixture reads the fixture from a json file, which is either a whole fixture or the records appended by a recorder.
+func LoadFixture(path string) (*Fixture, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture %v, error: %v", path, err)
+	}
+	defer file.Close()
+
+	fixture := newFixture()
+	decoder := json.NewDecoder(file)
+	for decoder.More() {
+		var entry struct {
+			Fixture
+			fixtureRecord
+		}
+		if err := decoder.Decode(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse fixture %v, error: %v", path, err)
+		}
+
+		for name, nodeFixture := range entry.Nodes {
+			fixture.Nodes[name] = nodeFixture
+		}
+		if entry.Node != "" {
+			fixture.add(&entry.fixtureRecord)
+		}
+	}
+	return fixture, nil
+}
+
+// Save writes the whole fixture to a json file, which is only readable by the owner as the responses of
+// the machines may still contain secrets.
+func (f *Fixture) Save(path string) error {
+	content, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+func (f *Fixture) add(record *fixtureRecord) {
+	nodeFixture := f.node(record.Node)
+	if record.Interaction != nil {
+		nodeFixture.Interactions = append(nodeFixture.Interactions, record.Interaction)
+	} else {
+		nodeFixture.Err = record.Err
+	}
+}
+
+func (f *Fixture) node(name string) *NodeFixture {
+	nodeFixture, ok := f.Nodes[name]
+	if !ok {
+		nodeFixture = new(NodeFixture)
+		f.Nodes[name] = nodeFixture
+	}
+	return nodeFixture
+}
+
+// Recorder records the interactions with all the machines created by NewMachine, the secrets in them are
+// redacted. Each interaction is appended to the fixture file once it's done, so the file is complete even if
+// deploy controller is killed, and the interactions are kept in memory only if there is no fixture file.
+type Recorder struct {
+	lock    sync.Mutex
+	path    string
+	fixture *Fixture
+	// truncated indicates whether the fixture file of the last run has been truncated
+	truncated bool
+}
+
+var _recorder *Recorder
+
+// NewRecorder returns a recorder which appends the interactions to path, they're kept in memory if path is empty.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{
+		path:    path,
+		fixture: newFixture(),
+	}
+}
+
+// SetRecorder starts recording by the recorder, or stops recording if it's nil.
+func SetRecorder(recorder *Recorder) {
+	_recorder = recorder
+}
+
+// Fixture returns a copy of the recorded fixture.
+func (r *Recorder) Fixture() *Fixture {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.path != "" {
+		fixture, err := LoadFixture(r.path)
+		if err != nil {
+			logrus.Warnf("failed to load machine fixture, error: %v", err)
+			return newFixture()
+		}
+		return fixture
+	}
+
+	fixture := newFixture()
+	for name, nodeFixture := range r.fixture.Nodes {
+		fixture.Nodes[name] = &NodeFixture{
+			Err:          nodeFixture.Err,
+			Interactions: append([]*Interaction(nil), nodeFixture.Interactions...),
+		}
+	}
+	return fixture
+}
+
+func (r *Recorder) recordError(node *pb.Node, err error) {
+	r.add(&fixtureRecord{Node: node.GetName(), Err: redact(err.Error())})
+}
+
+func (r *Recorder) record(node *pb.Node, interaction *Interaction) {
+	r.add(&fixtureRecord{Node: node.GetName(), Interaction: interaction.redact()})
+}
+
+func (r *Recorder) add(record *fixtureRecord) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.path == "" {
+		r.fixture.add(record)
+		return
+	}
+	if err := r.append(record); err != nil {
+		logrus.Warnf("failed to append machine fixture to %v, error: %v", r.path, err)
+	}
+}
+
+// append writes the record as a line to the end of the fixture file, the file of the last run is truncated first.
+func (r *Recorder) append(record *fixtureRecord) error {
+	content, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	flag := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if !r.truncated {
+		flag |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(r.path, flag, 0600)
+	if err != nil {
+		return err
+	}
+	r.truncated = true
+
+	_, err = file.Write(append(content, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// maxRecordedContent is the max size of the content of a file which is recorded, the large files such as
+// images are not kept in memory.
+const maxRecordedContent = 1 << 20
+
+// contentBuffer keeps the first maxRecordedContent bytes written to it.
+type contentBuffer struct {
+	bytes.Buffer
+	size int64
+}
+
+func (b *contentBuffer) Write(p []byte) (int, error) {
+	b.size += int64(len(p))
+	if left := maxRecordedContent - b.Len(); left > 0 {
+		if len(p) > left {
+			b.Buffer.Write(p[:left])
+		} else {
+			b.Buffer.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+func (b *contentBuffer) String() string {
+	if b.size > int64(b.Len()) {
+		return fmt.Sprintf("%s...[%d bytes not recorded]", b.Buffer.String(), b.size-int64(b.Len()))
+	}
+	return b.Buffer.String()
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// RecordingMachine records the calls to the machine and their results.
+type RecordingMachine struct {
+	IMachine
+	recorder *Recorder
+}
+
+func (r *Recorder) wrap(m IMachine) IMachine {
+	return &RecordingMachine{
+		IMachine: m,
+		recorder: r,
+	}
+}
+
+func (m *RecordingMachine) Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error) {
+	stdout, stderr, err = m.IMachine.Run(ctx, cmd)
+	m.recorder.record(m.GetNode(), &Interaction{
+		Op:      OpRun,
+		Command: cmd,
+		Stdout:  string(stdout),
+		Stderr:  string(stderr),
+		Err:     errString(err),
+	})
+	return
+}
+
+func (m *RecordingMachine) PutFile(content io.Reader, remotePath string) error {
+	buffer := new(contentBuffer)
+	err := m.IMachine.PutFile(io.TeeReader(content, buffer), remotePath)
+	m.recorder.record(m.GetNode(), &Interaction{
+		Op:      OpPutFile,
+		Path:    remotePath,
+		Content: buffer.String(),
+		Err:     errString(err),
+	})
+	return err
+}
+
+func (m *RecordingMachine) FetchFile(dst io.Writer, remotePath string) error {
+	buffer := new(contentBuffer)
+	var writer io.Writer = buffer
+	if dst != nil {
+		writer = io.MultiWriter(dst, buffer)
+	}
+	err := m.IMachine.FetchFile(writer, remotePath)
+	m.recorder.record(m.GetNode(), &Interaction{
+		Op:      OpFetchFile,
+		Path:    remotePath,
+		Content: buffer.String(),
+		Err:     errString(err),
+	})
+	return err
+}
+
+func (m *RecordingMachine) FetchFileToLocalPath(localPath, remotePath string) error {
+	err := m.IMachine.FetchFileToLocalPath(localPath, remotePath)
+	interaction := &Interaction{
+		Op:        OpFetchFileToLocalPath,
+		Path:      remotePath,
+		LocalPath: localPath,
+		Err:       errString(err),
+	}
+	if err == nil {
+		if file, openErr := os.Open(localPath); openErr == nil {
+			buffer := new(contentBuffer)
+			io.Copy(buffer, file)
+			file.Close()
+			interaction.Content = buffer.String()
+		}
+	}
+	m.recorder.record(m.GetNode(), interaction)
+	return err
+}
+
+// PutDir records the directories and the result only, the content of the files is not recorded.
+func (m *RecordingMachine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	err := m.IMachine.PutDir(localDir, remoteDir, fileNeeded)
+	m.recorder.record(m.GetNode(), &Interaction{
+		Op:        OpPutDir,
+		Path:      remoteDir,
+		LocalPath: localDir,
+		Err:       errString(err),
+	})
+	return err
+}
+
+// FetchDir records the directories and the result only, the content of the files is not recorded.
+func (m *RecordingMachine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	err := m.IMachine.FetchDir(localDir, remoteDir, fileNeeded)
+	m.recorder.record(m.GetNode(), &Interaction{
+		Op:        OpFetchDir,
+		Path:      remoteDir,
+		LocalPath: localDir,
+		Err:       errString(err),
+	})
+	return err
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"sync"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// replayer serves the interactions of a fixture, the state is shared by all the machines of a node,
+// so an executor creating several machines for the same node goes through the interactions in order.
+type replayer struct {
+	lock    sync.Mutex
+	fixture *Fixture
+	// consumed records the interactions which have been served, by node name
+	consumed map[string][]bool
+}
+
+var _replayer *replayer
+
+// SetReplayFixture makes NewMachine return machines replaying the fixture, or stops replaying if it's nil.
+// The machines replaying the fixture take precedence over the mock machines.
+func SetReplayFixture(fixture *Fixture) {
+	if fixture == nil {
+		_replayer = nil
+		return
+	}
+
+	_replayer = &replayer{
+		fixture:  fixture,
+		consumed: make(map[string][]bool),
+	}
+}
+
+// ReplayMachine serves the interactions recorded of its node instead of connecting to the node.
+type ReplayMachine struct {
+	*pb.Node
+	replayer *replayer
+}
+
+func newReplayMachine(node *pb.Node) (IMachine, error) {
+	nodeFixture, ok := _replayer.fixture.Nodes[node.GetName()]
+	if !ok {
+		return nil, fmt.Errorf("no fixture of machine: %v", node.GetName())
+	}
+	if nodeFixture.Err != "" {
+		return nil, errors.New(nodeFixture.Err)
+	}
+
+	return &ReplayMachine{
+		Node:     node,
+		replayer: _replayer,
+	}, nil
+}
+
+// next returns the first interaction not served yet which matches, the last matched interaction is served
+// again if all of them have been served, as the order of retries and polls is not deterministic.
+func (r *replayer) next(node, op, key string) (*Interaction, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	interactions := r.fixture.Nodes[node].Interactions
+	consumed, ok := r.consumed[node]
+	if !ok {
+		consumed = make([]bool, len(interactions))
+		r.consumed[node] = consumed
+	}
+
+	var last *Interaction
+	for index, interaction := range interactions {
+		if interaction.Op != op || interaction.key() != key {
+			continue
+		}
+		if !consumed[index] {
+			consumed[index] = true
+			return interaction, nil
+		}
+		last = interaction
+	}
+
+	if last == nil {
+		return nil, fmt.Errorf("no recorded %v of %q on machine: %v", op, key, node)
+	}
+	return last, nil
+}
+
+func (i *Interaction) key() string {
+	if i.Op == OpRun {
+		return redact(i.Command)
+	}
+	return i.Path
+}
+
+func (i *Interaction) err() error {
+	if i.Err == "" {
+		return nil
+	}
+	return errors.New(i.Err)
+}
+
+func (m *ReplayMachine) GetName() string {
+	return m.Name
+}
+
+func (m *ReplayMachine) GetIp() string {
+	return m.Ip
+}
+
+func (m *ReplayMachine) GetNode() *pb.Node {
+	return m.Node
+}
+
+func (m *ReplayMachine) Close() {}
+
+func (m *ReplayMachine) Run(ctx context.Context, cmd string) (stdout, stderr []byte, err error) {
+	if err = ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	interaction, err := m.replayer.next(m.Name, OpRun, redact(cmd))
+	if err != nil {
+		return nil, nil, err
+	}
+	return []byte(interaction.Stdout), []byte(interaction.Stderr), interaction.err()
+}
+
+func (m *ReplayMachine) PutFile(content io.Reader, remotePath string) error {
+	interaction, err := m.replayer.next(m.Name, OpPutFile, remotePath)
+	if err != nil {
+		return err
+	}
+	if content != nil {
+		io.Copy(ioutil.Discard, content)
+	}
+	return interaction.err()
+}
+
+func (m *ReplayMachine) FetchFile(dst io.Writer, remotePath string) error {
+	interaction, err := m.replayer.next(m.Name, OpFetchFile, remotePath)
+	if err != nil {
+		return err
+	}
+	if dst != nil {
+		if _, err := io.Copy(dst, strings.NewReader(interaction.Content)); err != nil {
+			return err
+		}
+	}
+	return interaction.err()
+}
+
+func (m *ReplayMachine) FetchFileToLocalPath(localPath, remotePath string) error {
+	interaction, err := m.replayer.next(m.Name, OpFetchFileToLocalPath, remotePath)
+	if err != nil {
+		return err
+	}
+	if err := interaction.err(); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(localPath, []byte(interaction.Content), 0600)
+}
+
+// PutDir serves the recorded result only, no file is read.
+func (m *ReplayMachine) PutDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	interaction, err := m.replayer.next(m.Name, OpPutDir, remoteDir)
+	if err != nil {
+		return err
+	}
+	return interaction.err()
+}
+
+// FetchDir serves the recorded result only, no file is written.
+func (m *ReplayMachine) FetchDir(localDir, remoteDir string, fileNeeded func(path string) bool) error {
+	interaction, err := m.replayer.next(m.Name, OpFetchDir, remoteDir)
+	if err != nil {
+		return err
+	}
+	return interaction.err()
+}
+
+// IsSimulated returns whether the machine is a mock or replays a fixture, whose node is not really deployed.
+func IsSimulated(m IMachine) bool {
+	switch machine := m.(type) {
+	case *MockMachine, *ReplayMachine:
+		return true
+	case *RecordingMachine:
+		return IsSimulated(machine.IMachine)
+	}
+	return false
+}
+
+// IsSimulatedNode returns whether the machine of the node will be simulated like IsSimulated, without connecting to it.
+func IsSimulatedNode(node *pb.Node) bool {
+	return !IsLocal(node) && (_replayer != nil || IsTesting)
+}
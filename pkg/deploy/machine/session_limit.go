@@ -0,0 +1,56 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
+)
+
+var (
+	sessionLimitLock sync.Mutex
+	// maxSessionsPerHost is the max number of the ssh sessions to a host at the same time, 0 means no limit.
+	maxSessionsPerHost int
+	// hostSessions limits the ssh sessions of each host
+	hostSessions = make(map[string]*utils.Semaphore)
+)
+
+// SetMaxSessionsPerHost sets the max number of the ssh sessions to a host at the same time,
+// including running commands and transferring files, 0 means no limit.
+func SetMaxSessionsPerHost(n int) {
+	sessionLimitLock.Lock()
+	defer sessionLimitLock.Unlock()
+
+	maxSessionsPerHost = n
+	hostSessions = make(map[string]*utils.Semaphore)
+}
+
+// acquireSession waits until a new session to the host is allowed or ctx is done,
+// the returned function should be called after the session is closed.
+func acquireSession(ctx context.Context, host string) (release func(), err error) {
+	sessionLimitLock.Lock()
+	sessions, ok := hostSessions[host]
+	if !ok {
+		sessions = utils.NewSemaphore(maxSessionsPerHost)
+		hostSessions[host] = sessions
+	}
+	sessionLimitLock.Unlock()
+
+	if err := sessions.Acquire(ctx); err != nil {
+		return nil, err
+	}
+	return sessions.Release, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+// Auth types supported by pb.Auth.Type
+const (
+	AuthTypePassword                 = "password"
+	AuthTypePrivateKey               = "privatekey"
+	AuthTypePrivateKeyWithPassphrase = "privatekey-with-passphrase"
+	AuthTypeAgent                    = "agent"
+	AuthTypeCertificate              = "certificate"
+)
+
+// authAgentSocketEnv is the environment variable of the ssh agent socket used by agent auth.
+const authAgentSocketEnv = "SSH_AUTH_SOCK"
+
+func newAuthMethod(auth *pb.Auth) (ssh.AuthMethod, error) {
+	auth, err := openAuth(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	switch auth.GetType() {
+	case AuthTypePassword:
+		return ssh.Password(auth.Credential), nil
+	case AuthTypePrivateKey:
+		signer, err := ssh.ParsePrivateKey([]byte(auth.Credential))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key, error: %v", err)
+		}
+		return ssh.PublicKeys(signer), nil
+	case AuthTypePrivateKeyWithPassphrase:
+		signer, err := parsePrivateKey(auth.Credential, auth.Passphrase)
+		if err != nil {
+			return nil, err
+		}
+		return ssh.PublicKeys(signer), nil
+	case AuthTypeCertificate:
+		signer, err := newCertSigner(auth)
+		if err != nil {
+			return nil, err
+		}
+		return ssh.PublicKeys(signer), nil
+	case AuthTypeAgent:
+		socket := os.Getenv(authAgentSocketEnv)
+		if socket == "" {
+			return nil, fmt.Errorf("%v is not set, no ssh agent to use", authAgentSocketEnv)
+		}
+		return ssh.PublicKeysCallback(agentSigners(socket)), nil
+	default:
+		return nil, fmt.Errorf("unrecognized auth type: %v", auth.GetType())
+	}
+}
+
+// openAuth returns a copy of the auth whose credential and passphrase are opened by the vault if they're sealed.
+func openAuth(auth *pb.Auth) (*pb.Auth, error) {
+	if !auth.GetSealed() {
+		return auth, nil
+	}
+
+	credential, err := vault.Open(auth.Credential)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credential, error: %v", err)
+	}
+	passphrase, err := vault.Open(auth.Passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open passphrase, error: %v", err)
+	}
+
+	return &pb.Auth{
+		Type:        auth.Type,
+		Credential:  credential,
+		Username:    auth.Username,
+		Passphrase:  passphrase,
+		Certificate: auth.Certificate,
+	}, nil
+}
+
+func parsePrivateKey(privateKey, passphrase string) (ssh.Signer, error) {
+	if passphrase == "" {
+		signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key, error: %v", err)
+		}
+		return signer, nil
+	}
+
+	signer, err := ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key with passphrase, error: %v", err)
+	}
+	return signer, nil
+}
+
+// newCertSigner returns a signer which presents the user certificate signed by the CA with the private key.
+func newCertSigner(auth *pb.Auth) (ssh.Signer, error) {
+	signer, err := parsePrivateKey(auth.Credential, auth.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(auth.Certificate))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate, error: %v", err)
+	}
+	cert, ok := publicKey.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a certificate", publicKey.Type())
+	}
+
+	certSigner, err := ssh.NewCertSigner(cert, signer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use certificate, error: %v", err)
+	}
+	return certSigner, nil
+}
+
+// agentSigners lists the keys held by the agent listening on the socket, the agent is dialed
+// for each operation, so no connection to the agent is left open after the handshake.
+func agentSigners(socket string) func() ([]ssh.Signer, error) {
+	return func() ([]ssh.Signer, error) {
+		conn, err := net.Dial("unix", socket)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect ssh agent, error: %v", err)
+		}
+		defer conn.Close()
+
+		keys, err := agent.NewClient(conn).List()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keys of ssh agent, error: %v", err)
+		}
+
+		signers := make([]ssh.Signer, 0, len(keys))
+		for _, key := range keys {
+			publicKey, err := ssh.ParsePublicKey(key.Marshal())
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse key of ssh agent, error: %v", err)
+			}
+			signers = append(signers, &agentSigner{socket: socket, publicKey: publicKey})
+		}
+		return signers, nil
+	}
+}
+
+type agentSigner struct {
+	socket    string
+	publicKey ssh.PublicKey
+}
+
+func (s *agentSigner) PublicKey() ssh.PublicKey {
+	return s.publicKey
+}
+
+func (s *agentSigner) Sign(rand io.Reader, data []byte) (*ssh.Signature, error) {
+	conn, err := net.Dial("unix", s.socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect ssh agent, error: %v", err)
+	}
+	defer conn.Close()
+
+	return agent.NewClient(conn).Sign(s.publicKey, data)
+}
@@ -0,0 +1,170 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+func newEncryptedPrivateKey(t *testing.T, passphrase string) (*rsa.PrivateKey, string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NoError(t, err)
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(privateKey),
+		[]byte(passphrase), x509.PEMCipherAES256)
+	assert.NoError(t, err)
+	return privateKey, string(pem.EncodeToMemory(block))
+}
+
+func TestNewAuthMethodWithPassphrase(t *testing.T) {
+	_, privateKey := newEncryptedPrivateKey(t, "passphrase")
+
+	_, err := newAuthMethod(&pb.Auth{Type: AuthTypePrivateKey, Credential: privateKey})
+	assert.Error(t, err)
+
+	_, err = newAuthMethod(&pb.Auth{Type: AuthTypePrivateKeyWithPassphrase, Credential: privateKey, Passphrase: "wrong"})
+	assert.Error(t, err)
+
+	_, err = newAuthMethod(&pb.Auth{Type: AuthTypePrivateKeyWithPassphrase, Credential: privateKey, Passphrase: "passphrase"})
+	assert.NoError(t, err)
+}
+
+func TestOpenAuth(t *testing.T) {
+	masterKey, err := vault.GenerateMasterKey()
+	assert.NoError(t, err)
+	v, err := vault.New(masterKey)
+	assert.NoError(t, err)
+	_, privateKey := newEncryptedPrivateKey(t, "passphrase")
+	sealedPrivateKey, err := v.Seal(privateKey)
+	assert.NoError(t, err)
+	sealedPassphrase, err := v.Seal("passphrase")
+	assert.NoError(t, err)
+
+	auth := &pb.Auth{
+		Type:       AuthTypePrivateKeyWithPassphrase,
+		Credential: sealedPrivateKey,
+		Passphrase: sealedPassphrase,
+		Sealed:     true,
+	}
+
+	// no master key to open the credential
+	_, err = newAuthMethod(auth)
+	assert.Error(t, err)
+
+	vault.SetDefault(v)
+	defer vault.SetDefault(nil)
+
+	opened, err := openAuth(auth)
+	assert.NoError(t, err)
+	assert.Equal(t, privateKey, opened.Credential)
+	assert.Equal(t, "passphrase", opened.Passphrase)
+	assert.False(t, opened.Sealed)
+	// the auth is not changed
+	assert.Equal(t, sealedPrivateKey, auth.Credential)
+
+	_, err = newAuthMethod(auth)
+	assert.NoError(t, err)
+}
+
+func TestNewCertSigner(t *testing.T) {
+	key, privateKey := newEncryptedPrivateKey(t, "passphrase")
+	publicKey, err := ssh.NewPublicKey(&key.PublicKey)
+	assert.NoError(t, err)
+
+	_, caKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromKey(caKey)
+	assert.NoError(t, err)
+	cert := &ssh.Certificate{
+		Key:             publicKey,
+		CertType:        ssh.UserCert,
+		KeyId:           "kpaas",
+		ValidPrincipals: []string{"root"},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	assert.NoError(t, cert.SignCert(rand.Reader, caSigner))
+
+	auth := &pb.Auth{
+		Type:        AuthTypeCertificate,
+		Credential:  privateKey,
+		Passphrase:  "passphrase",
+		Certificate: string(ssh.MarshalAuthorizedKey(cert)),
+	}
+	signer, err := newCertSigner(auth)
+	if assert.NoError(t, err) {
+		assert.Equal(t, cert.Marshal(), signer.PublicKey().Marshal())
+	}
+
+	// a plain public key is not a certificate
+	auth.Certificate = string(ssh.MarshalAuthorizedKey(publicKey))
+	_, err = newCertSigner(auth)
+	assert.Error(t, err)
+}
+
+func TestAgentSigners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "agent")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	socket := filepath.Join(dir, "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	keyring := agent.NewKeyring()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	assert.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: key}))
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				agent.ServeAgent(keyring, conn)
+			}()
+		}
+	}()
+
+	signers, err := agentSigners(socket)()
+	assert.NoError(t, err)
+	if assert.Len(t, signers, 1) {
+		signature, err := signers[0].Sign(rand.Reader, []byte("data"))
+		assert.NoError(t, err)
+		assert.NoError(t, signers[0].PublicKey().Verify([]byte("data"), signature))
+	}
+
+	_, err = newAuthMethod(&pb.Auth{Type: AuthTypeAgent})
+	if os.Getenv(authAgentSocketEnv) == "" {
+		assert.Error(t, err)
+	}
+}
@@ -15,7 +15,9 @@
 package ssh
 
 import (
+	"errors"
 	"fmt"
+	"net"
 	"time"
 
 	"golang.org/x/crypto/ssh"
@@ -27,45 +29,156 @@ const (
 	defaultTimeout = 60 * time.Second
 )
 
-func newConfig(user string, auth *pb.Auth) (*ssh.ClientConfig, error) {
-	var authMethod ssh.AuthMethod
+// errHostKeyScanned is used to stop the handshake once the host key is got.
+var errHostKeyScanned = errors.New("host key scanned")
 
-	switch {
-	case auth.Type == "password":
-		authMethod = ssh.Password(auth.Credential)
-	case auth.Type == "privatekey":
-		privateKey, err := ssh.ParsePrivateKey([]byte(auth.Credential))
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse private key: %v, error: %v", auth.Credential, err)
+// HostKeyMismatchedError represents the host key presented by a node doesn't match the pinned one,
+// the host key may be rotated or the host may be spoofed.
+type HostKeyMismatchedError struct {
+	Host     string
+	Expected string
+	Actual   string
+}
+
+func (e *HostKeyMismatchedError) Error() string {
+	return fmt.Sprintf("host key of %v mismatched: the pinned fingerprint is %v, but the presented one is %v",
+		e.Host, e.Expected, e.Actual)
+}
+
+// HostKeyNotPinnedError represents there is no pinned host key for a host, the host is refused so that
+// no credential is sent to a host which is never verified.
+type HostKeyNotPinnedError struct {
+	Host string
+}
+
+func (e *HostKeyNotPinnedError) Error() string {
+	return fmt.Sprintf("host key of %v is not pinned, test the connection to pin it", e.Host)
+}
+
+// hostKeyCallback returns a callback which verifies the host key against the pinned fingerprint,
+// the host is refused if there is no pinned fingerprint.
+func hostKeyCallback(fingerprint string) ssh.HostKeyCallback {
+	if fingerprint == "" {
+		return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return &HostKeyNotPinnedError{Host: hostname}
+		}
+	}
+
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		if actual := ssh.FingerprintSHA256(key); actual != fingerprint {
+			return &HostKeyMismatchedError{
+				Host:     hostname,
+				Expected: fingerprint,
+				Actual:   actual,
+			}
+		}
+		return nil
+	}
+}
+
+// trustOnFirstUse returns a callback which trusts the host key if there is no pinned fingerprint, otherwise
+// verifies it against the pinned one. The fingerprint of the presented host key is stored in presented.
+func trustOnFirstUse(fingerprint string, presented *string) ssh.HostKeyCallback {
+	verify := hostKeyCallback(fingerprint)
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		*presented = ssh.FingerprintSHA256(key)
+		if fingerprint == "" {
+			return nil
 		}
+		return verify(hostname, remote, key)
+	}
+}
 
-		authMethod = ssh.PublicKeys(privateKey)
-	default:
-		return nil, fmt.Errorf("unrecognized auth type: %v", auth.Type)
+func newConfig(user string, auth *pb.Auth, callback ssh.HostKeyCallback) (*ssh.ClientConfig, error) {
+	authMethod, err := newAuthMethod(auth)
+	if err != nil {
+		return nil, err
 	}
 
 	return &ssh.ClientConfig{
 		User:            user,
 		Auth:            []ssh.AuthMethod{authMethod},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		HostKeyCallback: callback,
 		Timeout:         defaultTimeout,
 	}, nil
 }
 
+// NewClient connects the host through the jump hosts in sshConfig if there are, the connections to the
+// jump hosts are closed after the client is closed. A *HopError is returned if a hop can't be connected.
 func NewClient(user string, host string, sshConfig *pb.SSH) (*ssh.Client, error) {
-	config, err := newConfig(user, sshConfig.Auth)
+	config, err := newConfig(user, sshConfig.Auth, hostKeyCallback(sshConfig.HostKeyFingerprint))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ssh client config: %v, error: %v", sshConfig, err)
 	}
 
-	client, err := ssh.Dial("tcp", fmt.Sprintf("%v:%v", host, sshConfig.Port), config)
+	address := fmt.Sprintf("%v:%v", host, sshConfig.Port)
+	if err := checkHostKeysPinned(address, sshConfig); err != nil {
+		return nil, err
+	}
+
+	jumpClients, _, err := dialJumpHosts(sshConfig.GetJumpHosts(), false)
 	if err != nil {
-		return nil, fmt.Errorf("failed to dial: %v, error: %v", host, err)
+		return nil, err
 	}
 
+	client, err := dialThrough(lastClient(jumpClients), address, config)
+	if err != nil {
+		closeClients(jumpClients)
+		return nil, &HopError{Address: address, Err: err}
+	}
+	closeWith(client, jumpClients)
+
 	return client, nil
 }
 
+// checkHostKeysPinned makes sure the host keys of the host and the jump hosts are pinned before any of them
+// is dialed, a *HopError wrapping a *HostKeyNotPinnedError is returned for the first hop without pinned host key.
+func checkHostKeysPinned(address string, sshConfig *pb.SSH) error {
+	for _, jumpHost := range sshConfig.GetJumpHosts() {
+		if jumpHost.GetHostKeyFingerprint() == "" {
+			jumpAddress := fmt.Sprintf("%v:%v", jumpHost.GetIp(), jumpHost.GetPort())
+			return &HopError{Address: jumpAddress, JumpHost: true, Err: &HostKeyNotPinnedError{Host: jumpAddress}}
+		}
+	}
+	if sshConfig.GetHostKeyFingerprint() == "" {
+		return &HopError{Address: address, Err: &HostKeyNotPinnedError{Host: address}}
+	}
+
+	return nil
+}
+
+// ScanHostKeyFingerprints returns the SHA256 fingerprint of the host key presented by the host, it
+// stops before the authentication, so no credential is sent to the host. The jump hosts in sshConfig
+// are authenticated to reach the host, a jump host without pinned host key is trusted on first use,
+// and the fingerprints presented by the jump hosts are returned in order.
+func ScanHostKeyFingerprints(host string, sshConfig *pb.SSH) (string, []string, error) {
+	var fingerprint string
+	config := &ssh.ClientConfig{
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			fingerprint = ssh.FingerprintSHA256(key)
+			return errHostKeyScanned
+		},
+		Timeout: defaultTimeout,
+	}
+
+	jumpClients, jumpHostFingerprints, err := dialJumpHosts(sshConfig.GetJumpHosts(), true)
+	if err != nil {
+		return "", nil, err
+	}
+	defer closeClients(jumpClients)
+
+	address := fmt.Sprintf("%v:%v", host, sshConfig.GetPort())
+	client, err := dialThrough(lastClient(jumpClients), address, config)
+	if err == nil {
+		client.Close()
+	}
+	if fingerprint == "" {
+		return "", nil, &HopError{Address: address, Err: fmt.Errorf("failed to scan host key, error: %v", err)}
+	}
+
+	return fingerprint, jumpHostFingerprints, nil
+}
+
 func NewSession(client *ssh.Client) (*ssh.Session, error) {
 	session, err := client.NewSession()
 	if err != nil {
@@ -0,0 +1,118 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ssh
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestHostKeyCallback(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(publicKey)
+	assert.NoError(t, err)
+
+	// the host is refused if there is no pinned host key
+	err = hostKeyCallback("")("10.1.1.1:22", nil, hostKey)
+	assert.IsType(t, new(HostKeyNotPinnedError), err)
+
+	assert.NoError(t, hostKeyCallback(ssh.FingerprintSHA256(hostKey))("10.1.1.1:22", nil, hostKey))
+
+	err = hostKeyCallback("SHA256:c3Bvb2ZlZCBob3N0IGtleQ")("10.1.1.1:22", nil, hostKey)
+	if assert.IsType(t, new(HostKeyMismatchedError), err) {
+		assert.Equal(t, ssh.FingerprintSHA256(hostKey), err.(*HostKeyMismatchedError).Actual)
+	}
+}
+
+func TestTrustOnFirstUse(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(publicKey)
+	assert.NoError(t, err)
+
+	var presented string
+	assert.NoError(t, trustOnFirstUse("", &presented)("10.1.1.1:22", nil, hostKey))
+	assert.Equal(t, ssh.FingerprintSHA256(hostKey), presented)
+
+	presented = ""
+	err = trustOnFirstUse("SHA256:c3Bvb2ZlZCBob3N0IGtleQ", &presented)("10.1.1.1:22", nil, hostKey)
+	assert.IsType(t, new(HostKeyMismatchedError), err)
+	assert.Equal(t, ssh.FingerprintSHA256(hostKey), presented)
+}
+
+func TestNewClientWithoutPinnedHostKey(t *testing.T) {
+	sshConfig := &pb.SSH{
+		Port:               22,
+		Auth:               &pb.Auth{Type: "password", Username: "root", Credential: "123456"},
+		HostKeyFingerprint: "SHA256:bW9jayBob3N0IGtleQ",
+		JumpHosts: []*pb.JumpHost{
+			{
+				Ip:   "127.0.0.1",
+				Port: 1,
+				Auth: &pb.Auth{Type: "password", Username: "root", Credential: "123456"},
+			},
+		},
+	}
+
+	// the jump host is refused before it's dialed
+	_, err := NewClient("root", "10.1.1.1", sshConfig)
+	var notPinned *HostKeyNotPinnedError
+	if assert.True(t, errors.As(err, &notPinned)) {
+		assert.Equal(t, "127.0.0.1:1", notPinned.Host)
+		assert.True(t, err.(*HopError).JumpHost)
+	}
+
+	sshConfig.JumpHosts = nil
+	sshConfig.HostKeyFingerprint = ""
+	_, err = NewClient("root", "10.1.1.1", sshConfig)
+	if assert.True(t, errors.As(err, &notPinned)) {
+		assert.Equal(t, "10.1.1.1:22", notPinned.Host)
+		assert.False(t, err.(*HopError).JumpHost)
+	}
+}
+
+func TestNewClientThroughUnreachableJumpHost(t *testing.T) {
+	sshConfig := &pb.SSH{
+		Port:               22,
+		Auth:               &pb.Auth{Type: "password", Username: "root", Credential: "123456"},
+		HostKeyFingerprint: "SHA256:bW9jayBob3N0IGtleQ",
+		JumpHosts: []*pb.JumpHost{
+			{
+				Ip:                 "127.0.0.1",
+				Port:               1,
+				Auth:               &pb.Auth{Type: "password", Username: "root", Credential: "123456"},
+				HostKeyFingerprint: "SHA256:bW9jayBob3N0IGtleQ",
+			},
+		},
+	}
+
+	_, err := NewClient("root", "10.1.1.1", sshConfig)
+	if assert.IsType(t, new(HopError), err) {
+		assert.True(t, err.(*HopError).JumpHost)
+		assert.Equal(t, "127.0.0.1:1", err.(*HopError).Address)
+	}
+
+	_, _, err = ScanHostKeyFingerprints("10.1.1.1", sshConfig)
+	if assert.IsType(t, new(HopError), err) {
+		assert.Equal(t, "127.0.0.1:1", err.(*HopError).Address)
+	}
+}
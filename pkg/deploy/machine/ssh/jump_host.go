@@ -0,0 +1,116 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package ssh
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// HopError represents a hop on the way to a node can't be connected, the hop is a jump host or the node itself.
+type HopError struct {
+	// Address is the address of the hop, such as "192.168.1.1:22"
+	Address string
+	// JumpHost indicates whether the hop is a jump host
+	JumpHost bool
+	Err      error
+}
+
+func (e *HopError) Error() string {
+	if e.JumpHost {
+		return fmt.Sprintf("failed to connect jump host %v, error: %v", e.Address, e.Err)
+	}
+	return fmt.Sprintf("failed to connect %v, error: %v", e.Address, e.Err)
+}
+
+func (e *HopError) Unwrap() error {
+	return e.Err
+}
+
+// dialJumpHosts connects the jump hosts in order, each one is dialed through the previous one. A jump host
+// without pinned host key is refused, unless trustFirstUse is set. The fingerprints presented by the jump
+// hosts are returned in order.
+func dialJumpHosts(jumpHosts []*pb.JumpHost, trustFirstUse bool) ([]*ssh.Client, []string, error) {
+	clients := make([]*ssh.Client, 0, len(jumpHosts))
+	fingerprints := make([]string, len(jumpHosts))
+	for i, jumpHost := range jumpHosts {
+		address := fmt.Sprintf("%v:%v", jumpHost.GetIp(), jumpHost.GetPort())
+		callback := hostKeyCallback(jumpHost.GetHostKeyFingerprint())
+		if trustFirstUse {
+			callback = trustOnFirstUse(jumpHost.GetHostKeyFingerprint(), &fingerprints[i])
+		}
+		config, err := newConfig(jumpHost.GetAuth().GetUsername(), jumpHost.GetAuth(), callback)
+		if err == nil {
+			var client *ssh.Client
+			client, err = dialThrough(lastClient(clients), address, config)
+			if err == nil {
+				clients = append(clients, client)
+				continue
+			}
+		}
+
+		closeClients(clients)
+		return nil, nil, &HopError{Address: address, JumpHost: true, Err: err}
+	}
+
+	return clients, fingerprints, nil
+}
+
+// dialThrough connects the address through the client, or directly if the client is nil.
+func dialThrough(via *ssh.Client, address string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	if via == nil {
+		return ssh.Dial("tcp", address, config)
+	}
+
+	conn, err := via.Dial("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(clientConn, chans, reqs), nil
+}
+
+func lastClient(clients []*ssh.Client) *ssh.Client {
+	if len(clients) == 0 {
+		return nil
+	}
+	return clients[len(clients)-1]
+}
+
+// closeClients closes the clients from the last one to the first one.
+func closeClients(clients []*ssh.Client) {
+	for i := len(clients) - 1; i >= 0; i-- {
+		clients[i].Close()
+	}
+}
+
+// closeWith closes the jump host clients after the client is closed.
+func closeWith(client *ssh.Client, jumpClients []*ssh.Client) {
+	if len(jumpClients) == 0 {
+		return
+	}
+
+	go func() {
+		client.Wait()
+		closeClients(jumpClients)
+	}()
+}
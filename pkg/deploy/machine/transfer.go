@@ -0,0 +1,355 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
+)
+
+// The files are transferred content-addressed: the SHA-256 of the local file is compared with the remote one to
+// skip the file already present, and the content is appended to a partial file named by the SHA-256 chunk by chunk,
+// so an interrupted transfer of the same content is resumed from the last appended chunk. The partial file is moved
+// to the remote path after its SHA-256 is verified. The transfers stop at the chunk boundaries once the context is
+// done, so the canceled or timed out actions don't keep on uploading.
+
+const (
+	// DefaultTransferParallelism is the number of files transferred to a machine at the same time
+	DefaultTransferParallelism = 4
+
+	partialFileSuffix = ".part"
+	chunkFileSuffix   = ".chunk"
+)
+
+var (
+	// transferChunkSize is the size of the content appended to the partial file at a time
+	transferChunkSize int64 = 32 << 20
+
+	// SHA-256 of the local files, keyed by the path, the size and the modification time
+	localHashesLock sync.Mutex
+	localHashes     = make(map[string]string)
+)
+
+// TransferFile puts the local file to the remote path content-addressed, the progress is written to progress,
+// like the ExecuteLogBuffer of an action, if it's not nil.
+func TransferFile(ctx context.Context, m IMachine, localPath, remotePath string, progress io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if progress == nil {
+		progress = ioutil.Discard
+	}
+
+	localFile, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("open %v failed, error: %v", localPath, err)
+	}
+	defer localFile.Close()
+
+	info, err := localFile.Stat()
+	if err != nil {
+		return fmt.Errorf("stat %v failed, error: %v", localPath, err)
+	}
+
+	hash, err := localFileHash(localFile, localPath, info)
+	if err != nil {
+		return err
+	}
+
+	remoteHash, partialSize, err := remoteFileState(ctx, m, remotePath, partialPath(remotePath, hash))
+	if err != nil {
+		return err
+	}
+
+	if remoteHash == hash {
+		fmt.Fprintf(progress, "%v:%v is up to date (sha256 %v), skipped\n", m.GetName(), remotePath, hash)
+		return nil
+	}
+
+	return transferContent(ctx, m, localFile, info.Size(), hash, remotePath, partialSize, progress)
+}
+
+// TransferReader puts the content read from the reader to the remote path, the progress is written to progress,
+// like the ExecuteLogBuffer of an action, if it's not nil. It's for the scripts, the certificates and the configurations
+// in memory, which are small enough to be put at once, the files on the disk such as images go by TransferFile.
+func TransferReader(ctx context.Context, m IMachine, content io.Reader, remotePath string, progress io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if progress == nil {
+		progress = ioutil.Discard
+	}
+
+	if err := ctx.Err(); err != nil {
+		fmt.Fprintf(progress, "failed to transfer %v:%v, error: %v\n", m.GetName(), remotePath, err)
+		return err
+	}
+
+	counter := &countingReader{r: content}
+	if err := m.PutFile(counter, remotePath); err != nil {
+		fmt.Fprintf(progress, "failed to transfer %v:%v, error: %v\n", m.GetName(), remotePath, err)
+		return err
+	}
+
+	fmt.Fprintf(progress, "transferred %v bytes to %v:%v\n", counter.n, m.GetName(), remotePath)
+	return nil
+}
+
+// countingReader counts the bytes read.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+// TransferDir puts the needed files in the local directory into the remote directory like PutDir, the files are
+// transferred content-addressed and in parallel.
+func TransferDir(ctx context.Context, m IMachine, localDir, remoteDir string, fileNeeded func(path string) bool, progress io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	localDir = strings.TrimPrefix(strings.TrimSuffix(localDir, "/"), "./")
+	remoteDir = strings.TrimSuffix(remoteDir, "/") + "/" + filepath.Base(localDir)
+
+	if !deploy.FileExist(localDir) {
+		return fmt.Errorf("local directory:%v doesn't exist", localDir)
+	}
+
+	var dirs []string
+	files := make(map[string]string)
+	if err := filepath.Walk(localDir, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("walk %v failed, error: %v", localPath, err)
+		}
+
+		remotePath := remoteDir + strings.TrimPrefix(localPath, localDir)
+		if info.IsDir() {
+			dirs = append(dirs, shellQuote(remotePath))
+		} else if fileNeeded(localPath) {
+			files[localPath] = remotePath
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if _, stderr, err := m.Run(ctx, "mkdir -p "+strings.Join(dirs, " ")); err != nil {
+		return fmt.Errorf("creating directories in %v:%v failed, stderr: %s, error: %v", m.GetName(), remoteDir, stderr, err)
+	}
+
+	progress = newSyncWriter(progress)
+	slots := utils.NewSemaphore(DefaultTransferParallelism)
+	errs := make(chan error, len(files))
+	var wg sync.WaitGroup
+	for localPath, remotePath := range files {
+		wg.Add(1)
+		go func(localPath, remotePath string) {
+			defer wg.Done()
+			if err := slots.Acquire(ctx); err != nil {
+				errs <- fmt.Errorf("failed to copy file:%v to %v:%v, error: %v", localPath, m.GetName(), remotePath, err)
+				return
+			}
+			defer slots.Release()
+
+			if err := TransferFile(ctx, m, localPath, remotePath, progress); err != nil {
+				errs <- fmt.Errorf("failed to copy file:%v to %v:%v, error: %v", localPath, m.GetName(), remotePath, err)
+			}
+		}(localPath, remotePath)
+	}
+	wg.Wait()
+	close(errs)
+
+	return joinErrors(errs)
+}
+
+// Distribute puts the local directory to all the machines in parallel by TransferDir, the errors of the machines are
+// returned together.
+func Distribute(ctx context.Context, machines []IMachine, localDir, remoteDir string, fileNeeded func(path string) bool, progress io.Writer) error {
+	progress = newSyncWriter(progress)
+	errs := make(chan error, len(machines))
+	var wg sync.WaitGroup
+	for _, m := range machines {
+		wg.Add(1)
+		go func(m IMachine) {
+			defer wg.Done()
+			if err := TransferDir(ctx, m, localDir, remoteDir, fileNeeded, progress); err != nil {
+				errs <- fmt.Errorf("distribute %v to %v failed, error: %v", localDir, m.GetName(), err)
+			}
+		}(m)
+	}
+	wg.Wait()
+	close(errs)
+
+	return joinErrors(errs)
+}
+
+func transferContent(ctx context.Context, m IMachine, localFile *os.File, size int64, hash, remotePath string, offset int64, progress io.Writer) error {
+	partial := partialPath(remotePath, hash)
+	chunk := partial + chunkFileSuffix
+
+	if offset > size {
+		// not a prefix of the content, start over
+		offset = 0
+	}
+	if offset > 0 {
+		fmt.Fprintf(progress, "resuming the transfer of %v:%v from %v/%v bytes\n", m.GetName(), remotePath, offset, size)
+	} else {
+		if _, stderr, err := m.Run(ctx, "mkdir -p "+shellQuote(path.Dir(remotePath))+" && : > "+shellQuote(partial)); err != nil {
+			return fmt.Errorf("create %v failed, stderr: %s, error: %v", partial, stderr, err)
+		}
+	}
+
+	for offset < size {
+		// the partial file is kept, so the transfer is resumed from here next time
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("transfer to %v:%v stopped at %v/%v bytes, error: %v", m.GetName(), remotePath, offset, size, err)
+		}
+
+		n := transferChunkSize
+		if size-offset < n {
+			n = size - offset
+		}
+
+		if err := m.PutFile(io.NewSectionReader(localFile, offset, n), chunk); err != nil {
+			return err
+		}
+		cmd := fmt.Sprintf("cat %s >> %s && rm -f %s", shellQuote(chunk), shellQuote(partial), shellQuote(chunk))
+		if _, stderr, err := m.Run(ctx, cmd); err != nil {
+			return fmt.Errorf("append %v to %v failed, stderr: %s, error: %v", chunk, partial, stderr, err)
+		}
+
+		offset += n
+		fmt.Fprintf(progress, "transferring %v to %v:%v, %v/%v bytes (%v%%)\n", localFile.Name(), m.GetName(), remotePath, offset, size, offset*100/size)
+	}
+
+	remoteHash, _, err := remoteFileState(ctx, m, partial, "")
+	if err != nil {
+		return err
+	}
+	if remoteHash != hash {
+		// the partial file is broken, it's transferred again next time, it's removed even if ctx is done
+		m.Run(context.Background(), "rm -f "+shellQuote(partial))
+		return fmt.Errorf("sha256 of %v:%v is %v, expected %v", m.GetName(), remotePath, remoteHash, hash)
+	}
+
+	if _, stderr, err := m.Run(ctx, fmt.Sprintf("mv -f %s %s", shellQuote(partial), shellQuote(remotePath))); err != nil {
+		return fmt.Errorf("move %v to %v failed, stderr: %s, error: %v", partial, remotePath, stderr, err)
+	}
+
+	fmt.Fprintf(progress, "transferred %v to %v:%v (sha256 %v)\n", localFile.Name(), m.GetName(), remotePath, hash)
+	logrus.Debugf("transferred %v to %v:%v", localFile.Name(), m.GetName(), remotePath)
+	return nil
+}
+
+// remoteFileState returns the SHA-256 of the remote file and the size of the partial file, they're empty and zero
+// if the files don't exist.
+func remoteFileState(ctx context.Context, m IMachine, remotePath, partial string) (hash string, partialSize int64, err error) {
+	cmd := fmt.Sprintf("sha256sum %s 2>/dev/null", shellQuote(remotePath))
+	if partial != "" {
+		cmd += fmt.Sprintf("; stat -c 'size %%s' %s 2>/dev/null", shellQuote(partial))
+	}
+	stdout, stderr, err := m.Run(ctx, cmd+"; true")
+	if err != nil {
+		return "", 0, fmt.Errorf("check %v:%v failed, stderr: %s, error: %v", m.GetName(), remotePath, stderr, err)
+	}
+
+	for _, line := range strings.Split(string(stdout), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 2 && fields[0] == "size":
+			partialSize, _ = strconv.ParseInt(fields[1], 10, 64)
+		case len(fields) >= 1 && len(fields[0]) == sha256.Size*2:
+			hash = fields[0]
+		}
+	}
+	return hash, partialSize, nil
+}
+
+// partialPath returns the path of the partial file of the content, which is hidden in the same directory.
+func partialPath(remotePath, hash string) string {
+	return path.Join(path.Dir(remotePath), "."+path.Base(remotePath)+"."+hash[:16]+partialFileSuffix)
+}
+
+// localFileHash returns the SHA-256 of the local file, it's computed only once for the same file content.
+func localFileHash(file *os.File, localPath string, info os.FileInfo) (string, error) {
+	key := fmt.Sprintf("%v:%v:%v", localPath, info.Size(), info.ModTime().UnixNano())
+
+	localHashesLock.Lock()
+	hash, ok := localHashes[key]
+	localHashesLock.Unlock()
+	if ok {
+		return hash, nil
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, io.NewSectionReader(file, 0, info.Size())); err != nil {
+		return "", fmt.Errorf("compute sha256 of %v failed, error: %v", localPath, err)
+	}
+	hash = hex.EncodeToString(hasher.Sum(nil))
+
+	localHashesLock.Lock()
+	localHashes[key] = hash
+	localHashesLock.Unlock()
+	return hash, nil
+}
+
+func joinErrors(errs <-chan error) error {
+	var messages []string
+	for err := range errs {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%v", strings.Join(messages, "; "))
+}
+
+// syncWriter serializes the writes of the progress from the parallel transfers
+type syncWriter struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+func newSyncWriter(w io.Writer) io.Writer {
+	if w == nil {
+		return ioutil.Discard
+	}
+	return &syncWriter{w: w}
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.w.Write(p)
+}
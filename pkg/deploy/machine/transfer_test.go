@@ -0,0 +1,199 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package machine
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferFile(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer func(size int64) { transferChunkSize = size }(transferChunkSize)
+	transferChunkSize = 4
+
+	content := []byte("0123456789")
+	localPath := filepath.Join(dir, "local", "bundle")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+	assert.NoError(t, ioutil.WriteFile(localPath, content, 0644))
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+	remotePath := filepath.Join(dir, "remote", "bundle")
+
+	progress := new(bytes.Buffer)
+	assert.NoError(t, TransferFile(context.Background(), m, localPath, remotePath, progress))
+	transferred, err := ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, transferred)
+	assert.Contains(t, progress.String(), "4/10 bytes (40%)")
+	assert.Contains(t, progress.String(), "10/10 bytes (100%)")
+	_, err = os.Stat(partialPath(remotePath, hash))
+	assert.True(t, os.IsNotExist(err))
+
+	// the file already present is skipped
+	progress.Reset()
+	assert.NoError(t, TransferFile(context.Background(), m, localPath, remotePath, progress))
+	assert.Contains(t, progress.String(), "is up to date")
+	assert.NotContains(t, progress.String(), "bytes")
+
+	// the partial upload is resumed
+	assert.NoError(t, os.Remove(remotePath))
+	assert.NoError(t, ioutil.WriteFile(partialPath(remotePath, hash), content[:8], 0644))
+	progress.Reset()
+	assert.NoError(t, TransferFile(context.Background(), m, localPath, remotePath, progress))
+	assert.Contains(t, progress.String(), "resuming the transfer of local:"+remotePath+" from 8/10 bytes")
+	assert.NotContains(t, progress.String(), "4/10 bytes")
+	transferred, err = ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, transferred)
+
+	// a broken partial file is removed to start over next time
+	assert.NoError(t, os.Remove(remotePath))
+	assert.NoError(t, ioutil.WriteFile(partialPath(remotePath, hash), []byte("broken"), 0644))
+	assert.Error(t, TransferFile(context.Background(), m, localPath, remotePath, nil))
+	_, err = os.Stat(partialPath(remotePath, hash))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, TransferFile(context.Background(), m, localPath, remotePath, nil))
+	transferred, err = ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, transferred)
+}
+
+// cancelWriter cancels the transfer once the progress is written.
+type cancelWriter struct {
+	cancel context.CancelFunc
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	w.cancel()
+	return len(p), nil
+}
+
+func TestTransferFileCanceled(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	defer func(size int64) { transferChunkSize = size }(transferChunkSize)
+	transferChunkSize = 4
+
+	content := []byte("0123456789")
+	localPath := filepath.Join(dir, "local", "bundle")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+	assert.NoError(t, ioutil.WriteFile(localPath, content, 0644))
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+	remotePath := filepath.Join(dir, "remote", "bundle")
+
+	// the transfer stops after the first chunk, and the partial file is kept
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err = TransferFile(ctx, m, localPath, remotePath, &cancelWriter{cancel: cancel})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "stopped at 4/10 bytes")
+	partial, err := ioutil.ReadFile(partialPath(remotePath, hash))
+	assert.NoError(t, err)
+	assert.Equal(t, content[:4], partial)
+
+	// nothing is transferred once the context is done
+	assert.Error(t, TransferReader(ctx, m, bytes.NewReader([]byte("echo ok")), filepath.Join(dir, "script.sh"), nil))
+	_, err = os.Stat(filepath.Join(dir, "script.sh"))
+	assert.True(t, os.IsNotExist(err))
+
+	// the transfer is resumed from the partial file
+	progress := new(bytes.Buffer)
+	assert.NoError(t, TransferFile(context.Background(), m, localPath, remotePath, progress))
+	assert.Contains(t, progress.String(), "from 4/10 bytes")
+	transferred, err := ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, content, transferred)
+}
+
+func TestTransferReader(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	remotePath := filepath.Join(dir, "script.sh")
+	progress := new(bytes.Buffer)
+	assert.NoError(t, TransferReader(context.Background(), m, bytes.NewReader([]byte("echo ok")), remotePath, progress))
+	transferred, err := ioutil.ReadFile(remotePath)
+	assert.NoError(t, err)
+	assert.Equal(t, "echo ok", string(transferred))
+	assert.Equal(t, "transferred 7 bytes to local:"+remotePath+"\n", progress.String())
+
+	// the progress is optional
+	assert.NoError(t, TransferReader(context.Background(), m, bytes.NewReader([]byte("echo ok")), remotePath, nil))
+}
+
+func TestTransferDir(t *testing.T) {
+	m := newTestLocalMachine(t)
+	defer m.Close()
+
+	dir, err := ioutil.TempDir("", "transfer")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	localDir := filepath.Join(dir, "bundle")
+	files := map[string]string{
+		"bin/kubeadm":      "kubeadm",
+		"images/pause.tar": "pause",
+		"images/skip.tar":  "skip",
+		"empty":            "",
+	}
+	for name, content := range files {
+		localPath := filepath.Join(localDir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+		assert.NoError(t, ioutil.WriteFile(localPath, []byte(content), 0644))
+	}
+	assert.NoError(t, os.MkdirAll(filepath.Join(localDir, "charts"), 0755))
+
+	fileNeeded := func(path string) bool { return filepath.Base(path) != "skip.tar" }
+	progress := new(bytes.Buffer)
+	assert.NoError(t, Distribute(context.Background(), []IMachine{m}, localDir, filepath.Join(dir, "remote"), fileNeeded, progress))
+
+	for name, content := range files {
+		transferred, err := ioutil.ReadFile(filepath.Join(dir, "remote", "bundle", name))
+		if name == "images/skip.tar" {
+			assert.True(t, os.IsNotExist(err))
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, content, string(transferred))
+	}
+	assert.DirExists(t, filepath.Join(dir, "remote", "bundle", "charts"))
+	assert.Contains(t, progress.String(), "transferred "+filepath.Join(localDir, "bin/kubeadm"))
+
+	assert.Error(t, TransferDir(context.Background(), m, filepath.Join(dir, "not-exist"), filepath.Join(dir, "remote"), fileNeeded, nil))
+}
@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
@@ -27,7 +28,7 @@ type CheckKernelOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckKernelOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckKernelOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -44,7 +45,7 @@ func (ckops *CheckKernelOperation) RunCommands(config *pb.NodeCheckConfig, logCh
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	// write buffer to channel
 	logChan <- itemBuffer
@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
@@ -33,7 +34,7 @@ type CheckPortOccupiedOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckPortOccupiedOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckPortOccupiedOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -50,7 +51,7 @@ func (ckops *CheckPortOccupiedOperation) RunCommands(config *pb.NodeCheckConfig,
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, checkRemoteScriptPath+portOccupiedScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, checkRemoteScriptPath+portOccupiedScript, itemBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -71,7 +72,7 @@ func (ckops *CheckPortOccupiedOperation) RunCommands(config *pb.NodeCheckConfig,
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	// write buffer to channel
 	logChan <- itemBuffer
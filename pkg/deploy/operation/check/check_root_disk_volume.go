@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
@@ -27,7 +28,7 @@ type CheckRootDiskOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckRootDiskOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckRootDiskOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -43,7 +44,7 @@ func (ckops *CheckRootDiskOperation) RunCommands(config *pb.NodeCheckConfig, log
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	logChan <- itemBuffer
 
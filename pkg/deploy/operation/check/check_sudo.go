@@ -0,0 +1,65 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const rootUID = "0"
+
+type CheckSudoOperation struct {
+	shellCmd *command.ShellCommand
+}
+
+// RunCommands prints the uid of the user running commands on the node, the commands are run
+// through the privilege escalation of the node, so it's 0 if the user has root privilege.
+func (ckops *CheckSudoOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+
+	itemBuffer := &bytes.Buffer{}
+
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer m.Close()
+
+	ckops.shellCmd = command.NewShellCommand(m, "id", "-u").
+		WithDescription("检查机器是否具有 root 权限").
+		WithExecuteLogWriter(itemBuffer)
+
+	// run commands
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
+
+	logChan <- itemBuffer
+
+	return
+}
+
+// check if commands are run as root
+func CheckSudo(uid string) error {
+	if strings.TrimSpace(uid) != rootUID {
+		return fmt.Errorf("commands are run by user %v rather than root", uid)
+	}
+	return nil
+}
@@ -12,8 +12,35 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package consts
+package check
 
-const (
-	KubernetesToken = "4a996f.8f1da0db96f8e50e"
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
+
+func TestCheckSudo(t *testing.T) {
+	testSample := []struct {
+		uid  string
+		want error
+	}{
+		{
+			uid:  "0",
+			want: nil,
+		},
+		{
+			uid:  "1000",
+			want: fmt.Errorf("commands are run by user 1000 rather than root"),
+		},
+		{
+			uid:  "",
+			want: fmt.Errorf("commands are run by user  rather than root"),
+		},
+	}
+
+	for _, eachValue := range testSample {
+		assert.Equal(t, eachValue.want, CheckSudo(eachValue.uid))
+	}
+}
@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -36,7 +37,7 @@ type CheckDistributionOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckDistributionOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckDistributionOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -52,7 +53,7 @@ func (ckops *CheckDistributionOperation) RunCommands(config *pb.NodeCheckConfig,
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	logChan <- itemBuffer
 
@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
@@ -27,7 +28,7 @@ type CheckSystemManagerOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckSystemManagerOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckSystemManagerOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -43,7 +44,7 @@ func (ckops *CheckSystemManagerOperation) RunCommands(config *pb.NodeCheckConfig
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	logChan <- itemBuffer
 
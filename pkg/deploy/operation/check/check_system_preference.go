@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
@@ -31,7 +32,7 @@ type CheckSysPrefOperation struct {
 	shellCmd *command.ShellCommand
 }
 
-func (ckops *CheckSysPrefOperation) RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (ckops *CheckSysPrefOperation) RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	itemBuffer := &bytes.Buffer{}
 
@@ -48,7 +49,7 @@ func (ckops *CheckSysPrefOperation) RunCommands(config *pb.NodeCheckConfig, logC
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, checkRemoteScriptPath+sysPrefScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, checkRemoteScriptPath+sysPrefScript, itemBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -57,7 +58,7 @@ func (ckops *CheckSysPrefOperation) RunCommands(config *pb.NodeCheckConfig, logC
 		WithExecuteLogWriter(itemBuffer)
 
 	// run commands
-	stdOut, stdErr, err = ckops.shellCmd.Execute()
+	stdOut, stdErr, err = ckops.shellCmd.WithContext(ctx).Execute()
 
 	logChan <- itemBuffer
 
@@ -16,6 +16,7 @@ package check
 
 import (
 	"bytes"
+	"context"
 
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
@@ -27,7 +28,7 @@ type OperationsGenerator struct {
 }
 
 type CheckOperation interface {
-	RunCommands(config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) ([]byte, []byte, error)
+	RunCommands(ctx context.Context, config *pb.NodeCheckConfig, logChan chan<- *bytes.Buffer) ([]byte, []byte, error)
 }
 
 const (
@@ -41,6 +42,7 @@ const (
 	SystemPreference      ItemEnum = "system-preference"
 	SystemManager         ItemEnum = "system-manager"
 	PortOccupied          ItemEnum = "port-occupied"
+	Sudo                  ItemEnum = "sudo"
 )
 
 func NewCheckOperations() *OperationsGenerator {
@@ -67,6 +69,8 @@ func (og *OperationsGenerator) CreateOperations(item ItemEnum) CheckOperation {
 		return &CheckSystemManagerOperation{}
 	case PortOccupied:
 		return &CheckPortOccupiedOperation{}
+	case Sudo:
+		return &CheckSudoOperation{}
 	default:
 		return nil
 	}
@@ -15,17 +15,21 @@
 package operation
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -55,6 +59,9 @@ const (
 
 const (
 	InitRemoteScriptPath = "/tmp"
+
+	// drainInterval is the interval to check and evict the remaining pods when draining a node.
+	drainInterval = 5 * time.Second
 )
 
 type NodeInitAction struct {
@@ -289,6 +296,50 @@ func GetKubeClient(masterNode *pb.Node) (*kubernetes.Clientset, error) {
 
 }
 
+// RequestCluster sends the request to the cluster by the first master, the request is skipped if
+// the master is simulated since there is no cluster to request.
+func RequestCluster(masterNodes []*pb.Node, request func(master *pb.Node) error) error {
+	if len(masterNodes) == 0 {
+		return fmt.Errorf("no master to access the cluster")
+	}
+
+	master := masterNodes[0]
+	m, err := machine.NewMachine(master)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if machine.IsSimulated(m) {
+		logrus.Debugf("master:%v is simulated, skipping the request to the cluster", master.GetName())
+		return nil
+	}
+
+	return request(master)
+}
+
+// CheckControlPlaneVersion checks the running control plane is at the kubernetes version, so the nodes
+// joining the cluster at the version work with it.
+func CheckControlPlaneVersion(masterNodes []*pb.Node, version string) error {
+	return RequestCluster(masterNodes, func(master *pb.Node) error {
+		clientset, err := GetKubeClient(master)
+		if err != nil {
+			return err
+		}
+
+		serverVersion, err := clientset.Discovery().ServerVersion()
+		if err != nil {
+			return fmt.Errorf("failed to get the version of the control plane, error:%v", err)
+		}
+
+		controlPlaneVersion := strings.TrimPrefix(serverVersion.GitVersion, "v")
+		if err := CheckVersion(controlPlaneVersion, strings.TrimPrefix(version, "v"), CheckEqual); err != nil {
+			return fmt.Errorf("the control plane is at version %v, %v", controlPlaneVersion, err)
+		}
+		return nil
+	})
+}
+
 func fetchKubeConfig(masterNode *pb.Node) (localKubeConfigPath string, err error) {
 	m, err := machine.NewMachine(masterNode)
 	if err != nil {
@@ -339,3 +390,194 @@ func Untaint(hostname string, tartgetTaint corev1.Taint, masterNode *pb.Node) er
 
 	return nil
 }
+
+// Cordon marks the node unschedulable, it's done if the node doesn't exist.
+func Cordon(hostname string, masterNode *pb.Node) error {
+	clientset, err := GetKubeClient(masterNode)
+	if err != nil {
+		return err
+	}
+
+	return cordon(clientset, hostname)
+}
+
+func cordon(clientset kubernetes.Interface, hostname string) error {
+	node, err := clientset.CoreV1().Nodes().Get(hostname, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if node.Spec.Unschedulable {
+		return nil
+	}
+
+	node.Spec.Unschedulable = true
+	if _, err := clientset.CoreV1().Nodes().Update(node); err != nil {
+		logrus.Errorf("failed to cordon node:%v, error:%v", hostname, err)
+		return err
+	}
+
+	return nil
+}
+
+// Uncordon marks the node schedulable.
+func Uncordon(hostname string, masterNode *pb.Node) error {
+	clientset, err := GetKubeClient(masterNode)
+	if err != nil {
+		return err
+	}
+
+	node, err := clientset.CoreV1().Nodes().Get(hostname, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if !node.Spec.Unschedulable {
+		return nil
+	}
+
+	node.Spec.Unschedulable = false
+	if _, err := clientset.CoreV1().Nodes().Update(node); err != nil {
+		logrus.Errorf("failed to uncordon node:%v, error:%v", hostname, err)
+		return err
+	}
+
+	return nil
+}
+
+// Drain cordons the node and evicts its pods except the ones of daemon sets and the mirror pods,
+// it waits for the evicted pods to be deleted until ctx is done. The terminating pods of a node
+// which is not ready are not waited for, since they are never deleted until the node comes back.
+func Drain(ctx context.Context, hostname string, masterNode *pb.Node) error {
+	clientset, err := GetKubeClient(masterNode)
+	if err != nil {
+		return err
+	}
+
+	return drain(ctx, clientset, hostname)
+}
+
+func drain(ctx context.Context, clientset kubernetes.Interface, hostname string) error {
+	if err := cordon(clientset, hostname); err != nil {
+		return err
+	}
+
+	for {
+		ready, err := isNodeReady(clientset, hostname)
+		if err != nil {
+			return err
+		}
+
+		pods, err := podsToEvict(clientset, hostname, !ready)
+		if err != nil {
+			return err
+		}
+		if len(pods) == 0 {
+			return nil
+		}
+
+		for _, pod := range pods {
+			// the pod is being deleted, it's waited for.
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			eviction := &policyv1beta1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod.Name,
+					Namespace: pod.Namespace,
+				},
+			}
+			// the eviction may be refused by a pod disruption budget for now, it's tried again later.
+			err := clientset.PolicyV1beta1().Evictions(pod.Namespace).Evict(eviction)
+			if err != nil && !errors.IsNotFound(err) && !errors.IsTooManyRequests(err) {
+				return fmt.Errorf("failed to evict pod %v/%v, error:%v", pod.Namespace, pod.Name, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to drain node:%v, %v pods remain, error:%v", hostname, len(pods), ctx.Err())
+		case <-time.After(drainInterval):
+		}
+	}
+}
+
+// IsNodeReady returns whether the node is ready, a node which doesn't exist is not ready.
+func IsNodeReady(hostname string, masterNode *pb.Node) (bool, error) {
+	clientset, err := GetKubeClient(masterNode)
+	if err != nil {
+		return false, err
+	}
+
+	return isNodeReady(clientset, hostname)
+}
+
+func isNodeReady(clientset kubernetes.Interface, hostname string) (bool, error) {
+	node, err := clientset.CoreV1().Nodes().Get(hostname, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
+
+// podsToEvict returns the pods on the node which should be evicted by draining, the terminating pods
+// are skipped if skipTerminating is true like the kubectl drain does.
+func podsToEvict(clientset kubernetes.Interface, hostname string, skipTerminating bool) ([]corev1.Pod, error) {
+	podList, err := clientset.CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{"spec.nodeName": hostname}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if pod.Spec.NodeName != hostname {
+			continue
+		}
+		// the pods of daemon sets will be recreated on the node, and the mirror pods can't be evicted.
+		if _, isMirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; isMirror {
+			continue
+		}
+		if controller := metav1.GetControllerOf(&pod); controller != nil && controller.Kind == "DaemonSet" {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if skipTerminating && pod.DeletionTimestamp != nil {
+			continue
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
+
+// DeleteNode deletes the node from the cluster, it's done if the node doesn't exist.
+func DeleteNode(hostname string, masterNode *pb.Node) error {
+	clientset, err := GetKubeClient(masterNode)
+	if err != nil {
+		return err
+	}
+
+	err = clientset.CoreV1().Nodes().Delete(hostname, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		logrus.Errorf("failed to delete node:%v, error:%v", hostname, err)
+		return err
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ package config
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -56,25 +57,45 @@ func (a *AppendAnnotation) append() *pb.Error {
 		return nil
 	}
 
-	annotations := make([]string, 0, len(a.config.Cluster.GetNodeAnnotations()))
-	for annotationKey, annotationValue := range a.config.Cluster.GetNodeAnnotations() {
-		annotations = append(annotations, fmt.Sprintf("%s='%s'", annotationKey, annotationValue))
-	}
-
 	a.config.Logger.
-		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "annotations": annotations}).
+		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "annotations": a.config.Cluster.GetNodeAnnotations()}).
 		Debug("append annotation")
 
 	return operation.NewCommandRunner(a.config.ExecuteLogWriter).RunCommand(
-		command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
-			"annotate", "node", a.config.Node.GetNode().GetName(),
-			strings.Join(annotations, " "),
-		),
-		"Append annotation to node error",                                               // 节点添加Annotation错误
+		a.command(),
+		"Append annotation to node error", // 节点添加Annotation错误
 		fmt.Sprintf("append annotation to node: %s", a.config.Node.GetNode().GetName()), // 添加Annotation到 %s 节点
 	)
 }
 
+// command returns the command to append the annotations, nil means there is no annotation to append.
+func (a *AppendAnnotation) command() command.Command {
+
+	if len(a.config.Cluster.GetNodeAnnotations()) == 0 {
+		return nil
+	}
+
+	annotations := make([]string, 0, len(a.config.Cluster.GetNodeAnnotations()))
+	for annotationKey, annotationValue := range a.config.Cluster.GetNodeAnnotations() {
+		annotations = append(annotations, fmt.Sprintf("%s='%s'", annotationKey, annotationValue))
+	}
+	sort.Strings(annotations)
+
+	return command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
+		"annotate", "node", a.config.Node.GetNode().GetName(),
+		strings.Join(annotations, " "),
+	)
+}
+
+// Plan returns the commands which will be run on the master to append the annotations, it doesn't connect to any node.
+func (a *AppendAnnotation) Plan() []string {
+
+	if cmd := a.command(); cmd != nil {
+		return []string{cmd.GetCommand()}
+	}
+	return nil
+}
+
 func (a *AppendAnnotation) Execute() *pb.Error {
 
 	return a.append()
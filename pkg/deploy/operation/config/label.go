@@ -17,6 +17,7 @@ package config
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -96,25 +97,46 @@ func (a *AppendLabel) append() *pb.Error {
 		return nil
 	}
 
-	labels := make([]string, 0, len(a.labels))
-	for labelKey, labelValue := range a.labels {
-		labels = append(labels, fmt.Sprintf("%s=%s", labelKey, labelValue))
-	}
-
 	a.config.Logger.
-		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "labels": labels}).
+		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "labels": a.labels}).
 		Debugf("append labels")
 
 	return operation.NewCommandRunner(a.config.ExecuteLogWriter).RunCommand(
-		command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
-			"label", "node", a.config.Node.GetNode().GetName(),
-			strings.Join(labels, " "),
-		),
+		a.command(),
 		"Append label to node error", // 节点添加Label错误
 		fmt.Sprintf("append label to node: %s", a.config.Node.GetNode().GetName()), // 添加Label到 %s 节点
 	)
 }
 
+// command returns the command to append the labels, nil means there is no label to append.
+func (a *AppendLabel) command() command.Command {
+
+	if len(a.labels) == 0 {
+		return nil
+	}
+
+	labels := make([]string, 0, len(a.labels))
+	for labelKey, labelValue := range a.labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", labelKey, labelValue))
+	}
+	sort.Strings(labels)
+
+	return command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
+		"label", "node", a.config.Node.GetNode().GetName(),
+		strings.Join(labels, " "),
+	)
+}
+
+// Plan returns the commands which will be run on the master to append the labels, it doesn't connect to any node.
+func (a *AppendLabel) Plan() []string {
+
+	a.computeLabels()
+	if cmd := a.command(); cmd != nil {
+		return []string{cmd.GetCommand()}
+	}
+	return nil
+}
+
 func (a *AppendLabel) Execute() *pb.Error {
 
 	a.computeLabels()
@@ -52,25 +52,44 @@ func (a *AppendTaint) append() *pb.Error {
 		return nil
 	}
 
-	taints := make([]string, 0, len(a.config.Node.GetTaints()))
-	for _, taint := range a.config.Node.GetTaints() {
-		taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.GetKey(), taint.GetValue(), taint.GetEffect()))
-	}
-
 	a.config.Logger.
-		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "taints": taints}).
+		WithFields(logrus.Fields{"node": a.config.Node.GetNode().GetName(), "taints": a.config.Node.GetTaints()}).
 		Debug("append taints")
 
 	return operation.NewCommandRunner(a.config.ExecuteLogWriter).RunCommand(
-		command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
-			"taint", "node", a.config.Node.GetNode().GetName(),
-			strings.Join(taints, " "),
-		),
+		a.command(),
 		"Append taint to node error", // 节点添加Taint错误
 		fmt.Sprintf("append taint to node: %s", a.config.Node.GetNode().GetName()), // 添加Taint到 %s 节点
 	)
 }
 
+// command returns the command to append the taints, nil means there is no taint to append.
+func (a *AppendTaint) command() command.Command {
+
+	if len(a.config.Node.GetTaints()) == 0 {
+		return nil
+	}
+
+	taints := make([]string, 0, len(a.config.Node.GetTaints()))
+	for _, taint := range a.config.Node.GetTaints() {
+		taints = append(taints, fmt.Sprintf("%s=%s:%s", taint.GetKey(), taint.GetValue(), taint.GetEffect()))
+	}
+
+	return command.NewKubectlCommand(a.config.MasterMachine, consts.KubeConfigPath, "",
+		"taint", "node", a.config.Node.GetNode().GetName(),
+		strings.Join(taints, " "),
+	)
+}
+
+// Plan returns the commands which will be run on the master to append the taints, it doesn't connect to any node.
+func (a *AppendTaint) Plan() []string {
+
+	if cmd := a.command(); cmd != nil {
+		return []string{cmd.GetCommand()}
+	}
+	return nil
+}
+
 func (a *AppendTaint) Execute() *pb.Error {
 
 	return a.append()
@@ -60,10 +60,21 @@ func (operation *ApplyYAML) Execute() *pb.Error {
 		Debugf("apply yaml")
 
 	return deployOperation.NewCommandRunner(operation.config.ExecuteLogWriter).RunCommand(
-		command.NewKubectlCommand(operation.config.Node, consts.KubeConfigPath, "",
-			"apply", "-f", operation.config.FilePath,
-		),
+		operation.command(),
 		"Apply YAML error", // 应用YAML错误
 		fmt.Sprintf("Apply YAML %s", operation.config.FilePath), // 应用 %s YAML
 	)
 }
+
+func (operation *ApplyYAML) command() command.Command {
+
+	return command.NewKubectlCommand(operation.config.Node, consts.KubeConfigPath, "",
+		"apply", "-f", operation.config.FilePath,
+	)
+}
+
+// Plan returns the commands which will be run to apply the yaml file, it doesn't connect to the node.
+func (operation *ApplyYAML) Plan() []string {
+
+	return []string{operation.command().GetCommand()}
+}
@@ -15,6 +15,7 @@
 package contour
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strings"
@@ -28,6 +29,8 @@ import (
 )
 
 type WriteFileConfig struct {
+	// Context is used to put the file, the file is not put once it is done
+	Context          context.Context
 	Node             deployMachine.IMachine
 	Logger           *logrus.Entry
 	ExecuteLogWriter io.Writer
@@ -72,7 +75,7 @@ func (operation *WriteFile) Execute() *pb.Error {
 	_, _ = operation.config.ExecuteLogWriter.Write([]byte(fmt.Sprintf("filePath: %s\n", operation.config.FilePath)))
 	_, _ = operation.config.ExecuteLogWriter.Write([]byte(fmt.Sprintf("fileContent:\n%s\n\n", operation.config.FileContent)))
 
-	err := operation.config.Node.PutFile(strings.NewReader(operation.config.FileContent), operation.config.FilePath)
+	err := deployMachine.TransferReader(operation.config.Context, operation.config.Node, strings.NewReader(operation.config.FileContent), operation.config.FilePath, operation.config.ExecuteLogWriter)
 	if err != nil {
 		_, _ = operation.config.ExecuteLogWriter.Write([]byte(fmt.Sprintf("Error: %v\n", err)))
 
@@ -0,0 +1,88 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newDrainTestClientset(ready corev1.ConditionStatus) *fake.Clientset {
+	deletionTimestamp := metav1.Now()
+	return fake.NewSimpleClientset(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "terminating", Namespace: "default", DeletionTimestamp: &deletionTimestamp},
+			Spec:       corev1.PodSpec{NodeName: "node1"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-node", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: "node2"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	)
+}
+
+func TestDrainNotReadyNode(t *testing.T) {
+	clientset := newDrainTestClientset(corev1.ConditionUnknown)
+
+	ready, err := isNodeReady(clientset, "node1")
+	assert.NoError(t, err)
+	assert.False(t, ready)
+
+	// the terminating pod of the dead node is not waited for
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, drain(ctx, clientset, "node1"))
+
+	node, err := clientset.CoreV1().Nodes().Get("node1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, node.Spec.Unschedulable)
+}
+
+func TestDrainReadyNode(t *testing.T) {
+	clientset := newDrainTestClientset(corev1.ConditionTrue)
+
+	ready, err := isNodeReady(clientset, "node1")
+	assert.NoError(t, err)
+	assert.True(t, ready)
+
+	// the terminating pod of a ready node is waited for until it's deleted
+	pods, err := podsToEvict(clientset, "node1", !ready)
+	assert.NoError(t, err)
+	assert.Len(t, pods, 1)
+	assert.Equal(t, "terminating", pods[0].Name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.Error(t, drain(ctx, clientset, "node1"))
+
+	// a node which doesn't exist is not ready
+	ready, err = isNodeReady(clientset, "node3")
+	assert.NoError(t, err)
+	assert.False(t, ready)
+}
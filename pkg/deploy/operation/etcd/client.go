@@ -49,33 +49,33 @@ func newCertPool(caCrt *x509.Certificate) (*x509.CertPool, error) {
 	return certPool, nil
 }
 
-func newCert(d *deployEtcdOperation) (*tls.Certificate, error) {
-	tlsCert, err := tls.X509KeyPair(d.encodedPeerCert, d.encodedPeerKey)
+func newCert(serverName string, encodedCert, encodedKey []byte) (*tls.Certificate, error) {
+	tlsCert, err := tls.X509KeyPair(encodedCert, encodedKey)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse certificate for etcd client:%v, error: %v", d.machine.GetName(), err)
+		return nil, fmt.Errorf("failed to parse certificate for etcd client:%v, error: %v", serverName, err)
 	}
 
 	return &tlsCert, nil
 }
 
-func getClientV3TLS(d *deployEtcdOperation) (*tls.Config, error) {
+func getClientV3TLS(serverName string, caCrt *x509.Certificate, encodedCert, encodedKey []byte) (*tls.Config, error) {
 	var err error
 
 	cfg := &tls.Config{
 		MinVersion: tls.VersionTLS12,
-		ServerName: d.machine.GetName(),
+		ServerName: serverName,
 	}
 
 	cfg.GetCertificate = func(clientHello *tls.ClientHelloInfo) (*tls.Certificate, error) {
-		return newCert(d)
+		return newCert(serverName, encodedCert, encodedKey)
 	}
 	cfg.GetClientCertificate = func(unused *tls.CertificateRequestInfo) (*tls.Certificate, error) {
-		return newCert(d)
+		return newCert(serverName, encodedCert, encodedKey)
 	}
 
-	cfg.RootCAs, err = newCertPool(d.caCrt)
+	cfg.RootCAs, err = newCertPool(caCrt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get cert pool for etcd client:%v, error:%v", d.machine.GetName(), err)
+		return nil, fmt.Errorf("failed to get cert pool for etcd client:%v, error:%v", serverName, err)
 	}
 
 	return cfg, nil
@@ -89,16 +89,17 @@ func composeEndpoints(nodes []*pb.Node) (endpoints []string) {
 	return
 }
 
-func newEtcdV3SecureClient(d *deployEtcdOperation) (*clientv3.Client, error) {
+// newSecureClient returns an etcd client connecting to the nodes with the cert and key signed by the ca,
+// serverName is the name of the etcd server which the cert of the nodes are verified with.
+func newSecureClient(serverName string, caCrt *x509.Certificate, encodedCert, encodedKey []byte, nodes []*pb.Node) (*clientv3.Client, error) {
 
-	tlsCfg, err := getClientV3TLS(d)
+	tlsCfg, err := getClientV3TLS(serverName, caCrt, encodedCert, encodedKey)
 	if err != nil {
 		return nil, err
 	}
 
-	// check etcd cluster health
 	cli, err := clientv3.New(clientv3.Config{
-		Endpoints:   composeEndpoints(d.clusterNodes),
+		Endpoints:   composeEndpoints(nodes),
 		DialTimeout: defaultEtcdDialTimeout,
 		TLS:         tlsCfg,
 	})
@@ -109,3 +110,8 @@ func newEtcdV3SecureClient(d *deployEtcdOperation) (*clientv3.Client, error) {
 
 	return cli, nil
 }
+
+func newEtcdV3SecureClient(d *deployEtcdOperation) (*clientv3.Client, error) {
+	// check etcd cluster health
+	return newSecureClient(d.machine.GetName(), d.caCrt, d.encodedPeerCert, d.encodedPeerKey, d.clusterNodes)
+}
@@ -73,6 +73,8 @@ type DeployEtcdOperationConfig struct {
 	Node         *pb.Node
 	ClusterNodes []*pb.Node
 	LogWriter    io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
 }
 
 type deployEtcdOperation struct {
@@ -85,6 +87,7 @@ type deployEtcdOperation struct {
 	clusterNodes                    []*pb.Node
 	containerName                   string
 	LogWriter                       io.Writer
+	ctx                             context.Context
 }
 
 func NewDeployEtcdOperation(config *DeployEtcdOperationConfig) (*deployEtcdOperation, error) {
@@ -94,6 +97,7 @@ func NewDeployEtcdOperation(config *DeployEtcdOperationConfig) (*deployEtcdOpera
 		caKey:        config.CAKey,
 		clusterNodes: config.ClusterNodes,
 		LogWriter:    config.LogWriter,
+		ctx:          config.Context,
 	}
 	m, err := machine.NewMachine(config.Node)
 	if err != nil {
@@ -105,25 +109,51 @@ func NewDeployEtcdOperation(config *DeployEtcdOperationConfig) (*deployEtcdOpera
 	return ops, nil
 }
 
+// PlanDeployEtcd returns the files which will be put to the etcd node and the commands which will be run on it,
+// the commands run only in some cases, such as removing the existing etcd container, are not included.
+func PlanDeployEtcd(node *pb.Node, clusterNodes []*pb.Node) *pb.ActionPlan {
+	containerName := composeContainerName(node.GetName())
+	return &pb.ActionPlan{
+		Files: operation.PlanGeneratedFiles(
+			DefaultEtcdCACertPath, defaultEtcdCAKeyPath,
+			defaultEtcdServerCertPath, defaultEtcdServerKeyPath,
+			defaultEtcdPeerCertPath, defaultEtcdPeerKeyPath,
+		),
+		Commands: []string{
+			listEtcdContainerCommand(nil, containerName).GetCommand(),
+			runEtcdContainerCommand(nil, node, containerName, clusterNodes).GetCommand(),
+		},
+	}
+}
+
+func composeContainerName(nodeName string) string {
+	return fmt.Sprintf("etcd-kpaas-%v", nodeName)
+}
+
 func (d *deployEtcdOperation) composeContainerName() {
-	d.containerName = fmt.Sprintf("etcd-kpaas-%v", d.machine.GetName())
+	d.containerName = composeContainerName(d.machine.GetName())
+}
+
+// listEtcdContainerCommand returns the command to list the etcd container on the machine.
+func listEtcdContainerCommand(m machine.IMachine, containerName string) *command.ShellCommand {
+	filterArg := fmt.Sprintf("name=%v", containerName)
+
+	return command.NewShellCommand(m,
+		"docker",
+		"ps",
+		"-q",
+		"--filter",
+		filterArg,
+	)
 }
 
 func (d *deployEtcdOperation) removeExistEtcdContainer() error {
 	d.logger.Debug("start removeExistEtcdContainer")
 
-	filterArg := fmt.Sprintf("name=%v", d.containerName)
-
-	d.logger.Debugf("filterArg: %v", filterArg)
+	d.logger.Debugf("container name: %v", d.containerName)
 
 	d.AddCommands(
-		command.NewShellCommand(d.machine,
-			"docker",
-			"ps",
-			"-q",
-			"--filter",
-			filterArg,
-		).WithExecuteLogWriter(d.LogWriter),
+		listEtcdContainerCommand(d.machine, d.containerName).WithExecuteLogWriter(d.LogWriter).WithContext(d.ctx),
 	)
 
 	stdOut, stdErr, err := d.BaseOperation.Do()
@@ -149,7 +179,7 @@ func (d *deployEtcdOperation) removeExistEtcdContainer() error {
 			"rm",
 			"-f",
 			containerID,
-		).WithExecuteLogWriter(d.LogWriter),
+		).WithExecuteLogWriter(d.LogWriter).WithContext(d.ctx),
 	)
 
 	stdOut, stdErr, err = d.BaseOperation.Do()
@@ -177,10 +207,10 @@ func (d *deployEtcdOperation) PreDo() (err error) {
 		return fmt.Errorf("failed to convert key and cert to byte, error: %v", err)
 	}
 
-	if err := d.machine.PutFile(bytes.NewReader(encodedCACert), DefaultEtcdCACertPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedCACert), DefaultEtcdCACertPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put ca cert to:%v, error: %v", d.machine.GetName(), err)
 	}
-	if err := d.machine.PutFile(bytes.NewReader(encodedCAKey), defaultEtcdCAKeyPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedCAKey), defaultEtcdCAKeyPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put ca key to:%v, error: %v", d.machine.GetName(), err)
 	}
 
@@ -194,10 +224,10 @@ func (d *deployEtcdOperation) PreDo() (err error) {
 		return fmt.Errorf("failed to generation etcd server key and cert for etcd node:%v, error: %v", d.machine.GetName(), err)
 	}
 
-	if err := d.machine.PutFile(bytes.NewReader(encodedServerCert), defaultEtcdServerCertPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedServerCert), defaultEtcdServerCertPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put etcd server cert to:%v, error: %v", d.machine.GetName(), err)
 	}
-	if err := d.machine.PutFile(bytes.NewReader(encodedServerKey), defaultEtcdServerKeyPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedServerKey), defaultEtcdServerKeyPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put etcd server key to:%v, error: %v", d.machine.GetName(), err)
 	}
 
@@ -215,10 +245,10 @@ func (d *deployEtcdOperation) PreDo() (err error) {
 	d.encodedPeerCert = encodedPeerCert
 	d.encodedPeerKey = encodedPeerKey
 
-	if err := d.machine.PutFile(bytes.NewReader(encodedPeerCert), defaultEtcdPeerCertPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedPeerCert), defaultEtcdPeerCertPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put etcd peer cert to:%v, error: %v", d.machine.GetName(), err)
 	}
-	if err := d.machine.PutFile(bytes.NewReader(encodedPeerKey), defaultEtcdPeerKeyPath); err != nil {
+	if err := machine.TransferReader(d.ctx, d.machine, bytes.NewReader(encodedPeerKey), defaultEtcdPeerKeyPath, d.LogWriter); err != nil {
 		return fmt.Errorf("failed to put etcd peer key to:%v, error: %v", d.machine.GetName(), err)
 	}
 
@@ -239,6 +269,14 @@ func composeInitialClusterUrl(nodes []*pb.Node) (clusterUrl string) {
 }
 
 func (d *deployEtcdOperation) composeEtcdDockerCmd() {
+	d.AddCommands(
+		runEtcdContainerCommand(d.machine, d.machine.GetNode(), d.containerName, d.clusterNodes).
+			WithExecuteLogWriter(d.LogWriter).WithContext(d.ctx),
+	)
+}
+
+// runEtcdContainerCommand returns the command to run the etcd container of the node on the machine.
+func runEtcdContainerCommand(m machine.IMachine, node *pb.Node, containerName string, clusterNodes []*pb.Node) *command.ShellCommand {
 
 	cmd := []string{"etcd"}
 
@@ -247,7 +285,7 @@ func (d *deployEtcdOperation) composeEtcdDockerCmd() {
 
 	cmd = append(cmd, fmt.Sprintf("--snapshot-count=%v", 10000))
 
-	cmd = append(cmd, fmt.Sprintf("--name=%v", d.machine.GetName()))
+	cmd = append(cmd, fmt.Sprintf("--name=%v", node.GetName()))
 	cmd = append(cmd, fmt.Sprintf("--data-dir=%v", defaultEtcdDataDir))
 	cmd = append(cmd, fmt.Sprintf("--key-file=%v", defaultEtcdServerKeyPath))
 	cmd = append(cmd, fmt.Sprintf("--cert-file=%v", defaultEtcdServerCertPath))
@@ -256,30 +294,28 @@ func (d *deployEtcdOperation) composeEtcdDockerCmd() {
 	cmd = append(cmd, fmt.Sprintf("--trusted-ca-file=%v", DefaultEtcdCACertPath))
 	cmd = append(cmd, fmt.Sprintf("--peer-trusted-ca-file=%v", DefaultEtcdCACertPath))
 
-	cmd = append(cmd, fmt.Sprintf("--advertise-client-urls=https://%v:%v", d.machine.GetIp(), defaultEtcdServerPort))
-	cmd = append(cmd, fmt.Sprintf("--initial-advertise-peer-urls=https://%v:%v", d.machine.GetIp(), defaultEtcdPeerPort))
+	cmd = append(cmd, fmt.Sprintf("--advertise-client-urls=https://%v:%v", node.GetIp(), defaultEtcdServerPort))
+	cmd = append(cmd, fmt.Sprintf("--initial-advertise-peer-urls=https://%v:%v", node.GetIp(), defaultEtcdPeerPort))
 	cmd = append(cmd, fmt.Sprintf("--listen-client-urls=https://0.0.0.0:%v", defaultEtcdServerPort))
 	cmd = append(cmd, fmt.Sprintf("--listen-peer-urls=https://0.0.0.0:%v", defaultEtcdPeerPort))
 
 	//initial-cluster: infra0=https://10.0.0.6:2380,infra1=https://10.0.0.7:2380,infra2=https://10.0.0.8:2380
-	cmd = append(cmd, fmt.Sprintf("--initial-cluster=%v", composeInitialClusterUrl(d.clusterNodes)))
-
-	nameArg := fmt.Sprintf("--name=%v", d.containerName)
-
-	d.AddCommands(
-		command.NewShellCommand(d.machine, "docker",
-			"run",
-			"-d",
-			"--restart=always",
-			"--net=host",
-			"-v",
-			"/etc/kubernetes/pki/etcd:/etc/kubernetes/pki/etcd",
-			"-v",
-			"/var/lib/etcd:/var/lib/etcd",
-			nameArg,
-			defaultEtcdImageUrl,
-			strings.Join(cmd, " "),
-		).WithExecuteLogWriter(d.LogWriter),
+	cmd = append(cmd, fmt.Sprintf("--initial-cluster=%v", composeInitialClusterUrl(clusterNodes)))
+
+	nameArg := fmt.Sprintf("--name=%v", containerName)
+
+	return command.NewShellCommand(m, "docker",
+		"run",
+		"-d",
+		"--restart=always",
+		"--net=host",
+		"-v",
+		"/etc/kubernetes/pki/etcd:/etc/kubernetes/pki/etcd",
+		"-v",
+		"/var/lib/etcd:/var/lib/etcd",
+		nameArg,
+		defaultEtcdImageUrl,
+		strings.Join(cmd, " "),
 	)
 }
 
@@ -323,8 +359,8 @@ func (d *deployEtcdOperation) Do() error {
 
 	d.logger.Debugf("exec command: %#v done, %s, %s, %v", d.Commands, stdOut, stdErr, err)
 
-	_, needPostDo := d.machine.(*machine.Machine)
-	if needPostDo {
+	// the etcd cluster is checked unless the machine is simulated
+	if !machine.IsSimulated(d.machine) {
 		// post do
 		if err := d.PostDo(); err != nil {
 			d.logger.Errorf("post do error:%v", err)
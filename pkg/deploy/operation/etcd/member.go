@@ -0,0 +1,203 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package etcd
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const defaultEtcdRequestTimeout = 10 * time.Second
+
+type RemoveEtcdMemberOperationConfig struct {
+	Logger *logrus.Entry
+	// Node is the etcd node to remove.
+	Node *pb.Node
+	// EtcdNodes are the etcd nodes which remain in the cluster, the member is removed by them.
+	EtcdNodes []*pb.Node
+	LogWriter io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
+}
+
+type removeEtcdMemberOperation struct {
+	operation.BaseOperation
+	logger         *logrus.Entry
+	node           *pb.Node
+	remainingNodes []*pb.Node
+	LogWriter      io.Writer
+	ctx            context.Context
+}
+
+func NewRemoveEtcdMemberOperation(config *RemoveEtcdMemberOperationConfig) (*removeEtcdMemberOperation, error) {
+	var remainingNodes []*pb.Node
+	for _, node := range config.EtcdNodes {
+		if node.GetName() != config.Node.GetName() {
+			remainingNodes = append(remainingNodes, node)
+		}
+	}
+	if len(remainingNodes) == 0 {
+		return nil, fmt.Errorf("the last etcd member:%v can't be removed", config.Node.GetName())
+	}
+
+	ctx := config.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	logWriter := config.LogWriter
+	if logWriter == nil {
+		logWriter = ioutil.Discard
+	}
+
+	// the node may be dead, so it's not connected until the member is removed by the remaining ones
+	return &removeEtcdMemberOperation{
+		logger:         config.Logger,
+		node:           config.Node,
+		remainingNodes: remainingNodes,
+		LogWriter:      logWriter,
+		ctx:            ctx,
+	}, nil
+}
+
+// PlanRemoveEtcdMember returns the commands which will be run on the etcd node after it is removed
+// from the etcd cluster.
+func PlanRemoveEtcdMember(node *pb.Node) *pb.ActionPlan {
+	plan := new(pb.ActionPlan)
+	for _, cmd := range cleanEtcdCommands(nil, composeContainerName(node.GetName())) {
+		plan.Commands = append(plan.Commands, cmd.GetCommand())
+	}
+	return plan
+}
+
+// cleanEtcdCommands returns the commands to remove the etcd container and its data on the machine.
+func cleanEtcdCommands(m machine.IMachine, containerName string) []*command.ShellCommand {
+	return []*command.ShellCommand{
+		command.NewShellCommand(m, "docker", "ps", "-aq", "--filter", fmt.Sprintf("name=%v", containerName),
+			"|", "xargs", "-r", "docker", "rm", "-f"),
+		command.NewShellCommand(m, "rm", "-rf", defaultEtcdDataDir),
+	}
+}
+
+// Do removes the member from the etcd cluster by a remaining member, then removes its container and data.
+// The member is removed even if the node is dead, the clean on the node is best-effort.
+func (r *removeEtcdMemberOperation) Do() error {
+	// the etcd cluster is changed unless the machines are simulated
+	if !machine.IsSimulatedNode(r.node) {
+		if err := r.removeMember(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.clean(); err != nil {
+		r.logger.Warnf("failed to clean etcd on machine:%v, error:%v", r.node.GetName(), err)
+		fmt.Fprintf(r.LogWriter, "the etcd member:%v was removed, but failed to clean it on the node, error: %v\n",
+			r.node.GetName(), err)
+		return nil
+	}
+
+	r.logger.Debugf("remove etcd member:%v done", r.node.GetName())
+	return nil
+}
+
+// clean removes the etcd container and its data on the node.
+func (r *removeEtcdMemberOperation) clean() error {
+	m, err := machine.NewMachine(r.node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	for _, cmd := range cleanEtcdCommands(m, composeContainerName(m.GetName())) {
+		r.AddCommands(cmd.WithExecuteLogWriter(r.LogWriter).WithContext(r.ctx))
+	}
+
+	_, stdErr, err := r.BaseOperation.Do()
+	if err != nil {
+		return fmt.Errorf("%v, stderr:%s", err, stdErr)
+	}
+	return nil
+}
+
+// removeMember removes the member by the first remaining member which is reachable,
+// it's done if the member has been removed.
+func (r *removeEtcdMemberOperation) removeMember() error {
+	var caCrt *x509.Certificate
+	var caKey crypto.Signer
+	var err error
+	for _, node := range r.remainingNodes {
+		if caCrt, caKey, err = FetchEtcdCertAndKey(node, "ca"); err == nil {
+			break
+		}
+		r.logger.Warnf("failed to fetch etcd ca from:%v, error:%v", node.GetName(), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	encodedKey, encodedCert, err := CreateFromCA(apiserverClientCertConfig, caCrt, caKey)
+	if err != nil {
+		return fmt.Errorf("failed to generate etcd client key and cert, error: %v", err)
+	}
+
+	for _, node := range r.remainingNodes {
+		if err = r.removeMemberBy(node, caCrt, encodedCert, encodedKey); err == nil {
+			return nil
+		}
+		r.logger.Warnf("failed to remove etcd member by:%v, error:%v", node.GetName(), err)
+	}
+
+	return fmt.Errorf("failed to remove etcd member:%v, error:%v", r.node.GetName(), err)
+}
+
+func (r *removeEtcdMemberOperation) removeMemberBy(node *pb.Node, caCrt *x509.Certificate, encodedCert, encodedKey []byte) error {
+
+	cli, err := newSecureClient(node.GetName(), caCrt, encodedCert, encodedKey, []*pb.Node{node})
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	ctx, cancel := context.WithTimeout(r.ctx, defaultEtcdRequestTimeout)
+	defer cancel()
+
+	resp, err := cli.MemberList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range resp.Members {
+		if member.Name != r.node.GetName() {
+			continue
+		}
+
+		r.logger.Infof("remove etcd member:%v, id:%x", member.Name, member.ID)
+		_, err = cli.MemberRemove(ctx, member.ID)
+		return err
+	}
+
+	r.logger.Infof("etcd member:%v not found, skipping", r.node.GetName())
+	return nil
+}
@@ -16,7 +16,9 @@ package init
 
 import (
 	"bytes"
+	"context"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
@@ -26,7 +28,10 @@ type ItemEnum string
 type OperationsGenerator struct{}
 
 type InitOperation interface {
-	RunCommands(config *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) ([]byte, []byte, error)
+	RunCommands(ctx context.Context, config *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) ([]byte, []byte, error)
+	// Plan returns the scripts which will be put to the node and the commands which will be run on it,
+	// it doesn't connect to the node.
+	Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error)
 }
 
 const (
@@ -80,6 +85,19 @@ func (og *OperationsGenerator) CreateOperations(item ItemEnum, action *operation
 	}
 }
 
+// newInitPlan returns the plan of putting the scripts to the node and running the command.
+func newInitPlan(cmd command.Command, scriptPaths ...string) (*pb.ActionPlan, error) {
+	files, err := operation.PlanScripts(operation.InitRemoteScriptPath, scriptPaths...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ActionPlan{
+		Files:    files,
+		Commands: []string{cmd.GetCommand()},
+	}, nil
+}
+
 // group by master role
 func groupByRole(arr []string, match string) bool {
 	for _, item := range arr {
@@ -16,6 +16,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
@@ -29,7 +30,7 @@ type InitHostNameOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitHostNameOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (itOps *InitHostNameOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -52,10 +53,19 @@ func (itOps *InitHostNameOperation) RunCommands(node *pb.Node, initAction *opera
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
 
 	return
 }
+
+func (itOps *InitHostNameOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	if node.Name == "" {
+		return nil, fmt.Errorf("node name can not be empty")
+	}
+
+	return newInitPlan(command.NewShellCommand(nil, "hostnamectl", fmt.Sprintf("set-hostname %v", node.Name)))
+}
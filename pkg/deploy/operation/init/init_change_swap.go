@@ -16,6 +16,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
@@ -33,7 +34,7 @@ type InitSwapOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitSwapOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (itOps *InitSwapOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -52,7 +53,7 @@ func (itOps *InitSwapOperation) RunCommands(node *pb.Node, initAction *operation
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+swapScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+swapScript, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -61,10 +62,15 @@ func (itOps *InitSwapOperation) RunCommands(node *pb.Node, initAction *operation
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
 
 	return
 }
+
+func (itOps *InitSwapOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	return newInitPlan(command.NewShellCommand(nil, "bash", operation.InitRemoteScriptPath+swapScript), swapScript)
+}
@@ -16,6 +16,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
@@ -33,7 +34,7 @@ type InitTimeZoneOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitTimeZoneOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (itOps *InitTimeZoneOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -51,10 +52,15 @@ func (itOps *InitTimeZoneOperation) RunCommands(node *pb.Node, initAction *opera
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
 
 	return
 }
+
+func (itOps *InitTimeZoneOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	return newInitPlan(command.NewShellCommand(nil, "timedatectl", fmt.Sprintf("set-timezone %v", defaultTimeZone)))
+}
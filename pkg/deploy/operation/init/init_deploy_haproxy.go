@@ -16,6 +16,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
@@ -62,7 +63,7 @@ type InitHaproxyOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (itOps *InitHaproxyOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -75,14 +76,8 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 
 	itOps.NodeInitAction = initAction
 
-	if masterIps := itOps.getMastersIP(); len(masterIps) == 0 {
-		err = fmt.Errorf("master ip can not be empty")
-		return nil, nil, err
-	}
-
-	haproxyStr := buildHaproxyStr(itOps.getMastersIP(), HaproxyPort)
-	if haproxyStr == "" {
-		err = fmt.Errorf("haproxy string can not be built, please check")
+	shellCmd, err := itOps.command(m)
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -93,7 +88,7 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+haproxyScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+haproxyScript, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -104,7 +99,7 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaDockerFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaDockerFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -115,7 +110,7 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaLibFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaLibFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -126,16 +121,16 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaSystemdFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaSystemdFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -u '%v' haproxy run", operation.InitRemoteScriptPath+haproxyScript, haproxyStr)).
+	itOps.shellCmd = shellCmd.
 		WithDescription("初始化部署 haproxy 工具").
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
@@ -143,6 +138,33 @@ func (itOps *InitHaproxyOperation) RunCommands(node *pb.Node, initAction *operat
 	return
 }
 
+func (itOps *InitHaproxyOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	itOps.NodeInitAction = initAction
+
+	shellCmd, err := itOps.command(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newInitPlan(shellCmd, haproxyScript, HaDockerFilePath, HaLibFilePath, HaSystemdFilePath)
+}
+
+// command returns the command to run haproxy on the machine.
+func (itOps *InitHaproxyOperation) command(m machine.IMachine) (*command.ShellCommand, error) {
+
+	if masterIps := itOps.getMastersIP(); len(masterIps) == 0 {
+		return nil, fmt.Errorf("master ip can not be empty")
+	}
+
+	haproxyStr := buildHaproxyStr(itOps.getMastersIP(), HaproxyPort)
+	if haproxyStr == "" {
+		return nil, fmt.Errorf("haproxy string can not be built, please check")
+	}
+
+	return command.NewShellCommand(m, "bash", fmt.Sprintf("%v -u '%v' haproxy run", operation.InitRemoteScriptPath+haproxyScript, haproxyStr)), nil
+}
+
 // construct haproxy parameter
 func buildHaproxyStr(masterIps []string, port uint16) string {
 	haproxyStr := ""
@@ -16,6 +16,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -56,7 +57,7 @@ type InitKeepalivedOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
+func (itOps *InitKeepalivedOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -69,17 +70,8 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 
 	itOps.NodeInitAction = initAction
 
-	// acquire floating IP for keepalived
-	floatingIP := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.Vip
-	if floatingIP == "" {
-		err = fmt.Errorf("floating ip can not be empty")
-		return nil, nil, err
-	}
-
-	// acquire floating ethernet for keepalived
-	floatingEthernet := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.NetInterfaceName
-	if floatingEthernet == "" {
-		err = fmt.Errorf("floating ethernet can not be empty")
+	shellCmd, err := keepalivedCommand(m, initAction)
+	if err != nil {
 		return nil, nil, err
 	}
 
@@ -90,7 +82,7 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+keepalivedScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+keepalivedScript, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -101,7 +93,7 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaDockerFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaDockerFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -112,7 +104,7 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaLibFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaLibFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -123,19 +115,47 @@ func (itOps *InitKeepalivedOperation) RunCommands(node *pb.Node, initAction *ope
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+HaSystemdFilePath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+HaSystemdFilePath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v -n '%v' -i %v keepalived run", operation.InitRemoteScriptPath+keepalivedScript, floatingIP, floatingEthernet)).
+	itOps.shellCmd = shellCmd.
 		WithDescription("初始化部署 keepalived 工具").
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
 
 	return
 }
+
+func (itOps *InitKeepalivedOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	shellCmd, err := keepalivedCommand(nil, initAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return newInitPlan(shellCmd, keepalivedScript, HaDockerFilePath, HaLibFilePath, HaSystemdFilePath)
+}
+
+// keepalivedCommand returns the command to run keepalived on the machine.
+func keepalivedCommand(m machine.IMachine, initAction *operation.NodeInitAction) (*command.ShellCommand, error) {
+
+	// acquire floating IP for keepalived
+	floatingIP := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.Vip
+	if floatingIP == "" {
+		return nil, fmt.Errorf("floating ip can not be empty")
+	}
+
+	// acquire floating ethernet for keepalived
+	floatingEthernet := initAction.ClusterConfig.KubeAPIServerConnect.Keepalived.NetInterfaceName
+	if floatingEthernet == "" {
+		return nil, fmt.Errorf("floating ethernet can not be empty")
+	}
+
+	return command.NewShellCommand(m, "bash", fmt.Sprintf("%v -n '%v' -i %v keepalived run", operation.InitRemoteScriptPath+keepalivedScript, floatingIP, floatingEthernet)), nil
+}
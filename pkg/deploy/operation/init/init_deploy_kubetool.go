@@ -16,12 +16,14 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net"
 
 	"k8s.io/kubernetes/pkg/registry/core/service/ipallocator"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
 	"github.com/kpaas-io/kpaas/pkg/deploy/command"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
@@ -35,20 +37,9 @@ type InitKubeToolOperation struct {
 	NodeInitAction *operation.NodeInitAction
 }
 
-func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
-
-	var imageRepository string
-	var clusterDNSIP string
-	var nodeIp string
+func (itOps *InitKubeToolOperation) RunCommands(ctx context.Context, node *pb.Node, initAction *operation.NodeInitAction, logChan chan<- *bytes.Buffer) (stdOut, stdErr []byte, err error) {
 
 	pkgMirrorUrl := fmt.Sprintf("--pkg-mirror %v", constant.DefaultPkgMirror)
-	kubernetesVersion := fmt.Sprintf("--version %v", constant.DefaultKubeVersion)
-
-	// we would use initAction's service subnet in the future
-	clusterDNSIP = fmt.Sprintf("--cluster-dns %v", getDNSIP(constant.DefaultServiceSubnet))
-
-	// we would use initAction's image repository in the future
-	imageRepository = fmt.Sprintf("--image-repository %v", constant.DefaultImageRepository)
 
 	m, err := machine.NewMachine(node)
 	if err != nil {
@@ -68,7 +59,7 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+consts.DefaultKubeToolScript); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+consts.DefaultKubeToolScript, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -79,7 +70,7 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 	}
 	defer scriptFile.Close()
 
-	if err := m.PutFile(scriptFile, operation.InitRemoteScriptPath+DefaultCommonLibPath); err != nil {
+	if err := machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+DefaultCommonLibPath, logBuffer); err != nil {
 		return nil, nil, err
 	}
 
@@ -90,13 +81,16 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 		WithExecuteLogWriter(logBuffer)
 
 	// install kubelet, kubeadm, kubectl
-	itOps.shellCmd = command.NewShellCommand(m, "bash", fmt.Sprintf("%v setup kubelet %v %v %v %v", operation.InitRemoteScriptPath+consts.DefaultKubeToolScript,
-		kubernetesVersion, imageRepository, clusterDNSIP, nodeIp)).
+	setupKubeletCmd, err := setupKubeletCommand(m, initAction.ClusterConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	itOps.shellCmd = setupKubeletCmd.
 		WithDescription("初始化安装 kubernetes 工具").
 		WithExecuteLogWriter(logBuffer)
 
 	// run commands
-	stdOut, stdErr, err = itOps.shellCmd.Execute()
+	stdOut, stdErr, err = itOps.shellCmd.WithContext(ctx).Execute()
 
 	// write to log channel
 	logChan <- logBuffer
@@ -104,6 +98,41 @@ func (itOps *InitKubeToolOperation) RunCommands(node *pb.Node, initAction *opera
 	return
 }
 
+func (itOps *InitKubeToolOperation) Plan(node *pb.Node, initAction *operation.NodeInitAction) (*pb.ActionPlan, error) {
+
+	setupKubeletCmd, err := setupKubeletCommand(nil, initAction.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return newInitPlan(setupKubeletCmd, consts.DefaultKubeToolScript, DefaultCommonLibPath)
+}
+
+// setupKubeletCommand returns the command to install kubelet, kubeadm and kubectl of the cluster's
+// kubernetes version on the machine.
+func setupKubeletCommand(m machine.IMachine, clusterConfig *pb.ClusterConfig) (*command.ShellCommand, error) {
+
+	var nodeIp string
+
+	release, err := deploy.GetKubernetesRelease(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	kubernetesVersion := fmt.Sprintf("--version %v", release.Version)
+	pauseImageTag := fmt.Sprintf("--pause-image-tag %v", release.PauseImageTag)
+
+	repository, err := deploy.GetImageRepository(clusterConfig)
+	if err != nil {
+		return nil, err
+	}
+	imageRepository := fmt.Sprintf("--image-repository %v", repository)
+
+	clusterDNSIP := fmt.Sprintf("--cluster-dns %v", getDNSIP(deploy.GetServiceSubnet(clusterConfig)))
+
+	return command.NewShellCommand(m, "bash", fmt.Sprintf("%v setup kubelet %v %v %v %v %v", operation.InitRemoteScriptPath+consts.DefaultKubeToolScript,
+		kubernetesVersion, imageRepository, pauseImageTag, clusterDNSIP, nodeIp)), nil
+}
+
 // get dns IP from subnet
 func getDNSIP(serviceSubnet string) string {
 	dnsIP, err := parseServiceSubnet(serviceSubnet)
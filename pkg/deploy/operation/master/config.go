@@ -17,22 +17,16 @@ package master
 import (
 	"bytes"
 	"fmt"
-	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubernetes/cmd/kubeadm/app/apis/kubeadm/v1beta2"
 	"sigs.k8s.io/yaml"
 
-	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/operation/etcd"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
-const (
-	Token = "4a996f.8f1da0db96f8e50e"
-)
-
 func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 	var (
 		err           error
@@ -48,14 +42,17 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 		APIVersion: "kubeadm.k8s.io/v1beta2",
 	}
 
-	initConfig.BootstrapTokens = make([]v1beta2.BootstrapToken, 1)
-	initConfig.BootstrapTokens[0].Token = new(v1beta2.BootstrapTokenString)
-
-	initConfig.BootstrapTokens[0].Token.ID = "4a996f"
-	initConfig.BootstrapTokens[0].Token.Secret = "8f1da0db96f8e50e"
-	initConfig.BootstrapTokens[0].TTL = &metav1.Duration{
-		Duration: time.Duration(0),
+	// the token is unique for each deploy and expires after the deploy, create a join token to add nodes later.
+	token, err := v1beta2.NewBootstrapTokenString(op.BootstrapToken)
+	if err != nil {
+		return "", fmt.Errorf("invalid bootstrap token of the deploy")
 	}
+	initConfig.BootstrapTokens = []v1beta2.BootstrapToken{{
+		Token: token,
+		TTL: &metav1.Duration{
+			Duration: deploy.BootstrapTokenTTL,
+		},
+	}}
 
 	initConfig.CertificateKey = certKey
 
@@ -64,10 +61,16 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 		APIVersion: "kubeadm.k8s.io/v1beta2",
 	}
 
-	//clusterConfig.KubernetesVersion = op.ClusterConfig.KubernetesVersion
-	clusterConfig.KubernetesVersion = constant.DefaultKubeVersion
-	//clusterConfig.ImageRepository = op.ClusterConfig.ImageRepository
-	clusterConfig.ImageRepository = constant.DefaultImageRepository
+	release, err := deploy.GetKubernetesRelease(op.ClusterConfig)
+	if err != nil {
+		return "", err
+	}
+	clusterConfig.KubernetesVersion = release.Version
+
+	clusterConfig.ImageRepository, err = deploy.GetImageRepository(op.ClusterConfig)
+	if err != nil {
+		return "", err
+	}
 
 	clusterConfig.ControlPlaneEndpoint, err = deploy.GetControlPlaneEndpoint(op.ClusterConfig, op.MasterNodes)
 	if err != nil {
@@ -75,7 +78,7 @@ func newInitConfig(op *initMasterOperation, certKey string) (string, error) {
 	}
 
 	clusterConfig.Networking = v1beta2.Networking{
-		ServiceSubnet: op.ClusterConfig.ServiceSubnet,
+		ServiceSubnet: deploy.GetServiceSubnet(op.ClusterConfig),
 		PodSubnet:     op.ClusterConfig.PodSubnet,
 	}
 
@@ -16,6 +16,7 @@ package master
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"fmt"
 	"io"
@@ -47,37 +48,44 @@ const (
 )
 
 type InitMasterOperationConfig struct {
-	Logger        *logrus.Entry
-	CertKey       string
-	Node          *pb.Node
-	NeedUntaint   bool
-	MasterNodes   []*pb.Node
-	EtcdNodes     []*pb.Node
-	ClusterConfig *pb.ClusterConfig
-	LogWriter     io.Writer
+	Logger         *logrus.Entry
+	CertKey        string
+	BootstrapToken string
+	Node           *pb.Node
+	NeedUntaint    bool
+	MasterNodes    []*pb.Node
+	EtcdNodes      []*pb.Node
+	ClusterConfig  *pb.ClusterConfig
+	LogWriter      io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
 }
 
 type initMasterOperation struct {
 	operation.BaseOperation
-	CertKey       string
-	Logger        *logrus.Entry
-	EtcdNodes     []*pb.Node
-	MasterNodes   []*pb.Node
-	NeedUntaint   bool
-	machine       machine.IMachine
-	ClusterConfig *pb.ClusterConfig
-	LogWriter     io.Writer
+	CertKey        string
+	BootstrapToken string
+	Logger         *logrus.Entry
+	EtcdNodes      []*pb.Node
+	MasterNodes    []*pb.Node
+	NeedUntaint    bool
+	machine        machine.IMachine
+	ClusterConfig  *pb.ClusterConfig
+	LogWriter      io.Writer
+	ctx            context.Context
 }
 
 func NewInitMasterOperation(config *InitMasterOperationConfig) (*initMasterOperation, error) {
 	ops := &initMasterOperation{
-		Logger:        config.Logger,
-		CertKey:       config.CertKey,
-		NeedUntaint:   config.NeedUntaint,
-		EtcdNodes:     config.EtcdNodes,
-		MasterNodes:   config.MasterNodes,
-		ClusterConfig: config.ClusterConfig,
-		LogWriter:     config.LogWriter,
+		Logger:         config.Logger,
+		CertKey:        config.CertKey,
+		BootstrapToken: config.BootstrapToken,
+		NeedUntaint:    config.NeedUntaint,
+		EtcdNodes:      config.EtcdNodes,
+		MasterNodes:    config.MasterNodes,
+		ClusterConfig:  config.ClusterConfig,
+		LogWriter:      config.LogWriter,
+		ctx:            config.Context,
 	}
 
 	m, err := machine.NewMachine(config.Node)
@@ -105,13 +113,13 @@ func (op *initMasterOperation) PreDo() error {
 
 	_, encodedEtcdCACrt, err := etcd.ToByte(etcdCACrt, nil)
 
-	if err := op.machine.PutFile(bytes.NewReader(encodedEtcdCACrt), etcd.DefaultEtcdCACertPath); err != nil {
+	if err := machine.TransferReader(op.ctx, op.machine, bytes.NewReader(encodedEtcdCACrt), etcd.DefaultEtcdCACertPath, op.LogWriter); err != nil {
 		return fmt.Errorf("failed to put etcd ca cert to %v:%v, error: %v", op.machine.GetName(), etcd.DefaultEtcdCACertPath, err)
 	}
-	if err := op.machine.PutFile(bytes.NewReader(encodedAPIServerCert), defaultApiServerEtcdClientCertPath); err != nil {
+	if err := machine.TransferReader(op.ctx, op.machine, bytes.NewReader(encodedAPIServerCert), defaultApiServerEtcdClientCertPath, op.LogWriter); err != nil {
 		return fmt.Errorf("failed to put apiserver etcd client cert to %v:%v, error: %v", op.machine.GetName(), defaultApiServerEtcdClientCertPath, err)
 	}
-	if err := op.machine.PutFile(bytes.NewReader(encodedAPIServerKey), defaultApiServerEtcdClientKeyPath); err != nil {
+	if err := machine.TransferReader(op.ctx, op.machine, bytes.NewReader(encodedAPIServerKey), defaultApiServerEtcdClientKeyPath, op.LogWriter); err != nil {
 		return fmt.Errorf("failed to put apiserver etcd client key to %v:%v, error: %v", op.machine.GetName(), defaultApiServerEtcdClientKeyPath, err)
 	}
 
@@ -120,19 +128,65 @@ func (op *initMasterOperation) PreDo() error {
 		return fmt.Errorf("failed to generate %v, error: %v", kubeadmConfigPath, err)
 	}
 
-	if err := op.machine.PutFile(strings.NewReader(kubeadmConfig), kubeadmConfigPath); err != nil {
+	if err := machine.TransferReader(op.ctx, op.machine, strings.NewReader(kubeadmConfig), kubeadmConfigPath, op.LogWriter); err != nil {
 		return fmt.Errorf("failed to put kubeadm init config file to %v:%v, error: %v", op.machine.GetName(), defaultApiServerEtcdClientKeyPath, err)
 	}
 
-	op.AddCommands(
-		command.NewShellCommand(op.machine, "systemctl", "start", "kubelet").WithExecuteLogWriter(op.LogWriter),
-		command.NewShellCommand(op.machine, "kubeadm", "init",
-			"--config", kubeadmConfigPath,
-			"--upload-certs").WithExecuteLogWriter(op.LogWriter),
-	)
+	for _, cmd := range initMasterCommands(op.machine) {
+		op.AddCommands(cmd.WithExecuteLogWriter(op.LogWriter).WithContext(op.ctx))
+	}
 	return nil
 }
 
+// initMasterCommands returns the commands to init the first master on the machine.
+func initMasterCommands(m machine.IMachine) []*command.ShellCommand {
+	return []*command.ShellCommand{
+		command.NewShellCommand(m, "systemctl", "start", "kubelet"),
+		command.NewShellCommand(m, "kubeadm", "init",
+			"--config", kubeadmConfigPath,
+			"--upload-certs"),
+	}
+}
+
+// UploadCertsCommand returns the command to upload the control plane certificates again on the first
+// master encrypted by the certificate key, the uploaded certificates are deleted by the cluster 2 hours
+// after the first master was initialized, new masters can't join the cluster without them.
+func UploadCertsCommand(m machine.IMachine, certKey string) *command.ShellCommand {
+	return command.NewShellCommand(m, "kubeadm", "init", "phase", "upload-certs",
+		"--upload-certs",
+		"--config", kubeadmConfigPath,
+		"--certificate-key", certKey)
+}
+
+// PlanInitMaster returns the files which will be put to the first master, including the kubeadm config,
+// and the commands which will be run on it, it doesn't connect to the node.
+func PlanInitMaster(config *InitMasterOperationConfig) (*pb.ActionPlan, error) {
+	op := &initMasterOperation{
+		CertKey:        config.CertKey,
+		BootstrapToken: config.BootstrapToken,
+		EtcdNodes:      config.EtcdNodes,
+		MasterNodes:    config.MasterNodes,
+		ClusterConfig:  config.ClusterConfig,
+	}
+
+	kubeadmConfig, err := newInitConfig(op, op.CertKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %v, error: %v", kubeadmConfigPath, err)
+	}
+
+	plan := &pb.ActionPlan{
+		Files: operation.PlanGeneratedFiles(etcd.DefaultEtcdCACertPath, defaultApiServerEtcdClientCertPath, defaultApiServerEtcdClientKeyPath),
+	}
+	plan.Files = append(plan.Files, &pb.PlannedFile{
+		Path:    kubeadmConfigPath,
+		Content: kubeadmConfig,
+	})
+	for _, cmd := range initMasterCommands(nil) {
+		plan.Commands = append(plan.Commands, cmd.GetCommand())
+	}
+	return plan, nil
+}
+
 func (op *initMasterOperation) Do() error {
 	defer op.machine.Close()
 
@@ -15,6 +15,7 @@
 package master
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -30,34 +31,41 @@ import (
 )
 
 type JoinMasterOperationConfig struct {
-	Logger        *logrus.Entry
-	CertKey       string
-	Node          *pb.Node
-	NeedUntaint   bool
-	MasterNodes   []*pb.Node
-	ClusterConfig *pb.ClusterConfig
-	LogWriter     io.Writer
+	Logger         *logrus.Entry
+	CertKey        string
+	BootstrapToken string
+	Node           *pb.Node
+	NeedUntaint    bool
+	MasterNodes    []*pb.Node
+	ClusterConfig  *pb.ClusterConfig
+	LogWriter      io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
 }
 
 type joinMasterOperation struct {
 	operation.BaseOperation
-	Logger        *logrus.Entry
-	CertKey       string
-	NeedUntaint   bool
-	MasterNodes   []*pb.Node
-	machine       machine.IMachine
-	ClusterConfig *pb.ClusterConfig
-	LogWriter     io.Writer
+	Logger         *logrus.Entry
+	CertKey        string
+	BootstrapToken string
+	NeedUntaint    bool
+	MasterNodes    []*pb.Node
+	machine        machine.IMachine
+	ClusterConfig  *pb.ClusterConfig
+	LogWriter      io.Writer
+	ctx            context.Context
 }
 
 func NewJoinMasterOperation(config *JoinMasterOperationConfig) (*joinMasterOperation, error) {
 	ops := &joinMasterOperation{
-		Logger:        config.Logger,
-		CertKey:       config.CertKey,
-		NeedUntaint:   config.NeedUntaint,
-		MasterNodes:   config.MasterNodes,
-		ClusterConfig: config.ClusterConfig,
-		LogWriter:     config.LogWriter,
+		Logger:         config.Logger,
+		CertKey:        config.CertKey,
+		BootstrapToken: config.BootstrapToken,
+		NeedUntaint:    config.NeedUntaint,
+		MasterNodes:    config.MasterNodes,
+		ClusterConfig:  config.ClusterConfig,
+		LogWriter:      config.LogWriter,
+		ctx:            config.Context,
 	}
 
 	m, err := machine.NewMachine(config.Node)
@@ -80,18 +88,40 @@ func (op *joinMasterOperation) PreDo() error {
 		return fmt.Errorf("failed to get control plane endpoint addr, error: %v", err)
 	}
 
-	op.AddCommands(
-		command.NewShellCommand(op.machine, "systemctl", "start", "kubelet").WithExecuteLogWriter(op.LogWriter),
-		command.NewShellCommand(op.machine, "kubeadm", "join", endpoint,
-			"--token", Token,
-			"--control-plane",
-			"--certificate-key", op.CertKey,
-			"--discovery-token-unsafe-skip-ca-verification").WithExecuteLogWriter(op.LogWriter),
-	)
+	for _, cmd := range joinMasterCommands(op.machine, endpoint, op.BootstrapToken, op.CertKey) {
+		op.AddCommands(cmd.WithExecuteLogWriter(op.LogWriter).WithContext(op.ctx))
+	}
 
 	return nil
 }
 
+// joinMasterCommands returns the commands to join the machine to the control plane.
+func joinMasterCommands(m machine.IMachine, endpoint, token, certKey string) []*command.ShellCommand {
+	return []*command.ShellCommand{
+		command.NewShellCommand(m, "systemctl", "start", "kubelet"),
+		command.NewShellCommand(m, "kubeadm", "join", endpoint,
+			"--token", token,
+			"--control-plane",
+			"--certificate-key", certKey,
+			"--discovery-token-unsafe-skip-ca-verification"),
+	}
+}
+
+// PlanJoinMaster returns the commands which will be run on the master to join the control plane,
+// it doesn't connect to the node.
+func PlanJoinMaster(config *JoinMasterOperationConfig) (*pb.ActionPlan, error) {
+	endpoint, err := deploy.GetControlPlaneEndpoint(config.ClusterConfig, config.MasterNodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get control plane endpoint addr, error: %v", err)
+	}
+
+	plan := new(pb.ActionPlan)
+	for _, cmd := range joinMasterCommands(nil, endpoint, config.BootstrapToken, config.CertKey) {
+		plan.Commands = append(plan.Commands, cmd.GetCommand())
+	}
+	return plan, nil
+}
+
 func (op *joinMasterOperation) Do() error {
 	defer op.machine.Close()
 
@@ -0,0 +1,59 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package operation
+
+import (
+	"io/ioutil"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// GeneratedContent is the content of a planned file which is generated during the deployment,
+// such as the certificates and keys.
+const GeneratedContent = "<generated during the deployment>"
+
+// PlanScripts returns the planned files of the scripts in assets, which will be put to the
+// same paths under remoteDir.
+func PlanScripts(remoteDir string, scriptPaths ...string) ([]*pb.PlannedFile, error) {
+	files := make([]*pb.PlannedFile, 0, len(scriptPaths))
+	for _, scriptPath := range scriptPaths {
+		scriptFile, err := assets.Assets.Open(scriptPath)
+		if err != nil {
+			return nil, err
+		}
+		content, err := ioutil.ReadAll(scriptFile)
+		scriptFile.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &pb.PlannedFile{
+			Path:    remoteDir + scriptPath,
+			Content: string(content),
+		})
+	}
+	return files, nil
+}
+
+// PlanGeneratedFiles returns the planned files whose contents are generated during the deployment.
+func PlanGeneratedFiles(paths ...string) []*pb.PlannedFile {
+	files := make([]*pb.PlannedFile, 0, len(paths))
+	for _, path := range paths {
+		files = append(files, &pb.PlannedFile{
+			Path:    path,
+			Content: GeneratedContent,
+		})
+	}
+	return files
+}
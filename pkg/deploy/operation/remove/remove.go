@@ -0,0 +1,124 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package remove
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// haContainerLabel is the label of the haproxy and keepalived containers deployed in docker mode.
+const haContainerLabel = "svc=kubernetes-ha"
+
+type RemoveNodeOperationConfig struct {
+	Logger *logrus.Entry
+	// Node is the node to remove.
+	Node *pb.Node
+	// MasterNodes are the masters which remain in the cluster, the first one is used to access the cluster.
+	MasterNodes []*pb.Node
+	LogWriter   io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
+}
+
+// DrainNode cordons the node and evicts its pods, it waits for the pods to be deleted until the context is done.
+// The failure is ignored if the node is not ready, since the pods of a dead node may never be deleted, and
+// the node is still removed.
+func DrainNode(config *RemoveNodeOperationConfig) error {
+	ctx := config.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return operation.RequestCluster(config.MasterNodes, func(master *pb.Node) error {
+		name := config.Node.GetName()
+		err := operation.Drain(ctx, name, master)
+		if err == nil {
+			return nil
+		}
+
+		if ready, readyErr := operation.IsNodeReady(name, master); readyErr != nil || ready {
+			return err
+		}
+		if config.Logger != nil {
+			config.Logger.Warnf("failed to drain node:%v which is not ready, ignore it, error:%v", name, err)
+		}
+		if config.LogWriter != nil {
+			fmt.Fprintf(config.LogWriter, "the node:%v is not ready, the failure to drain it is ignored, error: %v\n", name, err)
+		}
+		return nil
+	})
+}
+
+// DeleteNode deletes the node object from the cluster.
+func DeleteNode(config *RemoveNodeOperationConfig) error {
+	return operation.RequestCluster(config.MasterNodes, func(master *pb.Node) error {
+		return operation.DeleteNode(config.Node.GetName(), master)
+	})
+}
+
+// ResetNode reverts the changes made by kubeadm, and removes the haproxy, keepalived and kubelet
+// with their configs on the node.
+func ResetNode(config *RemoveNodeOperationConfig) error {
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	op := new(operation.BaseOperation)
+	for _, cmd := range resetCommands(m) {
+		op.AddCommands(cmd.WithExecuteLogWriter(config.LogWriter).WithContext(config.Context))
+	}
+
+	_, stdErr, err := op.Do()
+	if err != nil {
+		return fmt.Errorf("failed to reset node:%v, error:%v, stderr:%s", m.GetName(), err, stdErr)
+	}
+
+	config.Logger.Debugf("reset node:%v done", m.GetName())
+	return nil
+}
+
+// PlanResetNode returns the commands which will be run on the node to reset it.
+func PlanResetNode() *pb.ActionPlan {
+	plan := new(pb.ActionPlan)
+	for _, cmd := range resetCommands(nil) {
+		plan.Commands = append(plan.Commands, cmd.GetCommand())
+	}
+	return plan
+}
+
+// resetCommands returns the commands to reset the machine, the services and containers which don't
+// exist are ignored.
+func resetCommands(m machine.IMachine) []*command.ShellCommand {
+	return []*command.ShellCommand{
+		command.NewShellCommand(m, "kubeadm", "reset", "-f"),
+		command.NewShellCommand(m, "docker", "ps", "-aq", "--filter", "label="+haContainerLabel,
+			"|", "xargs", "-r", "docker", "rm", "-f"),
+		command.NewShellCommand(m, "systemctl", "stop", "kubelet", "haproxy", "keepalived", "||", "true"),
+		command.NewShellCommand(m, "systemctl", "disable", "kubelet", "haproxy", "keepalived", "||", "true"),
+		command.NewShellCommand(m, "rm", "-rf", consts.DefaultK8sConfigDir, "/var/lib/kubelet",
+			"/etc/haproxy/haproxy.cfg", "/etc/keepalived/keepalived.conf"),
+	}
+}
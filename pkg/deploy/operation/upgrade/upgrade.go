@@ -0,0 +1,249 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/assets"
+	"github.com/kpaas-io/kpaas/pkg/deploy/command"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation"
+	it "github.com/kpaas-io/kpaas/pkg/deploy/operation/init"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// maxKubeletMinorSkew is how many minor versions the kubelet can be older than the control plane.
+const maxKubeletMinorSkew = 2
+
+type UpgradeOperationConfig struct {
+	Logger *logrus.Entry
+	// Node is the node to upgrade.
+	Node *pb.Node
+	// MasterNodes are the masters of the cluster, the first one is used to access the cluster.
+	MasterNodes []*pb.Node
+	// Version is the kubernetes version to upgrade to.
+	Version   string
+	LogWriter io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
+}
+
+// CheckVersionSkew checks the cluster can be upgraded from the current version to the target version:
+// the target version is newer than the current one, and no minor version is skipped.
+func CheckVersionSkew(current, target string) error {
+	current, target = trimVersion(current), trimVersion(target)
+	if current == target {
+		return fmt.Errorf("the cluster is already at version %v", target)
+	}
+
+	if err := operation.CheckVersion(target, current, operation.CheckLarge); err != nil {
+		return fmt.Errorf("the cluster can't be downgraded, %v", err)
+	}
+
+	nextMinor, err := shiftMinorVersion(current, 1)
+	if err != nil {
+		return err
+	}
+	targetMinor, err := shiftMinorVersion(target, 0)
+	if err != nil {
+		return err
+	}
+	if err := operation.CheckVersion(targetMinor, nextMinor, operation.CheckLess); err != nil {
+		return fmt.Errorf("the minor version can't be skipped when upgrading from %v, %v", current, err)
+	}
+
+	return nil
+}
+
+// CheckCluster checks the versions of the running cluster before upgrading: the control plane can be
+// upgraded to the version, and the kubelets are neither newer than the version nor too old for it.
+func CheckCluster(config *UpgradeOperationConfig) error {
+	return operation.RequestCluster(config.MasterNodes, func(master *pb.Node) error {
+		clientset, err := operation.GetKubeClient(master)
+		if err != nil {
+			return err
+		}
+
+		serverVersion, err := clientset.Discovery().ServerVersion()
+		if err != nil {
+			return fmt.Errorf("failed to get the version of the control plane, error:%v", err)
+		}
+		config.Logger.Debugf("control plane version: %v", serverVersion.GitVersion)
+
+		if err := CheckVersionSkew(serverVersion.GitVersion, config.Version); err != nil {
+			return err
+		}
+
+		oldestKubelet, err := shiftMinorVersion(config.Version, -maxKubeletMinorSkew)
+		if err != nil {
+			return err
+		}
+
+		nodes, err := clientset.CoreV1().Nodes().List(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes.Items {
+			kubeletVersion := trimVersion(node.Status.NodeInfo.KubeletVersion)
+			if err := operation.CheckVersion(kubeletVersion, config.Version, operation.CheckLess); err != nil {
+				return fmt.Errorf("the kubelet of node %v is newer than the version to upgrade to, %v", node.Name, err)
+			}
+			if err := operation.CheckVersion(kubeletVersion, oldestKubelet, operation.CheckLarge); err != nil {
+				return fmt.Errorf("the kubelet of node %v is too old to work with the upgraded control plane, %v", node.Name, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// UpgradeFirstMaster upgrades the control plane by the first master, then upgrades its kubelet.
+func UpgradeFirstMaster(config *UpgradeOperationConfig) error {
+	return upgradeNode(config, true)
+}
+
+// UpgradeMaster upgrades the control plane components and the kubelet of a master,
+// after the control plane is upgraded by the first master.
+func UpgradeMaster(config *UpgradeOperationConfig) error {
+	return upgradeNode(config, false)
+}
+
+// UpgradeWorker drains the worker, upgrades its kubelet config and kubelet, then uncordons it.
+func UpgradeWorker(config *UpgradeOperationConfig) error {
+	ctx := config.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	err := operation.RequestCluster(config.MasterNodes, func(master *pb.Node) error {
+		return operation.Drain(ctx, config.Node.GetName(), master)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to drain node:%v, error:%v", config.Node.GetName(), err)
+	}
+
+	if err := upgradeNode(config, false); err != nil {
+		return err
+	}
+
+	err = operation.RequestCluster(config.MasterNodes, func(master *pb.Node) error {
+		return operation.Uncordon(config.Node.GetName(), master)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to uncordon node:%v, error:%v", config.Node.GetName(), err)
+	}
+
+	return nil
+}
+
+// PlanUpgradeNode returns the scripts which will be put to the node and the commands which will be run on it.
+func PlanUpgradeNode(version string, firstMaster bool) (*pb.ActionPlan, error) {
+	files, err := operation.PlanScripts(operation.InitRemoteScriptPath, consts.DefaultKubeToolScript, it.DefaultCommonLibPath)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &pb.ActionPlan{Files: files}
+	for _, cmd := range upgradeCommands(nil, version, firstMaster) {
+		plan.Commands = append(plan.Commands, cmd.GetCommand())
+	}
+	return plan, nil
+}
+
+// upgradeCommands returns the commands to upgrade kubeadm, the node by kubeadm, then the kubelet and kubectl,
+// the control plane is upgraded by the first master.
+func upgradeCommands(m machine.IMachine, version string, firstMaster bool) []*command.ShellCommand {
+	version = trimVersion(version)
+	script := operation.InitRemoteScriptPath + consts.DefaultKubeToolScript
+
+	upgradeNode := command.NewShellCommand(m, "kubeadm", "upgrade", "node")
+	if firstMaster {
+		upgradeNode = command.NewShellCommand(m, "kubeadm", "upgrade", "apply", "v"+version, "-y")
+	}
+
+	return []*command.ShellCommand{
+		command.NewShellCommand(m, "bash", script, "upgrade", "kubeadm", "--version", version),
+		upgradeNode,
+		command.NewShellCommand(m, "bash", script, "upgrade", "kubelet", "--version", version),
+	}
+}
+
+// upgradeNode puts the kubetool scripts to the node and runs the commands to upgrade it.
+func upgradeNode(config *UpgradeOperationConfig, firstMaster bool) error {
+	m, err := machine.NewMachine(config.Node)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	for _, scriptPath := range []string{consts.DefaultKubeToolScript, it.DefaultCommonLibPath} {
+		if err := putScript(config.Context, m, scriptPath, config.LogWriter); err != nil {
+			return fmt.Errorf("failed to put script %v to node:%v, error:%v", scriptPath, m.GetName(), err)
+		}
+	}
+
+	op := new(operation.BaseOperation)
+	for _, cmd := range upgradeCommands(m, config.Version, firstMaster) {
+		op.AddCommands(cmd.WithExecuteLogWriter(config.LogWriter).WithContext(config.Context))
+	}
+
+	_, stdErr, err := op.Do()
+	if err != nil {
+		return fmt.Errorf("failed to upgrade node:%v, error:%v, stderr:%s", m.GetName(), err, stdErr)
+	}
+
+	config.Logger.Debugf("upgrade node:%v to %v done", m.GetName(), config.Version)
+	return nil
+}
+
+func putScript(ctx context.Context, m machine.IMachine, scriptPath string, progress io.Writer) error {
+	scriptFile, err := assets.Assets.Open(scriptPath)
+	if err != nil {
+		return err
+	}
+	defer scriptFile.Close()
+
+	return machine.TransferReader(ctx, m, scriptFile, operation.InitRemoteScriptPath+scriptPath, progress)
+}
+
+// trimVersion returns the version without the "v" prefix.
+func trimVersion(version string) string {
+	return strings.TrimPrefix(strings.TrimSpace(version), "v")
+}
+
+// shiftMinorVersion returns the "major.minor" version which is delta minor versions away from the version.
+func shiftMinorVersion(version string, delta int) (string, error) {
+	parts := strings.Split(trimVersion(version), ".")
+	if len(parts) < 2 {
+		return "", fmt.Errorf("invalid version: %q", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid version: %q, error:%v", version, err)
+	}
+	if minor += delta; minor < 0 {
+		minor = 0
+	}
+
+	return fmt.Sprintf("%v.%v", parts[0], minor), nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unit test of CheckVersionSkew
+func TestCheckVersionSkew(t *testing.T) {
+	testSample := []struct {
+		current string
+		target  string
+		wantErr bool
+	}{
+		{
+			current: "1.15.6",
+			target:  "1.16.3",
+			wantErr: false,
+		},
+		{
+			current: "v1.16.3",
+			target:  "v1.17.0",
+			wantErr: false,
+		},
+		{
+			// the minor version 1.16 is skipped
+			current: "1.15.6",
+			target:  "1.17.0",
+			wantErr: true,
+		},
+		{
+			current: "1.17.0",
+			target:  "1.16.3",
+			wantErr: true,
+		},
+		{
+			current: "1.16.3",
+			target:  "1.16.3",
+			wantErr: true,
+		},
+	}
+
+	for _, eachValue := range testSample {
+		err := CheckVersionSkew(eachValue.current, eachValue.target)
+		assert.Equal(t, eachValue.wantErr, err != nil, "%v -> %v: %v", eachValue.current, eachValue.target, err)
+	}
+}
@@ -15,6 +15,7 @@
 package worker
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -34,7 +35,10 @@ type JoinClusterConfig struct {
 	Logger           *logrus.Entry
 	Cluster          *pb.ClusterConfig
 	MasterNodes      []*pb.Node
+	BootstrapToken   string
 	ExecuteLogWriter io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
 }
 
 type JoinCluster struct {
@@ -77,18 +81,35 @@ func (operation *JoinCluster) JoinKubernetes() *pb.Error {
 		Debugf("control plane endpoint: %s", controlPlaneEndpoint)
 
 	return op.NewCommandRunner(operation.config.ExecuteLogWriter).RunCommand(
-		command.NewShellCommand(
-			operation.config.Machine,
-			fmt.Sprintf("/bin/bash %s/%s", op.InitRemoteScriptPath, consts.DefaultKubeToolScript),
-			fmt.Sprint("join"),
-			fmt.Sprintf("--token %v", consts.KubernetesToken),
-			fmt.Sprintf("--master %v", controlPlaneEndpoint),
-		),
+		joinCommand(operation.config.Machine, controlPlaneEndpoint, operation.config.BootstrapToken).WithContext(operation.config.Context),
 		"Join node to cluster failed",     // 添加节点到集群失败
 		"join node to kubernetes cluster", // 添加节点到Kubernetes集群
 	)
 }
 
+// joinCommand returns the command to join the machine to the cluster by the control plane endpoint and the bootstrap token.
+func joinCommand(machine deployMachine.IMachine, controlPlaneEndpoint, token string) *command.ShellCommand {
+
+	return command.NewShellCommand(
+		machine,
+		fmt.Sprintf("/bin/bash %s/%s", op.InitRemoteScriptPath, consts.DefaultKubeToolScript),
+		fmt.Sprint("join"),
+		fmt.Sprintf("--token %v", token),
+		fmt.Sprintf("--master %v", controlPlaneEndpoint),
+	)
+}
+
+// Plan returns the commands which will be run to join the node to the cluster, it doesn't connect to any node.
+func (operation *JoinCluster) Plan() ([]string, error) {
+
+	controlPlaneEndpoint, err := deploy.GetControlPlaneEndpoint(operation.config.Cluster, operation.config.MasterNodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get control plane endpoint, error: %v", err)
+	}
+
+	return []string{joinCommand(nil, controlPlaneEndpoint, operation.config.BootstrapToken).GetCommand()}, nil
+}
+
 func (operation *JoinCluster) Execute() *pb.Error {
 
 	return operation.JoinKubernetes()
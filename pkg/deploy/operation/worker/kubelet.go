@@ -15,6 +15,7 @@
 package worker
 
 import (
+	"context"
 	"io"
 
 	"github.com/sirupsen/logrus"
@@ -25,11 +26,15 @@ import (
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
+const restartKubeletCommand = "systemctl restart kubelet"
+
 type StartKubeletConfig struct {
 	Machine          deployMachine.IMachine
 	Node             *pb.NodeDeployConfig
 	Logger           *logrus.Entry
 	ExecuteLogWriter io.Writer
+	// Context is used to run the commands, commands will be interrupted once it is done
+	Context context.Context
 }
 
 type StartKubelet struct {
@@ -48,7 +53,7 @@ func (operation *StartKubelet) RunKubelet() *pb.Error {
 	operation.config.Logger.WithField("node", operation.config.Node.GetNode().GetName()).Info("Start kubelet service")
 
 	if err := operation.runCommand(
-		"systemctl restart kubelet",
+		restartKubeletCommand,
 		"Restart kubelet service error", // 重启kubelet服务错误
 		"restart kubelet service",       // 重启kubelet服务
 	); err != nil {
@@ -64,10 +69,16 @@ func (operation *StartKubelet) RunKubelet() *pb.Error {
 func (operation *StartKubelet) runCommand(shellCommand string, errorTitle string, doSomeThing string) *pb.Error {
 
 	return deployOperation.NewCommandRunner(operation.config.ExecuteLogWriter).RunCommand(
-		command.NewShellCommand(operation.config.Machine, shellCommand), errorTitle, doSomeThing,
+		command.NewShellCommand(operation.config.Machine, shellCommand).WithContext(operation.config.Context), errorTitle, doSomeThing,
 	)
 }
 
+// Plan returns the commands which will be run to start kubelet, it doesn't connect to the node.
+func (operation *StartKubelet) Plan() []string {
+
+	return []string{restartKubeletCommand}
+}
+
 func (operation *StartKubelet) Execute() *pb.Error {
 
 	if err := operation.RunKubelet(); err != nil {
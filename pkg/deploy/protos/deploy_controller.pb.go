@@ -10,6 +10,8 @@ It is generated from these files:
 It has these top-level messages:
 	Auth
 	SSH
+	Escalation
+	JumpHost
 	Node
 	Error
 	TestConnectionRequest
@@ -24,6 +26,9 @@ It has these top-level messages:
 	GetCheckNodesResultReply
 	GetCheckNodesLogRequest
 	GetCheckNodesLogReply
+	WatchCheckNodesRequest
+	CancelCheckNodesRequest
+	CancelCheckNodesReply
 	NodePortRange
 	Keepalived
 	Loadbalancer
@@ -33,14 +38,30 @@ It has these top-level messages:
 	NodeDeployConfig
 	DeployRequest
 	DeployReply
+	PlannedFile
+	ActionPlan
+	TaskPlan
+	PlanDeployReply
 	GetDeployResultRequest
 	DeployItem
 	DeployItemResult
 	GetDeployResultReply
 	GetDeployLogRequest
 	GetDeployLogReply
+	ResumeDeployRequest
+	ResumeDeployReply
+	WatchDeployRequest
+	TailDeployLogRequest
+	TailDeployLogReply
+	CancelDeployRequest
+	CancelDeployReply
 	FetchKubeConfigRequest
 	FetchKubeConfigReply
+	CreateJoinTokenRequest
+	CreateJoinTokenReply
+	ScaleOutRequest
+	RemoveNodesRequest
+	UpgradeClusterRequest
 	CalicoOptions
 	NetworkOptions
 	CheckNetworkRequirementRequest
@@ -70,12 +91,19 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Auth struct {
-	// type could be ["password", "privatekey"]
+	// type could be ["password", "privatekey", "privatekey-with-passphrase", "agent", "certificate"]
 	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
-	// credential stores the content of password or privatekey
+	// credential stores the content of password or privatekey, it's not used by agent auth
 	Credential string `protobuf:"bytes,2,opt,name=credential" json:"credential,omitempty"`
 	// username is the user name used for password auth.
 	Username string `protobuf:"bytes,3,opt,name=username" json:"username,omitempty"`
+	// passphrase decrypts the privatekey, it's used by privatekey-with-passphrase auth and optional for certificate auth
+	Passphrase string `protobuf:"bytes,4,opt,name=passphrase" json:"passphrase,omitempty"`
+	// certificate is the OpenSSH user certificate of the privatekey in authorized_keys format, used by certificate auth
+	Certificate string `protobuf:"bytes,5,opt,name=certificate" json:"certificate,omitempty"`
+	// sealed means the credential and the passphrase are sealed by the vault master key shared with deploy controller,
+	// they are opened only when the node is dialed.
+	Sealed bool `protobuf:"varint,6,opt,name=sealed" json:"sealed,omitempty"`
 }
 
 func (m *Auth) Reset()                    { *m = Auth{} }
@@ -104,10 +132,40 @@ func (m *Auth) GetUsername() string {
 	return ""
 }
 
+func (m *Auth) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *Auth) GetCertificate() string {
+	if m != nil {
+		return m.Certificate
+	}
+	return ""
+}
+
+func (m *Auth) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
+}
+
 // SSH contains the ssh login info.
 type SSH struct {
 	Port uint32 `protobuf:"varint,1,opt,name=port" json:"port,omitempty"`
 	Auth *Auth  `protobuf:"bytes,2,opt,name=auth" json:"auth,omitempty"`
+	// hostKeyFingerprint is the SHA256 fingerprint of the pinned host key, such as "SHA256:xxx",
+	// the connection will be rejected if the host key of the node doesn't match it.
+	// If it is empty, the node is refused except in a connection test, where the host key is trusted on first use.
+	HostKeyFingerprint string `protobuf:"bytes,3,opt,name=hostKeyFingerprint" json:"hostKeyFingerprint,omitempty"`
+	// jumpHosts are the proxy hosts to reach the node, the first one is dialed directly,
+	// and the node is dialed through the last one.
+	JumpHosts []*JumpHost `protobuf:"bytes,4,rep,name=jumpHosts" json:"jumpHosts,omitempty"`
+	// escalation is used to run commands and put files as root if the login user is not root.
+	Escalation *Escalation `protobuf:"bytes,5,opt,name=escalation" json:"escalation,omitempty"`
 }
 
 func (m *SSH) Reset()                    { *m = SSH{} }
@@ -129,17 +187,120 @@ func (m *SSH) GetAuth() *Auth {
 	return nil
 }
 
+func (m *SSH) GetHostKeyFingerprint() string {
+	if m != nil {
+		return m.HostKeyFingerprint
+	}
+	return ""
+}
+
+func (m *SSH) GetJumpHosts() []*JumpHost {
+	if m != nil {
+		return m.JumpHosts
+	}
+	return nil
+}
+
+func (m *SSH) GetEscalation() *Escalation {
+	if m != nil {
+		return m.Escalation
+	}
+	return nil
+}
+
+// Escalation is the privilege escalation mode of a node.
+type Escalation struct {
+	// method could be ["sudo", "su"], no privilege escalation if it's empty
+	Method string `protobuf:"bytes,1,opt,name=method" json:"method,omitempty"`
+	// password is the password of sudo or the password of root for su, sudo should not require password if it's empty.
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+	// sealed means the password is sealed by the vault master key shared with deploy controller
+	Sealed bool `protobuf:"varint,3,opt,name=sealed" json:"sealed,omitempty"`
+}
+
+func (m *Escalation) Reset()                    { *m = Escalation{} }
+func (m *Escalation) String() string            { return proto.CompactTextString(m) }
+func (*Escalation) ProtoMessage()               {}
+func (*Escalation) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Escalation) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *Escalation) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *Escalation) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
+}
+
+// JumpHost is a proxy host to reach a node via ssh.
+type JumpHost struct {
+	Ip   string `protobuf:"bytes,1,opt,name=ip" json:"ip,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=port" json:"port,omitempty"`
+	Auth *Auth  `protobuf:"bytes,3,opt,name=auth" json:"auth,omitempty"`
+	// hostKeyFingerprint is the SHA256 fingerprint of the pinned host key of the jump host,
+	// if it is empty, the jump host is refused except in a connection test.
+	HostKeyFingerprint string `protobuf:"bytes,4,opt,name=hostKeyFingerprint" json:"hostKeyFingerprint,omitempty"`
+}
+
+func (m *JumpHost) Reset()                    { *m = JumpHost{} }
+func (m *JumpHost) String() string            { return proto.CompactTextString(m) }
+func (*JumpHost) ProtoMessage()               {}
+func (*JumpHost) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *JumpHost) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+func (m *JumpHost) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *JumpHost) GetAuth() *Auth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *JumpHost) GetHostKeyFingerprint() string {
+	if m != nil {
+		return m.HostKeyFingerprint
+	}
+	return ""
+}
+
 // Node contains the node metadata info
 type Node struct {
 	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
 	Ip   string `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
 	Ssh  *SSH   `protobuf:"bytes,3,opt,name=ssh" json:"ssh,omitempty"`
+	// connectionType could be ["ssh", "local"], ssh is used if it's empty.
+	// The commands of a local node are run on the host of deploy controller, and ssh is not used.
+	ConnectionType string `protobuf:"bytes,4,opt,name=connectionType" json:"connectionType,omitempty"`
 }
 
 func (m *Node) Reset()                    { *m = Node{} }
 func (m *Node) String() string            { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()               {}
-func (*Node) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Node) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Node) GetName() string {
 	if m != nil {
@@ -162,6 +323,13 @@ func (m *Node) GetSsh() *SSH {
 	return nil
 }
 
+func (m *Node) GetConnectionType() string {
+	if m != nil {
+		return m.ConnectionType
+	}
+	return ""
+}
+
 type Error struct {
 	Reason     string `protobuf:"bytes,1,opt,name=reason" json:"reason,omitempty"`
 	Detail     string `protobuf:"bytes,2,opt,name=detail" json:"detail,omitempty"`
@@ -171,7 +339,7 @@ type Error struct {
 func (m *Error) Reset()                    { *m = Error{} }
 func (m *Error) String() string            { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()               {}
-func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *Error) GetReason() string {
 	if m != nil {
@@ -202,7 +370,7 @@ type TestConnectionRequest struct {
 func (m *TestConnectionRequest) Reset()                    { *m = TestConnectionRequest{} }
 func (m *TestConnectionRequest) String() string            { return proto.CompactTextString(m) }
 func (*TestConnectionRequest) ProtoMessage()               {}
-func (*TestConnectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*TestConnectionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *TestConnectionRequest) GetNode() *Node {
 	if m != nil {
@@ -215,12 +383,19 @@ func (m *TestConnectionRequest) GetNode() *Node {
 type TestConnectionReply struct {
 	Passed bool   `protobuf:"varint,1,opt,name=passed" json:"passed,omitempty"`
 	Err    *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	// hostKeyFingerprint is the SHA256 fingerprint of the host key presented by the node.
+	HostKeyFingerprint string `protobuf:"bytes,3,opt,name=hostKeyFingerprint" json:"hostKeyFingerprint,omitempty"`
+	// failedHop is the address of the host which failed to be connected, it could be
+	// a jump host or the node itself, such as "192.168.1.1:22".
+	FailedHop string `protobuf:"bytes,4,opt,name=failedHop" json:"failedHop,omitempty"`
+	// jumpHostKeyFingerprints are the SHA256 fingerprints of the host keys presented by the jump hosts in order.
+	JumpHostKeyFingerprints []string `protobuf:"bytes,5,rep,name=jumpHostKeyFingerprints" json:"jumpHostKeyFingerprints,omitempty"`
 }
 
 func (m *TestConnectionReply) Reset()                    { *m = TestConnectionReply{} }
 func (m *TestConnectionReply) String() string            { return proto.CompactTextString(m) }
 func (*TestConnectionReply) ProtoMessage()               {}
-func (*TestConnectionReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*TestConnectionReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *TestConnectionReply) GetPassed() bool {
 	if m != nil {
@@ -236,6 +411,27 @@ func (m *TestConnectionReply) GetErr() *Error {
 	return nil
 }
 
+func (m *TestConnectionReply) GetHostKeyFingerprint() string {
+	if m != nil {
+		return m.HostKeyFingerprint
+	}
+	return ""
+}
+
+func (m *TestConnectionReply) GetFailedHop() string {
+	if m != nil {
+		return m.FailedHop
+	}
+	return ""
+}
+
+func (m *TestConnectionReply) GetJumpHostKeyFingerprints() []string {
+	if m != nil {
+		return m.JumpHostKeyFingerprints
+	}
+	return nil
+}
+
 // NodeCheckConfig contains the pre-checking configuration for a node
 type NodeCheckConfig struct {
 	Node  *Node    `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
@@ -245,7 +441,7 @@ type NodeCheckConfig struct {
 func (m *NodeCheckConfig) Reset()                    { *m = NodeCheckConfig{} }
 func (m *NodeCheckConfig) String() string            { return proto.CompactTextString(m) }
 func (*NodeCheckConfig) ProtoMessage()               {}
-func (*NodeCheckConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*NodeCheckConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *NodeCheckConfig) GetNode() *Node {
 	if m != nil {
@@ -265,12 +461,13 @@ func (m *NodeCheckConfig) GetRoles() []string {
 type CheckNodesRequest struct {
 	Configs        []*NodeCheckConfig `protobuf:"bytes,1,rep,name=configs" json:"configs,omitempty"`
 	NetworkOptions *NetworkOptions    `protobuf:"bytes,2,opt,name=networkOptions" json:"networkOptions,omitempty"`
+	ClusterId      string             `protobuf:"bytes,3,opt,name=clusterId" json:"clusterId,omitempty"`
 }
 
 func (m *CheckNodesRequest) Reset()                    { *m = CheckNodesRequest{} }
 func (m *CheckNodesRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesRequest) ProtoMessage()               {}
-func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*CheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *CheckNodesRequest) GetConfigs() []*NodeCheckConfig {
 	if m != nil {
@@ -286,6 +483,13 @@ func (m *CheckNodesRequest) GetNetworkOptions() *NetworkOptions {
 	return nil
 }
 
+func (m *CheckNodesRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
 // CheckNodesReply contains the result of node pre-checking.
 type CheckNodesReply struct {
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
@@ -295,7 +499,7 @@ type CheckNodesReply struct {
 func (m *CheckNodesReply) Reset()                    { *m = CheckNodesReply{} }
 func (m *CheckNodesReply) String() string            { return proto.CompactTextString(m) }
 func (*CheckNodesReply) ProtoMessage()               {}
-func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*CheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *CheckNodesReply) GetAccepted() bool {
 	if m != nil {
@@ -320,7 +524,7 @@ type CheckItem struct {
 func (m *CheckItem) Reset()                    { *m = CheckItem{} }
 func (m *CheckItem) String() string            { return proto.CompactTextString(m) }
 func (*CheckItem) ProtoMessage()               {}
-func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*CheckItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *CheckItem) GetName() string {
 	if m != nil {
@@ -347,7 +551,7 @@ type ItemCheckResult struct {
 func (m *ItemCheckResult) Reset()                    { *m = ItemCheckResult{} }
 func (m *ItemCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*ItemCheckResult) ProtoMessage()               {}
-func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*ItemCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *ItemCheckResult) GetItem() *CheckItem {
 	if m != nil {
@@ -388,7 +592,7 @@ type NodeCheckResult struct {
 func (m *NodeCheckResult) Reset()                    { *m = NodeCheckResult{} }
 func (m *NodeCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*NodeCheckResult) ProtoMessage()               {}
-func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*NodeCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *NodeCheckResult) GetNodeName() string {
 	if m != nil {
@@ -420,12 +624,20 @@ func (m *NodeCheckResult) GetItems() []*ItemCheckResult {
 
 // GetCheckNodesResultRequest contains the request of getting nodes check result.
 type GetCheckNodesResultRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
 }
 
 func (m *GetCheckNodesResultRequest) Reset()                    { *m = GetCheckNodesResultRequest{} }
 func (m *GetCheckNodesResultRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultRequest) ProtoMessage()               {}
-func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*GetCheckNodesResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *GetCheckNodesResultRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
 
 // GetCheckNodesResultReply contains the result of nodes check
 type GetCheckNodesResultReply struct {
@@ -437,7 +649,7 @@ type GetCheckNodesResultReply struct {
 func (m *GetCheckNodesResultReply) Reset()                    { *m = GetCheckNodesResultReply{} }
 func (m *GetCheckNodesResultReply) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesResultReply) ProtoMessage()               {}
-func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*GetCheckNodesResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *GetCheckNodesResultReply) GetStatus() string {
 	if m != nil {
@@ -462,13 +674,14 @@ func (m *GetCheckNodesResultReply) GetNodes() map[string]*NodeCheckResult {
 
 // GetCheckNodesLogRequest contains the request of getting nodes check log.
 type GetCheckNodesLogRequest struct {
-	NodeName string `protobuf:"bytes,1,opt,name=nodeName" json:"nodeName,omitempty"`
+	NodeName  string `protobuf:"bytes,1,opt,name=nodeName" json:"nodeName,omitempty"`
+	ClusterId string `protobuf:"bytes,2,opt,name=clusterId" json:"clusterId,omitempty"`
 }
 
 func (m *GetCheckNodesLogRequest) Reset()                    { *m = GetCheckNodesLogRequest{} }
 func (m *GetCheckNodesLogRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesLogRequest) ProtoMessage()               {}
-func (*GetCheckNodesLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*GetCheckNodesLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *GetCheckNodesLogRequest) GetNodeName() string {
 	if m != nil {
@@ -477,6 +690,13 @@ func (m *GetCheckNodesLogRequest) GetNodeName() string {
 	return ""
 }
 
+func (m *GetCheckNodesLogRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
 // GetCheckNodesLogReply contains the log of nodes check
 type GetCheckNodesLogReply struct {
 	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
@@ -485,7 +705,7 @@ type GetCheckNodesLogReply struct {
 func (m *GetCheckNodesLogReply) Reset()                    { *m = GetCheckNodesLogReply{} }
 func (m *GetCheckNodesLogReply) String() string            { return proto.CompactTextString(m) }
 func (*GetCheckNodesLogReply) ProtoMessage()               {}
-func (*GetCheckNodesLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*GetCheckNodesLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *GetCheckNodesLogReply) GetLog() []byte {
 	if m != nil {
@@ -494,6 +714,66 @@ func (m *GetCheckNodesLogReply) GetLog() []byte {
 	return nil
 }
 
+// WatchCheckNodesRequest contains the request of watching nodes check, a nodes check result
+// will be sent whenever the status of the check changes until the check finished.
+type WatchCheckNodesRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *WatchCheckNodesRequest) Reset()                    { *m = WatchCheckNodesRequest{} }
+func (m *WatchCheckNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchCheckNodesRequest) ProtoMessage()               {}
+func (*WatchCheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *WatchCheckNodesRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// CancelCheckNodesRequest contains the request of canceling the running nodes check.
+type CancelCheckNodesRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *CancelCheckNodesRequest) Reset()                    { *m = CancelCheckNodesRequest{} }
+func (m *CancelCheckNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelCheckNodesRequest) ProtoMessage()               {}
+func (*CancelCheckNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *CancelCheckNodesRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// CancelCheckNodesReply contains the response of a cancel nodes check request.
+type CancelCheckNodesReply struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *CancelCheckNodesReply) Reset()                    { *m = CancelCheckNodesReply{} }
+func (m *CancelCheckNodesReply) String() string            { return proto.CompactTextString(m) }
+func (*CancelCheckNodesReply) ProtoMessage()               {}
+func (*CancelCheckNodesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *CancelCheckNodesReply) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *CancelCheckNodesReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
 type NodePortRange struct {
 	From uint32 `protobuf:"varint,1,opt,name=from" json:"from,omitempty"`
 	To   uint32 `protobuf:"varint,2,opt,name=to" json:"to,omitempty"`
@@ -502,7 +782,7 @@ type NodePortRange struct {
 func (m *NodePortRange) Reset()                    { *m = NodePortRange{} }
 func (m *NodePortRange) String() string            { return proto.CompactTextString(m) }
 func (*NodePortRange) ProtoMessage()               {}
-func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*NodePortRange) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *NodePortRange) GetFrom() uint32 {
 	if m != nil {
@@ -526,7 +806,7 @@ type Keepalived struct {
 func (m *Keepalived) Reset()                    { *m = Keepalived{} }
 func (m *Keepalived) String() string            { return proto.CompactTextString(m) }
 func (*Keepalived) ProtoMessage()               {}
-func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*Keepalived) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *Keepalived) GetVip() string {
 	if m != nil {
@@ -550,7 +830,7 @@ type Loadbalancer struct {
 func (m *Loadbalancer) Reset()                    { *m = Loadbalancer{} }
 func (m *Loadbalancer) String() string            { return proto.CompactTextString(m) }
 func (*Loadbalancer) ProtoMessage()               {}
-func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*Loadbalancer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *Loadbalancer) GetIp() string {
 	if m != nil {
@@ -577,7 +857,7 @@ type KubeAPIServerConnect struct {
 func (m *KubeAPIServerConnect) Reset()                    { *m = KubeAPIServerConnect{} }
 func (m *KubeAPIServerConnect) String() string            { return proto.CompactTextString(m) }
 func (*KubeAPIServerConnect) ProtoMessage()               {}
-func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*KubeAPIServerConnect) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *KubeAPIServerConnect) GetType() string {
 	if m != nil {
@@ -616,7 +896,7 @@ type ClusterConfig struct {
 func (m *ClusterConfig) Reset()                    { *m = ClusterConfig{} }
 func (m *ClusterConfig) String() string            { return proto.CompactTextString(m) }
 func (*ClusterConfig) ProtoMessage()               {}
-func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*ClusterConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *ClusterConfig) GetClusterName() string {
 	if m != nil {
@@ -690,7 +970,7 @@ type Taint struct {
 func (m *Taint) Reset()                    { *m = Taint{} }
 func (m *Taint) String() string            { return proto.CompactTextString(m) }
 func (*Taint) ProtoMessage()               {}
-func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*Taint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *Taint) GetKey() string {
 	if m != nil {
@@ -725,7 +1005,7 @@ type NodeDeployConfig struct {
 func (m *NodeDeployConfig) Reset()                    { *m = NodeDeployConfig{} }
 func (m *NodeDeployConfig) String() string            { return proto.CompactTextString(m) }
 func (*NodeDeployConfig) ProtoMessage()               {}
-func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*NodeDeployConfig) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
 func (m *NodeDeployConfig) GetNode() *Node {
 	if m != nil {
@@ -759,12 +1039,15 @@ func (m *NodeDeployConfig) GetTaints() []*Taint {
 type DeployRequest struct {
 	NodeConfigs   []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
 	ClusterConfig *ClusterConfig      `protobuf:"bytes,2,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	ClusterId     string              `protobuf:"bytes,3,opt,name=clusterId" json:"clusterId,omitempty"`
+	// dryRun means only planning the deploy, nothing will be done on the nodes.
+	DryRun bool `protobuf:"varint,4,opt,name=dryRun" json:"dryRun,omitempty"`
 }
 
 func (m *DeployRequest) Reset()                    { *m = DeployRequest{} }
 func (m *DeployRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeployRequest) ProtoMessage()               {}
-func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*DeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
 
 func (m *DeployRequest) GetNodeConfigs() []*NodeDeployConfig {
 	if m != nil {
@@ -780,16 +1063,32 @@ func (m *DeployRequest) GetClusterConfig() *ClusterConfig {
 	return nil
 }
 
+func (m *DeployRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *DeployRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // DeployReply contains the response of a deploy request.
 type DeployReply struct {
 	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
 	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	// plan is what the deploy will do, it is returned only for a dry run deploy request.
+	Plan *TaskPlan `protobuf:"bytes,3,opt,name=plan" json:"plan,omitempty"`
 }
 
 func (m *DeployReply) Reset()                    { *m = DeployReply{} }
 func (m *DeployReply) String() string            { return proto.CompactTextString(m) }
 func (*DeployReply) ProtoMessage()               {}
-func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*DeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
 
 func (m *DeployReply) GetAccepted() bool {
 	if m != nil {
@@ -805,156 +1104,347 @@ func (m *DeployReply) GetErr() *Error {
 	return nil
 }
 
-// GetDeployResultRequest contains the request of getting deploy result.
-type GetDeployResultRequest struct {
+func (m *DeployReply) GetPlan() *TaskPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
 }
 
-func (m *GetDeployResultRequest) Reset()                    { *m = GetDeployResultRequest{} }
-func (m *GetDeployResultRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployResultRequest) ProtoMessage()               {}
-func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
-
-// DeployItem represents a deploy action in a node for a role.
-type DeployItem struct {
-	Role                string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-	NodeName            string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
-	FailureCanBeIgnored bool   `protobuf:"varint,3,opt,name=failureCanBeIgnored" json:"failureCanBeIgnored,omitempty"`
+// PlannedFile represents a file which will be put to a node.
+type PlannedFile struct {
+	Path    string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content" json:"content,omitempty"`
 }
 
-func (m *DeployItem) Reset()                    { *m = DeployItem{} }
-func (m *DeployItem) String() string            { return proto.CompactTextString(m) }
-func (*DeployItem) ProtoMessage()               {}
-func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (m *PlannedFile) Reset()                    { *m = PlannedFile{} }
+func (m *PlannedFile) String() string            { return proto.CompactTextString(m) }
+func (*PlannedFile) ProtoMessage()               {}
+func (*PlannedFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
-func (m *DeployItem) GetRole() string {
+func (m *PlannedFile) GetPath() string {
 	if m != nil {
-		return m.Role
+		return m.Path
 	}
 	return ""
 }
 
-func (m *DeployItem) GetNodeName() string {
+func (m *PlannedFile) GetContent() string {
 	if m != nil {
-		return m.NodeName
+		return m.Content
 	}
 	return ""
 }
 
-func (m *DeployItem) GetFailureCanBeIgnored() bool {
+// ActionPlan represents what an action will do on a node.
+type ActionPlan struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	// nodeName is the node where the files will be put and the commands will be run.
+	NodeName string         `protobuf:"bytes,3,opt,name=nodeName" json:"nodeName,omitempty"`
+	Files    []*PlannedFile `protobuf:"bytes,4,rep,name=files" json:"files,omitempty"`
+	Commands []string       `protobuf:"bytes,5,rep,name=commands" json:"commands,omitempty"`
+	// err is why the action can't be planned.
+	Err *Error `protobuf:"bytes,6,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *ActionPlan) Reset()                    { *m = ActionPlan{} }
+func (m *ActionPlan) String() string            { return proto.CompactTextString(m) }
+func (*ActionPlan) ProtoMessage()               {}
+func (*ActionPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *ActionPlan) GetName() string {
 	if m != nil {
-		return m.FailureCanBeIgnored
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-// DeployItemResult represents the deploy result in a node for a role.
-type DeployItemResult struct {
-	DeployItem *DeployItem `protobuf:"bytes,1,opt,name=deployItem" json:"deployItem,omitempty"`
-	Status     string      `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
-	Err        *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
-	Logs       string      `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+func (m *ActionPlan) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *DeployItemResult) Reset()                    { *m = DeployItemResult{} }
-func (m *DeployItemResult) String() string            { return proto.CompactTextString(m) }
-func (*DeployItemResult) ProtoMessage()               {}
-func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (m *ActionPlan) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
 
-func (m *DeployItemResult) GetDeployItem() *DeployItem {
+func (m *ActionPlan) GetFiles() []*PlannedFile {
 	if m != nil {
-		return m.DeployItem
+		return m.Files
 	}
 	return nil
 }
 
-func (m *DeployItemResult) GetStatus() string {
+func (m *ActionPlan) GetCommands() []string {
 	if m != nil {
-		return m.Status
+		return m.Commands
 	}
-	return ""
+	return nil
 }
 
-func (m *DeployItemResult) GetErr() *Error {
+func (m *ActionPlan) GetErr() *Error {
 	if m != nil {
 		return m.Err
 	}
 	return nil
 }
 
-func (m *DeployItemResult) GetLogs() string {
+// TaskPlan represents what a task will do, including its sub tasks and actions.
+type TaskPlan struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type,omitempty"`
+	// dependencies are the names of the sibling tasks which should be done before the task starts.
+	Dependencies []string      `protobuf:"bytes,3,rep,name=dependencies" json:"dependencies,omitempty"`
+	SubTasks     []*TaskPlan   `protobuf:"bytes,4,rep,name=subTasks" json:"subTasks,omitempty"`
+	Actions      []*ActionPlan `protobuf:"bytes,5,rep,name=actions" json:"actions,omitempty"`
+}
+
+func (m *TaskPlan) Reset()                    { *m = TaskPlan{} }
+func (m *TaskPlan) String() string            { return proto.CompactTextString(m) }
+func (*TaskPlan) ProtoMessage()               {}
+func (*TaskPlan) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *TaskPlan) GetName() string {
 	if m != nil {
-		return m.Logs
+		return m.Name
 	}
 	return ""
 }
 
-// GetDeployResultReply represents the result of a deploy
-type GetDeployResultReply struct {
-	Status string              `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
-	Err    *Error              `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
-	Items  []*DeployItemResult `protobuf:"bytes,3,rep,name=items" json:"items,omitempty"`
+func (m *TaskPlan) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *GetDeployResultReply) Reset()                    { *m = GetDeployResultReply{} }
-func (m *GetDeployResultReply) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployResultReply) ProtoMessage()               {}
-func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
-
-func (m *GetDeployResultReply) GetStatus() string {
+func (m *TaskPlan) GetDependencies() []string {
 	if m != nil {
-		return m.Status
+		return m.Dependencies
 	}
-	return ""
+	return nil
 }
 
-func (m *GetDeployResultReply) GetErr() *Error {
+func (m *TaskPlan) GetSubTasks() []*TaskPlan {
 	if m != nil {
-		return m.Err
+		return m.SubTasks
 	}
 	return nil
 }
 
-func (m *GetDeployResultReply) GetItems() []*DeployItemResult {
+func (m *TaskPlan) GetActions() []*ActionPlan {
 	if m != nil {
-		return m.Items
+		return m.Actions
 	}
 	return nil
 }
 
-// GetDeployLogRequest contains the request of getting deploy log.
-type GetDeployLogRequest struct {
-	Role     string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
-	NodeName string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+// PlanDeployReply contains the plan of a deploy request.
+type PlanDeployReply struct {
+	Plan *TaskPlan `protobuf:"bytes,1,opt,name=plan" json:"plan,omitempty"`
+	Err  *Error    `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
 }
 
-func (m *GetDeployLogRequest) Reset()                    { *m = GetDeployLogRequest{} }
-func (m *GetDeployLogRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDeployLogRequest) ProtoMessage()               {}
-func (*GetDeployLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (m *PlanDeployReply) Reset()                    { *m = PlanDeployReply{} }
+func (m *PlanDeployReply) String() string            { return proto.CompactTextString(m) }
+func (*PlanDeployReply) ProtoMessage()               {}
+func (*PlanDeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
-func (m *GetDeployLogRequest) GetRole() string {
+func (m *PlanDeployReply) GetPlan() *TaskPlan {
 	if m != nil {
-		return m.Role
+		return m.Plan
 	}
-	return ""
+	return nil
 }
 
-func (m *GetDeployLogRequest) GetNodeName() string {
+func (m *PlanDeployReply) GetErr() *Error {
 	if m != nil {
-		return m.NodeName
+		return m.Err
 	}
-	return ""
+	return nil
 }
 
-// GetDeployLogReply represents the response of getting deploy log.
-type GetDeployLogReply struct {
-	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+// GetDeployResultRequest contains the request of getting deploy result.
+type GetDeployResultRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *GetDeployResultRequest) Reset()                    { *m = GetDeployResultRequest{} }
+func (m *GetDeployResultRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployResultRequest) ProtoMessage()               {}
+func (*GetDeployResultRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *GetDeployResultRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// DeployItem represents a deploy action in a node for a role.
+type DeployItem struct {
+	Role                string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	NodeName            string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+	FailureCanBeIgnored bool   `protobuf:"varint,3,opt,name=failureCanBeIgnored" json:"failureCanBeIgnored,omitempty"`
+}
+
+func (m *DeployItem) Reset()                    { *m = DeployItem{} }
+func (m *DeployItem) String() string            { return proto.CompactTextString(m) }
+func (*DeployItem) ProtoMessage()               {}
+func (*DeployItem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *DeployItem) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *DeployItem) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *DeployItem) GetFailureCanBeIgnored() bool {
+	if m != nil {
+		return m.FailureCanBeIgnored
+	}
+	return false
+}
+
+// DeployItemResult represents the deploy result in a node for a role.
+type DeployItemResult struct {
+	DeployItem *DeployItem `protobuf:"bytes,1,opt,name=deployItem" json:"deployItem,omitempty"`
+	Status     string      `protobuf:"bytes,2,opt,name=status" json:"status,omitempty"`
+	Err        *Error      `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+	Logs       string      `protobuf:"bytes,4,opt,name=logs" json:"logs,omitempty"`
+	// attempts is how many times the action of the deploy item has been attempted
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts" json:"attempts,omitempty"`
+}
+
+func (m *DeployItemResult) Reset()                    { *m = DeployItemResult{} }
+func (m *DeployItemResult) String() string            { return proto.CompactTextString(m) }
+func (*DeployItemResult) ProtoMessage()               {}
+func (*DeployItemResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *DeployItemResult) GetDeployItem() *DeployItem {
+	if m != nil {
+		return m.DeployItem
+	}
+	return nil
+}
+
+func (m *DeployItemResult) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DeployItemResult) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *DeployItemResult) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+func (m *DeployItemResult) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// GetDeployResultReply represents the result of a deploy
+type GetDeployResultReply struct {
+	Status string              `protobuf:"bytes,1,opt,name=status" json:"status,omitempty"`
+	Err    *Error              `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+	Items  []*DeployItemResult `protobuf:"bytes,3,rep,name=items" json:"items,omitempty"`
+}
+
+func (m *GetDeployResultReply) Reset()                    { *m = GetDeployResultReply{} }
+func (m *GetDeployResultReply) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployResultReply) ProtoMessage()               {}
+func (*GetDeployResultReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *GetDeployResultReply) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetDeployResultReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *GetDeployResultReply) GetItems() []*DeployItemResult {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// GetDeployLogRequest contains the request of getting deploy log.
+type GetDeployLogRequest struct {
+	Role      string `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	NodeName  string `protobuf:"bytes,2,opt,name=nodeName" json:"nodeName,omitempty"`
+	ClusterId string `protobuf:"bytes,3,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *GetDeployLogRequest) Reset()                    { *m = GetDeployLogRequest{} }
+func (m *GetDeployLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeployLogRequest) ProtoMessage()               {}
+func (*GetDeployLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *GetDeployLogRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *GetDeployLogRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *GetDeployLogRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// GetDeployLogReply represents the response of getting deploy log.
+type GetDeployLogReply struct {
+	Log []byte `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
 }
 
 func (m *GetDeployLogReply) Reset()                    { *m = GetDeployLogReply{} }
 func (m *GetDeployLogReply) String() string            { return proto.CompactTextString(m) }
 func (*GetDeployLogReply) ProtoMessage()               {}
-func (*GetDeployLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*GetDeployLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *GetDeployLogReply) GetLog() []byte {
 	if m != nil {
@@ -963,6 +1453,167 @@ func (m *GetDeployLogReply) GetLog() []byte {
 	return nil
 }
 
+// ResumeDeployRequest contains the request of resuming an interrupted or failed deploy.
+type ResumeDeployRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *ResumeDeployRequest) Reset()                    { *m = ResumeDeployRequest{} }
+func (m *ResumeDeployRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResumeDeployRequest) ProtoMessage()               {}
+func (*ResumeDeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *ResumeDeployRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// ResumeDeployReply contains the response of a resume deploy request.
+type ResumeDeployReply struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *ResumeDeployReply) Reset()                    { *m = ResumeDeployReply{} }
+func (m *ResumeDeployReply) String() string            { return proto.CompactTextString(m) }
+func (*ResumeDeployReply) ProtoMessage()               {}
+func (*ResumeDeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+func (m *ResumeDeployReply) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *ResumeDeployReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+// WatchDeployRequest contains the request of watching deploy, a deploy result will be sent
+// whenever the status of the deploy changes until the deploy finished.
+type WatchDeployRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *WatchDeployRequest) Reset()                    { *m = WatchDeployRequest{} }
+func (m *WatchDeployRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchDeployRequest) ProtoMessage()               {}
+func (*WatchDeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *WatchDeployRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// TailDeployLogRequest contains the request of following the deploy log of a node in a role,
+// the log will be sent as it is written until the deploy finished.
+type TailDeployLogRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+	Role      string `protobuf:"bytes,2,opt,name=role" json:"role,omitempty"`
+	NodeName  string `protobuf:"bytes,3,opt,name=nodeName" json:"nodeName,omitempty"`
+}
+
+func (m *TailDeployLogRequest) Reset()                    { *m = TailDeployLogRequest{} }
+func (m *TailDeployLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*TailDeployLogRequest) ProtoMessage()               {}
+func (*TailDeployLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *TailDeployLogRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *TailDeployLogRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *TailDeployLogRequest) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+// TailDeployLogReply contains a piece of the execute log of a deploy action.
+type TailDeployLogReply struct {
+	ActionName string `protobuf:"bytes,1,opt,name=actionName" json:"actionName,omitempty"`
+	Log        []byte `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *TailDeployLogReply) Reset()                    { *m = TailDeployLogReply{} }
+func (m *TailDeployLogReply) String() string            { return proto.CompactTextString(m) }
+func (*TailDeployLogReply) ProtoMessage()               {}
+func (*TailDeployLogReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *TailDeployLogReply) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
+func (m *TailDeployLogReply) GetLog() []byte {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+// CancelDeployRequest contains the request of canceling the running deploy.
+type CancelDeployRequest struct {
+	ClusterId string `protobuf:"bytes,1,opt,name=clusterId" json:"clusterId,omitempty"`
+}
+
+func (m *CancelDeployRequest) Reset()                    { *m = CancelDeployRequest{} }
+func (m *CancelDeployRequest) String() string            { return proto.CompactTextString(m) }
+func (*CancelDeployRequest) ProtoMessage()               {}
+func (*CancelDeployRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *CancelDeployRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+// CancelDeployReply contains the response of a cancel deploy request.
+type CancelDeployReply struct {
+	Accepted bool   `protobuf:"varint,1,opt,name=accepted" json:"accepted,omitempty"`
+	Err      *Error `protobuf:"bytes,2,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *CancelDeployReply) Reset()                    { *m = CancelDeployReply{} }
+func (m *CancelDeployReply) String() string            { return proto.CompactTextString(m) }
+func (*CancelDeployReply) ProtoMessage()               {}
+func (*CancelDeployReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *CancelDeployReply) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *CancelDeployReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
 // FetchKubeConfigRequest contains the request of getting kube config.
 type FetchKubeConfigRequest struct {
 	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
@@ -971,7 +1622,7 @@ type FetchKubeConfigRequest struct {
 func (m *FetchKubeConfigRequest) Reset()                    { *m = FetchKubeConfigRequest{} }
 func (m *FetchKubeConfigRequest) String() string            { return proto.CompactTextString(m) }
 func (*FetchKubeConfigRequest) ProtoMessage()               {}
-func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*FetchKubeConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *FetchKubeConfigRequest) GetNode() *Node {
 	if m != nil {
@@ -989,7 +1640,7 @@ type FetchKubeConfigReply struct {
 func (m *FetchKubeConfigReply) Reset()                    { *m = FetchKubeConfigReply{} }
 func (m *FetchKubeConfigReply) String() string            { return proto.CompactTextString(m) }
 func (*FetchKubeConfigReply) ProtoMessage()               {}
-func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*FetchKubeConfigReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *FetchKubeConfigReply) GetKubeConfig() []byte {
 	if m != nil {
@@ -1005,6 +1656,243 @@ func (m *FetchKubeConfigReply) GetErr() *Error {
 	return nil
 }
 
+// CreateJoinTokenRequest creates a short-lived bootstrap token to join nodes to a deployed cluster.
+type CreateJoinTokenRequest struct {
+	// node is a master of the cluster, the token is created on it by kubeadm.
+	Node *Node `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	// ttlSeconds is how long the token lives, it's 2 hours if it's 0 and it can't be longer than 7 days.
+	TtlSeconds uint32 `protobuf:"varint,2,opt,name=ttlSeconds" json:"ttlSeconds,omitempty"`
+}
+
+func (m *CreateJoinTokenRequest) Reset()                    { *m = CreateJoinTokenRequest{} }
+func (m *CreateJoinTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateJoinTokenRequest) ProtoMessage()               {}
+func (*CreateJoinTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+func (m *CreateJoinTokenRequest) GetNode() *Node {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *CreateJoinTokenRequest) GetTtlSeconds() uint32 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
+// CreateJoinTokenReply contains the created join token.
+type CreateJoinTokenReply struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// expiration is the unix timestamp in seconds when the token expires.
+	Expiration int64  `protobuf:"varint,2,opt,name=expiration" json:"expiration,omitempty"`
+	Err        *Error `protobuf:"bytes,3,opt,name=err" json:"err,omitempty"`
+}
+
+func (m *CreateJoinTokenReply) Reset()                    { *m = CreateJoinTokenReply{} }
+func (m *CreateJoinTokenReply) String() string            { return proto.CompactTextString(m) }
+func (*CreateJoinTokenReply) ProtoMessage()               {}
+func (*CreateJoinTokenReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
+func (m *CreateJoinTokenReply) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CreateJoinTokenReply) GetExpiration() int64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+func (m *CreateJoinTokenReply) GetErr() *Error {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+// ScaleOutRequest adds new nodes to a deployed cluster, the cluster's deploy result and log
+// are replaced by the ones of the scale-out.
+type ScaleOutRequest struct {
+	// nodeConfigs are the new nodes, etcd nodes can't be added by scale-out.
+	NodeConfigs []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
+	// masterNodes are the deployed masters of the cluster, the first one must be the master
+	// which initialized the cluster, the join token is created on it.
+	MasterNodes   []*Node        `protobuf:"bytes,2,rep,name=masterNodes" json:"masterNodes,omitempty"`
+	ClusterConfig *ClusterConfig `protobuf:"bytes,3,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	ClusterId     string         `protobuf:"bytes,4,opt,name=clusterId" json:"clusterId,omitempty"`
+	// dryRun means only planning the scale-out, nothing will be done on the nodes.
+	DryRun bool `protobuf:"varint,5,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *ScaleOutRequest) Reset()                    { *m = ScaleOutRequest{} }
+func (m *ScaleOutRequest) String() string            { return proto.CompactTextString(m) }
+func (*ScaleOutRequest) ProtoMessage()               {}
+func (*ScaleOutRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+
+func (m *ScaleOutRequest) GetNodeConfigs() []*NodeDeployConfig {
+	if m != nil {
+		return m.NodeConfigs
+	}
+	return nil
+}
+
+func (m *ScaleOutRequest) GetMasterNodes() []*Node {
+	if m != nil {
+		return m.MasterNodes
+	}
+	return nil
+}
+
+func (m *ScaleOutRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
+}
+
+func (m *ScaleOutRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *ScaleOutRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// RemoveNodesRequest removes nodes from a deployed cluster: the nodes are drained, removed from
+// the etcd cluster, reset and deleted. The cluster's deploy result and log are replaced by the
+// ones of the removal, whose items are the steps of each node.
+type RemoveNodesRequest struct {
+	// nodeConfigs are the nodes to remove with their roles, the etcd member is removed for the
+	// node with the etcd role.
+	NodeConfigs []*NodeDeployConfig `protobuf:"bytes,1,rep,name=nodeConfigs" json:"nodeConfigs,omitempty"`
+	// masterNodes are the masters which remain in the cluster, the first one is used to access
+	// the cluster.
+	MasterNodes []*Node `protobuf:"bytes,2,rep,name=masterNodes" json:"masterNodes,omitempty"`
+	// etcdNodes are all the etcd nodes of the cluster, including the ones to remove.
+	EtcdNodes []*Node `protobuf:"bytes,3,rep,name=etcdNodes" json:"etcdNodes,omitempty"`
+	ClusterId string  `protobuf:"bytes,4,opt,name=clusterId" json:"clusterId,omitempty"`
+	// dryRun means only planning the removal, nothing will be done on the nodes.
+	DryRun bool `protobuf:"varint,5,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *RemoveNodesRequest) Reset()                    { *m = RemoveNodesRequest{} }
+func (m *RemoveNodesRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveNodesRequest) ProtoMessage()               {}
+func (*RemoveNodesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+func (m *RemoveNodesRequest) GetNodeConfigs() []*NodeDeployConfig {
+	if m != nil {
+		return m.NodeConfigs
+	}
+	return nil
+}
+
+func (m *RemoveNodesRequest) GetMasterNodes() []*Node {
+	if m != nil {
+		return m.MasterNodes
+	}
+	return nil
+}
+
+func (m *RemoveNodesRequest) GetEtcdNodes() []*Node {
+	if m != nil {
+		return m.EtcdNodes
+	}
+	return nil
+}
+
+func (m *RemoveNodesRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *RemoveNodesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// UpgradeClusterRequest upgrades a deployed cluster to another kubernetes version: the first master
+// is upgraded by kubeadm upgrade apply, then the other masters by kubeadm upgrade node, then the
+// workers are drained, upgraded and uncordoned one at a time. The upgrade stops on the first failure.
+type UpgradeClusterRequest struct {
+	// masterNodes are the masters of the cluster, the first one is upgraded first and is used to
+	// access the cluster.
+	MasterNodes []*Node `protobuf:"bytes,1,rep,name=masterNodes" json:"masterNodes,omitempty"`
+	// workerNodes are the other nodes of the cluster.
+	WorkerNodes []*Node `protobuf:"bytes,2,rep,name=workerNodes" json:"workerNodes,omitempty"`
+	// kubernetesVersion is the version to upgrade to, which is at most one minor version newer.
+	KubernetesVersion string `protobuf:"bytes,3,opt,name=kubernetesVersion" json:"kubernetesVersion,omitempty"`
+	// clusterConfig is the current config of the cluster, including the current kubernetes version.
+	ClusterConfig *ClusterConfig `protobuf:"bytes,4,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	ClusterId     string         `protobuf:"bytes,5,opt,name=clusterId" json:"clusterId,omitempty"`
+	// dryRun means only planning the upgrade, nothing will be done on the nodes.
+	DryRun bool `protobuf:"varint,6,opt,name=dryRun" json:"dryRun,omitempty"`
+}
+
+func (m *UpgradeClusterRequest) Reset()                    { *m = UpgradeClusterRequest{} }
+func (m *UpgradeClusterRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpgradeClusterRequest) ProtoMessage()               {}
+func (*UpgradeClusterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+func (m *UpgradeClusterRequest) GetMasterNodes() []*Node {
+	if m != nil {
+		return m.MasterNodes
+	}
+	return nil
+}
+
+func (m *UpgradeClusterRequest) GetWorkerNodes() []*Node {
+	if m != nil {
+		return m.WorkerNodes
+	}
+	return nil
+}
+
+func (m *UpgradeClusterRequest) GetKubernetesVersion() string {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return ""
+}
+
+func (m *UpgradeClusterRequest) GetClusterConfig() *ClusterConfig {
+	if m != nil {
+		return m.ClusterConfig
+	}
+	return nil
+}
+
+func (m *UpgradeClusterRequest) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *UpgradeClusterRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // CalicoOptions options for checking requirements for deploying calico network.
 type CalicoOptions struct {
 	// if checkConnectivityAll = true, check connectivity between each pair of nodes bidirectionally.
@@ -1017,7 +1905,7 @@ type CalicoOptions struct {
 func (m *CalicoOptions) Reset()                    { *m = CalicoOptions{} }
 func (m *CalicoOptions) String() string            { return proto.CompactTextString(m) }
 func (*CalicoOptions) ProtoMessage()               {}
-func (*CalicoOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*CalicoOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *CalicoOptions) GetCheckConnectivityAll() bool {
 	if m != nil {
@@ -1050,7 +1938,7 @@ type NetworkOptions struct {
 func (m *NetworkOptions) Reset()                    { *m = NetworkOptions{} }
 func (m *NetworkOptions) String() string            { return proto.CompactTextString(m) }
 func (*NetworkOptions) ProtoMessage()               {}
-func (*NetworkOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*NetworkOptions) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *NetworkOptions) GetNetworkType() string {
 	if m != nil {
@@ -1072,10 +1960,12 @@ type CheckNetworkRequirementRequest struct {
 	Options *NetworkOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
 }
 
-func (m *CheckNetworkRequirementRequest) Reset()                    { *m = CheckNetworkRequirementRequest{} }
-func (m *CheckNetworkRequirementRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckNetworkRequirementRequest) ProtoMessage()               {}
-func (*CheckNetworkRequirementRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (m *CheckNetworkRequirementRequest) Reset()         { *m = CheckNetworkRequirementRequest{} }
+func (m *CheckNetworkRequirementRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckNetworkRequirementRequest) ProtoMessage()    {}
+func (*CheckNetworkRequirementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{56}
+}
 
 func (m *CheckNetworkRequirementRequest) GetNodes() []*Node {
 	if m != nil {
@@ -1102,7 +1992,7 @@ type ConnectivityCheckResult struct {
 func (m *ConnectivityCheckResult) Reset()                    { *m = ConnectivityCheckResult{} }
 func (m *ConnectivityCheckResult) String() string            { return proto.CompactTextString(m) }
 func (*ConnectivityCheckResult) ProtoMessage()               {}
-func (*ConnectivityCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ConnectivityCheckResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 func (m *ConnectivityCheckResult) GetSourceNodeName() string {
 	if m != nil {
@@ -1150,7 +2040,7 @@ type CheckNetworkRequirementsReply struct {
 func (m *CheckNetworkRequirementsReply) Reset()                    { *m = CheckNetworkRequirementsReply{} }
 func (m *CheckNetworkRequirementsReply) String() string            { return proto.CompactTextString(m) }
 func (*CheckNetworkRequirementsReply) ProtoMessage()               {}
-func (*CheckNetworkRequirementsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*CheckNetworkRequirementsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *CheckNetworkRequirementsReply) GetPassed() bool {
 	if m != nil {
@@ -1183,6 +2073,8 @@ func (m *CheckNetworkRequirementsReply) GetConnectivities() []*ConnectivityCheck
 func init() {
 	proto.RegisterType((*Auth)(nil), "protos.Auth")
 	proto.RegisterType((*SSH)(nil), "protos.SSH")
+	proto.RegisterType((*Escalation)(nil), "protos.Escalation")
+	proto.RegisterType((*JumpHost)(nil), "protos.JumpHost")
 	proto.RegisterType((*Node)(nil), "protos.Node")
 	proto.RegisterType((*Error)(nil), "protos.Error")
 	proto.RegisterType((*TestConnectionRequest)(nil), "protos.TestConnectionRequest")
@@ -1197,6 +2089,9 @@ func init() {
 	proto.RegisterType((*GetCheckNodesResultReply)(nil), "protos.GetCheckNodesResultReply")
 	proto.RegisterType((*GetCheckNodesLogRequest)(nil), "protos.GetCheckNodesLogRequest")
 	proto.RegisterType((*GetCheckNodesLogReply)(nil), "protos.GetCheckNodesLogReply")
+	proto.RegisterType((*WatchCheckNodesRequest)(nil), "protos.WatchCheckNodesRequest")
+	proto.RegisterType((*CancelCheckNodesRequest)(nil), "protos.CancelCheckNodesRequest")
+	proto.RegisterType((*CancelCheckNodesReply)(nil), "protos.CancelCheckNodesReply")
 	proto.RegisterType((*NodePortRange)(nil), "protos.NodePortRange")
 	proto.RegisterType((*Keepalived)(nil), "protos.Keepalived")
 	proto.RegisterType((*Loadbalancer)(nil), "protos.Loadbalancer")
@@ -1206,14 +2101,30 @@ func init() {
 	proto.RegisterType((*NodeDeployConfig)(nil), "protos.NodeDeployConfig")
 	proto.RegisterType((*DeployRequest)(nil), "protos.DeployRequest")
 	proto.RegisterType((*DeployReply)(nil), "protos.DeployReply")
+	proto.RegisterType((*PlannedFile)(nil), "protos.PlannedFile")
+	proto.RegisterType((*ActionPlan)(nil), "protos.ActionPlan")
+	proto.RegisterType((*TaskPlan)(nil), "protos.TaskPlan")
+	proto.RegisterType((*PlanDeployReply)(nil), "protos.PlanDeployReply")
 	proto.RegisterType((*GetDeployResultRequest)(nil), "protos.GetDeployResultRequest")
 	proto.RegisterType((*DeployItem)(nil), "protos.DeployItem")
 	proto.RegisterType((*DeployItemResult)(nil), "protos.DeployItemResult")
 	proto.RegisterType((*GetDeployResultReply)(nil), "protos.GetDeployResultReply")
 	proto.RegisterType((*GetDeployLogRequest)(nil), "protos.GetDeployLogRequest")
 	proto.RegisterType((*GetDeployLogReply)(nil), "protos.GetDeployLogReply")
+	proto.RegisterType((*ResumeDeployRequest)(nil), "protos.ResumeDeployRequest")
+	proto.RegisterType((*ResumeDeployReply)(nil), "protos.ResumeDeployReply")
+	proto.RegisterType((*WatchDeployRequest)(nil), "protos.WatchDeployRequest")
+	proto.RegisterType((*TailDeployLogRequest)(nil), "protos.TailDeployLogRequest")
+	proto.RegisterType((*TailDeployLogReply)(nil), "protos.TailDeployLogReply")
+	proto.RegisterType((*CancelDeployRequest)(nil), "protos.CancelDeployRequest")
+	proto.RegisterType((*CancelDeployReply)(nil), "protos.CancelDeployReply")
 	proto.RegisterType((*FetchKubeConfigRequest)(nil), "protos.FetchKubeConfigRequest")
 	proto.RegisterType((*FetchKubeConfigReply)(nil), "protos.FetchKubeConfigReply")
+	proto.RegisterType((*CreateJoinTokenRequest)(nil), "protos.CreateJoinTokenRequest")
+	proto.RegisterType((*CreateJoinTokenReply)(nil), "protos.CreateJoinTokenReply")
+	proto.RegisterType((*ScaleOutRequest)(nil), "protos.ScaleOutRequest")
+	proto.RegisterType((*RemoveNodesRequest)(nil), "protos.RemoveNodesRequest")
+	proto.RegisterType((*UpgradeClusterRequest)(nil), "protos.UpgradeClusterRequest")
 	proto.RegisterType((*CalicoOptions)(nil), "protos.CalicoOptions")
 	proto.RegisterType((*NetworkOptions)(nil), "protos.NetworkOptions")
 	proto.RegisterType((*CheckNetworkRequirementRequest)(nil), "protos.CheckNetworkRequirementRequest")
@@ -1236,10 +2147,21 @@ type DeployContollerClient interface {
 	CheckNodes(ctx context.Context, in *CheckNodesRequest, opts ...grpc.CallOption) (*CheckNodesReply, error)
 	GetCheckNodesResult(ctx context.Context, in *GetCheckNodesResultRequest, opts ...grpc.CallOption) (*GetCheckNodesResultReply, error)
 	GetCheckNodesLog(ctx context.Context, in *GetCheckNodesLogRequest, opts ...grpc.CallOption) (*GetCheckNodesLogReply, error)
+	CancelCheckNodes(ctx context.Context, in *CancelCheckNodesRequest, opts ...grpc.CallOption) (*CancelCheckNodesReply, error)
+	WatchCheckNodes(ctx context.Context, in *WatchCheckNodesRequest, opts ...grpc.CallOption) (DeployContoller_WatchCheckNodesClient, error)
 	Deploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*DeployReply, error)
+	PlanDeploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*PlanDeployReply, error)
 	GetDeployResult(ctx context.Context, in *GetDeployResultRequest, opts ...grpc.CallOption) (*GetDeployResultReply, error)
 	GetDeployLog(ctx context.Context, in *GetDeployLogRequest, opts ...grpc.CallOption) (*GetDeployLogReply, error)
+	ResumeDeploy(ctx context.Context, in *ResumeDeployRequest, opts ...grpc.CallOption) (*ResumeDeployReply, error)
+	CancelDeploy(ctx context.Context, in *CancelDeployRequest, opts ...grpc.CallOption) (*CancelDeployReply, error)
+	WatchDeploy(ctx context.Context, in *WatchDeployRequest, opts ...grpc.CallOption) (DeployContoller_WatchDeployClient, error)
+	TailDeployLog(ctx context.Context, in *TailDeployLogRequest, opts ...grpc.CallOption) (DeployContoller_TailDeployLogClient, error)
 	FetchKubeConfig(ctx context.Context, in *FetchKubeConfigRequest, opts ...grpc.CallOption) (*FetchKubeConfigReply, error)
+	CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*CreateJoinTokenReply, error)
+	ScaleOut(ctx context.Context, in *ScaleOutRequest, opts ...grpc.CallOption) (*DeployReply, error)
+	RemoveNodes(ctx context.Context, in *RemoveNodesRequest, opts ...grpc.CallOption) (*DeployReply, error)
+	UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*DeployReply, error)
 	CheckNetworkRequirements(ctx context.Context, in *CheckNetworkRequirementRequest, opts ...grpc.CallOption) (*CheckNetworkRequirementsReply, error)
 }
 
@@ -1260,31 +2182,72 @@ func (c *deployContollerClient) TestConnection(ctx context.Context, in *TestConn
 	return out, nil
 }
 
-func (c *deployContollerClient) CheckNodes(ctx context.Context, in *CheckNodesRequest, opts ...grpc.CallOption) (*CheckNodesReply, error) {
-	out := new(CheckNodesReply)
-	err := grpc.Invoke(ctx, "/protos.DeployContoller/CheckNodes", in, out, c.cc, opts...)
+func (c *deployContollerClient) CheckNodes(ctx context.Context, in *CheckNodesRequest, opts ...grpc.CallOption) (*CheckNodesReply, error) {
+	out := new(CheckNodesReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/CheckNodes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) GetCheckNodesResult(ctx context.Context, in *GetCheckNodesResultRequest, opts ...grpc.CallOption) (*GetCheckNodesResultReply, error) {
+	out := new(GetCheckNodesResultReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetCheckNodesResult", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) GetCheckNodesLog(ctx context.Context, in *GetCheckNodesLogRequest, opts ...grpc.CallOption) (*GetCheckNodesLogReply, error) {
+	out := new(GetCheckNodesLogReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetCheckNodesLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) CancelCheckNodes(ctx context.Context, in *CancelCheckNodesRequest, opts ...grpc.CallOption) (*CancelCheckNodesReply, error) {
+	out := new(CancelCheckNodesReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/CancelCheckNodes", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *deployContollerClient) GetCheckNodesResult(ctx context.Context, in *GetCheckNodesResultRequest, opts ...grpc.CallOption) (*GetCheckNodesResultReply, error) {
-	out := new(GetCheckNodesResultReply)
-	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetCheckNodesResult", in, out, c.cc, opts...)
+func (c *deployContollerClient) WatchCheckNodes(ctx context.Context, in *WatchCheckNodesRequest, opts ...grpc.CallOption) (DeployContoller_WatchCheckNodesClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DeployContoller_serviceDesc.Streams[0], c.cc, "/protos.DeployContoller/WatchCheckNodes", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &deployContollerWatchCheckNodesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *deployContollerClient) GetCheckNodesLog(ctx context.Context, in *GetCheckNodesLogRequest, opts ...grpc.CallOption) (*GetCheckNodesLogReply, error) {
-	out := new(GetCheckNodesLogReply)
-	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetCheckNodesLog", in, out, c.cc, opts...)
-	if err != nil {
+type DeployContoller_WatchCheckNodesClient interface {
+	Recv() (*GetCheckNodesResultReply, error)
+	grpc.ClientStream
+}
+
+type deployContollerWatchCheckNodesClient struct {
+	grpc.ClientStream
+}
+
+func (x *deployContollerWatchCheckNodesClient) Recv() (*GetCheckNodesResultReply, error) {
+	m := new(GetCheckNodesResultReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 func (c *deployContollerClient) Deploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*DeployReply, error) {
@@ -1296,6 +2259,15 @@ func (c *deployContollerClient) Deploy(ctx context.Context, in *DeployRequest, o
 	return out, nil
 }
 
+func (c *deployContollerClient) PlanDeploy(ctx context.Context, in *DeployRequest, opts ...grpc.CallOption) (*PlanDeployReply, error) {
+	out := new(PlanDeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/PlanDeploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deployContollerClient) GetDeployResult(ctx context.Context, in *GetDeployResultRequest, opts ...grpc.CallOption) (*GetDeployResultReply, error) {
 	out := new(GetDeployResultReply)
 	err := grpc.Invoke(ctx, "/protos.DeployContoller/GetDeployResult", in, out, c.cc, opts...)
@@ -1314,6 +2286,88 @@ func (c *deployContollerClient) GetDeployLog(ctx context.Context, in *GetDeployL
 	return out, nil
 }
 
+func (c *deployContollerClient) ResumeDeploy(ctx context.Context, in *ResumeDeployRequest, opts ...grpc.CallOption) (*ResumeDeployReply, error) {
+	out := new(ResumeDeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/ResumeDeploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) CancelDeploy(ctx context.Context, in *CancelDeployRequest, opts ...grpc.CallOption) (*CancelDeployReply, error) {
+	out := new(CancelDeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/CancelDeploy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) WatchDeploy(ctx context.Context, in *WatchDeployRequest, opts ...grpc.CallOption) (DeployContoller_WatchDeployClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DeployContoller_serviceDesc.Streams[1], c.cc, "/protos.DeployContoller/WatchDeploy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deployContollerWatchDeployClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeployContoller_WatchDeployClient interface {
+	Recv() (*GetDeployResultReply, error)
+	grpc.ClientStream
+}
+
+type deployContollerWatchDeployClient struct {
+	grpc.ClientStream
+}
+
+func (x *deployContollerWatchDeployClient) Recv() (*GetDeployResultReply, error) {
+	m := new(GetDeployResultReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deployContollerClient) TailDeployLog(ctx context.Context, in *TailDeployLogRequest, opts ...grpc.CallOption) (DeployContoller_TailDeployLogClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_DeployContoller_serviceDesc.Streams[2], c.cc, "/protos.DeployContoller/TailDeployLog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deployContollerTailDeployLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeployContoller_TailDeployLogClient interface {
+	Recv() (*TailDeployLogReply, error)
+	grpc.ClientStream
+}
+
+type deployContollerTailDeployLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *deployContollerTailDeployLogClient) Recv() (*TailDeployLogReply, error) {
+	m := new(TailDeployLogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *deployContollerClient) FetchKubeConfig(ctx context.Context, in *FetchKubeConfigRequest, opts ...grpc.CallOption) (*FetchKubeConfigReply, error) {
 	out := new(FetchKubeConfigReply)
 	err := grpc.Invoke(ctx, "/protos.DeployContoller/FetchKubeConfig", in, out, c.cc, opts...)
@@ -1323,6 +2377,42 @@ func (c *deployContollerClient) FetchKubeConfig(ctx context.Context, in *FetchKu
 	return out, nil
 }
 
+func (c *deployContollerClient) CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*CreateJoinTokenReply, error) {
+	out := new(CreateJoinTokenReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/CreateJoinToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) ScaleOut(ctx context.Context, in *ScaleOutRequest, opts ...grpc.CallOption) (*DeployReply, error) {
+	out := new(DeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/ScaleOut", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) RemoveNodes(ctx context.Context, in *RemoveNodesRequest, opts ...grpc.CallOption) (*DeployReply, error) {
+	out := new(DeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/RemoveNodes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployContollerClient) UpgradeCluster(ctx context.Context, in *UpgradeClusterRequest, opts ...grpc.CallOption) (*DeployReply, error) {
+	out := new(DeployReply)
+	err := grpc.Invoke(ctx, "/protos.DeployContoller/UpgradeCluster", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deployContollerClient) CheckNetworkRequirements(ctx context.Context, in *CheckNetworkRequirementRequest, opts ...grpc.CallOption) (*CheckNetworkRequirementsReply, error) {
 	out := new(CheckNetworkRequirementsReply)
 	err := grpc.Invoke(ctx, "/protos.DeployContoller/CheckNetworkRequirements", in, out, c.cc, opts...)
@@ -1339,10 +2429,21 @@ type DeployContollerServer interface {
 	CheckNodes(context.Context, *CheckNodesRequest) (*CheckNodesReply, error)
 	GetCheckNodesResult(context.Context, *GetCheckNodesResultRequest) (*GetCheckNodesResultReply, error)
 	GetCheckNodesLog(context.Context, *GetCheckNodesLogRequest) (*GetCheckNodesLogReply, error)
+	CancelCheckNodes(context.Context, *CancelCheckNodesRequest) (*CancelCheckNodesReply, error)
+	WatchCheckNodes(*WatchCheckNodesRequest, DeployContoller_WatchCheckNodesServer) error
 	Deploy(context.Context, *DeployRequest) (*DeployReply, error)
+	PlanDeploy(context.Context, *DeployRequest) (*PlanDeployReply, error)
 	GetDeployResult(context.Context, *GetDeployResultRequest) (*GetDeployResultReply, error)
 	GetDeployLog(context.Context, *GetDeployLogRequest) (*GetDeployLogReply, error)
+	ResumeDeploy(context.Context, *ResumeDeployRequest) (*ResumeDeployReply, error)
+	CancelDeploy(context.Context, *CancelDeployRequest) (*CancelDeployReply, error)
+	WatchDeploy(*WatchDeployRequest, DeployContoller_WatchDeployServer) error
+	TailDeployLog(*TailDeployLogRequest, DeployContoller_TailDeployLogServer) error
 	FetchKubeConfig(context.Context, *FetchKubeConfigRequest) (*FetchKubeConfigReply, error)
+	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenReply, error)
+	ScaleOut(context.Context, *ScaleOutRequest) (*DeployReply, error)
+	RemoveNodes(context.Context, *RemoveNodesRequest) (*DeployReply, error)
+	UpgradeCluster(context.Context, *UpgradeClusterRequest) (*DeployReply, error)
 	CheckNetworkRequirements(context.Context, *CheckNetworkRequirementRequest) (*CheckNetworkRequirementsReply, error)
 }
 
@@ -1422,6 +2523,45 @@ func _DeployContoller_GetCheckNodesLog_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployContoller_CancelCheckNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCheckNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).CancelCheckNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/CancelCheckNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).CancelCheckNodes(ctx, req.(*CancelCheckNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_WatchCheckNodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCheckNodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeployContollerServer).WatchCheckNodes(m, &deployContollerWatchCheckNodesServer{stream})
+}
+
+type DeployContoller_WatchCheckNodesServer interface {
+	Send(*GetCheckNodesResultReply) error
+	grpc.ServerStream
+}
+
+type deployContollerWatchCheckNodesServer struct {
+	grpc.ServerStream
+}
+
+func (x *deployContollerWatchCheckNodesServer) Send(m *GetCheckNodesResultReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DeployContoller_Deploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeployRequest)
 	if err := dec(in); err != nil {
@@ -1440,6 +2580,24 @@ func _DeployContoller_Deploy_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployContoller_PlanDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).PlanDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/PlanDeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).PlanDeploy(ctx, req.(*DeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeployContoller_GetDeployResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDeployResultRequest)
 	if err := dec(in); err != nil {
@@ -1476,6 +2634,84 @@ func _DeployContoller_GetDeployLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployContoller_ResumeDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).ResumeDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/ResumeDeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).ResumeDeploy(ctx, req.(*ResumeDeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_CancelDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).CancelDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/CancelDeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).CancelDeploy(ctx, req.(*CancelDeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_WatchDeploy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeployRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeployContollerServer).WatchDeploy(m, &deployContollerWatchDeployServer{stream})
+}
+
+type DeployContoller_WatchDeployServer interface {
+	Send(*GetDeployResultReply) error
+	grpc.ServerStream
+}
+
+type deployContollerWatchDeployServer struct {
+	grpc.ServerStream
+}
+
+func (x *deployContollerWatchDeployServer) Send(m *GetDeployResultReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeployContoller_TailDeployLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailDeployLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeployContollerServer).TailDeployLog(m, &deployContollerTailDeployLogServer{stream})
+}
+
+type DeployContoller_TailDeployLogServer interface {
+	Send(*TailDeployLogReply) error
+	grpc.ServerStream
+}
+
+type deployContollerTailDeployLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *deployContollerTailDeployLogServer) Send(m *TailDeployLogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DeployContoller_FetchKubeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FetchKubeConfigRequest)
 	if err := dec(in); err != nil {
@@ -1494,6 +2730,78 @@ func _DeployContoller_FetchKubeConfig_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeployContoller_CreateJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJoinTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).CreateJoinToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/CreateJoinToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).CreateJoinToken(ctx, req.(*CreateJoinTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_ScaleOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).ScaleOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/ScaleOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).ScaleOut(ctx, req.(*ScaleOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_RemoveNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).RemoveNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/RemoveNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).RemoveNodes(ctx, req.(*RemoveNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployContoller_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployContollerServer).UpgradeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protos.DeployContoller/UpgradeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeployContollerServer).UpgradeCluster(ctx, req.(*UpgradeClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeployContoller_CheckNetworkRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckNetworkRequirementRequest)
 	if err := dec(in); err != nil {
@@ -1532,10 +2840,18 @@ var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCheckNodesLog",
 			Handler:    _DeployContoller_GetCheckNodesLog_Handler,
 		},
+		{
+			MethodName: "CancelCheckNodes",
+			Handler:    _DeployContoller_CancelCheckNodes_Handler,
+		},
 		{
 			MethodName: "Deploy",
 			Handler:    _DeployContoller_Deploy_Handler,
 		},
+		{
+			MethodName: "PlanDeploy",
+			Handler:    _DeployContoller_PlanDeploy_Handler,
+		},
 		{
 			MethodName: "GetDeployResult",
 			Handler:    _DeployContoller_GetDeployResult_Handler,
@@ -1544,124 +2860,218 @@ var _DeployContoller_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeployLog",
 			Handler:    _DeployContoller_GetDeployLog_Handler,
 		},
+		{
+			MethodName: "ResumeDeploy",
+			Handler:    _DeployContoller_ResumeDeploy_Handler,
+		},
+		{
+			MethodName: "CancelDeploy",
+			Handler:    _DeployContoller_CancelDeploy_Handler,
+		},
 		{
 			MethodName: "FetchKubeConfig",
 			Handler:    _DeployContoller_FetchKubeConfig_Handler,
 		},
+		{
+			MethodName: "CreateJoinToken",
+			Handler:    _DeployContoller_CreateJoinToken_Handler,
+		},
+		{
+			MethodName: "ScaleOut",
+			Handler:    _DeployContoller_ScaleOut_Handler,
+		},
+		{
+			MethodName: "RemoveNodes",
+			Handler:    _DeployContoller_RemoveNodes_Handler,
+		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _DeployContoller_UpgradeCluster_Handler,
+		},
 		{
 			MethodName: "CheckNetworkRequirements",
 			Handler:    _DeployContoller_CheckNetworkRequirements_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCheckNodes",
+			Handler:       _DeployContoller_WatchCheckNodes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDeploy",
+			Handler:       _DeployContoller_WatchDeploy_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TailDeployLog",
+			Handler:       _DeployContoller_TailDeployLog_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "deploy_controller.proto",
 }
 
 func init() { proto.RegisterFile("deploy_controller.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x4f, 0x1c, 0x47,
-	0x13, 0xf7, 0xec, 0x03, 0x43, 0x2d, 0xcb, 0xa3, 0x59, 0x60, 0xbd, 0x06, 0x8c, 0x5a, 0xc6, 0xe2,
-	0xf3, 0xf7, 0x7d, 0xc8, 0x59, 0x2b, 0x91, 0x1f, 0x49, 0x24, 0x8c, 0x09, 0xc6, 0x0f, 0x62, 0x0f,
-	0xc8, 0x3e, 0x45, 0xd1, 0x30, 0xdb, 0xc0, 0x88, 0x61, 0x7a, 0xd2, 0xd3, 0xbb, 0xf1, 0x9e, 0x72,
-	0x4a, 0x94, 0x5b, 0x0e, 0x51, 0xa4, 0xfc, 0x59, 0xb9, 0xe7, 0x2f, 0x48, 0x2e, 0xf9, 0x07, 0x72,
-	0x88, 0xfa, 0x35, 0xdb, 0x33, 0x3b, 0x6b, 0xb0, 0xc9, 0x69, 0xa7, 0xeb, 0xd5, 0xbf, 0xaa, 0xae,
-	0xae, 0xae, 0x5a, 0x58, 0xec, 0x90, 0x38, 0xa4, 0xfd, 0xaf, 0x7d, 0x1a, 0x71, 0x46, 0xc3, 0x90,
-	0xb0, 0x8d, 0x98, 0x51, 0x4e, 0xd1, 0x98, 0xfc, 0x49, 0xf0, 0x6b, 0xa8, 0x6c, 0x76, 0xf9, 0x09,
-	0x42, 0x50, 0xe1, 0xfd, 0x98, 0x34, 0x9d, 0x55, 0x67, 0x7d, 0xc2, 0x95, 0xdf, 0x68, 0x05, 0xc0,
-	0x67, 0xa4, 0x43, 0x22, 0x1e, 0x78, 0x61, 0xb3, 0x24, 0x39, 0x16, 0x05, 0xb5, 0x60, 0xbc, 0x9b,
-	0x10, 0x16, 0x79, 0x67, 0xa4, 0x59, 0x96, 0xdc, 0x74, 0x8d, 0x1f, 0x42, 0x79, 0x7f, 0xff, 0x89,
-	0x30, 0x1b, 0x53, 0xc6, 0xa5, 0xd9, 0xba, 0x2b, 0xbf, 0xd1, 0x2a, 0x54, 0xbc, 0x2e, 0x3f, 0x91,
-	0x06, 0x6b, 0xed, 0x49, 0x05, 0x28, 0xd9, 0x10, 0x30, 0x5c, 0xc9, 0xc1, 0xbb, 0x50, 0xd9, 0xa3,
-	0x1d, 0x22, 0xb4, 0xa5, 0x71, 0x0d, 0x4a, 0x7c, 0xa3, 0x29, 0x28, 0x05, 0xb1, 0x06, 0x53, 0x0a,
-	0x62, 0xb4, 0x0c, 0xe5, 0x24, 0x39, 0x91, 0xfb, 0xd7, 0xda, 0x35, 0x63, 0x6c, 0x7f, 0xff, 0x89,
-	0x2b, 0xe8, 0xf8, 0x0d, 0x54, 0xb7, 0x19, 0xa3, 0x0c, 0x2d, 0xc0, 0x18, 0x23, 0x5e, 0x42, 0x23,
-	0x6d, 0x4d, 0xaf, 0x04, 0xbd, 0x43, 0xb8, 0x17, 0x18, 0x07, 0xf5, 0x4a, 0x38, 0x7f, 0x14, 0xbc,
-	0x7d, 0x41, 0xf8, 0x09, 0xed, 0x24, 0xda, 0x3d, 0x8b, 0x82, 0xef, 0xc3, 0xfc, 0x01, 0x49, 0xf8,
-	0x16, 0x8d, 0x22, 0xe2, 0xf3, 0x80, 0x46, 0x2e, 0xf9, 0xa6, 0x4b, 0x12, 0xe9, 0x5e, 0x44, 0x3b,
-	0x0a, 0xb4, 0xe5, 0x9e, 0x70, 0xc8, 0x95, 0x1c, 0xbc, 0x07, 0x73, 0x79, 0xd5, 0x38, 0xec, 0x0b,
-	0x24, 0xb1, 0x97, 0x24, 0xa4, 0x23, 0x55, 0xc7, 0x5d, 0xbd, 0x42, 0x37, 0xa0, 0x4c, 0x18, 0xd3,
-	0xe1, 0xaa, 0x1b, 0x7b, 0xd2, 0x2b, 0x57, 0x70, 0xf0, 0x2e, 0x4c, 0x0b, 0xeb, 0x5b, 0x27, 0xc4,
-	0x3f, 0xdd, 0xa2, 0xd1, 0x51, 0x70, 0x7c, 0x3e, 0x08, 0xd4, 0x80, 0x2a, 0xa3, 0x21, 0x49, 0x9a,
-	0xa5, 0xd5, 0xf2, 0xfa, 0x84, 0xab, 0x16, 0xf8, 0x07, 0x07, 0x66, 0xa5, 0x1d, 0x21, 0x99, 0x18,
-	0x97, 0x3e, 0x82, 0xab, 0xbe, 0xb4, 0x9b, 0x34, 0x9d, 0xd5, 0xf2, 0x7a, 0xad, 0xbd, 0x68, 0x1b,
-	0xb4, 0xf6, 0x75, 0x8d, 0x1c, 0xfa, 0x1c, 0xa6, 0x22, 0xc2, 0xbf, 0xa5, 0xec, 0xf4, 0xcb, 0x58,
-	0xb8, 0x98, 0x68, 0xfc, 0x0b, 0xa9, 0x66, 0x86, 0xeb, 0xe6, 0xa4, 0xf1, 0x1e, 0x4c, 0xdb, 0x38,
-	0x44, 0x7c, 0x5a, 0x30, 0xee, 0xf9, 0x3e, 0x89, 0x79, 0x1a, 0xa1, 0x74, 0x7d, 0x7e, 0x8c, 0x36,
-	0x61, 0x42, 0xda, 0xdb, 0xe5, 0xe4, 0xac, 0x30, 0xaf, 0x56, 0xa1, 0xd6, 0x21, 0x89, 0xcf, 0x02,
-	0x09, 0x40, 0x27, 0x83, 0x4d, 0xc2, 0xdf, 0x3b, 0x30, 0x2d, 0xd4, 0xa5, 0x1d, 0x97, 0x24, 0xdd,
-	0x90, 0xa3, 0x35, 0xa8, 0x04, 0x9c, 0x9c, 0xe9, 0x38, 0xcf, 0x9a, 0x8d, 0xd3, 0xad, 0x5c, 0xc9,
-	0x16, 0x47, 0x9b, 0x70, 0x8f, 0x77, 0x13, 0x93, 0x64, 0x6a, 0x65, 0x60, 0x97, 0x47, 0xc1, 0x16,
-	0x48, 0x43, 0x7a, 0x9c, 0x34, 0x2b, 0x0a, 0xa9, 0xf8, 0xc6, 0xbf, 0x38, 0xd6, 0x79, 0x6b, 0x1c,
-	0x2d, 0x18, 0x17, 0xa7, 0xba, 0x37, 0xf0, 0x2a, 0x5d, 0x7f, 0xf8, 0xe6, 0xff, 0x87, 0xaa, 0x40,
-	0x2f, 0x76, 0xcf, 0x1c, 0x7a, 0x2e, 0x08, 0xae, 0x92, 0xc2, 0x4b, 0xd0, 0xda, 0x21, 0xdc, 0x3e,
-	0x35, 0xc9, 0x55, 0x39, 0x84, 0xff, 0x70, 0xa0, 0x59, 0xc8, 0xd6, 0xa9, 0xaf, 0x21, 0x3a, 0x45,
-	0x10, 0x47, 0x1e, 0x2b, 0xda, 0x84, 0xaa, 0xf0, 0x53, 0x5c, 0x50, 0x01, 0xf1, 0xbf, 0x46, 0x64,
-	0xd4, 0x4e, 0x32, 0x61, 0x93, 0xed, 0x88, 0xb3, 0xbe, 0xab, 0x34, 0x5b, 0xaf, 0x00, 0x06, 0x44,
-	0x34, 0x03, 0xe5, 0x53, 0xd2, 0xd7, 0x30, 0xc4, 0xa7, 0x88, 0x42, 0xcf, 0x0b, 0xbb, 0x44, 0xa3,
-	0x18, 0x4e, 0x7d, 0x13, 0x05, 0x29, 0xf5, 0xa0, 0x74, 0xcf, 0xc1, 0x1f, 0xc3, 0x62, 0x06, 0xc0,
-	0x73, 0x7a, 0x6c, 0xae, 0xd2, 0x3b, 0x0e, 0x0a, 0xff, 0x07, 0xe6, 0x87, 0xd5, 0x44, 0x78, 0x66,
-	0xa0, 0x1c, 0xd2, 0x63, 0x29, 0x3f, 0xe9, 0x8a, 0x4f, 0x7c, 0x17, 0xea, 0x42, 0xe4, 0x25, 0x65,
-	0xdc, 0xf5, 0xa2, 0x63, 0x59, 0x2a, 0x8f, 0x18, 0x3d, 0x33, 0x85, 0x56, 0x7c, 0x8b, 0x52, 0xc9,
-	0xa9, 0x84, 0x5d, 0x77, 0x4b, 0x9c, 0xe2, 0xa7, 0x00, 0xcf, 0x08, 0x89, 0xbd, 0x30, 0xe8, 0x91,
-	0x8e, 0x30, 0xda, 0x0b, 0x62, 0xe3, 0x69, 0x2f, 0x88, 0xd1, 0x6d, 0x98, 0x89, 0x08, 0xdf, 0x8d,
-	0x38, 0x61, 0x47, 0x9e, 0xaf, 0x30, 0xaa, 0x94, 0x19, 0xa2, 0xe3, 0x36, 0x4c, 0x3e, 0xa7, 0x5e,
-	0xe7, 0xd0, 0x0b, 0xbd, 0xc8, 0x27, 0x4c, 0x97, 0x65, 0x27, 0x2d, 0xcb, 0xa6, 0xf0, 0x97, 0x06,
-	0x85, 0x1f, 0xff, 0xea, 0x40, 0xe3, 0x59, 0xf7, 0x90, 0x6c, 0xbe, 0xdc, 0xdd, 0x27, 0xac, 0x47,
-	0x98, 0xae, 0x80, 0x85, 0x8f, 0x4f, 0x1b, 0xe0, 0x34, 0x05, 0xab, 0x63, 0x8f, 0x4c, 0xec, 0x07,
-	0x6e, 0xb8, 0x96, 0x14, 0xba, 0x07, 0x93, 0xa1, 0x05, 0x4a, 0xa7, 0x76, 0xc3, 0x68, 0xd9, 0x80,
-	0xdd, 0x8c, 0x24, 0xfe, 0xbb, 0x02, 0xf5, 0xad, 0xb0, 0x9b, 0x70, 0x09, 0x4a, 0x55, 0xd0, 0x9a,
-	0xaf, 0x08, 0xd6, 0x59, 0xd9, 0x24, 0xf4, 0x12, 0x1a, 0xa7, 0x05, 0xde, 0x68, 0xac, 0x4b, 0x29,
-	0xd6, 0x02, 0x19, 0xb7, 0x50, 0x13, 0x3d, 0x84, 0x7a, 0x64, 0x9f, 0xaa, 0x76, 0x60, 0xde, 0x4e,
-	0xb9, 0x94, 0xe9, 0x66, 0x65, 0xd1, 0x36, 0x80, 0x20, 0x3c, 0xf7, 0x0e, 0x49, 0x68, 0xae, 0xec,
-	0x5a, 0x5a, 0x90, 0x6c, 0xdf, 0xa4, 0x1d, 0x25, 0xa7, 0x6e, 0x82, 0xa5, 0x88, 0x0e, 0x60, 0x5a,
-	0xac, 0x36, 0xa3, 0x88, 0x72, 0x4f, 0x55, 0xee, 0xaa, 0xb4, 0x75, 0x7b, 0xb4, 0x2d, 0x4b, 0x58,
-	0x19, 0xcc, 0x9b, 0x40, 0xeb, 0x30, 0x1d, 0x9c, 0x79, 0xc7, 0xc4, 0x25, 0x31, 0x4d, 0x02, 0x4e,
-	0x59, 0xbf, 0x39, 0x26, 0x23, 0x9a, 0x27, 0xa3, 0x25, 0x98, 0x88, 0x69, 0x67, 0xbf, 0x7b, 0x18,
-	0x11, 0xde, 0xbc, 0x2a, 0x65, 0x06, 0x04, 0x74, 0x13, 0xea, 0x09, 0x61, 0xbd, 0xc0, 0x27, 0x5a,
-	0x62, 0x5c, 0x4a, 0x64, 0x89, 0xe8, 0x7f, 0x30, 0x2b, 0xe2, 0xcb, 0x22, 0xc2, 0x49, 0xf2, 0x9a,
-	0xb0, 0x44, 0x54, 0xf4, 0x09, 0x29, 0x39, 0xcc, 0x68, 0x7d, 0xa6, 0xca, 0xa9, 0x15, 0x90, 0x82,
-	0x2a, 0xd0, 0xb0, 0xab, 0xc0, 0x84, 0x75, 0xd9, 0x5b, 0x8f, 0xa0, 0x51, 0x14, 0x83, 0xf7, 0xb1,
-	0x81, 0x77, 0xa0, 0x7a, 0xe0, 0x05, 0x11, 0xbf, 0xa8, 0x92, 0x28, 0x98, 0xe4, 0xe8, 0x48, 0x64,
-	0x9b, 0xea, 0x4c, 0xf4, 0x0a, 0xff, 0xe9, 0xc0, 0x8c, 0x40, 0xf3, 0x58, 0xb6, 0x7d, 0x97, 0x6b,
-	0x06, 0xd0, 0xa7, 0x30, 0x16, 0xaa, 0x6c, 0x52, 0xd5, 0xf5, 0xa6, 0xad, 0x69, 0xef, 0xb0, 0x61,
-	0x27, 0x93, 0xd6, 0x41, 0x6b, 0x30, 0xc6, 0x85, 0x4f, 0x26, 0x17, 0xd3, 0xf2, 0x2d, 0x3d, 0x75,
-	0x35, 0xb3, 0x75, 0x1f, 0x6a, 0x1f, 0x18, 0x79, 0xfc, 0xa3, 0x03, 0x75, 0x05, 0xc3, 0x54, 0xd7,
-	0x07, 0x50, 0x13, 0xfe, 0x6c, 0x65, 0x9a, 0x95, 0xe6, 0x28, 0xd8, 0xae, 0x2d, 0x2c, 0x2e, 0x9f,
-	0x6f, 0x67, 0xb6, 0xbe, 0xc7, 0xf3, 0x85, 0x69, 0xef, 0x66, 0x65, 0xf1, 0x53, 0xa8, 0x19, 0x24,
-	0x97, 0x6e, 0x55, 0x9a, 0xb0, 0xb0, 0x43, 0xb8, 0x31, 0x67, 0xbf, 0xa1, 0x11, 0x80, 0x22, 0x9b,
-	0x2e, 0x46, 0x9c, 0x93, 0xa9, 0x9a, 0xe2, 0x3b, 0xf3, 0xbc, 0x94, 0x72, 0x7d, 0xc0, 0x1d, 0x98,
-	0x3b, 0xf2, 0x82, 0xb0, 0xcb, 0xc8, 0x96, 0x17, 0x3d, 0x22, 0xbb, 0xc7, 0x11, 0x65, 0xa4, 0x23,
-	0x13, 0x68, 0xdc, 0x2d, 0x62, 0xe1, 0x9f, 0x1d, 0x98, 0x19, 0x6c, 0xa8, 0x5b, 0x8d, 0x36, 0x40,
-	0x27, 0xa5, 0xe9, 0x9c, 0x4a, 0x0b, 0xb3, 0x25, 0x6d, 0x49, 0xfd, 0xbb, 0xfd, 0xcf, 0x77, 0xd0,
-	0x18, 0x8a, 0xcf, 0xa5, 0x9a, 0x88, 0x0d, 0xd3, 0xe7, 0x94, 0xb3, 0xf9, 0x92, 0x77, 0xdd, 0x34,
-	0x3a, 0xdb, 0x30, 0x97, 0x02, 0xb0, 0x9e, 0xf6, 0xf7, 0x3c, 0x0f, 0xbc, 0x06, 0xb3, 0x59, 0x33,
-	0xc5, 0x4f, 0xfd, 0x03, 0x58, 0xf8, 0x82, 0x70, 0xff, 0x44, 0xbc, 0x23, 0x3a, 0xf9, 0x2e, 0x3c,
-	0x69, 0xbc, 0x81, 0xc6, 0x90, 0xae, 0xd8, 0x65, 0x05, 0xe0, 0x34, 0x25, 0xe9, 0xcd, 0x2c, 0xca,
-	0xf9, 0x39, 0xfa, 0x93, 0x03, 0xf5, 0x2d, 0x2f, 0x0c, 0x7c, 0xaa, 0x1b, 0x76, 0xd4, 0x86, 0x86,
-	0xaf, 0x07, 0x01, 0x39, 0xd5, 0xf4, 0x02, 0xde, 0xdf, 0x0c, 0x43, 0x9d, 0xfe, 0x85, 0x3c, 0x51,
-	0xa7, 0x49, 0xe4, 0x7b, 0x71, 0xd2, 0x0d, 0x65, 0xe5, 0x7c, 0x21, 0xbc, 0x51, 0x61, 0x1a, 0x66,
-	0x88, 0x97, 0xa1, 0xf7, 0x36, 0xf4, 0x22, 0xf1, 0xe4, 0x35, 0x41, 0xf6, 0x15, 0x03, 0x02, 0xa6,
-	0x30, 0x95, 0x1d, 0x29, 0xc4, 0x0b, 0xae, 0x87, 0x8a, 0x83, 0x41, 0x73, 0x61, 0x93, 0xe4, 0x95,
-	0xb7, 0x9d, 0x90, 0x56, 0xed, 0x2b, 0x6f, 0x33, 0xdd, 0xac, 0x2c, 0xee, 0xc1, 0x8a, 0x6a, 0xd5,
-	0x94, 0x41, 0x71, 0x28, 0x01, 0x23, 0x67, 0x24, 0x32, 0xd7, 0x15, 0x61, 0xd3, 0x9c, 0xaa, 0x3a,
-	0x94, 0x3d, 0x20, 0xc5, 0x42, 0x77, 0xe0, 0x2a, 0xbd, 0xd0, 0x80, 0x64, 0xc4, 0xf0, 0xef, 0x0e,
-	0x2c, 0xda, 0x81, 0xb4, 0xc7, 0x80, 0x5b, 0x30, 0xb5, 0x4f, 0xbb, 0xcc, 0x27, 0x7b, 0xd9, 0x1e,
-	0x33, 0x47, 0x15, 0xa5, 0xe0, 0x31, 0x49, 0x78, 0x10, 0xc9, 0xe8, 0xee, 0x65, 0x33, 0xb4, 0x88,
-	0x65, 0x5d, 0xae, 0x72, 0xd1, 0xe5, 0xaa, 0x9c, 0x3f, 0x44, 0x54, 0x2f, 0x34, 0x44, 0xfc, 0xe6,
-	0xc0, 0xf2, 0x88, 0xb0, 0x26, 0x97, 0x1b, 0x93, 0x05, 0x12, 0x7b, 0x56, 0x18, 0xdd, 0xc8, 0xab,
-	0x93, 0xd9, 0x81, 0x29, 0x7f, 0x10, 0xe6, 0x80, 0x98, 0x77, 0xec, 0x46, 0x9a, 0x1d, 0xc5, 0x87,
-	0xe0, 0xe6, 0xd4, 0xda, 0x7f, 0x55, 0x61, 0x3a, 0x7d, 0x76, 0xb8, 0xfc, 0x13, 0x06, 0xed, 0xc1,
-	0x54, 0xf6, 0x2f, 0x00, 0xb4, 0x9c, 0x3e, 0x8f, 0x45, 0xff, 0x2a, 0xb4, 0xae, 0x8f, 0x62, 0xc7,
-	0x61, 0x1f, 0x5f, 0x41, 0x8f, 0x00, 0x06, 0x73, 0x03, 0xba, 0x96, 0x99, 0x43, 0xed, 0x51, 0xbe,
-	0xb5, 0x58, 0xc4, 0x52, 0x36, 0xbe, 0x92, 0x65, 0x2d, 0x3f, 0x36, 0x21, 0xfc, 0xce, 0x99, 0x4a,
-	0x59, 0x5d, 0x3d, 0x6f, 0xee, 0xc2, 0x57, 0xd0, 0x01, 0xcc, 0xe4, 0xa7, 0x1b, 0x74, 0xa3, 0x50,
-	0x6f, 0x50, 0x53, 0x5b, 0xcb, 0xa3, 0x05, 0x94, 0xd5, 0x4f, 0x60, 0x4c, 0xc5, 0x16, 0xcd, 0x67,
-	0xcb, 0xb6, 0xb1, 0x30, 0x97, 0x27, 0x2b, 0xbd, 0x57, 0x30, 0x9d, 0x7b, 0x44, 0xd0, 0x8a, 0xb5,
-	0x57, 0xc1, 0xeb, 0xdb, 0x5a, 0x1a, 0xc9, 0x57, 0x26, 0x9f, 0xc0, 0xa4, 0x5d, 0xcf, 0xd1, 0xf5,
-	0x21, 0x79, 0xcb, 0xb1, 0x6b, 0xc5, 0xcc, 0x14, 0x5c, 0xae, 0x6c, 0x0f, 0xc0, 0x15, 0xbf, 0x05,
-	0x03, 0x70, 0x45, 0xf5, 0x1e, 0x5f, 0x41, 0xa7, 0xd0, 0x1c, 0x75, 0xad, 0xd0, 0xad, 0x6c, 0x4e,
-	0x8c, 0xaa, 0x67, 0xad, 0xb5, 0x73, 0xe4, 0x4c, 0x26, 0x1d, 0xaa, 0x3f, 0x17, 0xef, 0xfe, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x8d, 0xed, 0xa0, 0xdb, 0x7e, 0x14, 0x00, 0x00,
+	// 2509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0x5e, 0xce, 0x43, 0x1e, 0xd5, 0x48, 0x1a, 0xb9, 0x35, 0x92, 0xc6, 0x5c, 0xd9, 0x16, 0x08,
+	0xdb, 0xd0, 0x3a, 0x5e, 0xc1, 0x91, 0x01, 0xc7, 0xeb, 0x4d, 0x16, 0x91, 0xb5, 0x7e, 0xc8, 0x0f,
+	0xad, 0x4d, 0x29, 0xf1, 0x22, 0x40, 0x10, 0x50, 0x64, 0x4b, 0x62, 0xc4, 0x61, 0x73, 0xc9, 0x1e,
+	0xad, 0x75, 0x4a, 0x2e, 0x39, 0xe7, 0x14, 0x60, 0x7f, 0x40, 0x80, 0x1c, 0x13, 0xe4, 0x94, 0x53,
+	0xfe, 0x42, 0x72, 0xca, 0x25, 0xb7, 0xdc, 0x92, 0x53, 0x7e, 0x40, 0x0e, 0x41, 0x3f, 0xd9, 0xe4,
+	0x90, 0x7a, 0x58, 0x8b, 0x3d, 0x89, 0xdd, 0x55, 0x5d, 0x5d, 0xfd, 0x55, 0x75, 0x75, 0x55, 0x8d,
+	0x60, 0x31, 0xc0, 0x49, 0x44, 0x8e, 0x7f, 0xe1, 0x93, 0x98, 0xa6, 0x24, 0x8a, 0x70, 0xba, 0x9a,
+	0xa4, 0x84, 0x12, 0x34, 0xc1, 0xff, 0x64, 0xce, 0x9f, 0x2c, 0x68, 0xad, 0x8f, 0xe8, 0x01, 0x42,
+	0xd0, 0xa2, 0xc7, 0x09, 0x1e, 0x58, 0xcb, 0xd6, 0xca, 0xa4, 0xcb, 0xbf, 0xd1, 0x35, 0x00, 0x3f,
+	0xc5, 0x01, 0x8e, 0x69, 0xe8, 0x45, 0x83, 0x06, 0xa7, 0x18, 0x33, 0xc8, 0x86, 0xce, 0x28, 0xc3,
+	0x69, 0xec, 0x0d, 0xf1, 0xa0, 0xc9, 0xa9, 0x7a, 0xcc, 0xd6, 0x26, 0x5e, 0x96, 0x25, 0x07, 0xa9,
+	0x97, 0xe1, 0x41, 0x4b, 0xac, 0xcd, 0x67, 0xd0, 0x32, 0x74, 0x7d, 0x9c, 0xd2, 0x70, 0x2f, 0xf4,
+	0x3d, 0x8a, 0x07, 0x6d, 0xce, 0x60, 0x4e, 0xa1, 0x05, 0x98, 0xc8, 0xb0, 0x17, 0xe1, 0x60, 0x30,
+	0xb1, 0x6c, 0xad, 0x74, 0x5c, 0x39, 0x72, 0xfe, 0x66, 0x41, 0x73, 0x7b, 0xfb, 0x19, 0xd3, 0x38,
+	0x21, 0x29, 0xe5, 0x1a, 0x4f, 0xbb, 0xfc, 0x1b, 0x2d, 0x43, 0xcb, 0x1b, 0xd1, 0x03, 0xae, 0x6b,
+	0x77, 0x6d, 0x4a, 0x1c, 0x36, 0x5b, 0x65, 0x27, 0x74, 0x39, 0x05, 0xad, 0x02, 0x3a, 0x20, 0x19,
+	0x7d, 0x81, 0x8f, 0x9f, 0x84, 0xf1, 0x3e, 0x4e, 0x93, 0x34, 0x8c, 0xa9, 0xd4, 0xbe, 0x82, 0x82,
+	0x56, 0x61, 0xf2, 0x97, 0xa3, 0x61, 0xf2, 0x8c, 0x64, 0x34, 0x1b, 0xb4, 0x96, 0x9b, 0x2b, 0xdd,
+	0xb5, 0x59, 0x25, 0xf6, 0xb9, 0x24, 0xb8, 0x39, 0x0b, 0x5a, 0x03, 0xc0, 0x99, 0xef, 0x45, 0x1e,
+	0x0d, 0x49, 0xcc, 0x8f, 0xd5, 0x5d, 0x43, 0x6a, 0xc1, 0x63, 0x4d, 0x71, 0x0d, 0x2e, 0xe7, 0x4b,
+	0x80, 0x9c, 0xc2, 0xce, 0x3d, 0xc4, 0xf4, 0x80, 0x04, 0xd2, 0x16, 0x72, 0xc4, 0xd0, 0x66, 0xf8,
+	0x7d, 0x4d, 0xd2, 0x40, 0xda, 0x42, 0x8f, 0x0d, 0xac, 0x9a, 0x05, 0xac, 0x7e, 0x6d, 0x41, 0x47,
+	0x69, 0x89, 0x66, 0xa0, 0x11, 0x26, 0x52, 0x68, 0x23, 0x4c, 0x34, 0x80, 0x8d, 0x0a, 0x00, 0x9b,
+	0xe7, 0x04, 0xb0, 0x55, 0x07, 0xa0, 0xf3, 0x15, 0xb4, 0xb6, 0x48, 0x80, 0xd9, 0x6e, 0xdc, 0x51,
+	0xa4, 0x83, 0xb1, 0x6f, 0xa9, 0x51, 0x43, 0x6b, 0x74, 0x15, 0x9a, 0x59, 0xa6, 0x36, 0xef, 0xaa,
+	0xcd, 0xb7, 0xb7, 0x9f, 0xb9, 0x6c, 0x1e, 0xdd, 0x82, 0x19, 0x9f, 0xc4, 0x31, 0xf6, 0x19, 0x4e,
+	0x3b, 0xcc, 0x5b, 0xc5, 0xb6, 0xa5, 0x59, 0xe7, 0x2d, 0xb4, 0x1f, 0xa7, 0x29, 0x49, 0x19, 0x2c,
+	0x29, 0xf6, 0x32, 0x12, 0x2b, 0x28, 0xc5, 0x88, 0xcd, 0x07, 0x98, 0x7a, 0xa1, 0x72, 0x6a, 0x39,
+	0x62, 0x4e, 0xbb, 0x17, 0xbe, 0x7b, 0xc5, 0xf1, 0xce, 0xa4, 0x53, 0x18, 0x33, 0xce, 0x27, 0x30,
+	0xbf, 0x83, 0x33, 0xba, 0xa1, 0xb7, 0x73, 0xf1, 0x57, 0x23, 0x9c, 0x71, 0xd8, 0x62, 0x12, 0x88,
+	0xc3, 0x19, 0xb0, 0xb1, 0x83, 0xbb, 0x9c, 0xe2, 0xfc, 0xc3, 0x82, 0xb9, 0xf2, 0xda, 0x24, 0x3a,
+	0x66, 0xaa, 0x30, 0x2b, 0x62, 0x61, 0xed, 0x8e, 0x2b, 0x47, 0xe8, 0x3a, 0x34, 0x71, 0x9a, 0x4a,
+	0x47, 0x9e, 0xd6, 0x0e, 0xc4, 0x8e, 0xe5, 0x32, 0xca, 0xb9, 0x1d, 0x79, 0x09, 0x26, 0xf7, 0xbc,
+	0x30, 0xc2, 0xc1, 0x33, 0x92, 0x48, 0xdc, 0xf2, 0x09, 0xf4, 0x00, 0x16, 0x95, 0x0f, 0x17, 0xd7,
+	0x65, 0x83, 0xf6, 0x72, 0x73, 0x65, 0xd2, 0xad, 0x23, 0x3b, 0x9b, 0xd0, 0x63, 0xc7, 0xdc, 0x38,
+	0xc0, 0xfe, 0xe1, 0x06, 0x89, 0xf7, 0xc2, 0xfd, 0xd3, 0xd1, 0x40, 0x7d, 0x68, 0xa7, 0x24, 0xc2,
+	0xd9, 0xa0, 0xc1, 0x85, 0x8b, 0x81, 0xf3, 0x7b, 0x0b, 0x2e, 0x73, 0x39, 0x8c, 0x33, 0x53, 0xd8,
+	0x7e, 0x1f, 0x2e, 0xf9, 0x5c, 0x6e, 0x36, 0xb0, 0xf8, 0xfd, 0x5b, 0x34, 0x05, 0x1a, 0xfb, 0xba,
+	0x8a, 0x0f, 0x7d, 0x06, 0x33, 0x31, 0xa6, 0x5f, 0x93, 0xf4, 0xf0, 0x8b, 0x84, 0x41, 0x9d, 0x49,
+	0x1c, 0x17, 0xf4, 0xca, 0x02, 0xd5, 0x2d, 0x71, 0x33, 0xac, 0xfc, 0x68, 0x94, 0x51, 0x9c, 0x6e,
+	0x06, 0x12, 0xd2, 0x7c, 0xc2, 0xd9, 0x82, 0x9e, 0xa9, 0x25, 0xb3, 0xa2, 0x0d, 0x1d, 0xcf, 0xf7,
+	0x71, 0x42, 0xb5, 0x1d, 0xf5, 0xf8, 0x54, 0x4b, 0x3a, 0xeb, 0x30, 0xc9, 0xe5, 0x6d, 0x52, 0x3c,
+	0xac, 0xbc, 0x26, 0xcb, 0xd0, 0x0d, 0x70, 0xe6, 0xa7, 0x21, 0x57, 0x4f, 0xfa, 0xac, 0x39, 0xe5,
+	0xfc, 0xc6, 0x82, 0x1e, 0x5b, 0xce, 0xe5, 0xb8, 0x38, 0x1b, 0x45, 0x14, 0xdd, 0x84, 0x56, 0x48,
+	0xf1, 0x50, 0x5a, 0xe1, 0xb2, 0xda, 0x58, 0x6f, 0xe5, 0x72, 0x32, 0x0f, 0x1d, 0xd4, 0xa3, 0xa3,
+	0x4c, 0xdd, 0x05, 0x31, 0x52, 0x6a, 0x37, 0x6b, 0x1d, 0x10, 0x41, 0x2b, 0x22, 0xfb, 0x99, 0xf4,
+	0x25, 0xfe, 0xed, 0xfc, 0xce, 0x32, 0xbc, 0x41, 0xea, 0x61, 0x43, 0x87, 0xd9, 0x7c, 0x2b, 0x3f,
+	0x95, 0x1e, 0xbf, 0xff, 0xe6, 0x1f, 0x43, 0x9b, 0x69, 0xaf, 0x42, 0xb2, 0x76, 0x89, 0x12, 0x08,
+	0xae, 0xe0, 0x72, 0x1e, 0x82, 0xfd, 0x14, 0x53, 0xd3, 0x6a, 0x9c, 0x2a, 0x3d, 0xac, 0x60, 0x6e,
+	0xab, 0x6c, 0xee, 0x7f, 0x5b, 0x30, 0xa8, 0x5c, 0x2c, 0xaf, 0xaf, 0x3c, 0x80, 0x55, 0x75, 0x80,
+	0xfa, 0xeb, 0xbb, 0x0e, 0x6d, 0x86, 0x02, 0x8b, 0x32, 0xec, 0x00, 0xdf, 0x53, 0x2c, 0x75, 0x3b,
+	0x71, 0x67, 0xcf, 0x1e, 0xc7, 0x34, 0x3d, 0x76, 0xc5, 0x4a, 0xfb, 0x0d, 0x40, 0x3e, 0x89, 0x66,
+	0xa1, 0x79, 0x88, 0x8f, 0xa5, 0x1a, 0xec, 0x93, 0x61, 0x74, 0xe4, 0x45, 0x23, 0x2c, 0xb5, 0x18,
+	0xbf, 0x36, 0x0a, 0x23, 0xce, 0xf5, 0xb0, 0xf1, 0xc0, 0x72, 0xb6, 0x61, 0xb1, 0xa0, 0xc0, 0x4b,
+	0xb2, 0xaf, 0x40, 0x3a, 0xc9, 0x8c, 0x05, 0x00, 0x1b, 0x65, 0x00, 0x3f, 0x82, 0xf9, 0x71, 0xa1,
+	0x0c, 0xbc, 0x59, 0x68, 0x46, 0x64, 0x9f, 0x4b, 0x9b, 0x72, 0xd9, 0xa7, 0x73, 0x1f, 0x16, 0xde,
+	0x7a, 0xd4, 0x3f, 0x18, 0x8f, 0x02, 0x27, 0xdb, 0xe8, 0x07, 0xb0, 0xb8, 0xe1, 0xc5, 0x3e, 0x8e,
+	0xce, 0xbb, 0x70, 0x07, 0xe6, 0xc7, 0x17, 0x5e, 0xf8, 0x46, 0xdf, 0x83, 0x69, 0x26, 0xea, 0x35,
+	0x49, 0xa9, 0xeb, 0xc5, 0xfb, 0xfc, 0xf1, 0xdb, 0x4b, 0xc9, 0x50, 0xe5, 0x2a, 0xec, 0x9b, 0x3d,
+	0x7e, 0x94, 0xc8, 0xc7, 0xb7, 0x41, 0x89, 0xf3, 0x1c, 0xe0, 0x05, 0xc6, 0x89, 0x17, 0x85, 0x47,
+	0x38, 0x60, 0xd8, 0x1c, 0xe9, 0xd7, 0x9a, 0x7d, 0xa2, 0xdb, 0x30, 0x1b, 0x63, 0xba, 0x19, 0x53,
+	0x9c, 0xee, 0x79, 0xbe, 0x30, 0x84, 0xc0, 0x7a, 0x6c, 0xde, 0x59, 0x83, 0xa9, 0x97, 0xc4, 0x0b,
+	0x76, 0xbd, 0x88, 0x1d, 0x2e, 0x3d, 0xcb, 0xd3, 0xef, 0x7c, 0x63, 0x41, 0xff, 0xc5, 0x68, 0x17,
+	0xaf, 0xbf, 0xde, 0xdc, 0xc6, 0xe9, 0x11, 0x4e, 0xe5, 0x53, 0x55, 0x99, 0x1a, 0xae, 0x01, 0x1c,
+	0x6a, 0x65, 0x07, 0x8d, 0x62, 0x9a, 0x93, 0x1f, 0xc3, 0x35, 0xb8, 0xd0, 0x03, 0x98, 0x8a, 0x0c,
+	0xa5, 0xe4, 0xed, 0xee, 0xab, 0x55, 0xa6, 0xc2, 0x6e, 0x81, 0xd3, 0xf9, 0x5f, 0x0b, 0xa6, 0x37,
+	0x84, 0xcd, 0xf4, 0x13, 0xd3, 0x95, 0x46, 0x34, 0x1c, 0xd2, 0x9c, 0x42, 0xaf, 0xa1, 0x7f, 0x58,
+	0x71, 0x1a, 0xa9, 0xeb, 0x92, 0xd6, 0xb5, 0x82, 0xc7, 0xad, 0x5c, 0x89, 0x3e, 0x85, 0xe9, 0xd8,
+	0xb4, 0xaa, 0x3c, 0xc0, 0xbc, 0x79, 0xaf, 0x34, 0xd1, 0x2d, 0xf2, 0xa2, 0xc7, 0x00, 0x6c, 0xe2,
+	0xa5, 0xb7, 0x8b, 0x23, 0x15, 0xb5, 0x6e, 0xea, 0x98, 0x6c, 0x9e, 0x6d, 0x75, 0x4b, 0xf3, 0x89,
+	0xeb, 0x6e, 0x2c, 0x44, 0x3b, 0xd0, 0x63, 0xa3, 0xf5, 0x38, 0x26, 0xd4, 0x13, 0x4f, 0x5b, 0x9b,
+	0xcb, 0xba, 0x5d, 0x2f, 0xcb, 0x60, 0x16, 0x02, 0xcb, 0x22, 0xd0, 0x0a, 0xf4, 0xc2, 0xa1, 0xb7,
+	0x8f, 0x5d, 0x9c, 0x90, 0x2c, 0xa4, 0x24, 0x3d, 0xe6, 0x39, 0xf7, 0xa4, 0x5b, 0x9e, 0x66, 0xb7,
+	0x29, 0x21, 0xc1, 0xf6, 0x68, 0x37, 0xc6, 0x74, 0x70, 0x49, 0xdc, 0x26, 0x3d, 0x81, 0x6e, 0xc0,
+	0x74, 0x86, 0xd3, 0xa3, 0xd0, 0xc7, 0x92, 0xa3, 0xc3, 0x39, 0x8a, 0x93, 0xe8, 0x0e, 0x5c, 0x66,
+	0xf8, 0xa6, 0x31, 0xa6, 0x38, 0xfb, 0x29, 0x4e, 0x33, 0xf6, 0xa8, 0x4d, 0x72, 0xce, 0x71, 0x82,
+	0xfd, 0x23, 0xf1, 0xa2, 0x18, 0x80, 0x54, 0x84, 0xba, 0xbe, 0x19, 0xea, 0x26, 0x8d, 0x88, 0x66,
+	0x3f, 0x82, 0x7e, 0x15, 0x06, 0xe7, 0x91, 0xe1, 0x3c, 0x85, 0xf6, 0x8e, 0xc7, 0x72, 0xa8, 0x33,
+	0x2e, 0x62, 0xaf, 0x02, 0xde, 0xdb, 0x63, 0xde, 0x26, 0x92, 0x07, 0x39, 0x72, 0xfe, 0x63, 0xc1,
+	0x2c, 0xd3, 0xe6, 0x73, 0x5e, 0x95, 0x5d, 0x2c, 0x5b, 0x42, 0x3f, 0x84, 0x89, 0x48, 0x78, 0x93,
+	0x78, 0x42, 0x6e, 0x98, 0x2b, 0xcd, 0x1d, 0x56, 0x4d, 0x67, 0x92, 0x6b, 0xd0, 0x4d, 0x98, 0xa0,
+	0x1e, 0xcf, 0xef, 0x84, 0x2f, 0xea, 0x30, 0xc6, 0x4f, 0xea, 0x4a, 0xa2, 0xfd, 0x09, 0x74, 0xdf,
+	0x13, 0x79, 0xe7, 0xaf, 0x16, 0x4c, 0x0b, 0x35, 0x54, 0x28, 0x7e, 0x08, 0x5d, 0x76, 0x9e, 0x8d,
+	0x42, 0x36, 0x37, 0xa8, 0x53, 0xdb, 0x35, 0x99, 0xd9, 0xe5, 0xf3, 0x4d, 0xcf, 0x1e, 0x34, 0x8a,
+	0x97, 0xaf, 0xe0, 0xf6, 0x6e, 0x91, 0xf7, 0xe4, 0x7c, 0x8e, 0x57, 0x03, 0xe9, 0xb1, 0x3b, 0x8a,
+	0x79, 0x2a, 0xd3, 0x71, 0xe5, 0xc8, 0x49, 0xa0, 0xab, 0xf4, 0xbf, 0xe8, 0x8b, 0x80, 0x6e, 0x40,
+	0x2b, 0x89, 0xbc, 0x58, 0x86, 0x8c, 0xd9, 0x1c, 0xec, 0xec, 0xf0, 0x75, 0xe4, 0xc5, 0x2e, 0xa7,
+	0x3a, 0x9f, 0x42, 0x97, 0x8d, 0x62, 0x1c, 0x3c, 0x09, 0x23, 0xfe, 0x6a, 0x24, 0x1e, 0x3d, 0x50,
+	0x81, 0x97, 0x7d, 0xa3, 0x01, 0xcf, 0x86, 0x29, 0x8e, 0xa9, 0x44, 0x5c, 0x0d, 0x9d, 0xbf, 0x58,
+	0x00, 0xeb, 0xbc, 0xb2, 0x60, 0x32, 0x2a, 0x13, 0x49, 0x15, 0xc9, 0x1b, 0x46, 0x24, 0x37, 0xdf,
+	0xf5, 0x66, 0xe9, 0x5d, 0xff, 0x08, 0xda, 0x7b, 0x61, 0x84, 0x95, 0x8f, 0xcc, 0x29, 0xb5, 0x0d,
+	0x25, 0x5d, 0xc1, 0xc1, 0xc4, 0xf8, 0x64, 0x38, 0xf4, 0xe2, 0x40, 0x55, 0x0c, 0x7a, 0xac, 0xd0,
+	0x99, 0xa8, 0x7d, 0x2f, 0xff, 0x6c, 0x41, 0x47, 0x41, 0x71, 0x66, 0xc5, 0x1d, 0x98, 0x0a, 0x70,
+	0x82, 0xe3, 0x00, 0xc7, 0x7e, 0x28, 0x13, 0xa9, 0x49, 0xb7, 0x30, 0x87, 0xee, 0x40, 0x27, 0x1b,
+	0xed, 0x32, 0xd1, 0x63, 0xc5, 0xbb, 0x86, 0x5e, 0x73, 0xa0, 0x3b, 0x70, 0xc9, 0xf3, 0xcd, 0xa0,
+	0xaa, 0x5f, 0xb4, 0x1c, 0x57, 0x57, 0xb1, 0x38, 0x5f, 0x42, 0x8f, 0x4d, 0x98, 0x2e, 0xa2, 0xac,
+	0x6c, 0x9d, 0x64, 0xe5, 0xd3, 0xd3, 0x87, 0xfb, 0xb0, 0xf0, 0x14, 0x53, 0x25, 0xf8, 0xec, 0x99,
+	0x6a, 0x0c, 0x20, 0x16, 0xa9, 0x4a, 0x82, 0x05, 0x0a, 0x85, 0x23, 0xfb, 0x2e, 0x18, 0xbb, 0x51,
+	0x32, 0xf6, 0x5d, 0x98, 0x63, 0xf5, 0xe0, 0x28, 0xc5, 0x1b, 0x5e, 0xfc, 0x08, 0x6f, 0xee, 0xc7,
+	0x24, 0xd5, 0x0d, 0x85, 0x2a, 0x92, 0xf3, 0x47, 0x0b, 0x66, 0xf3, 0x0d, 0x65, 0xba, 0xbf, 0x06,
+	0x10, 0xe8, 0x39, 0x89, 0x84, 0xc6, 0xd1, 0xe0, 0x36, 0xb8, 0xbe, 0xd5, 0x1a, 0x84, 0xdf, 0x53,
+	0x4a, 0xf1, 0x30, 0xe1, 0xb5, 0xab, 0xb5, 0xd2, 0x76, 0xf5, 0xd8, 0xf9, 0x15, 0xf4, 0xc7, 0x90,
+	0xbd, 0x50, 0x1a, 0xbf, 0xaa, 0xea, 0x90, 0x66, 0x31, 0x98, 0x95, 0x61, 0x51, 0x85, 0x88, 0x0f,
+	0x73, 0x5a, 0x01, 0x23, 0xb9, 0x3e, 0xaf, 0xad, 0x4e, 0x2e, 0x50, 0x6f, 0xc2, 0xe5, 0xe2, 0x26,
+	0xd5, 0xc9, 0xf6, 0x3d, 0x98, 0x63, 0xca, 0x0d, 0x71, 0x31, 0x4a, 0x9f, 0xec, 0x63, 0xaf, 0xe1,
+	0x72, 0x71, 0xd1, 0x85, 0x93, 0xe5, 0x35, 0x40, 0x3c, 0xe7, 0x3f, 0x8f, 0x16, 0x01, 0xf4, 0x77,
+	0xbc, 0x30, 0x1a, 0xc3, 0xf1, 0xc4, 0x55, 0x1a, 0xe5, 0x46, 0x0d, 0xca, 0xa5, 0xf0, 0xe7, 0x3c,
+	0x01, 0x54, 0xda, 0x85, 0x1d, 0xf6, 0x1a, 0x80, 0x08, 0x01, 0x46, 0xe6, 0x69, 0xcc, 0x28, 0xa0,
+	0x1b, 0x05, 0xa0, 0x45, 0x91, 0x71, 0x4e, 0xa0, 0x8b, 0x8b, 0x2e, 0x0c, 0xf4, 0x43, 0x58, 0x78,
+	0x82, 0xa9, 0x7f, 0xc0, 0x52, 0x5e, 0xf9, 0x4e, 0x9e, 0xb9, 0x7d, 0xf5, 0x16, 0xfa, 0x63, 0x6b,
+	0x25, 0x18, 0x87, 0x7a, 0x4a, 0x3a, 0x97, 0x31, 0x73, 0xba, 0x52, 0x3f, 0x83, 0x85, 0x8d, 0x14,
+	0x7b, 0x14, 0x3f, 0x27, 0x61, 0xbc, 0x43, 0x0e, 0xf1, 0xd9, 0x7b, 0x6a, 0x6c, 0x73, 0x4a, 0xa3,
+	0x6d, 0xec, 0x13, 0xf6, 0xea, 0x88, 0x5a, 0xc6, 0x98, 0x71, 0x86, 0xd0, 0x1f, 0x93, 0xcd, 0x94,
+	0xee, 0x43, 0x9b, 0xb2, 0x91, 0x04, 0x5d, 0x0c, 0x98, 0x34, 0xfc, 0x2e, 0x09, 0x53, 0x4f, 0x37,
+	0x59, 0x9a, 0xae, 0x31, 0x73, 0x6a, 0x30, 0x72, 0xfe, 0x6b, 0x41, 0x6f, 0xdb, 0xf7, 0x22, 0xfc,
+	0xc5, 0x88, 0x7e, 0x1b, 0x29, 0xcf, 0x2a, 0x74, 0x87, 0x1e, 0xaf, 0x67, 0x48, 0x20, 0x93, 0xbf,
+	0x32, 0x0e, 0x26, 0xc3, 0x78, 0x8a, 0xd4, 0x7c, 0xdf, 0x14, 0xa9, 0x55, 0x9f, 0x22, 0xb5, 0x0b,
+	0x29, 0xd2, 0xbf, 0x2c, 0x40, 0x2e, 0x1e, 0x92, 0x23, 0x5c, 0xa8, 0xb9, 0xbf, 0xcb, 0x53, 0xdf,
+	0x86, 0x49, 0x4c, 0xfd, 0x60, 0xcb, 0x68, 0xa6, 0x14, 0xb9, 0x73, 0xf2, 0x7b, 0x1e, 0xf2, 0x9b,
+	0x06, 0xcc, 0xff, 0x24, 0xd9, 0x4f, 0xbd, 0x00, 0x4b, 0x08, 0xd5, 0x39, 0x4b, 0xba, 0x5a, 0xa7,
+	0xe9, 0xba, 0x0a, 0x5d, 0xd6, 0x66, 0x3c, 0xf1, 0x6c, 0x06, 0x43, 0x75, 0xa5, 0xd4, 0xac, 0xa9,
+	0x94, 0xc6, 0xed, 0xdf, 0x7a, 0x5f, 0xfb, 0xb7, 0xeb, 0xa1, 0x99, 0x28, 0x40, 0xf3, 0x5b, 0x0b,
+	0xa6, 0x37, 0xbc, 0x28, 0xf4, 0x89, 0x6a, 0x9d, 0xae, 0x41, 0xdf, 0x97, 0x2d, 0x59, 0xde, 0xe7,
+	0x3e, 0x0a, 0xe9, 0xf1, 0x7a, 0x14, 0xc9, 0x68, 0x55, 0x49, 0x63, 0xc7, 0xc4, 0xb1, 0xef, 0x25,
+	0xd9, 0x48, 0xfc, 0x04, 0xf2, 0x8a, 0x5d, 0x7b, 0x11, 0xa4, 0xc7, 0x09, 0x4c, 0xd3, 0xa3, 0x77,
+	0x91, 0x17, 0xb3, 0xda, 0x7a, 0x00, 0xfc, 0xd2, 0xe7, 0x13, 0x0e, 0x81, 0x99, 0x62, 0x73, 0x97,
+	0xb5, 0x0a, 0x64, 0x7b, 0x77, 0x27, 0xef, 0x62, 0x98, 0x53, 0x1c, 0x38, 0xf3, 0x10, 0x03, 0x28,
+	0x01, 0x67, 0x12, 0xdd, 0x22, 0xaf, 0x73, 0x04, 0xd7, 0x44, 0xef, 0x48, 0x08, 0x64, 0xae, 0x11,
+	0xa6, 0x78, 0x88, 0x63, 0x1d, 0x03, 0x1c, 0xd5, 0xea, 0xab, 0xf2, 0x0f, 0x41, 0x42, 0x77, 0xe1,
+	0x12, 0x39, 0x53, 0xab, 0x5a, 0xb1, 0x39, 0xff, 0xb4, 0x60, 0xd1, 0x04, 0xd2, 0x6c, 0xb9, 0xde,
+	0x82, 0x99, 0x6d, 0x32, 0x4a, 0x7d, 0x7e, 0x2b, 0x8d, 0x67, 0xaa, 0x34, 0xcb, 0x52, 0xbe, 0xcf,
+	0x71, 0x46, 0xc3, 0x98, 0xa3, 0xbb, 0x55, 0xcc, 0x36, 0xaa, 0x48, 0x46, 0xa2, 0xd4, 0xac, 0x4a,
+	0x94, 0x5a, 0xa7, 0x37, 0x6c, 0xdb, 0x67, 0x6a, 0xd8, 0xfe, 0xdd, 0x82, 0xab, 0x35, 0xb0, 0x66,
+	0x17, 0xfc, 0xe1, 0xe4, 0xe3, 0x62, 0xe7, 0xb5, 0xbe, 0x2d, 0x2a, 0x2c, 0xf3, 0x34, 0xff, 0xd1,
+	0xe9, 0x28, 0xa4, 0xa1, 0x2e, 0x86, 0xae, 0x6b, 0xef, 0xa8, 0x36, 0x82, 0x5b, 0x5a, 0xb6, 0xf6,
+	0x87, 0x29, 0xe8, 0xe9, 0xb0, 0x47, 0xf9, 0x8f, 0xb1, 0x68, 0x0b, 0x66, 0x8a, 0x3f, 0x0a, 0xa1,
+	0xab, 0xba, 0x68, 0xa8, 0xfa, 0xa1, 0xc9, 0xfe, 0xb0, 0x8e, 0x9c, 0x44, 0xc7, 0xce, 0x07, 0xe8,
+	0x11, 0x40, 0xde, 0xc8, 0x44, 0x57, 0x0a, 0x3d, 0x7f, 0x33, 0x42, 0xdb, 0x8b, 0x55, 0x24, 0x21,
+	0xe3, 0xe7, 0x3c, 0x45, 0x2d, 0x37, 0xa1, 0x91, 0x73, 0x62, 0x87, 0x5a, 0x48, 0x5d, 0x3e, 0xad,
+	0x8b, 0xed, 0x7c, 0x80, 0x76, 0x60, 0xb6, 0xdc, 0x0d, 0x46, 0xd7, 0x2b, 0xd7, 0xe5, 0x79, 0x9d,
+	0x7d, 0xb5, 0x9e, 0x41, 0x4b, 0x2d, 0xf7, 0x71, 0x73, 0xa9, 0x35, 0xad, 0x61, 0xfb, 0x6a, 0x3d,
+	0x83, 0x90, 0xfa, 0x16, 0x7a, 0xa5, 0x76, 0x34, 0xba, 0xa6, 0xd6, 0x54, 0xf7, 0xa9, 0xcf, 0x02,
+	0xc1, 0x5d, 0x0b, 0xdd, 0x87, 0x09, 0xe1, 0x0a, 0x68, 0xbe, 0x58, 0x31, 0x28, 0x31, 0x73, 0xe5,
+	0x69, 0xa1, 0xd0, 0x67, 0x00, 0x79, 0xcd, 0x59, 0xb7, 0x76, 0xd1, 0x2c, 0xd3, 0x8b, 0xeb, 0xdf,
+	0x40, 0xaf, 0x54, 0xff, 0xe4, 0x07, 0xaa, 0x2e, 0x39, 0xed, 0xa5, 0x5a, 0xba, 0x10, 0xf9, 0x0c,
+	0xa6, 0xcc, 0x62, 0x03, 0x7d, 0x38, 0xc6, 0x6f, 0xd8, 0xf1, 0x4a, 0x35, 0x51, 0x4b, 0x32, 0x4b,
+	0x8b, 0x5c, 0x52, 0x45, 0x95, 0x62, 0x5f, 0xa9, 0x26, 0x6a, 0x49, 0x66, 0xee, 0x9c, 0x4b, 0xaa,
+	0x48, 0xc3, 0xed, 0x2b, 0xd5, 0x44, 0x21, 0xe9, 0x05, 0x74, 0x8d, 0xe2, 0x04, 0xd9, 0x05, 0xeb,
+	0x17, 0xe5, 0x9c, 0x02, 0xd4, 0x5d, 0x0b, 0xbd, 0x82, 0xe9, 0x42, 0x3d, 0x81, 0x96, 0x8c, 0xa6,
+	0xdb, 0x58, 0x31, 0x63, 0xdb, 0x35, 0x54, 0x25, 0xee, 0x0d, 0xf4, 0x4a, 0x39, 0x79, 0x6e, 0xcc,
+	0xea, 0x44, 0xdf, 0x5e, 0xaa, 0xa5, 0x6b, 0xff, 0x28, 0x65, 0xcc, 0xb9, 0xc8, 0xea, 0x34, 0xdd,
+	0x5e, 0xaa, 0xa5, 0x0b, 0x91, 0x0f, 0xa1, 0xa3, 0x92, 0x62, 0xa4, 0x3d, 0xb3, 0x94, 0x26, 0xd7,
+	0xb9, 0xfb, 0x8f, 0xa1, 0x6b, 0x64, 0x97, 0x39, 0xfa, 0xe3, 0x29, 0x67, 0x9d, 0x84, 0x27, 0x30,
+	0x53, 0x4c, 0xdd, 0xf2, 0x00, 0x5b, 0x99, 0xd2, 0xd5, 0xc9, 0x39, 0x84, 0x41, 0xdd, 0x73, 0x84,
+	0x6e, 0x15, 0x63, 0x69, 0x5d, 0x1e, 0x60, 0xdf, 0x3c, 0x85, 0x4f, 0x85, 0x9d, 0x5d, 0xf1, 0xcf,
+	0x39, 0xf7, 0xfe, 0x3f, 0x00, 0x9a, 0xcd, 0xa2, 0x59, 0xbe, 0x23, 0x00, 0x00,
 }
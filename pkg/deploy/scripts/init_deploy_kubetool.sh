@@ -25,6 +25,7 @@ COMPONENT=
 VERSION=
 NODEIP=
 IMAGE_REPOSITORY=docker.io/kpaas
+PAUSE_IMAGE_TAG=3.1
 DEVICE_MOUNTS=
 
 # kubelet specific
@@ -275,7 +276,7 @@ kubelet::config() {
     Environment="KUBELET_AUTHZ_ARGS=--authorization-mode=Webhook --client-ca-file=/etc/kubernetes/pki/ca.crt"
     #Environment="KUBELET_CADVISOR_ARGS=--cadvisor-port=0"
     Environment="KUBELET_CERTIFICATE_ARGS=--rotate-certificates=true --cert-dir=/var/lib/kubelet/pki"
-    Environment="KUBELET_POD_INFRA_ARGS=--pod-infra-container-image='${IMAGE_REPOSITORY%*/}'/pause:3.1"
+    Environment="KUBELET_POD_INFRA_ARGS=--pod-infra-container-image='${IMAGE_REPOSITORY%*/}'/pause:'${PAUSE_IMAGE_TAG}'"
     Environment="KUBELET_FEATURE_GATES=--feature-gates=DevicePlugins=true"
     Environment="KUBELET_LOG_LEVEL=-v=4"
     ExecStart=
@@ -297,6 +298,17 @@ kubelet::setup() {
     kubelet::run
 }
 
+kubeadm::upgrade() {
+    log::deploy I "upgrading kubeadm to kubeadm${VERSION_SYMBOL}${VERSION}"
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} kubeadm${VERSION_SYMBOL}${VERSION}*"
+}
+
+kubelet::upgrade() {
+    log::deploy I "upgrading kubelet and kubectl to ${VERSION}"
+    command::exec "$PKG_MGR install ${INSTALL_OPTIONS} kubelet${VERSION_SYMBOL}${VERSION}* kubectl${VERSION_SYMBOL}${VERSION}*"
+    kubelet::run
+}
+
 join() {
     log::deploy I "join node to cluster"
     local skip_ca=
@@ -314,8 +326,10 @@ usage() {
 cat <<EOF
 Usage:
     $0 setup repos [--local-repo-addr http://10.10.0.1:8880/localrepo --pkg-mirror mirrors.aliyun.com] [--debug]
-    $0 setup kubelet --cluster-dns 169.169.0.10 --version 1.11.0 --image-repository docker.io/kpaas [--debug]
+    $0 setup kubelet --cluster-dns 169.169.0.10 --version 1.16.3 --image-repository docker.io/kpaas [--pause-image-tag 3.1] [--debug]
     $0 join --token 845e36.bc466480ab621387 --master 10.10.0.1:6443 [--control-plane] [--debug]
+    $0 upgrade kubeadm --version 1.17.0 [--debug]
+    $0 upgrade kubelet --version 1.17.0 [--debug]
     $0 clean [--debug]
 EOF
 }
@@ -357,12 +371,18 @@ parse() {
             join)
                 ACTION=join
             ;;
+            upgrade)
+                ACTION=upgrade
+            ;;
             clean)
                 ACTION=clean
             ;;
             repos)
                 COMPONENT=repos
             ;;
+            kubeadm)
+                COMPONENT=kubeadm
+            ;;
             kubelet)
                 COMPONENT=kubelet
             ;;
@@ -414,6 +434,14 @@ parse() {
                     usage_exit "no docker image repository given for --image-repository"
                 }
             ;;
+            --pause-image-tag)
+                [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
+                    PAUSE_IMAGE_TAG="$2"
+                    shift
+                } || {
+                    usage_exit "no pause image tag given for --pause-image-tag"
+                }
+            ;;
             --config)
                 [[ -n ${2+x} ]] && ! echo $2 | grep -q ^- && {
                     INIT_CONFIG="$2"
@@ -470,6 +498,20 @@ parse() {
                 ;;
             esac
         ;;
+        upgrade)
+            [[ -z $VERSION ]] && usage_exit "no version given for --version"
+            case "$COMPONENT" in
+                kubeadm)
+                    ACTION=kubeadm::upgrade
+                ;;
+                kubelet)
+                    ACTION=kubelet::upgrade
+                ;;
+                *)
+                    usage_exit "invalid component"
+                ;;
+            esac
+        ;;
         init|join|clean)
         ;;
         *)
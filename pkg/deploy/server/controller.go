@@ -17,10 +17,13 @@ package server
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
@@ -31,6 +34,17 @@ import (
 type controller struct {
 	store      task.Store
 	logFileLoc string
+	// retryPolicies are the retry policies of the action types applied to the tasks,
+	// action.DefaultRetryPolicies is used if it's nil.
+	retryPolicies map[action.Type]*action.RetryPolicy
+
+	// launchLock serializes the launches of tasks, so a running task will not be
+	// replaced by another one with the same name.
+	launchLock sync.Mutex
+
+	// cancelFuncs holds the functions to cancel the launched tasks, keyed by task name.
+	cancelLock  sync.Mutex
+	cancelFuncs map[string]context.CancelFunc
 }
 
 func (c *controller) TestConnection(ctx context.Context, req *pb.TestConnectionRequest) (*pb.TestConnectionReply, error) {
@@ -55,7 +69,7 @@ func (c *controller) TestConnection(ctx context.Context, req *pb.TestConnectionR
 		return nil, err
 	}
 
-	if err = c.storeAndExecuteTask(testConnTask); err != nil {
+	if err = c.storeAndExecuteTask(ctx, testConnTask); err != nil {
 		logrus.Errorf("request failed: %s", err)
 		return nil, err
 	}
@@ -73,6 +87,13 @@ func (c *controller) TestConnection(ctx context.Context, req *pb.TestConnectionR
 			Err:    nil,
 		}
 	}
+	for _, act := range testConnTask.GetActions() {
+		if testConnAction, ok := act.(*action.TestConnectionAction); ok {
+			reply.HostKeyFingerprint = testConnAction.HostKeyFingerprint
+			reply.JumpHostKeyFingerprints = testConnAction.JumpHostKeyFingerprints
+			reply.FailedHop = testConnAction.FailedHop
+		}
+	}
 
 	logrus.Infof("Ends TestConnection request, test result: %v", reply.Passed)
 	return reply, nil
@@ -81,11 +102,12 @@ func (c *controller) TestConnection(ctx context.Context, req *pb.TestConnectionR
 func (c *controller) CheckNodes(ctx context.Context, req *pb.CheckNodesRequest) (*pb.CheckNodesReply, error) {
 	logrus.Info("Begins CheckNodes request")
 
-	taskName := getCheckNodeTaskName()
+	taskName := getCheckNodeTaskName(req.GetClusterId())
 	taskConfig := &task.NodeCheckTaskConfig{
 		NodeConfigs:     req.GetConfigs(),
 		NetworkOptions:  req.GetNetworkOptions(),
 		LogFileBasePath: c.logFileLoc,
+		RetryPolicies:   c.retryPolicies,
 	}
 
 	nodeCheckTask, err := task.NewNodeCheckTask(taskName, taskConfig)
@@ -123,7 +145,7 @@ func (c *controller) GetCheckNodesResult(ctx context.Context, req *pb.GetCheckNo
 		}
 	}()
 
-	tsk, err := c.getTask(getCheckNodeTaskName())
+	tsk, err := c.getTask(getCheckNodeTaskName(req.GetClusterId()))
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +166,7 @@ func (c *controller) GetCheckNodesLog(ctx context.Context, req *pb.GetCheckNodes
 		}
 	}()
 
-	tsk, err := c.getTask(getCheckNodeTaskName())
+	tsk, err := c.getTask(getCheckNodeTaskName(req.GetClusterId()))
 	if err != nil {
 		return nil, err
 	}
@@ -153,20 +175,48 @@ func (c *controller) GetCheckNodesLog(ctx context.Context, req *pb.GetCheckNodes
 	return resp, err
 }
 
+func (c *controller) CancelCheckNodes(ctx context.Context, req *pb.CancelCheckNodesRequest) (*pb.CancelCheckNodesReply, error) {
+	logrus.Info("Begins CancelCheckNodes request")
+
+	if err := c.cancelTask(getCheckNodeTaskName(req.GetClusterId())); err != nil {
+		logrus.Errorf("CancelCheckNodes request failed: %s", err)
+		return &pb.CancelCheckNodesReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("CancelCheckNodes request succeeded")
+	return &pb.CancelCheckNodesReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
 func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.DeployReply, error) {
 	logrus.Info("Begins Deploy request")
 
-	taskName := getDeployTaskName()
+	taskName := getDeployTaskName(req.GetClusterId())
 	taskConfig := &task.DeployTaskConfig{
 		NodeConfigs:     req.NodeConfigs,
 		ClusterConfig:   req.ClusterConfig,
 		LogFileBasePath: c.logFileLoc, // /app/deploy/logs
+		RetryPolicies:   c.retryPolicies,
 	}
 
 	deployTask, err := task.NewDeployTask(taskName, taskConfig)
+	var plan *pb.TaskPlan
 	if err == nil {
-		// store and launch the task
-		err = c.storeAndLanuchTask(deployTask)
+		if req.GetDryRun() {
+			// only plan the task, it will not be stored or launched.
+			plan, err = task.PlanTask(deployTask)
+		} else {
+			// store and launch the task
+			err = c.storeAndLanuchTask(deployTask, getClusterTaskNames(req.GetClusterId())...)
+		}
 	}
 	if err != nil {
 		logrus.Errorf("Deploy request failed: %s", err)
@@ -183,6 +233,169 @@ func (c *controller) Deploy(ctx context.Context, req *pb.DeployRequest) (*pb.Dep
 	return &pb.DeployReply{
 		Accepted: true,
 		Err:      nil,
+		Plan:     plan,
+	}, nil
+}
+
+// ScaleOut adds new nodes to a deployed cluster. The scale-out task is kept apart from the cluster's deploy
+// task, but as the latest task of the cluster, its result and log are got, watched, resumed and canceled as the deploy.
+func (c *controller) ScaleOut(ctx context.Context, req *pb.ScaleOutRequest) (*pb.DeployReply, error) {
+	logrus.Info("Begins ScaleOut request")
+
+	taskName := getScaleOutTaskName(req.GetClusterId())
+	taskConfig := &task.ScaleOutTaskConfig{
+		NodeConfigs:     req.NodeConfigs,
+		MasterNodes:     req.MasterNodes,
+		ClusterConfig:   req.ClusterConfig,
+		LogFileBasePath: c.logFileLoc,
+		RetryPolicies:   c.retryPolicies,
+	}
+
+	scaleOutTask, err := task.NewScaleOutTask(taskName, taskConfig)
+	var plan *pb.TaskPlan
+	if err == nil {
+		if req.GetDryRun() {
+			// only plan the task, it will not be stored or launched.
+			plan, err = task.PlanTask(scaleOutTask)
+		} else {
+			// store and launch the task, it will be the latest task of the cluster.
+			err = c.storeAndLanuchTask(scaleOutTask, getClusterTaskNames(req.GetClusterId())...)
+		}
+	}
+	if err != nil {
+		logrus.Errorf("ScaleOut request failed: %s", err)
+		return &pb.DeployReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("ScaleOut request succeeded")
+	return &pb.DeployReply{
+		Accepted: true,
+		Err:      nil,
+		Plan:     plan,
+	}, nil
+}
+
+// RemoveNodes removes nodes from a deployed cluster. The remove-nodes task is kept apart from the cluster's deploy
+// task, but as the latest task of the cluster, each step of the nodes is reported as a deploy item.
+func (c *controller) RemoveNodes(ctx context.Context, req *pb.RemoveNodesRequest) (*pb.DeployReply, error) {
+	logrus.Info("Begins RemoveNodes request")
+
+	taskName := getRemoveNodesTaskName(req.GetClusterId())
+	taskConfig := &task.RemoveNodesTaskConfig{
+		NodeConfigs:     req.NodeConfigs,
+		MasterNodes:     req.MasterNodes,
+		EtcdNodes:       req.EtcdNodes,
+		LogFileBasePath: c.logFileLoc,
+		RetryPolicies:   c.retryPolicies,
+	}
+
+	removeTask, err := task.NewRemoveNodesTask(taskName, taskConfig)
+	var plan *pb.TaskPlan
+	if err == nil {
+		if req.GetDryRun() {
+			// only plan the task, it will not be stored or launched.
+			plan, err = task.PlanTask(removeTask)
+		} else {
+			// store and launch the task, it will be the latest task of the cluster.
+			err = c.storeAndLanuchTask(removeTask, getClusterTaskNames(req.GetClusterId())...)
+		}
+	}
+	if err != nil {
+		logrus.Errorf("RemoveNodes request failed: %s", err)
+		return &pb.DeployReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("RemoveNodes request succeeded")
+	return &pb.DeployReply{
+		Accepted: true,
+		Err:      nil,
+		Plan:     plan,
+	}, nil
+}
+
+// UpgradeCluster upgrades the kubernetes of a deployed cluster. The upgrade-cluster task is kept apart from the
+// cluster's deploy task, but as the latest task of the cluster, each step of the nodes is reported as a deploy item.
+func (c *controller) UpgradeCluster(ctx context.Context, req *pb.UpgradeClusterRequest) (*pb.DeployReply, error) {
+	logrus.Info("Begins UpgradeCluster request")
+
+	taskName := getUpgradeClusterTaskName(req.GetClusterId())
+	taskConfig := &task.UpgradeClusterTaskConfig{
+		MasterNodes:     req.MasterNodes,
+		WorkerNodes:     req.WorkerNodes,
+		ClusterConfig:   req.ClusterConfig,
+		Version:         req.KubernetesVersion,
+		LogFileBasePath: c.logFileLoc,
+		RetryPolicies:   c.retryPolicies,
+	}
+
+	upgradeTask, err := task.NewUpgradeClusterTask(taskName, taskConfig)
+	var plan *pb.TaskPlan
+	if err == nil {
+		if req.GetDryRun() {
+			// only plan the task, it will not be stored or launched.
+			plan, err = task.PlanTask(upgradeTask)
+		} else {
+			// store and launch the task, it will be the latest task of the cluster.
+			err = c.storeAndLanuchTask(upgradeTask, getClusterTaskNames(req.GetClusterId())...)
+		}
+	}
+	if err != nil {
+		logrus.Errorf("UpgradeCluster request failed: %s", err)
+		return &pb.DeployReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("UpgradeCluster request succeeded")
+	return &pb.DeployReply{
+		Accepted: true,
+		Err:      nil,
+		Plan:     plan,
+	}, nil
+}
+
+func (c *controller) PlanDeploy(ctx context.Context, req *pb.DeployRequest) (*pb.PlanDeployReply, error) {
+	logrus.Info("Begins PlanDeploy request")
+
+	taskConfig := &task.DeployTaskConfig{
+		NodeConfigs:   req.NodeConfigs,
+		ClusterConfig: req.ClusterConfig,
+	}
+
+	deployTask, err := task.NewDeployTask(getDeployTaskName(req.GetClusterId()), taskConfig)
+	var plan *pb.TaskPlan
+	if err == nil {
+		plan, err = task.PlanTask(deployTask)
+	}
+	if err != nil {
+		logrus.Errorf("PlanDeploy request failed: %s", err)
+		return &pb.PlanDeployReply{
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("PlanDeploy request succeeded")
+	return &pb.PlanDeployReply{
+		Plan: plan,
 	}, nil
 }
 
@@ -198,7 +411,7 @@ func (c *controller) GetDeployResult(ctx context.Context, req *pb.GetDeployResul
 		}
 	}()
 
-	tsk, err := c.getTask(getDeployTaskName())
+	tsk, err := c.getTask(c.getClusterTaskName(req.GetClusterId()))
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +431,7 @@ func (c *controller) GetDeployLog(ctx context.Context, req *pb.GetDeployLogReque
 		}
 	}()
 
-	tsk, err := c.getTask(getDeployTaskName())
+	tsk, err := c.getTask(c.getClusterTaskName(req.GetClusterId()))
 	if err != nil {
 		return nil, err
 	}
@@ -227,6 +440,67 @@ func (c *controller) GetDeployLog(ctx context.Context, req *pb.GetDeployLogReque
 	return resp, err
 }
 
+func (c *controller) ResumeDeploy(ctx context.Context, req *pb.ResumeDeployRequest) (*pb.ResumeDeployReply, error) {
+	logrus.Info("Begins ResumeDeploy request")
+
+	// the latest task of the cluster is resumed, it may be a scale-out or a removal of nodes
+	previousTask, err := c.getTask(c.getClusterTaskName(req.GetClusterId()))
+	var deployTask task.Task
+	if err == nil {
+		switch previousTask.(type) {
+		case *task.ScaleOutTask:
+			deployTask, err = task.NewResumedScaleOutTask(previousTask)
+		case *task.RemoveNodesTask:
+			deployTask, err = task.NewResumedRemoveNodesTask(previousTask)
+		case *task.UpgradeClusterTask:
+			deployTask, err = task.NewResumedUpgradeClusterTask(previousTask)
+		default:
+			deployTask, err = task.NewResumedDeployTask(previousTask)
+		}
+	}
+	if err == nil {
+		// store and launch the task, it will take the place of the previous one.
+		err = c.storeAndLanuchTask(deployTask, getClusterTaskNames(req.GetClusterId())...)
+	}
+	if err != nil {
+		logrus.Errorf("ResumeDeploy request failed: %s", err)
+		return &pb.ResumeDeployReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("ResumeDeploy request succeeded")
+	return &pb.ResumeDeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+func (c *controller) CancelDeploy(ctx context.Context, req *pb.CancelDeployRequest) (*pb.CancelDeployReply, error) {
+	logrus.Info("Begins CancelDeploy request")
+
+	if err := c.cancelTask(c.getClusterTaskName(req.GetClusterId())); err != nil {
+		logrus.Errorf("CancelDeploy request failed: %s", err)
+		return &pb.CancelDeployReply{
+			Accepted: false,
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	logrus.Info("CancelDeploy request succeeded")
+	return &pb.CancelDeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
 func (c *controller) FetchKubeConfig(ctx context.Context, req *pb.FetchKubeConfigRequest) (*pb.FetchKubeConfigReply, error) {
 	logrus.Info("Begins FetchKubeConfig request")
 
@@ -248,7 +522,7 @@ func (c *controller) FetchKubeConfig(ctx context.Context, req *pb.FetchKubeConfi
 		return nil, err
 	}
 
-	if err = c.storeAndExecuteTask(kubeConfigTask); err != nil {
+	if err = c.storeAndExecuteTask(ctx, kubeConfigTask); err != nil {
 		return nil, err
 	}
 
@@ -266,6 +540,61 @@ func (c *controller) FetchKubeConfig(ctx context.Context, req *pb.FetchKubeConfi
 	}, nil
 }
 
+func (c *controller) CreateJoinToken(ctx context.Context, req *pb.CreateJoinTokenRequest) (*pb.CreateJoinTokenReply, error) {
+	logrus.Info("Begins CreateJoinToken request")
+
+	var err error
+	defer func() {
+		if err != nil {
+			logrus.Errorf("request failed: %s", err)
+		}
+	}()
+
+	ttl := deploy.DefaultJoinTokenTTL
+	if req.GetTtlSeconds() > 0 {
+		ttl = time.Duration(req.GetTtlSeconds()) * time.Second
+	}
+	if ttl > deploy.MaxJoinTokenTTL {
+		err = fmt.Errorf("the ttl of join token can't be longer than %v", deploy.MaxJoinTokenTTL)
+		return &pb.CreateJoinTokenReply{
+			Err: &pb.Error{
+				Reason: consts.MsgRequestFailed,
+				Detail: err.Error(),
+			},
+		}, err
+	}
+
+	taskName := getCreateJoinTokenTaskName(req.GetNode().GetName())
+	taskConfig := &task.CreateJoinTokenTaskConfig{
+		Node:            req.Node,
+		TTL:             ttl,
+		LogFileBasePath: c.logFileLoc,
+	}
+
+	tokenTask, err := task.NewCreateJoinTokenTask(taskName, taskConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.storeAndExecuteTask(ctx, tokenTask); err != nil {
+		return nil, err
+	}
+
+	taskErr := tokenTask.GetErr()
+	if taskErr != nil {
+		err = fmt.Errorf(taskErr.String())
+		return &pb.CreateJoinTokenReply{
+			Err: taskErr,
+		}, err
+	}
+
+	logrus.Info("Ends CreateJoinToken request: succeeded")
+	return &pb.CreateJoinTokenReply{
+		Token:      tokenTask.(*task.CreateJoinTokenTask).Token,
+		Expiration: time.Now().Add(ttl).Unix(),
+	}, nil
+}
+
 func (c *controller) CheckNetworkRequirements(
 	context context.Context, req *pb.CheckNetworkRequirementRequest) (
 	*pb.CheckNetworkRequirementsReply, error) {
@@ -283,7 +612,7 @@ func (c *controller) CheckNetworkRequirements(
 
 	checkTask, err := task.NewCheckNetworkRequirementsTask(taskName, taskConfig)
 	if err == nil {
-		err = c.storeAndExecuteTask(checkTask)
+		err = c.storeAndExecuteTask(context, checkTask)
 	}
 	if err != nil {
 		logrus.Errorf("failed to create task for CheckNetworkRequirements, error %v", err)
@@ -306,10 +635,8 @@ func (c *controller) storeTask(task task.Task) error {
 		return fmt.Errorf("no task store")
 	}
 
-	// Currently, we have to use the same task name for some kinds of repeated requests,
-	// for example, multiple check nodes and deploy requests. That means we only keep the latest
-	// result for the same kind request.
-	// TODO: review this design when support multiple clusters.
+	// The check nodes and deploy tasks are named by the cluster id, that means we only keep
+	// the latest result of the same kind request for a cluster.
 	return c.store.UpdateOrAddTask(task)
 }
 
@@ -326,41 +653,170 @@ func (c *controller) getTask(name string) (task.Task, error) {
 }
 
 // Store the task and start the task, will not wait task to finish execution.
-func (c *controller) storeAndLanuchTask(aTask task.Task) error {
+// The task is not launched while any of the exclusive tasks is running, e.g. the other tasks of the cluster.
+func (c *controller) storeAndLanuchTask(aTask task.Task, exclusiveTaskNames ...string) error {
+	c.launchLock.Lock()
+	defer c.launchLock.Unlock()
+
+	// tasks with different names (e.g. tasks of different clusters) run independently,
+	// but a running task can't be replaced.
+	if c.store != nil {
+		for _, name := range append([]string{aTask.GetName()}, exclusiveTaskNames...) {
+			if existing := c.store.GetTask(name); existing != nil && isTaskRunning(existing) {
+				return fmt.Errorf("task %s is already running", name)
+			}
+		}
+	}
+
 	// store the task
 	if err := c.storeTask(aTask); err != nil {
 		return err
 	}
 
-	// launch the task
-	return task.StartTask(aTask)
+	// launch the task, it can be canceled by cancelTask() later.
+	ctx, cancel := context.WithCancel(context.Background())
+	c.setCancelFunc(aTask.GetName(), cancel)
+	if err := task.StartTask(ctx, aTask); err != nil {
+		c.setCancelFunc(aTask.GetName(), nil)
+		cancel()
+		return err
+	}
+	return nil
 }
 
-// Store the task and wait the task to finish execution.
-func (c *controller) storeAndExecuteTask(aTask task.Task) error {
+// Store the task and wait the task to finish execution, the task will be
+// aborted if ctx is done.
+func (c *controller) storeAndExecuteTask(ctx context.Context, aTask task.Task) error {
 	// store the task
 	if err := c.storeTask(aTask); err != nil {
 		return err
 	}
 
 	// execute the task
-	return task.ExecuteTask(aTask)
+	return task.ExecuteTask(ctx, aTask)
+}
+
+func (c *controller) setCancelFunc(name string, cancel context.CancelFunc) {
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	if c.cancelFuncs == nil {
+		c.cancelFuncs = make(map[string]context.CancelFunc)
+	}
+	if cancel == nil {
+		delete(c.cancelFuncs, name)
+		return
+	}
+	c.cancelFuncs[name] = cancel
+}
+
+// Cancel a launched task which is still running, its running actions will be
+// interrupted and the pending ones will be marked as aborted.
+func (c *controller) cancelTask(name string) error {
+	aTask, err := c.getTask(name)
+	if err != nil {
+		return err
+	}
+
+	if !isTaskRunning(aTask) {
+		return fmt.Errorf("task %s is not running, status: %s", name, aTask.GetStatus())
+	}
+
+	c.cancelLock.Lock()
+	defer c.cancelLock.Unlock()
+
+	cancel, ok := c.cancelFuncs[name]
+	if !ok {
+		return fmt.Errorf("task %s can't be canceled", name)
+	}
+	cancel()
+	delete(c.cancelFuncs, name)
+	return nil
 }
 
-func getCheckNodeTaskName() string {
-	// use a fixed name for checknode task, it may be changed in the future
-	return "node-check"
+func isTaskRunning(aTask task.Task) bool {
+	switch aTask.GetStatus() {
+	case task.TaskPending, task.TaskInitializing, task.TaskSplitting, task.TaskDoing:
+		return true
+	}
+	return false
+}
+
+func getCheckNodeTaskName(clusterID string) string {
+	// use "<cluster id>-node-check" as the check node task name, so the nodes of
+	// different clusters can be checked at the same time.
+	if clusterID == "" {
+		// keep the fixed name for the requests without a cluster id
+		return "node-check"
+	}
+	return fmt.Sprintf("%s-%s", clusterID, "node-check")
 }
 
-func getDeployTaskName() string {
-	// use "<cluster name>-deploy" as the deploy task name
-	clusterName := "unknown"
-	// TODO: review this later
-	// if req.ClusterConfig != nil && reg.ClusterConfig.ClusterName != "" {
-	// 	clusterName = reg.ClusterConfig.ClusterName
-	// }
+func getDeployTaskName(clusterID string) string {
+	// use "<cluster id>-deploy" as the deploy task name, so different clusters
+	// can be deployed at the same time.
+	if clusterID == "" {
+		clusterID = "unknown"
+	}
 
-	return fmt.Sprintf("%s-%s", clusterName, "deploy")
+	return fmt.Sprintf("%s-%s", clusterID, "deploy")
+}
+
+func getScaleOutTaskName(clusterID string) string {
+	// use "<cluster id>-scale-out" as the scale-out task name, so the deploy task of the cluster is kept.
+	if clusterID == "" {
+		clusterID = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%s", clusterID, "scale-out")
+}
+
+func getRemoveNodesTaskName(clusterID string) string {
+	// use "<cluster id>-remove-nodes" as the remove-nodes task name, so the deploy task of the cluster is kept.
+	if clusterID == "" {
+		clusterID = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%s", clusterID, "remove-nodes")
+}
+
+func getUpgradeClusterTaskName(clusterID string) string {
+	// use "<cluster id>-upgrade-cluster" as the upgrade-cluster task name, so the deploy task of the cluster is kept.
+	if clusterID == "" {
+		clusterID = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%s", clusterID, "upgrade-cluster")
+}
+
+// getClusterTaskNames returns the names of the tasks which deploy or change the nodes of the cluster,
+// only one of them runs at a time.
+func getClusterTaskNames(clusterID string) []string {
+	return []string{
+		getDeployTaskName(clusterID),
+		getScaleOutTaskName(clusterID),
+		getRemoveNodesTaskName(clusterID),
+		getUpgradeClusterTaskName(clusterID),
+	}
+}
+
+// getClusterTaskName returns the name of the latest task which deploys or changes the nodes of the cluster,
+// it's the deploy task name if there's no such task.
+func (c *controller) getClusterTaskName(clusterID string) string {
+	name := getDeployTaskName(clusterID)
+	if c.store == nil {
+		return name
+	}
+
+	var latest task.Task
+	for _, taskName := range getClusterTaskNames(clusterID) {
+		aTask := c.store.GetTask(taskName)
+		if aTask != nil && (latest == nil || aTask.GetCreationTimestamp().After(latest.GetCreationTimestamp())) {
+			latest = aTask
+			name = taskName
+		}
+	}
+	return name
 }
 
 func getFetchKubeConfigTaskName(req *pb.FetchKubeConfigRequest) string {
@@ -368,6 +824,11 @@ func getFetchKubeConfigTaskName(req *pb.FetchKubeConfigRequest) string {
 	return "fetch-kube-config"
 }
 
+func getCreateJoinTokenTaskName(nodeName string) string {
+	// tokens may be created repeatly, so create a unique task name for each request
+	return fmt.Sprintf("create-join-token-%v-%v", nodeName, idcreator.NextString())
+}
+
 func getTestConnectionTaskName(nodeName string) string {
 	// User may test a node's connection repeatly, so create a unique task name
 	// for each request
@@ -384,6 +845,8 @@ func taskStatusToOperationStatus(status task.Status) constant.OperationStatus {
 		return constant.OperationStatusSuccessful
 	case task.TaskFailed:
 		return constant.OperationStatusFailed
+	case task.TaskAborted:
+		return constant.OperationStatusAborted
 	default:
 		return constant.OperationStatusUnknown
 	}
@@ -399,6 +862,8 @@ func actionStatusToOperationStatus(status action.Status) constant.OperationStatu
 		return constant.OperationStatusSuccessful
 	case action.ActionFailed:
 		return constant.OperationStatusFailed
+	case action.ActionAborted:
+		return constant.OperationStatusAborted
 	default:
 		return constant.OperationStatusUnknown
 	}
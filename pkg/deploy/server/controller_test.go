@@ -0,0 +1,334 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+)
+
+func TestGetTaskName(t *testing.T) {
+	assert.Equal(t, "unknown-deploy", getDeployTaskName(""))
+	assert.Equal(t, "cluster1-deploy", getDeployTaskName("cluster1"))
+	assert.NotEqual(t, getDeployTaskName("cluster1"), getDeployTaskName("cluster2"))
+
+	assert.Equal(t, "unknown-scale-out", getScaleOutTaskName(""))
+	assert.Equal(t, "cluster1-scale-out", getScaleOutTaskName("cluster1"))
+	assert.Equal(t, "cluster1-remove-nodes", getRemoveNodesTaskName("cluster1"))
+	assert.Equal(t, "cluster1-upgrade-cluster", getUpgradeClusterTaskName("cluster1"))
+	assert.Equal(t, []string{"cluster1-deploy", "cluster1-scale-out", "cluster1-remove-nodes", "cluster1-upgrade-cluster"},
+		getClusterTaskNames("cluster1"))
+
+	assert.Equal(t, "node-check", getCheckNodeTaskName(""))
+	assert.Equal(t, "cluster1-node-check", getCheckNodeTaskName("cluster1"))
+	assert.NotEqual(t, getCheckNodeTaskName("cluster1"), getCheckNodeTaskName("cluster2"))
+}
+
+func TestStoreAndLaunchRunningTask(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	taskConfig := &task.DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "node1"}}},
+	}
+
+	runningTask, err := task.NewDeployTask(getDeployTaskName("running-cluster"), taskConfig)
+	assert.NoError(t, err)
+	runningTask.SetStatus(task.TaskDoing)
+	assert.NoError(t, c.storeTask(runningTask))
+
+	// a running task can't be replaced by another task with the same name
+	newTask, err := task.NewDeployTask(getDeployTaskName("running-cluster"), taskConfig)
+	assert.NoError(t, err)
+	assert.Error(t, c.storeAndLanuchTask(newTask))
+	assert.Equal(t, runningTask, c.store.GetTask(getDeployTaskName("running-cluster")))
+
+	// a task can't be launched while another task of the cluster is running
+	scaleOutTask, err := task.NewScaleOutTask(getScaleOutTaskName("running-cluster"), &task.ScaleOutTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "node2"}, Roles: []string{"worker"}}},
+		MasterNodes: []*pb.Node{{Name: "node1"}},
+	})
+	assert.NoError(t, err)
+	assert.Error(t, c.storeAndLanuchTask(scaleOutTask, getClusterTaskNames("running-cluster")...))
+	assert.Nil(t, c.store.GetTask(getScaleOutTaskName("running-cluster")))
+
+	// a running task without cancel function can't be canceled
+	assert.Error(t, c.cancelTask(getDeployTaskName("running-cluster")))
+	// a task which doesn't exist can't be canceled
+	assert.Error(t, c.cancelTask(getDeployTaskName("another-cluster")))
+}
+
+func TestGetClusterTaskName(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	assert.Equal(t, getDeployTaskName("latest-cluster"), c.getClusterTaskName("latest-cluster"))
+
+	deployTask, err := task.NewDeployTask(getDeployTaskName("latest-cluster"), &task.DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "node1"}}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, c.storeTask(deployTask))
+	assert.Equal(t, getDeployTaskName("latest-cluster"), c.getClusterTaskName("latest-cluster"))
+
+	// the scale-out after the deploy is the latest task of the cluster, the deploy task is kept
+	scaleOutTask, err := task.NewScaleOutTask(getScaleOutTaskName("latest-cluster"), &task.ScaleOutTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "node2"}, Roles: []string{"worker"}}},
+		MasterNodes: []*pb.Node{{Name: "node1"}},
+	})
+	assert.NoError(t, err)
+	scaleOutTask.(*task.ScaleOutTask).CreationTimestamp = deployTask.GetCreationTimestamp().Add(time.Second)
+	assert.NoError(t, c.storeTask(scaleOutTask))
+	assert.Equal(t, getScaleOutTaskName("latest-cluster"), c.getClusterTaskName("latest-cluster"))
+	assert.Equal(t, deployTask, c.store.GetTask(getDeployTaskName("latest-cluster")))
+}
+
+func TestDryRunDeploy(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	req := &pb.DeployRequest{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  &pb.Node{Name: "node1", Ip: "10.1.1.1"},
+				Roles: []string{"etcd", "master"},
+			},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+		},
+		ClusterId: "dry-run-cluster",
+		DryRun:    true,
+	}
+
+	reply, err := c.Deploy(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, reply.GetAccepted())
+	assert.Equal(t, getDeployTaskName("dry-run-cluster"), reply.GetPlan().GetName())
+	assert.NotEmpty(t, reply.GetPlan().GetSubTasks())
+	// the task of a dry run deploy is neither stored nor launched
+	assert.Nil(t, c.store.GetTask(getDeployTaskName("dry-run-cluster")))
+
+	planReply, err := c.PlanDeploy(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Nil(t, planReply.GetErr())
+	assert.Equal(t, len(reply.GetPlan().GetSubTasks()), len(planReply.GetPlan().GetSubTasks()))
+
+	_, err = c.PlanDeploy(context.Background(), &pb.DeployRequest{})
+	assert.Error(t, err)
+}
+
+func TestDryRunScaleOut(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	req := &pb.ScaleOutRequest{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  &pb.Node{Name: "master2", Ip: "10.1.1.2"},
+				Roles: []string{"master"},
+			},
+			{
+				Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+				Roles: []string{"worker"},
+			},
+		},
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+		},
+		ClusterId: "scale-out-cluster",
+		DryRun:    true,
+	}
+
+	reply, err := c.ScaleOut(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, reply.GetAccepted())
+	assert.Equal(t, getScaleOutTaskName("scale-out-cluster"), reply.GetPlan().GetName())
+	assert.Equal(t, string(task.TaskTypeScaleOut), reply.GetPlan().GetType())
+	var subTasks []string
+	for _, subPlan := range reply.GetPlan().GetSubTasks() {
+		subTasks = append(subTasks, subPlan.GetName())
+	}
+	assert.Equal(t, []string{"create-join-token", "init", "joinMaster-master2", "deploy-worker", "deploy-config"}, subTasks)
+	assert.Nil(t, c.store.GetTask(getScaleOutTaskName("scale-out-cluster")))
+
+	// there must be a deployed master to create the join token
+	req.MasterNodes = nil
+	reply, err = c.ScaleOut(context.Background(), req)
+	assert.Error(t, err)
+	assert.False(t, reply.GetAccepted())
+	assert.NotNil(t, reply.GetErr())
+}
+
+func TestGetScaleOutResult(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	scaleOutTask, err := task.NewScaleOutTask(getDeployTaskName("scale-out-result"), &task.ScaleOutTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+				Roles: []string{"worker"},
+			},
+		},
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+	})
+	assert.NoError(t, err)
+
+	// only the new nodes are in the result
+	result, err := c.getDeployResult(scaleOutTask)
+	assert.NoError(t, err)
+	assert.Len(t, result.GetItems(), 1)
+	assert.Equal(t, "worker1", result.GetItems()[0].GetDeployItem().GetNodeName())
+	assert.Equal(t, "worker", result.GetItems()[0].GetDeployItem().GetRole())
+}
+
+func TestDryRunRemoveNodes(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	req := &pb.RemoveNodesRequest{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+				Roles: []string{"worker"},
+			},
+		},
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		EtcdNodes:   []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		ClusterId:   "remove-nodes-cluster",
+		DryRun:      true,
+	}
+
+	reply, err := c.RemoveNodes(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, reply.GetAccepted())
+	assert.Equal(t, getRemoveNodesTaskName("remove-nodes-cluster"), reply.GetPlan().GetName())
+	assert.Equal(t, string(task.TaskTypeRemoveNodes), reply.GetPlan().GetType())
+	var subTasks []string
+	for _, subPlan := range reply.GetPlan().GetSubTasks() {
+		subTasks = append(subTasks, subPlan.GetName())
+	}
+	assert.Equal(t, []string{"drain-node", "reset-node", "delete-node"}, subTasks)
+	assert.Nil(t, c.store.GetTask(getRemoveNodesTaskName("remove-nodes-cluster")))
+
+	// the remaining master can't be removed
+	req.NodeConfigs[0].Node.Name = "master1"
+	reply, err = c.RemoveNodes(context.Background(), req)
+	assert.Error(t, err)
+	assert.False(t, reply.GetAccepted())
+	assert.NotNil(t, reply.GetErr())
+}
+
+func TestDryRunUpgradeCluster(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	req := &pb.UpgradeClusterRequest{
+		MasterNodes:       []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		WorkerNodes:       []*pb.Node{{Name: "worker1", Ip: "10.1.1.11"}},
+		KubernetesVersion: "1.17.0",
+		ClusterConfig:     &pb.ClusterConfig{KubernetesVersion: "1.16.3"},
+		ClusterId:         "upgrade-cluster",
+		DryRun:            true,
+	}
+
+	reply, err := c.UpgradeCluster(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, reply.GetAccepted())
+	assert.Equal(t, string(task.TaskTypeUpgradeCluster), reply.GetPlan().GetType())
+	assert.Equal(t, getUpgradeClusterTaskName("upgrade-cluster"), reply.GetPlan().GetName())
+	var subTasks []string
+	for _, subPlan := range reply.GetPlan().GetSubTasks() {
+		subTasks = append(subTasks, subPlan.GetName())
+	}
+	assert.Equal(t, []string{"check-upgrade", "upgrade-master-master1", "upgrade-worker-worker1"}, subTasks)
+	assert.Nil(t, c.store.GetTask(getUpgradeClusterTaskName("upgrade-cluster")))
+
+	// the minor version 1.16 can't be skipped
+	req.ClusterConfig.KubernetesVersion = "1.15.6"
+	reply, err = c.UpgradeCluster(context.Background(), req)
+	assert.Error(t, err)
+	assert.False(t, reply.GetAccepted())
+	assert.NotNil(t, reply.GetErr())
+}
+
+func TestGetRemoveNodesResult(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	removeTask, err := task.NewRemoveNodesTask(getRemoveNodesTaskName("remove-nodes-result"), &task.RemoveNodesTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  &pb.Node{Name: "etcd2", Ip: "10.1.1.2"},
+				Roles: []string{"etcd"},
+			},
+			{
+				Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+				Roles: []string{"worker"},
+			},
+		},
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		EtcdNodes:   []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}, {Name: "etcd2", Ip: "10.1.1.2"}},
+	})
+	assert.NoError(t, err)
+
+	// each step of the nodes is an item, sorted by the order of the steps
+	result, err := c.getDeployResult(removeTask)
+	assert.NoError(t, err)
+	var items []string
+	for _, item := range result.GetItems() {
+		items = append(items, item.GetDeployItem().GetRole()+"/"+item.GetDeployItem().GetNodeName())
+		assert.Equal(t, string(constant.OperationStatusPending), item.GetStatus())
+	}
+	assert.Equal(t, []string{
+		"drainNode/worker1",
+		"removeEtcdMember/etcd2",
+		"resetNode/etcd2", "resetNode/worker1",
+		"deleteNode/worker1",
+	}, items)
+}
+
+func TestCreateJoinToken(t *testing.T) {
+	machine.IsTesting = true
+	logDir, err := ioutil.TempDir("", "create-join-token")
+	assert.NoError(t, err)
+	defer os.RemoveAll(logDir)
+
+	c := &controller{store: task.GetGlobalCacheStore(), logFileLoc: logDir}
+	master := &pb.Node{Name: "master1", Ip: "10.1.1.1"}
+
+	reply, err := c.CreateJoinToken(context.Background(), &pb.CreateJoinTokenRequest{Node: master})
+	assert.NoError(t, err)
+	assert.Nil(t, reply.GetErr())
+	assert.True(t, deploy.IsValidBootstrapToken(reply.GetToken()))
+	expiration := time.Unix(reply.GetExpiration(), 0)
+	assert.WithinDuration(t, time.Now().Add(deploy.DefaultJoinTokenTTL), expiration, time.Minute)
+
+	another, err := c.CreateJoinToken(context.Background(), &pb.CreateJoinTokenRequest{Node: master, TtlSeconds: 600})
+	assert.NoError(t, err)
+	assert.NotEqual(t, reply.GetToken(), another.GetToken())
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), time.Unix(another.GetExpiration(), 0), time.Minute)
+
+	// a join token never lives forever
+	reply, err = c.CreateJoinToken(context.Background(), &pb.CreateJoinTokenRequest{
+		Node:       master,
+		TtlSeconds: uint32((deploy.MaxJoinTokenTTL + time.Hour) / time.Second),
+	})
+	assert.Error(t, err)
+	assert.NotNil(t, reply.GetErr())
+	assert.Empty(t, reply.GetToken())
+
+	// the token can't be created on an unreachable master
+	reply, err = c.CreateJoinToken(context.Background(), &pb.CreateJoinTokenRequest{Node: &pb.Node{Name: "error"}})
+	assert.Error(t, err)
+	assert.NotNil(t, reply.GetErr())
+}
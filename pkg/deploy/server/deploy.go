@@ -34,11 +34,21 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 		return nil, fmt.Errorf("Task is nil")
 	}
 
-	deployTask, ok := aTask.(*task.DeployTask)
-	if !ok {
+	// the result of a scale-out only contains the new nodes
+	var nodeConfigs []*pb.NodeDeployConfig
+	switch deployTask := aTask.(type) {
+	case *task.DeployTask:
+		nodeConfigs = deployTask.NodeConfigs
+	case *task.ScaleOutTask:
+		nodeConfigs = deployTask.NodeConfigs
+	case *task.RemoveNodesTask:
+		return c.getRemoveNodesResult(deployTask), nil
+	case *task.UpgradeClusterTask:
+		nodeConfigs = upgradeNodeConfigs(deployTask)
+	default:
 		return nil, fmt.Errorf("invalid task")
 	}
-	roleNodes := groupNodesByRole(deployTask.NodeConfigs)
+	roleNodes := groupNodesByRole(nodeConfigs)
 	// If the task is already failed, set the default status in deploy item result as "aborted",
 	// otherewise, set the default status to "pending". The final status of them would be updated
 	// in the following process.
@@ -91,6 +101,7 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 			itemResult := roleNodeDeployItemResult[role][node.Name]
 			itemResult.Status = string(actionStatusToOperationStatus(act.GetStatus()))
 			itemResult.Err = act.GetErr()
+			itemResult.Attempts = int32(act.GetAttempts())
 		}
 
 	}
@@ -120,6 +131,7 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 			itemResult := roleNodeDeployItemResult[role][node.Name]
 			itemResult.Status = string(actionStatusToOperationStatus(act.GetStatus()))
 			itemResult.Err = act.GetErr()
+			itemResult.Attempts = int32(act.GetAttempts())
 		}
 	}
 
@@ -134,6 +146,66 @@ func (c *controller) getDeployResult(aTask task.Task) (*pb.GetDeployResultReply,
 
 	return result, nil
 }
+
+// removeNodeStepItems maps the steps to remove a node to their deploy items.
+var removeNodeStepItems = map[action.Type]constant.DeployItem{
+	action.ActionTypeDrainNode:        constant.DeployItemDrainNode,
+	action.ActionTypeRemoveEtcdMember: constant.DeployItemRemoveEtcdMember,
+	action.ActionTypeResetNode:        constant.DeployItemResetNode,
+	action.ActionTypeDeleteNode:       constant.DeployItemDeleteNode,
+}
+
+// getRemoveNodesResult returns the result of removing nodes, each step of a node is a deploy item,
+// and the items are sorted by the order of the steps.
+func (c *controller) getRemoveNodesResult(removeTask *task.RemoveNodesTask) *pb.GetDeployResultReply {
+	initStatus := string(constant.OperationStatusPending)
+	if removeTask.GetStatus() == task.TaskFailed {
+		initStatus = string(constant.OperationStatusAborted)
+	}
+
+	stepNodeItemResult := make(map[action.Type]map[string]*pb.DeployItemResult)
+	for step, nodes := range removeTask.StepNodes() {
+		stepNodeItemResult[step] = make(map[string]*pb.DeployItemResult)
+		for _, node := range nodes {
+			stepNodeItemResult[step][node.GetName()] = &pb.DeployItemResult{
+				DeployItem: &pb.DeployItem{
+					Role:     string(removeNodeStepItems[step]),
+					NodeName: node.GetName(),
+				},
+				Status: initStatus,
+			}
+		}
+	}
+
+	for _, act := range task.GetAllActions(removeTask) {
+		itemResult, ok := stepNodeItemResult[act.GetType()][act.GetNode().GetName()]
+		if !ok {
+			logrus.Warnf("Didn't find the node %q of the step %q in the map", act.GetNode().GetName(), act.GetType())
+			continue
+		}
+		itemResult.Status = string(actionStatusToOperationStatus(act.GetStatus()))
+		itemResult.Err = act.GetErr()
+		itemResult.Attempts = int32(act.GetAttempts())
+	}
+
+	var items []*pb.DeployItemResult
+	for _, step := range action.RemoveNodeActionTypes {
+		if nodeItemResult, ok := stepNodeItemResult[step]; ok {
+			items = append(items, sortMap(nodeItemResult)...)
+		}
+	}
+
+	result := &pb.GetDeployResultReply{
+		Status: string(taskStatusToOperationStatus(removeTask.GetStatus())),
+		Err:    removeTask.GetErr(),
+		Items:  items,
+	}
+
+	logrus.Debugf("Result: %+v", *result)
+
+	return result
+}
+
 func sortMap(m map[string]*pb.DeployItemResult) []*pb.DeployItemResult {
 	// get all keys
 	var keys []string
@@ -176,13 +248,34 @@ func groupNodesByRole(cfgs []*pb.NodeDeployConfig) map[constant.MachineRole][]st
 	return roleNodes
 }
 
+// upgradeNodeConfigs returns the nodes of an upgrade-cluster task, the masters are in the master role
+// and the others are in the worker role, so each node has a result item of its upgrade.
+func upgradeNodeConfigs(upgradeTask *task.UpgradeClusterTask) []*pb.NodeDeployConfig {
+	var nodeConfigs []*pb.NodeDeployConfig
+	for _, node := range upgradeTask.MasterNodes {
+		nodeConfigs = append(nodeConfigs, &pb.NodeDeployConfig{
+			Node:  node,
+			Roles: []string{string(constant.MachineRoleMaster)},
+		})
+	}
+	for _, node := range upgradeTask.WorkerNodes {
+		nodeConfigs = append(nodeConfigs, &pb.NodeDeployConfig{
+			Node:  node,
+			Roles: []string{string(constant.MachineRoleWorker)},
+		})
+	}
+	return nodeConfigs
+}
+
 func actionTypeToRole(actionType action.Type) constant.MachineRole {
 	switch actionType {
 	case action.ActionTypeDeployEtcd:
 		return constant.MachineRoleEtcd
-	case action.ActionTypeInitMaster, action.ActionTypeJoinMaster:
+	// the check of an upgrade is done on the first master
+	case action.ActionTypeInitMaster, action.ActionTypeJoinMaster,
+		action.ActionTypeCheckUpgrade, action.ActionTypeUpgradeFirstMaster, action.ActionTypeUpgradeMaster:
 		return constant.MachineRoleMaster
-	case action.ActionTypeDeployWorker:
+	case action.ActionTypeDeployWorker, action.ActionTypeUpgradeWorker:
 		return constant.MachineRoleWorker
 	case action.ActionTypeDeployIngress:
 		return constant.MachineRoleIngress
@@ -16,8 +16,10 @@ package server
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -26,6 +28,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
 )
 
 func (c *controller) getCheckNodesLog(aTask task.Task, nodeName string) (*pb.GetCheckNodesLogReply, error) {
@@ -124,6 +127,117 @@ func (c *controller) getDeployLog(aTask task.Task, role constant.MachineRole, no
 	return result, nil
 }
 
+func (c *controller) TailDeployLog(req *pb.TailDeployLogRequest, stream pb.DeployContoller_TailDeployLogServer) error {
+	logrus.Info("Begins TailDeployLog request")
+
+	err := c.tailDeployLog(stream.Context(), c.getClusterTaskName(req.GetClusterId()),
+		constant.MachineRole(req.GetRole()), req.GetNodeName(), stream.Send)
+	if err != nil {
+		logrus.Errorf("TailDeployLog request failed: %s", err)
+		return err
+	}
+
+	logrus.Info("Ends TailDeployLog request")
+	return nil
+}
+
+// tailDeployLog sends the execute logs of the deploy actions of the node in the role one action after another,
+// the log of an executing action is sent as it is written. It returns after all the logs of the finished deploy
+// are sent, or ctx is done.
+func (c *controller) tailDeployLog(ctx context.Context, taskName string, role constant.MachineRole, nodeName string,
+	send func(*pb.TailDeployLogReply) error) error {
+
+	// the actions whose logs have all been sent
+	finished := make(map[string]bool)
+	// the action being followed and the length of its log which has been sent
+	var following string
+	var offset int
+
+	for {
+		// get the channel before reading the task, so no change will be missed.
+		statusChanged := action.StatusChanged()
+
+		aTask, err := c.getTask(taskName)
+		if err != nil {
+			return err
+		}
+
+		running := isTaskRunning(aTask)
+		waiting := false
+		var logChanged <-chan struct{}
+		for _, act := range task.GetAllActions(aTask) {
+			if finished[act.GetName()] || !actionBelongsToRole(act.GetType(), role) {
+				continue
+			}
+			if node := act.GetNode(); node == nil || node.GetName() != nodeName {
+				continue
+			}
+
+			if act.GetName() != following {
+				following = act.GetName()
+				offset = 0
+			}
+
+			log, done, changed := followActionLog(act, offset)
+			if len(log) > 0 {
+				if err := send(&pb.TailDeployLogReply{ActionName: act.GetName(), Log: log}); err != nil {
+					return err
+				}
+				offset += len(log)
+			}
+			// The actions which have not been executed will never be executed once the task finished.
+			if !done && (changed != nil || running) {
+				// Wait for the action before going on with the next one, if the action has
+				// not been executed, logChanged is nil and its status change is waited for.
+				waiting, logChanged = true, changed
+				break
+			}
+			finished[act.GetName()] = true
+		}
+
+		// More actions may be created until the task finished.
+		if !waiting && !running {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-statusChanged:
+		case <-logChanged:
+		}
+	}
+}
+
+// followActionLog returns the log of the action written after offset, and whether the log is completed. If the
+// log is not completed, the returned channel will be closed once there is more log.
+func followActionLog(act action.Action, offset int) (log []byte, done bool, changed <-chan struct{}) {
+	if buf, ok := act.GetExecuteLogBuffer().(*utils.LogBuffer); ok {
+		return buf.Follow(offset)
+	}
+
+	// The action has not been executed yet.
+	if status := act.GetStatus(); status == action.ActionPending || status == action.ActionDoing {
+		return nil, false, nil
+	}
+
+	// The action was executed without a followable buffer, e.g. restored from the previous execution of
+	// a resumed task, so send its log file instead.
+	logFilePath := act.GetLogFilePath()
+	if logFilePath == "" {
+		return nil, true, nil
+	}
+	content, err := ioutil.ReadFile(logFilePath)
+	if err != nil {
+		logrus.Warnf("Read log file %q failed: %v", logFilePath, err)
+		return nil, true, nil
+	}
+	if offset < len(content) {
+		log = content[offset:]
+	}
+	return log, true, nil
+}
+
 // Treat the node init and deploy config action beglongs to each deploy role since it
 // is needed for each deploy role.
 var roleActionTypeMap = map[constant.MachineRole]map[action.Type]struct{}{
@@ -137,11 +251,17 @@ var roleActionTypeMap = map[constant.MachineRole]map[action.Type]struct{}{
 		action.ActionTypeDeployConfig: struct{}{},
 		action.ActionTypeInitMaster:   struct{}{},
 		action.ActionTypeJoinMaster:   struct{}{},
+		// the actions of an upgrade
+		action.ActionTypeCheckUpgrade:       struct{}{},
+		action.ActionTypeUpgradeFirstMaster: struct{}{},
+		action.ActionTypeUpgradeMaster:      struct{}{},
 	},
 	constant.MachineRoleWorker: map[action.Type]struct{}{
 		action.ActionTypeNodeInit:     struct{}{},
 		action.ActionTypeDeployConfig: struct{}{},
 		action.ActionTypeDeployWorker: struct{}{},
+		// the action of an upgrade
+		action.ActionTypeUpgradeWorker: struct{}{},
 	},
 	constant.MachineRoleIngress: map[action.Type]struct{}{
 		action.ActionTypeNodeInit:      struct{}{},
@@ -149,6 +269,19 @@ var roleActionTypeMap = map[constant.MachineRole]map[action.Type]struct{}{
 		action.ActionTypeDeployIngress: struct{}{},
 		action.ActionTypeDeployContour: struct{}{},
 	},
+	// the deploy items of the steps to remove a node
+	constant.MachineRole(constant.DeployItemDrainNode): map[action.Type]struct{}{
+		action.ActionTypeDrainNode: struct{}{},
+	},
+	constant.MachineRole(constant.DeployItemRemoveEtcdMember): map[action.Type]struct{}{
+		action.ActionTypeRemoveEtcdMember: struct{}{},
+	},
+	constant.MachineRole(constant.DeployItemResetNode): map[action.Type]struct{}{
+		action.ActionTypeResetNode: struct{}{},
+	},
+	constant.MachineRole(constant.DeployItemDeleteNode): map[action.Type]struct{}{
+		action.ActionTypeDeleteNode: struct{}{},
+	},
 }
 
 // Check if an action is created for a deploy role.
@@ -15,12 +15,20 @@
 package server
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
 )
 
 func TestActionBelongsToRole(t *testing.T) {
@@ -107,3 +115,71 @@ func TestActionBelongsToRole(t *testing.T) {
 		assert.Equal(t, tt.want, result)
 	}
 }
+
+type actionMockupForLogTest struct {
+	action.Base
+}
+
+func TestTailDeployLog(t *testing.T) {
+	logDir, err := ioutil.TempDir("", "tail-deploy-log")
+	assert.NoError(t, err)
+	defer os.RemoveAll(logDir)
+
+	node1 := &pb.Node{Name: "node1"}
+	node2 := &pb.Node{Name: "node2"}
+	newAction := func(name string, actionType action.Type, node *pb.Node, status action.Status) *actionMockupForLogTest {
+		return &actionMockupForLogTest{
+			Base: action.Base{Name: name, ActionType: actionType, Node: node, Status: status},
+		}
+	}
+
+	// an executed action without log buffer, its log file will be sent.
+	act1 := newAction("action1", action.ActionTypeNodeInit, node1, action.ActionDone)
+	act1.LogFilePath = filepath.Join(logDir, "action1.log")
+	assert.NoError(t, ioutil.WriteFile(act1.LogFilePath, []byte("file log"), 0644))
+	// an executing action, its log will be sent as it is written.
+	act2 := newAction("action2", action.ActionTypeDeployWorker, node1, action.ActionDoing)
+	logBuffer := utils.NewLogBuffer()
+	logBuffer.Write([]byte("first "))
+	act2.ExecuteLogBuffer = logBuffer
+	// actions of other roles or nodes will be ignored.
+	act3 := newAction("action3", action.ActionTypeDeployEtcd, node1, action.ActionDone)
+	act4 := newAction("action4", action.ActionTypeDeployWorker, node2, action.ActionDoing)
+
+	taskName := getDeployTaskName("tailed-cluster")
+	aTask := &task.DeployTask{
+		Base: task.Base{
+			Name:    taskName,
+			Status:  task.TaskDoing,
+			Actions: []action.Action{act1, act2, act3, act4},
+		},
+	}
+	c := &controller{store: task.GetGlobalCacheStore()}
+	assert.NoError(t, c.storeTask(aTask))
+
+	logs := make(map[string]string)
+	send := func(reply *pb.TailDeployLogReply) error {
+		logs[reply.GetActionName()] += string(reply.GetLog())
+		if reply.GetActionName() == act2.GetName() && logs[act2.GetName()] == "first " {
+			// finish the deploy once the first piece of log was sent
+			go func() {
+				logBuffer.Write([]byte("second"))
+				logBuffer.Close()
+				act2.SetStatus(action.ActionDone)
+				act4.SetStatus(action.ActionDone)
+				aTask.SetStatus(task.TaskSuccessful)
+			}()
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = c.tailDeployLog(ctx, taskName, constant.MachineRoleWorker, node1.GetName(), send)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"action1": "file log", "action2": "first second"}, logs)
+
+	// tailing the log of a task which doesn't exist
+	err = c.tailDeployLog(ctx, getDeployTaskName("not-existed"), constant.MachineRoleWorker, node1.GetName(), send)
+	assert.Error(t, err)
+}
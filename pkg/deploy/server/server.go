@@ -15,46 +15,150 @@
 package server
 
 import (
+	"expvar"
 	"fmt"
 	"net"
+	"net/http"
+	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
 )
 
 type Interface interface {
 	Run(stopCh <-chan struct{}) error
 }
 
+// StoreType represents the type of the task store
+type StoreType string
+
+const (
+	// StoreTypeMemory keeps the tasks in memory, they are lost when the controller restarts.
+	StoreTypeMemory StoreType = "memory"
+	// StoreTypeBolt keeps the tasks in a bolt database file.
+	StoreTypeBolt StoreType = "bolt"
+)
+
 type ServerOptions struct {
 	Port       uint16
 	LogFileLoc string
+	// StoreType is the type of task store, default is StoreTypeMemory
+	StoreType StoreType
+	// StorePath is the database file path of a persistent task store,
+	// default is <LogFileLoc>/tasks.db
+	StorePath string
+	// MaxConcurrentActions is the max number of actions executed at the same time, 0 means no limit
+	MaxConcurrentActions int
+	// MaxConcurrentActionsPerTaskType is the max number of actions of each task type executed at the same time
+	MaxConcurrentActionsPerTaskType map[string]int
+	// MaxSSHSessionsPerHost is the max number of ssh sessions to a node at the same time, 0 means no limit
+	MaxSSHSessionsPerHost int
+	// SSHConnectionIdleTimeout is how long an unused ssh connection is kept in the pool, 0 means no pooling
+	SSHConnectionIdleTimeout time.Duration
+	// MetricsPort is the port to serve the metrics at /debug/vars, 0 means the metrics are not served
+	MetricsPort uint16
+	// MachineRecordFile is the fixture file to record the interactions with the machines, nothing is recorded if it's empty
+	MachineRecordFile string
+	// VaultMasterKeyFile is the file of the base64 encoded master key shared with the service to open the sealed credentials
+	VaultMasterKeyFile string
+	// RetryPolicyFile is the YAML or JSON file of the retry policies keyed by the action types,
+	// the default retry policies are used if it's empty
+	RetryPolicyFile string
 }
 
 type server struct {
-	port       uint16
-	logFileLoc string
+	port                  uint16
+	logFileLoc            string
+	storeType             StoreType
+	storePath             string
+	workerPoolConfig      task.WorkerPoolConfig
+	maxSSHSessionsPerHost int
+	sshConnIdleTimeout    time.Duration
+	metricsPort           uint16
+	machineRecordFile     string
+	vaultMasterKeyFile    string
+	retryPolicyFile       string
 }
 
 func New(options ServerOptions) Interface {
+	workerPoolConfig := task.WorkerPoolConfig{
+		MaxWorkers:            options.MaxConcurrentActions,
+		MaxWorkersPerTaskType: make(map[task.Type]int, len(options.MaxConcurrentActionsPerTaskType)),
+	}
+	for taskType, maxWorkers := range options.MaxConcurrentActionsPerTaskType {
+		workerPoolConfig.MaxWorkersPerTaskType[task.Type(taskType)] = maxWorkers
+	}
+
 	return &server{
-		port:       options.Port,
-		logFileLoc: options.LogFileLoc,
+		port:                  options.Port,
+		logFileLoc:            options.LogFileLoc,
+		storeType:             options.StoreType,
+		storePath:             options.StorePath,
+		workerPoolConfig:      workerPoolConfig,
+		maxSSHSessionsPerHost: options.MaxSSHSessionsPerHost,
+		sshConnIdleTimeout:    options.SSHConnectionIdleTimeout,
+		metricsPort:           options.MetricsPort,
+		machineRecordFile:     options.MachineRecordFile,
+		vaultMasterKeyFile:    options.VaultMasterKeyFile,
+		retryPolicyFile:       options.RetryPolicyFile,
 	}
 }
 
 func (s *server) Run(stopCh <-chan struct{}) error {
+	store, err := s.newStore()
+	if err != nil {
+		return err
+	}
+	if persistentStore, ok := store.(task.PersistentStore); ok {
+		defer func() {
+			if err := persistentStore.Close(); err != nil {
+				logrus.Errorf("Failed to close the task store: %v", err)
+			}
+		}()
+	}
+
+	if s.vaultMasterKeyFile != "" {
+		masterKey, err := vault.LoadMasterKey(s.vaultMasterKeyFile)
+		if err != nil {
+			return err
+		}
+		v, err := vault.New(masterKey)
+		if err != nil {
+			return err
+		}
+		vault.SetDefault(v)
+	}
+
+	var retryPolicies map[action.Type]*action.RetryPolicy
+	if s.retryPolicyFile != "" {
+		if retryPolicies, err = action.LoadRetryPolicies(s.retryPolicyFile); err != nil {
+			return err
+		}
+		logrus.Infof("Load the retry policies from %s", s.retryPolicyFile)
+	}
+
+	task.SetWorkerPool(s.workerPoolConfig)
+	machine.SetMaxSessionsPerHost(s.maxSSHSessionsPerHost)
+	machine.SetConnectionIdleTimeout(s.sshConnIdleTimeout)
+	if s.machineRecordFile != "" {
+		logrus.Infof("Record the interactions with the machines to %s", s.machineRecordFile)
+		machine.SetRecorder(machine.NewRecorder(s.machineRecordFile))
+	}
+
 	gRpcSvr := grpc.NewServer()
 
-	// use the map cache store
-	store := task.GetGlobalCacheStore()
 	protos.RegisterDeployContollerServer(gRpcSvr, &controller{
-		store:      store,
-		logFileLoc: s.logFileLoc,
+		store:         store,
+		logFileLoc:    s.logFileLoc,
+		retryPolicies: retryPolicies,
 	})
 	reflection.Register(gRpcSvr)
 
@@ -67,7 +171,44 @@ func (s *server) Run(stopCh <-chan struct{}) error {
 	logrus.Info("Begin to serve.")
 	go gRpcSvr.Serve(listener)
 
+	var metricsSvr *http.Server
+	if s.metricsPort > 0 {
+		mux := http.NewServeMux()
+		mux.Handle("/debug/vars", expvar.Handler())
+		metricsSvr = &http.Server{
+			Addr:    fmt.Sprintf("0.0.0.0:%d", s.metricsPort),
+			Handler: mux,
+		}
+		go func() {
+			logrus.Infof("Serve metrics on %s", metricsSvr.Addr)
+			if err := metricsSvr.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logrus.Errorf("Failed to serve metrics: %v", err)
+			}
+		}()
+	}
+
 	<-stopCh
+	gRpcSvr.Stop()
+	if metricsSvr != nil {
+		metricsSvr.Close()
+	}
 
 	return nil
 }
+
+func (s *server) newStore() (task.Store, error) {
+	switch s.storeType {
+	case "", StoreTypeMemory:
+		// use the map cache store
+		return task.GetGlobalCacheStore(), nil
+	case StoreTypeBolt:
+		storePath := s.storePath
+		if storePath == "" {
+			storePath = filepath.Join(s.logFileLoc, task.DefaultBoltStoreFileName)
+		}
+		logrus.Infof("Use the bolt task store: %s", storePath)
+		return task.NewBoltStore(storePath, task.DefaultBoltStoreSyncPeriod)
+	default:
+		return nil, fmt.Errorf("unsupported task store type: %s", s.storeType)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+)
+
+func (c *controller) WatchCheckNodes(req *pb.WatchCheckNodesRequest, stream pb.DeployContoller_WatchCheckNodesServer) error {
+	logrus.Info("Begins WatchCheckNodes request")
+
+	err := c.watchTask(stream.Context(), getCheckNodeTaskName(req.GetClusterId()),
+		func(aTask task.Task) (proto.Message, error) {
+			return c.getCheckNodesResult(aTask)
+		},
+		func(result proto.Message) error {
+			return stream.Send(result.(*pb.GetCheckNodesResultReply))
+		})
+	if err != nil {
+		logrus.Errorf("WatchCheckNodes request failed: %s", err)
+		return err
+	}
+
+	logrus.Info("Ends WatchCheckNodes request")
+	return nil
+}
+
+func (c *controller) WatchDeploy(req *pb.WatchDeployRequest, stream pb.DeployContoller_WatchDeployServer) error {
+	logrus.Info("Begins WatchDeploy request")
+
+	err := c.watchTask(stream.Context(), c.getClusterTaskName(req.GetClusterId()),
+		func(aTask task.Task) (proto.Message, error) {
+			return c.getDeployResult(aTask)
+		},
+		func(result proto.Message) error {
+			return stream.Send(result.(*pb.GetDeployResultReply))
+		})
+	if err != nil {
+		logrus.Errorf("WatchDeploy request failed: %s", err)
+		return err
+	}
+
+	logrus.Info("Ends WatchDeploy request")
+	return nil
+}
+
+// watchTask sends the result of the task whenever the status or error of the task,
+// or any of its sub tasks and actions, is changed; it returns after the result of the
+// finished task is sent, or ctx is done.
+func (c *controller) watchTask(ctx context.Context, name string,
+	getResult func(task.Task) (proto.Message, error), send func(proto.Message) error) error {
+
+	var lastResult proto.Message
+	for {
+		// get the channel before reading the task, so no change will be missed.
+		changed := action.StatusChanged()
+
+		aTask, err := c.getTask(name)
+		if err != nil {
+			return err
+		}
+
+		result, err := getResult(aTask)
+		if err != nil {
+			return err
+		}
+
+		// only send the result when it was changed.
+		if lastResult == nil || !proto.Equal(lastResult, result) {
+			if err := send(result); err != nil {
+				return err
+			}
+			lastResult = result
+		}
+
+		if !isTaskRunning(aTask) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
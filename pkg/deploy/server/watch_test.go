@@ -0,0 +1,63 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/deploy/task"
+)
+
+func TestWatchTask(t *testing.T) {
+	c := &controller{store: task.GetGlobalCacheStore()}
+	taskConfig := &task.DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: &pb.Node{Name: "node1"}}},
+	}
+	taskName := getDeployTaskName("watched-cluster")
+	aTask, err := task.NewDeployTask(taskName, taskConfig)
+	assert.NoError(t, err)
+	aTask.SetStatus(task.TaskDoing)
+	assert.NoError(t, c.storeTask(aTask))
+
+	getResult := func(aTask task.Task) (proto.Message, error) {
+		return &pb.GetDeployResultReply{Status: string(aTask.GetStatus())}, nil
+	}
+
+	var statuses []string
+	send := func(result proto.Message) error {
+		statuses = append(statuses, result.(*pb.GetDeployResultReply).GetStatus())
+		if len(statuses) == 1 {
+			// finish the task once the watcher got the first result
+			go aTask.SetStatus(task.TaskSuccessful)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = c.watchTask(ctx, taskName, getResult, send)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{string(task.TaskDoing), string(task.TaskSuccessful)}, statuses)
+
+	// watching a task which doesn't exist
+	err = c.watchTask(ctx, getDeployTaskName("not-existed"), getResult, send)
+	assert.Error(t, err)
+}
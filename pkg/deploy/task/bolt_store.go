@@ -0,0 +1,274 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const (
+	// DefaultBoltStoreFileName is the default file name of the bolt database under the log dir.
+	DefaultBoltStoreFileName = "tasks.db"
+	// DefaultBoltStoreSyncPeriod is the default period to write the running tasks into the database.
+	DefaultBoltStoreSyncPeriod = 2 * time.Second
+
+	boltTaskBucket  = "tasks"
+	boltOpenTimeout = 5 * time.Second
+)
+
+// transientTaskTypes are the types of the tasks which are done within a request, and named uniquely for each request,
+// they are kept in the cache only, or the database grows forever with them.
+var transientTaskTypes = map[Type]bool{
+	TaskTypeTestConnection:  true,
+	TaskTypeCreateJoinToken: true,
+}
+
+// errInterrupted is set to the tasks and actions which were still running when the controller stopped.
+var errInterrupted = &pb.Error{
+	Reason:     "interrupted",
+	Detail:     "the task was interrupted by a restart of the deploy controller",
+	FixMethods: "retry the operation",
+}
+
+// A Store implementation via BoltDB. The live tasks are kept in a cache since they are
+// updated in place during execution, and their snapshots are written to the database
+// whenever they are stored and periodically while they are running.
+type boltStore struct {
+	*cache
+	db *bolt.DB
+
+	// protects saved
+	savedLock sync.Mutex
+	// the last encoded form written into the database for each task
+	saved map[string][]byte
+
+	stopCh    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewBoltStore opens (or creates) the bolt database at the given path, restores the tasks stored
+// in it, and starts to sync the tasks into the database every syncPeriod.
+func NewBoltStore(path string, syncPeriod time.Duration) (PersistentStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.FileMode(0755)); err != nil {
+		return nil, fmt.Errorf("failed to create the dir of task store: %v", err)
+	}
+
+	db, err := bolt.Open(path, os.FileMode(0600), &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open task store %q: %v", path, err)
+	}
+
+	s := &boltStore{
+		cache: &cache{
+			m: make(map[string]Task),
+		},
+		db:     db,
+		saved:  make(map[string][]byte),
+		stopCh: make(chan struct{}),
+	}
+
+	if err = s.load(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	if syncPeriod <= 0 {
+		syncPeriod = DefaultBoltStoreSyncPeriod
+	}
+	go s.syncLoop(syncPeriod)
+
+	return s, nil
+}
+
+func (s *boltStore) AddTask(task Task) error {
+	if err := s.cache.AddTask(task); err != nil {
+		return err
+	}
+	return s.save(task)
+}
+
+func (s *boltStore) UpdateTask(task Task) error {
+	if err := s.cache.UpdateTask(task); err != nil {
+		return err
+	}
+	return s.save(task)
+}
+
+func (s *boltStore) UpdateOrAddTask(task Task) error {
+	if err := s.cache.UpdateOrAddTask(task); err != nil {
+		return err
+	}
+	return s.save(task)
+}
+
+// Sync writes all the tasks which were changed since the last sync into the database.
+func (s *boltStore) Sync() error {
+	s.cache.RLock()
+	tasks := make([]Task, 0, len(s.cache.m))
+	for _, t := range s.cache.m {
+		tasks = append(tasks, t)
+	}
+	s.cache.RUnlock()
+
+	var errs []string
+	for _, t := range tasks {
+		if err := s.save(t); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to sync tasks: %v", errs)
+	}
+	return nil
+}
+
+// Close stops the periodic sync, syncs the tasks for the last time and closes the database.
+func (s *boltStore) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stopCh)
+		err = s.Sync()
+		if closeErr := s.db.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	})
+	return err
+}
+
+func (s *boltStore) syncLoop(period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			if err := s.Sync(); err != nil {
+				logrus.Warnf("Failed to sync the task store: %v", err)
+			}
+		}
+	}
+}
+
+// save writes the task into the database if it was changed since it was written last time,
+// the transient tasks are not written.
+func (s *boltStore) save(t Task) error {
+	if transientTaskTypes[t.GetType()] {
+		return nil
+	}
+
+	data, err := encodeTask(t)
+	if err != nil {
+		return err
+	}
+
+	name := t.GetName()
+
+	s.savedLock.Lock()
+	defer s.savedLock.Unlock()
+
+	if bytes.Equal(s.saved[name], data) {
+		return nil
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(boltTaskBucket))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(name), data)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save task %q: %v", name, err)
+	}
+
+	s.saved[name] = data
+	return nil
+}
+
+// load restores all tasks from the database into the cache, the transient tasks written by
+// the previous versions are deleted.
+func (s *boltStore) load() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltTaskBucket))
+		if bucket == nil {
+			return nil
+		}
+
+		var transientKeys [][]byte
+		err := bucket.ForEach(func(key, value []byte) error {
+			t, err := decodeTask(value)
+			if err != nil {
+				// Don't let a single broken record block the controller from starting.
+				logrus.Warnf("Failed to restore task %q from the task store: %v", key, err)
+				return nil
+			}
+			if transientTaskTypes[t.GetType()] {
+				transientKeys = append(transientKeys, append([]byte(nil), key...))
+				return nil
+			}
+
+			markInterrupted(t)
+			s.cache.m[t.GetName()] = t
+			// Keep the original data so the interrupted status will be written back in the next sync.
+			s.saved[t.GetName()] = append([]byte(nil), value...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// the keys can't be deleted while iterating the bucket
+		for _, key := range transientKeys {
+			if err := bucket.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// markInterrupted sets the tasks and actions which were still in progress when the
+// controller stopped to failed, since nothing will drive them to the end any more.
+func markInterrupted(t Task) {
+	for _, subTask := range t.GetSubTasks() {
+		markInterrupted(subTask)
+	}
+
+	for _, act := range t.GetActions() {
+		if act.GetStatus() == action.ActionDoing {
+			act.SetStatus(action.ActionFailed)
+			act.SetErr(errInterrupted)
+		}
+	}
+
+	switch t.GetStatus() {
+	case TaskInitializing, TaskSplitting, TaskDoing:
+		t.SetStatus(TaskFailed)
+		t.SetErr(errInterrupted)
+	}
+}
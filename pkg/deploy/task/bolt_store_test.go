@@ -0,0 +1,275 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestBoltStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kpaas-task-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, DefaultBoltStoreFileName)
+
+	node := &pb.Node{Name: "node1", Ip: "192.168.0.1"}
+	checkAction := &action.NodeCheckAction{
+		Base: action.Base{
+			Name:        "node-check-1",
+			ActionType:  action.ActionTypeNodeCheck,
+			Status:      action.ActionDone,
+			LogFilePath: "/tmp/node1-node-check-1.log",
+			Node:        node,
+		},
+		CheckItems: []*action.NodeCheckItem{
+			{
+				Name:   "check cpu",
+				Status: action.ItemFailed,
+				Err:    &pb.Error{Reason: "too few cpus"},
+			},
+		},
+	}
+	initAction := &action.NodeInitAction{
+		Base: action.Base{
+			Name:       "node-init-1",
+			ActionType: action.ActionTypeNodeInit,
+			Status:     action.ActionDoing,
+			Node:       node,
+		},
+	}
+	checkTask := &NodeCheckTask{
+		Base: Base{
+			Name:     "node-check",
+			TaskType: TaskTypeNodeCheck,
+			Status:   TaskSuccessful,
+			Actions:  []action.Action{checkAction},
+		},
+	}
+	deployTask := &DeployTask{
+		Base: Base{
+			Name:     "unknown-deploy",
+			TaskType: TaskTypeDeploy,
+			Status:   TaskDoing,
+			SubTasks: []Task{
+				&NodeInitTask{
+					Base: Base{
+						Name:     "init",
+						TaskType: TaskTypeNodeInit,
+						Status:   TaskDoing,
+						Actions:  []action.Action{initAction},
+					},
+				},
+			},
+		},
+		NodeConfigs: []*pb.NodeDeployConfig{{Node: node, Roles: []string{"master"}}},
+	}
+
+	store, err := NewBoltStore(dbPath, DefaultBoltStoreSyncPeriod)
+	assert.NoError(t, err)
+	assert.NoError(t, store.AddTask(checkTask))
+	assert.Error(t, store.AddTask(checkTask))
+	assert.NoError(t, store.UpdateOrAddTask(deployTask))
+	// Changes made after storing the task are written by Sync/Close.
+	checkAction.CheckItems[0].Description = "check the cpu number"
+	assert.NoError(t, store.Close())
+
+	store, err = NewBoltStore(dbPath, DefaultBoltStoreSyncPeriod)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	// The finished task is restored as it was.
+	restoredCheck, ok := store.GetTask("node-check").(*NodeCheckTask)
+	assert.True(t, ok)
+	assert.Equal(t, TaskSuccessful, restoredCheck.GetStatus())
+	assert.Len(t, restoredCheck.GetActions(), 1)
+	restoredCheckAction, ok := restoredCheck.GetActions()[0].(*action.NodeCheckAction)
+	assert.True(t, ok)
+	assert.Equal(t, checkAction.CheckItems, restoredCheckAction.CheckItems)
+	assert.Equal(t, checkAction.LogFilePath, restoredCheckAction.GetLogFilePath())
+	assert.Equal(t, node, restoredCheckAction.GetNode())
+
+	// The running task is restored as interrupted.
+	restoredDeploy, ok := store.GetTask("unknown-deploy").(*DeployTask)
+	assert.True(t, ok)
+	assert.Equal(t, TaskFailed, restoredDeploy.GetStatus())
+	assert.Equal(t, errInterrupted, restoredDeploy.GetErr())
+	assert.Equal(t, deployTask.NodeConfigs, restoredDeploy.NodeConfigs)
+	assert.Len(t, restoredDeploy.GetSubTasks(), 1)
+	assert.Equal(t, TaskFailed, restoredDeploy.GetSubTasks()[0].GetStatus())
+	restoredInitAction := restoredDeploy.GetSubTasks()[0].GetActions()[0]
+	assert.Equal(t, action.ActionFailed, restoredInitAction.GetStatus())
+	assert.Equal(t, errInterrupted, restoredInitAction.GetErr())
+
+	assert.Nil(t, store.GetTask("not-existed"))
+}
+
+func TestBoltStoreSyncWhileExecuting(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kpaas-task-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	node := &pb.Node{Name: "node1", Ip: "192.168.0.1"}
+	kubeCfgAction := &action.FetchKubeConfigAction{
+		Base: action.Base{
+			Name:       "fetch-kube-config-1",
+			ActionType: action.ActionTypeFetchKubeConfig,
+			Status:     action.ActionPending,
+			Node:       node,
+		},
+	}
+	kubeCfgTask := &FetchKubeConfigTask{
+		Base: Base{
+			Name:     "fetch-kube-config",
+			TaskType: TaskTypeFetchKubeConfig,
+			Status:   TaskDoing,
+			Actions:  []action.Action{kubeCfgAction},
+		},
+	}
+
+	store, err := NewBoltStore(filepath.Join(dir, DefaultBoltStoreFileName), time.Millisecond)
+	assert.NoError(t, err)
+	assert.NoError(t, store.AddTask(kubeCfgTask))
+
+	// The task is executed while it's synced, the executor changes the action only after it's doing.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		kubeCfgAction.SetStatus(action.ActionDoing)
+		for i := 0; i < 100; i++ {
+			kubeCfgAction.KubeConfig = []byte(fmt.Sprintf("kube config %d", i))
+			kubeCfgAction.SetAttempts(i)
+		}
+		kubeCfgAction.SetStatus(action.ActionDone)
+		kubeCfgTask.SetStatus(TaskSuccessful)
+	}()
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, store.Sync())
+	}
+	<-done
+	assert.NoError(t, store.Close())
+
+	store, err = NewBoltStore(filepath.Join(dir, DefaultBoltStoreFileName), DefaultBoltStoreSyncPeriod)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	restored, ok := store.GetTask("fetch-kube-config").(*FetchKubeConfigTask)
+	assert.True(t, ok)
+	assert.Equal(t, TaskSuccessful, restored.GetStatus())
+	restoredAction := restored.GetActions()[0].(*action.FetchKubeConfigAction)
+	assert.Equal(t, []byte("kube config 99"), restoredAction.KubeConfig)
+}
+
+func TestBoltStoreSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "kpaas-task-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, DefaultBoltStoreFileName)
+
+	const token = "abcdef.0123456789abcdef"
+	node := &pb.Node{Name: "master1", Ip: "192.168.0.1"}
+	newTokenTask := func(name string) *CreateJoinTokenTask {
+		return &CreateJoinTokenTask{
+			Base: Base{
+				Name:     name,
+				TaskType: TaskTypeCreateJoinToken,
+				Status:   TaskSuccessful,
+				Actions: []action.Action{
+					&action.CreateJoinTokenAction{
+						Base: action.Base{
+							Name:       "create-join-token-1",
+							ActionType: action.ActionTypeCreateJoinToken,
+							Status:     action.ActionDone,
+							Node:       node,
+						},
+						TTL:            time.Hour,
+						RequestedToken: token,
+						Token:          token,
+					},
+				},
+			},
+			Node:  node,
+			TTL:   time.Hour,
+			Token: token,
+		}
+	}
+
+	// a transient task written by a previous version is deleted
+	data, err := encodeTask(newTokenTask("create-join-token-master1-1"))
+	assert.NoError(t, err)
+	db, err := bolt.Open(dbPath, os.FileMode(0600), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(boltTaskBucket))
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte("create-join-token-master1-1"), data)
+	}))
+	assert.NoError(t, db.Close())
+
+	scaleOutTask := &ScaleOutTask{
+		Base: Base{
+			Name:     "unknown-scale-out",
+			TaskType: TaskTypeScaleOut,
+			Status:   TaskFailed,
+			SubTasks: []Task{newTokenTask("create-join-token")},
+		},
+	}
+
+	store, err := NewBoltStore(dbPath, DefaultBoltStoreSyncPeriod)
+	assert.NoError(t, err)
+	assert.Nil(t, store.GetTask("create-join-token-master1-1"))
+	// the transient tasks are kept in the cache only
+	assert.NoError(t, store.AddTask(newTokenTask("create-join-token-master1-2")))
+	assert.NoError(t, store.AddTask(&TestConnectionTask{
+		Base: Base{Name: "testconnection-master1-1", TaskType: TaskTypeTestConnection, Status: TaskSuccessful},
+	}))
+	assert.NotNil(t, store.GetTask("create-join-token-master1-2"))
+	assert.NoError(t, store.AddTask(scaleOutTask))
+	assert.NoError(t, store.Close())
+
+	content, err := ioutil.ReadFile(dbPath)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), token)
+
+	store, err = NewBoltStore(dbPath, DefaultBoltStoreSyncPeriod)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	assert.Nil(t, store.GetTask("create-join-token-master1-2"))
+	assert.Nil(t, store.GetTask("testconnection-master1-1"))
+
+	// the tokens of the sub task and action are not restored, the other fields are
+	restored, ok := store.GetTask("unknown-scale-out").(*ScaleOutTask)
+	assert.True(t, ok)
+	restoredTokenTask := restored.GetSubTasks()[0].(*CreateJoinTokenTask)
+	assert.Empty(t, restoredTokenTask.Token)
+	assert.Equal(t, time.Hour, restoredTokenTask.TTL)
+	restoredTokenAction := restoredTokenTask.GetActions()[0].(*action.CreateJoinTokenAction)
+	assert.Empty(t, restoredTokenAction.RequestedToken)
+	assert.Empty(t, restoredTokenAction.Token)
+	assert.Equal(t, time.Hour, restoredTokenAction.TTL)
+}
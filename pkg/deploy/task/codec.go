@@ -0,0 +1,241 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+)
+
+// taskTypes maps a task type to the concrete struct used to restore it from its encoded form.
+var taskTypes = map[Type]reflect.Type{
+	TaskTypeDeploy:                   reflect.TypeOf(DeployTask{}),
+	TaskTypeNodeInit:                 reflect.TypeOf(NodeInitTask{}),
+	TaskTypeDeployEtcd:               reflect.TypeOf(DeployEtcdTask{}),
+	TaskTypeDeployMaster:             reflect.TypeOf(deployMasterTask{}),
+	TaskTypeInitMaster:               reflect.TypeOf(InitMasterTask{}),
+	TaskTypeJoinMaster:               reflect.TypeOf(JoinMasterTask{}),
+	TaskTypeDeployWorker:             reflect.TypeOf(deployWorkerTask{}),
+	TaskTypeDeployIngress:            reflect.TypeOf(deployIngressTask{}),
+	TaskTypeDeployConfig:             reflect.TypeOf(DeployConfigTask{}),
+	TaskTypeNodeCheck:                reflect.TypeOf(NodeCheckTask{}),
+	TaskTypeCheckNetworkRequirements: reflect.TypeOf(CheckNetworkRequirementsTask{}),
+	TaskTypeTestConnection:           reflect.TypeOf(TestConnectionTask{}),
+	TaskTypeFetchKubeConfig:          reflect.TypeOf(FetchKubeConfigTask{}),
+	TaskTypeCreateJoinToken:          reflect.TypeOf(CreateJoinTokenTask{}),
+	TaskTypeScaleOut:                 reflect.TypeOf(ScaleOutTask{}),
+	TaskTypeRemoveNodes:              reflect.TypeOf(RemoveNodesTask{}),
+	TaskTypeRemoveNodeStep:           reflect.TypeOf(RemoveNodeStepTask{}),
+	TaskTypeUpgradeCluster:           reflect.TypeOf(UpgradeClusterTask{}),
+	TaskTypeUpgradeNode:              reflect.TypeOf(UpgradeNodeTask{}),
+}
+
+// actionTypes maps an action type to the concrete struct used to restore it from its encoded form.
+var actionTypes = map[action.Type]reflect.Type{
+	action.ActionTypeNodeInit:           reflect.TypeOf(action.NodeInitAction{}),
+	action.ActionTypeDeployEtcd:         reflect.TypeOf(action.DeployEtcdAction{}),
+	action.ActionTypeInitMaster:         reflect.TypeOf(action.InitMasterAction{}),
+	action.ActionTypeJoinMaster:         reflect.TypeOf(action.JoinMasterAction{}),
+	action.ActionTypeDeployWorker:       reflect.TypeOf(action.DeployWorkerAction{}),
+	action.ActionTypeDeployIngress:      reflect.TypeOf(action.DeployIngressAction{}),
+	action.ActionTypeDeployContour:      reflect.TypeOf(action.DeployContourAction{}),
+	action.ActionTypeDeployConfig:       reflect.TypeOf(action.DeployConfigAction{}),
+	action.ActionTypeNodeCheck:          reflect.TypeOf(action.NodeCheckAction{}),
+	action.ActionTypeConnectivityCheck:  reflect.TypeOf(action.ConnectivityCheckAction{}),
+	action.ActionTypeTestConnection:     reflect.TypeOf(action.TestConnectionAction{}),
+	action.ActionTypeFetchKubeConfig:    reflect.TypeOf(action.FetchKubeConfigAction{}),
+	action.ActionTypeCreateJoinToken:    reflect.TypeOf(action.CreateJoinTokenAction{}),
+	action.ActionTypeDrainNode:          reflect.TypeOf(action.RemoveNodeAction{}),
+	action.ActionTypeRemoveEtcdMember:   reflect.TypeOf(action.RemoveNodeAction{}),
+	action.ActionTypeResetNode:          reflect.TypeOf(action.RemoveNodeAction{}),
+	action.ActionTypeDeleteNode:         reflect.TypeOf(action.RemoveNodeAction{}),
+	action.ActionTypeCheckUpgrade:       reflect.TypeOf(action.UpgradeNodeAction{}),
+	action.ActionTypeUpgradeFirstMaster: reflect.TypeOf(action.UpgradeNodeAction{}),
+	action.ActionTypeUpgradeMaster:      reflect.TypeOf(action.UpgradeNodeAction{}),
+	action.ActionTypeUpgradeWorker:      reflect.TypeOf(action.UpgradeNodeAction{}),
+}
+
+// redactedTaskFields are the fields of the tasks which are secrets, they're not encoded.
+// The join token is created again if the task is resumed, so it's not needed to be restored.
+var redactedTaskFields = map[Type][]string{
+	TaskTypeCreateJoinToken: {"Token"},
+}
+
+// redactedActionFields are the fields of the actions which are secrets, they're not encoded.
+var redactedActionFields = map[action.Type][]string{
+	action.ActionTypeCreateJoinToken: {"RequestedToken", "Token"},
+}
+
+// taskRecord is the encoded form of a task. The sub tasks and actions are kept apart from the
+// task itself since they are interfaces and need their type to be restored.
+type taskRecord struct {
+	Type     Type            `json:"type"`
+	Task     json.RawMessage `json:"task"`
+	SubTasks []*taskRecord   `json:"subTasks,omitempty"`
+	Actions  []*actionRecord `json:"actions,omitempty"`
+}
+
+// actionRecord is the encoded form of an action.
+type actionRecord struct {
+	Type   action.Type     `json:"type"`
+	Action json.RawMessage `json:"action"`
+}
+
+// encodeTask encodes a task and all of its sub tasks and actions.
+func encodeTask(t Task) ([]byte, error) {
+	record, err := newTaskRecord(t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(record)
+}
+
+// decodeTask restores a task and all of its sub tasks and actions from the encoded data.
+func decodeTask(data []byte) (Task, error) {
+	record := new(taskRecord)
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+	return record.restore()
+}
+
+func newTaskRecord(t Task) (*taskRecord, error) {
+	if t == nil {
+		return nil, fmt.Errorf("failed to encode task: task is nil")
+	}
+
+	// The task is encoded under its lock, so its status can't be changed meanwhile.
+	base := t.getBase()
+	base.lock.RLock()
+	defer base.lock.RUnlock()
+
+	data, err := json.Marshal(t)
+	if err == nil {
+		data, err = redactFields(data, redactedTaskFields[base.TaskType])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode task %q: %v", base.Name, err)
+	}
+	record := &taskRecord{
+		Type: base.TaskType,
+		Task: data,
+	}
+
+	// The sub tasks and actions are being created while the task is initialized and split,
+	// they are encoded once the task is doing.
+	if base.Status == TaskInitializing || base.Status == TaskSplitting {
+		return record, nil
+	}
+
+	for _, subTask := range base.SubTasks {
+		subRecord, err := newTaskRecord(subTask)
+		if err != nil {
+			return nil, err
+		}
+		record.SubTasks = append(record.SubTasks, subRecord)
+	}
+
+	for _, act := range base.Actions {
+		data, err := action.EncodeAction(act)
+		if err == nil {
+			data, err = redactFields(data, redactedActionFields[act.GetType()])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode action %q: %v", act.GetName(), err)
+		}
+		record.Actions = append(record.Actions, &actionRecord{
+			Type:   act.GetType(),
+			Action: data,
+		})
+	}
+
+	return record, nil
+}
+
+func (r *taskRecord) restore() (Task, error) {
+	taskType, ok := taskTypes[r.Type]
+	if !ok {
+		return nil, fmt.Errorf("failed to decode task: unknown task type %q", r.Type)
+	}
+
+	t, ok := reflect.New(taskType).Interface().(Task)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode task: type %v is not a task", taskType)
+	}
+	if err := json.Unmarshal(r.Task, t); err != nil {
+		return nil, fmt.Errorf("failed to decode task: %v", err)
+	}
+
+	var subTasks []Task
+	for _, subRecord := range r.SubTasks {
+		subTask, err := subRecord.restore()
+		if err != nil {
+			return nil, err
+		}
+		subTasks = append(subTasks, subTask)
+	}
+
+	var actions []action.Action
+	for _, actRecord := range r.Actions {
+		act, err := actRecord.restore()
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, act)
+	}
+
+	// The sub tasks and actions are not part of the encoded task, set them back via the Base.
+	base := t.getBase()
+	base.SubTasks = subTasks
+	base.Actions = actions
+
+	return t, nil
+}
+
+func (r *actionRecord) restore() (action.Action, error) {
+	actionType, ok := actionTypes[r.Type]
+	if !ok {
+		return nil, fmt.Errorf("failed to decode action: unknown action type %q", r.Type)
+	}
+
+	act, ok := reflect.New(actionType).Interface().(action.Action)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode action: type %v is not an action", actionType)
+	}
+	if err := json.Unmarshal(r.Action, act); err != nil {
+		return nil, fmt.Errorf("failed to decode action: %v", err)
+	}
+
+	return act, nil
+}
+
+// redactFields removes the fields from the encoded object.
+func redactFields(data []byte, fields []string) ([]byte, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	object := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	for _, field := range fields {
+		delete(object, field)
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,102 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeCreateJoinToken, new(createJoinTokenProcessor))
+}
+
+// createJoinTokenProcessor implements the specific logic for the create-join-token task.
+type createJoinTokenProcessor struct {
+}
+
+// Spilt the task into one create-join-token action
+func (p *createJoinTokenProcessor) SplitTask(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	tokenTask := t.(*CreateJoinTokenTask)
+
+	act, err := action.NewCreateJoinTokenAction(&action.CreateJoinTokenActionConfig{
+		Node:              tokenTask.Node,
+		TTL:               tokenTask.TTL,
+		Token:             tokenTask.Token,
+		CertKey:           tokenTask.CertKey,
+		KubernetesVersion: tokenTask.KubernetesVersion,
+		LogFileBasePath:   tokenTask.LogFileDir,
+	})
+	if err != nil {
+		return err
+	}
+	tokenTask.Actions = []action.Action{act}
+
+	logger.Debug("Finish to split task")
+	return nil
+}
+
+func (p *createJoinTokenProcessor) ProcessExtraResult(t Task) error {
+	if err := p.verifyTask(t); err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	tokenTask := t.(*CreateJoinTokenTask)
+	if len(tokenTask.Actions) == 0 {
+		logrus.WithField(consts.LogFieldTask, t.GetName()).Debug("Task has no action")
+		return nil
+	}
+
+	tokenAction, ok := tokenTask.Actions[0].(*action.CreateJoinTokenAction)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgActionTypeMismatched, tokenTask.Actions[0])
+	}
+
+	tokenTask.Token = tokenAction.Token
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *createJoinTokenProcessor) verifyTask(t Task) error {
+	if t == nil {
+		return consts.ErrEmptyTask
+	}
+
+	tokenTask, ok := t.(*CreateJoinTokenTask)
+	if !ok {
+		return fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if tokenTask.Node == nil {
+		return fmt.Errorf("node field is nil")
+	}
+
+	return nil
+}
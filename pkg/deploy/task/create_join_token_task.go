@@ -0,0 +1,87 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeCreateJoinToken Type = "CreateJoinToken"
+
+// CreateJoinTokenTaskConfig represents the config for a create-join-token task.
+type CreateJoinTokenTaskConfig struct {
+	// Node is a master of the cluster which the token is created on.
+	Node *pb.Node
+	TTL  time.Duration
+	// Token is the token to create, a new token is generated if it's empty.
+	Token string
+	// CertKey is the key to upload the control plane certificates again, the certificates
+	// are not uploaded if it's empty.
+	CertKey string
+	// KubernetesVersion is the version which the control plane must be at, it's not checked if it's empty.
+	KubernetesVersion string
+	LogFileBasePath   string
+	Priority          int
+	Parent            string
+}
+
+type CreateJoinTokenTask struct {
+	Base
+
+	Node              *pb.Node
+	TTL               time.Duration
+	CertKey           string
+	KubernetesVersion string
+	// Token stores the task result: the created join token.
+	Token string
+}
+
+// NewCreateJoinTokenTask returns a create-join-token task based on the config.
+// User should use this function to create a create-join-token task.
+func NewCreateJoinTokenTask(taskName string, taskConfig *CreateJoinTokenTaskConfig) (Task, error) {
+	if taskName == "" {
+		return nil, fmt.Errorf("taskName can't be empty")
+	}
+	if taskConfig == nil {
+		return nil, fmt.Errorf("invalid task config: nil")
+	}
+	if taskConfig.Node == nil {
+		return nil, fmt.Errorf("invalid task config: Node field is nil")
+	}
+	if taskConfig.TTL <= 0 {
+		return nil, fmt.Errorf("invalid task config: TTL must be positive")
+	}
+
+	task := &CreateJoinTokenTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeCreateJoinToken,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			Parent:            taskConfig.Parent,
+		},
+		Node:              taskConfig.Node,
+		TTL:               taskConfig.TTL,
+		CertKey:           taskConfig.CertKey,
+		KubernetesVersion: taskConfig.KubernetesVersion,
+		Token:             taskConfig.Token,
+	}
+
+	return task, nil
+}
@@ -15,6 +15,8 @@
 package task
 
 import (
+	"crypto"
+	"crypto/x509"
 	"fmt"
 
 	"github.com/sirupsen/logrus"
@@ -48,8 +50,7 @@ func (p *deployEtcdProcessor) SplitTask(t Task) error {
 	etcdTask := t.(*DeployEtcdTask)
 
 	// generate etcd ca cert and key and put it into every action
-	caCertConfig := etcd.GetCaCrtConfig()
-	caCrt, cakey, err := etcd.CreateAsCA(caCertConfig)
+	caCrt, cakey, err := p.getCA(etcdTask)
 	if err != nil {
 		return fmt.Errorf("failed to get etcd-ca key and cert, error: %v", err)
 	}
@@ -78,6 +79,20 @@ func (p *deployEtcdProcessor) SplitTask(t Task) error {
 	return nil
 }
 
+// getCA returns the etcd ca cert and key. When resuming, the ca is fetched from a node which
+// was deployed in the previous execution, since the remaining nodes have to join the same cluster.
+func (p *deployEtcdProcessor) getCA(etcdTask *DeployEtcdTask) (*x509.Certificate, crypto.Signer, error) {
+	if previous := etcdTask.GetResumeFrom(); previous != nil {
+		for _, act := range previous.GetActions() {
+			if act.GetStatus() == action.ActionDone && act.GetNode() != nil {
+				return etcd.FetchEtcdCertAndKey(act.GetNode(), "ca")
+			}
+		}
+	}
+
+	return etcd.CreateAsCA(etcd.GetCaCrtConfig())
+}
+
 // Verify if the task is valid.
 func (p *deployEtcdProcessor) verifyTask(t Task) error {
 	if t == nil {
@@ -61,6 +61,7 @@ func (processor *DeployIngressProcessor) SplitTask(task Task) error {
 			ClusterConfig:   deployTask.Config.ClusterConfig,
 			LogFileBasePath: deployTask.LogFileDir, // /app/deploy/logs/unknown/deploy-ingress
 			MasterNodes:     deployTask.Config.MasterNodes,
+			BootstrapToken:  deployTask.Config.BootstrapToken,
 		}
 		act, err := action.NewDeployIngressAction(actionCfg)
 		if err != nil {
@@ -99,6 +99,7 @@ func (p *deployMasterProcessor) createDeployMasterSubTask(index int, parent *dep
 	case 0:
 		config := &InitMasterTaskConfig{
 			certKey:         parent.CertKey,
+			bootstrapToken:  parent.BootstrapToken,
 			node:            parent.Nodes[index],
 			roles:           deploy.GetNodeRoles(parent.Nodes[index], parent.NodeConfigs),
 			etcdNodes:       parent.EtcdNodes,
@@ -114,6 +115,7 @@ func (p *deployMasterProcessor) createDeployMasterSubTask(index int, parent *dep
 	default:
 		config := &JoinMasterTaskConfig{
 			certKey:         parent.CertKey,
+			bootstrapToken:  parent.BootstrapToken,
 			node:            parent.Nodes[index],
 			roles:           deploy.GetNodeRoles(parent.Nodes[index], parent.NodeConfigs),
 			masterNodes:     parent.Nodes,
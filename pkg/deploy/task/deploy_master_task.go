@@ -28,6 +28,7 @@ const TaskTypeDeployMaster Type = "DeployMaster"
 // DeploymasterTaskConfig represents the config for a deploy master task.
 type DeployMasterTaskConfig struct {
 	CertKey         string
+	BootstrapToken  string
 	EtcdNodes       []*pb.Node
 	Nodes           []*pb.Node
 	NodeConfigs     []*pb.NodeDeployConfig
@@ -39,11 +40,12 @@ type DeployMasterTaskConfig struct {
 
 type deployMasterTask struct {
 	Base
-	CertKey       string
-	Nodes         []*pb.Node
-	EtcdNodes     []*pb.Node
-	NodeConfigs   []*pb.NodeDeployConfig
-	ClusterConfig *pb.ClusterConfig
+	CertKey        string
+	BootstrapToken string
+	Nodes          []*pb.Node
+	EtcdNodes      []*pb.Node
+	NodeConfigs    []*pb.NodeDeployConfig
+	ClusterConfig  *pb.ClusterConfig
 }
 
 // NewDeploymasterTask returns a deploy master task based on the config.
@@ -75,11 +77,12 @@ func NewDeployMasterTask(taskName string, taskConfig *DeployMasterTaskConfig) (T
 			Priority:          taskConfig.Priority,
 			Parent:            taskConfig.Parent,
 		},
-		CertKey:       taskConfig.CertKey,
-		NodeConfigs:   taskConfig.NodeConfigs,
-		Nodes:         taskConfig.Nodes,
-		EtcdNodes:     taskConfig.EtcdNodes,
-		ClusterConfig: taskConfig.ClusterConfig,
+		CertKey:        taskConfig.CertKey,
+		BootstrapToken: taskConfig.BootstrapToken,
+		NodeConfigs:    taskConfig.NodeConfigs,
+		Nodes:          taskConfig.Nodes,
+		EtcdNodes:      taskConfig.EtcdNodes,
+		ClusterConfig:  taskConfig.ClusterConfig,
 	}
 
 	return task, nil
@@ -21,6 +21,7 @@ import (
 	"k8s.io/kubernetes/cmd/kubeadm/app/phases/copycerts"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
 	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
@@ -47,7 +48,8 @@ func (p *deployProcessor) SplitTask(t Task) error {
 
 	logger.Debug("Start to split deploy task")
 
-	// split task into subtask: init, deploy etcd, deploy master, deploy worker, deploy ingress
+	// split task into subtask: init, deploy etcd, deploy master, deploy worker, deploy ingress, and schedule
+	// them by their dependencies: init -> etcd -> master -> worker/ingress -> config
 	var subTasks []Task
 
 	// first collect all roles and their related nodes
@@ -62,6 +64,27 @@ func (p *deployProcessor) SplitTask(t Task) error {
 	}
 	subTasks = append(subTasks, initTask)
 
+	// the tasks which the deploy of masters depends on
+	masterDependencies := []string{initTask.GetName()}
+	// the tasks which the deploy of workers and ingresses depends on
+	nodeDependencies := []string{initTask.GetName()}
+	// the tasks which the deploy config depends on
+	configDependencies := []string{initTask.GetName()}
+
+	// create the join token sub task with priority = 10 if the first master was initialized by the resumed deploy
+	tokenTask, err := p.createResumedJoinTokenSubTask(deployTask, roles)
+	if err != nil {
+		err = fmt.Errorf("failed to create join token sub task: %s", err)
+		logger.Error(err)
+		return err
+	}
+	if tokenTask != nil {
+		subTasks = append(subTasks, tokenTask)
+		masterDependencies = append(masterDependencies, tokenTask.GetName())
+		nodeDependencies = append(nodeDependencies, tokenTask.GetName())
+		configDependencies = append(configDependencies, tokenTask.GetName())
+	}
+
 	// create the deploy etcd sub tasks with priority = 20
 	if _, ok := roles[constant.MachineRoleEtcd]; ok {
 		etcdTask, err := p.createDeploySubTask(constant.MachineRoleEtcd, deployTask, roles)
@@ -70,7 +93,10 @@ func (p *deployProcessor) SplitTask(t Task) error {
 			logger.Error(err)
 			return err
 		}
+		etcdTask.SetDependencies([]string{initTask.GetName()})
 		subTasks = append(subTasks, etcdTask)
+		masterDependencies = append(masterDependencies, etcdTask.GetName())
+		configDependencies = append(configDependencies, etcdTask.GetName())
 	}
 
 	// create the deploy master sub tasks with priority = 30
@@ -81,7 +107,10 @@ func (p *deployProcessor) SplitTask(t Task) error {
 			logger.Error(err)
 			return err
 		}
+		masterTask.SetDependencies(masterDependencies)
 		subTasks = append(subTasks, masterTask)
+		nodeDependencies = []string{masterTask.GetName()}
+		configDependencies = append(configDependencies, masterTask.GetName())
 	}
 
 	// create the deploy worker sub tasks with priority = 40
@@ -92,7 +121,14 @@ func (p *deployProcessor) SplitTask(t Task) error {
 			logger.Error(err)
 			return err
 		}
+		workerTask.SetDependencies(nodeDependencies)
 		subTasks = append(subTasks, workerTask)
+		configDependencies = append(configDependencies, workerTask.GetName())
+
+		// the worker and ingress nodes join the cluster parallelly, unless a node has both roles.
+		if p.haveCommonNodes(roles[constant.MachineRoleWorker], roles[constant.MachineRoleIngress]) {
+			nodeDependencies = append(nodeDependencies, workerTask.GetName())
+		}
 	}
 
 	// create the deploy ingress sub tasks with priority = 50
@@ -103,16 +139,19 @@ func (p *deployProcessor) SplitTask(t Task) error {
 			logger.Error(err)
 			return err
 		}
+		ingressTask.SetDependencies(nodeDependencies)
 		subTasks = append(subTasks, ingressTask)
+		configDependencies = append(configDependencies, ingressTask.GetName())
 	}
 
-	// create the deploy config sub task with priority = 60
+	// create the deploy config sub task with priority = 60, the nodes are configured after they all joined.
 	configTask, err := p.createConfigSubTask(deployTask, roles)
 	if err != nil {
 		err = fmt.Errorf("failed to create deploy config sub tasks: %s", err)
 		logger.Error(err)
 		return err
 	}
+	configTask.SetDependencies(configDependencies)
 	subTasks = append(subTasks, configTask)
 
 	deployTask.SubTasks = subTasks
@@ -151,6 +190,20 @@ func (p *deployProcessor) groupByRole(cfgs []*pb.NodeDeployConfig) map[constant.
 	return roles
 }
 
+// haveCommonNodes returns whether any node is in both of the node config lists.
+func (p *deployProcessor) haveCommonNodes(nodeConfigs1, nodeConfigs2 []*pb.NodeDeployConfig) bool {
+	names := make(map[string]bool, len(nodeConfigs1))
+	for _, nodeCfg := range nodeConfigs1 {
+		names[nodeCfg.GetNode().GetName()] = true
+	}
+	for _, nodeCfg := range nodeConfigs2 {
+		if names[nodeCfg.GetNode().GetName()] {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *deployProcessor) createInitSubTask(parent *DeployTask, rn map[constant.MachineRole][]*pb.NodeDeployConfig) (task Task, err error) {
 
 	config := &NodeInitTaskConfig{
@@ -192,13 +245,14 @@ func (p *deployProcessor) createDeploySubTask(role constant.MachineRole, parent
 		task, err = NewDeployEtcdTask(fmt.Sprintf("deploy-%s", role), config)
 
 	case constant.MachineRoleMaster:
-		certificateKey, err := copycerts.CreateCertificateKey()
+		certificateKey, err := p.getCertificateKey(parent)
 		if err != nil {
 			return nil, err
 		}
 
 		config := &DeployMasterTaskConfig{
 			CertKey:         certificateKey,
+			BootstrapToken:  parent.BootstrapToken,
 			NodeConfigs:     parent.NodeConfigs,
 			EtcdNodes:       p.unwrapNodes(rn[constant.MachineRoleEtcd]),
 			Nodes:           p.unwrapNodes(rn[role]),
@@ -220,9 +274,10 @@ func (p *deployProcessor) createDeploySubTask(role constant.MachineRole, parent
 					Priority:        int(Priorities[role]),
 					Parent:          parent.GetName(),
 				},
-				Nodes:         rn[constant.MachineRoleWorker],
-				ClusterConfig: parent.ClusterConfig,
-				MasterNodes:   p.unwrapNodes(rn[constant.MachineRoleMaster]),
+				Nodes:          rn[constant.MachineRoleWorker],
+				ClusterConfig:  parent.ClusterConfig,
+				MasterNodes:    p.unwrapNodes(rn[constant.MachineRoleMaster]),
+				BootstrapToken: parent.BootstrapToken,
 			},
 		)
 
@@ -236,9 +291,10 @@ func (p *deployProcessor) createDeploySubTask(role constant.MachineRole, parent
 					Priority:        int(Priorities[role]),
 					Parent:          parent.GetName(),
 				},
-				Nodes:         rn[constant.MachineRoleIngress],
-				ClusterConfig: parent.ClusterConfig,
-				MasterNodes:   p.unwrapNodes(rn[constant.MachineRoleMaster]),
+				Nodes:          rn[constant.MachineRoleIngress],
+				ClusterConfig:  parent.ClusterConfig,
+				MasterNodes:    p.unwrapNodes(rn[constant.MachineRoleMaster]),
+				BootstrapToken: parent.BootstrapToken,
 			},
 		)
 
@@ -249,6 +305,71 @@ func (p *deployProcessor) createDeploySubTask(role constant.MachineRole, parent
 	return
 }
 
+// createResumedJoinTokenSubTask returns the sub task to create the bootstrap token on the first master again
+// when resuming a deploy which initialized the first master, since the token may have expired; the control plane
+// certificates are uploaded again if any master is still to join, they expire sooner than the token.
+// It returns nil if the first master is to be initialized, kubeadm init creates the token then.
+func (p *deployProcessor) createResumedJoinTokenSubTask(parent *DeployTask, rn map[constant.MachineRole][]*pb.NodeDeployConfig) (Task, error) {
+	previous := parent.GetResumeFrom()
+	if previous == nil || len(rn[constant.MachineRoleMaster]) == 0 {
+		return nil, nil
+	}
+
+	var initialized, uploadCerts bool
+	for _, subTask := range previous.GetSubTasks() {
+		masterTask, ok := subTask.(*deployMasterTask)
+		if !ok {
+			continue
+		}
+		for _, masterSubTask := range masterTask.GetSubTasks() {
+			switch masterSubTask.(type) {
+			case *InitMasterTask:
+				initialized = masterSubTask.GetStatus() == TaskSuccessful
+			case *JoinMasterTask:
+				// the successful deploy master task is skipped as a whole
+				uploadCerts = uploadCerts ||
+					masterTask.GetStatus() != TaskSuccessful && masterSubTask.GetStatus() != TaskSuccessful
+			}
+		}
+	}
+	if !initialized {
+		return nil, nil
+	}
+
+	config := &CreateJoinTokenTaskConfig{
+		Node:            p.unwrapNode(rn[constant.MachineRoleMaster][0]),
+		TTL:             deploy.BootstrapTokenTTL,
+		Token:           parent.BootstrapToken,
+		LogFileBasePath: parent.GetLogFileDir(),
+		Priority:        int(initPriority),
+		Parent:          parent.GetName(),
+	}
+	if uploadCerts {
+		certificateKey, err := p.getCertificateKey(parent)
+		if err != nil {
+			return nil, err
+		}
+		config.CertKey = certificateKey
+	}
+
+	return NewCreateJoinTokenTask("create-join-token", config)
+}
+
+// getCertificateKey returns the key to encrypt the uploaded control plane certificates. When resuming
+// a deploy, the key of the previous deploy is reused since the first master may have uploaded the
+// certificates with it.
+func (p *deployProcessor) getCertificateKey(parent *DeployTask) (string, error) {
+	if previous := parent.GetResumeFrom(); previous != nil {
+		for _, subTask := range previous.GetSubTasks() {
+			if masterTask, ok := subTask.(*deployMasterTask); ok && masterTask.CertKey != "" {
+				return masterTask.CertKey, nil
+			}
+		}
+	}
+
+	return copycerts.CreateCertificateKey()
+}
+
 func (p deployProcessor) unwrapNode(config *pb.NodeDeployConfig) *pb.Node {
 	return config.GetNode()
 }
@@ -0,0 +1,90 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestSplitResumedDeployTask(t *testing.T) {
+	newDeployTask := func() Task {
+		deployTask, err := NewDeployTask("cluster1-deploy", &DeployTaskConfig{
+			NodeConfigs: []*pb.NodeDeployConfig{
+				{
+					Node:  &pb.Node{Name: "master1", Ip: "10.1.1.1"},
+					Roles: []string{string(constant.MachineRoleEtcd), string(constant.MachineRoleMaster)},
+				},
+				{
+					Node:  &pb.Node{Name: "master2", Ip: "10.1.1.2"},
+					Roles: []string{string(constant.MachineRoleMaster)},
+				},
+				{
+					Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+					Roles: []string{string(constant.MachineRoleWorker)},
+				},
+			},
+			ClusterConfig: &pb.ClusterConfig{
+				KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+			},
+		})
+		assert.NoError(t, err)
+		return deployTask
+	}
+	split := func(deployTask Task) map[string]Task {
+		assert.NoError(t, new(deployProcessor).SplitTask(deployTask))
+		subTasks := make(map[string]Task)
+		for _, subTask := range deployTask.GetSubTasks() {
+			subTasks[subTask.GetName()] = subTask
+		}
+		return subTasks
+	}
+
+	previous := newDeployTask()
+	previousSubTasks := split(previous)
+	assert.NotContains(t, previousSubTasks, "create-join-token")
+	previousMasterTask := previousSubTasks["deploy-master"]
+	assert.NoError(t, new(deployMasterProcessor).SplitTask(previousMasterTask))
+	previous.SetStatus(TaskFailed)
+
+	// kubeadm init creates the token if the first master wasn't initialized
+	resumed, err := NewResumedDeployTask(previous)
+	assert.NoError(t, err)
+	assert.NotContains(t, split(resumed), "create-join-token")
+
+	// the token is created again and the certificates are uploaded for master2 which is still to join
+	previousMasterTask.SetStatus(TaskFailed)
+	previousMasterTask.GetSubTasks()[0].SetStatus(TaskSuccessful)
+	previousMasterTask.GetSubTasks()[1].SetStatus(TaskFailed)
+	resumed, err = NewResumedDeployTask(previous)
+	assert.NoError(t, err)
+	subTasks := split(resumed)
+	tokenTask, ok := subTasks["create-join-token"].(*CreateJoinTokenTask)
+	assert.True(t, ok)
+	assert.Equal(t, "master1", tokenTask.Node.GetName())
+	assert.Equal(t, previous.(*DeployTask).BootstrapToken, tokenTask.Token)
+	assert.Equal(t, previousMasterTask.(*deployMasterTask).CertKey, tokenTask.CertKey)
+	assert.Contains(t, subTasks["deploy-master"].GetDependencies(), "create-join-token")
+	assert.NoError(t, verifyDependencies(resumed.GetSubTasks()))
+
+	// the certificates are not uploaded if all the masters joined
+	previousMasterTask.GetSubTasks()[1].SetStatus(TaskSuccessful)
+	resumed, err = NewResumedDeployTask(previous)
+	assert.NoError(t, err)
+	assert.Empty(t, split(resumed)["create-join-token"].(*CreateJoinTokenTask).CertKey)
+}
@@ -22,6 +22,9 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -57,12 +60,17 @@ type DeployTaskConfig struct {
 	ClusterConfig   *pb.ClusterConfig
 	LogFileBasePath string
 	Priority        int
+	// RetryPolicies are the retry policies of the action types,
+	// action.DefaultRetryPolicies is used if it is nil.
+	RetryPolicies map[action.Type]*action.RetryPolicy
 }
 
 type DeployTask struct {
 	Base
 	NodeConfigs   []*pb.NodeDeployConfig
 	ClusterConfig *pb.ClusterConfig
+	// BootstrapToken is generated for each deploy, the nodes join the cluster with it.
+	BootstrapToken string
 }
 
 // NewDeployTask returns a deploy task based on the config.
@@ -74,6 +82,8 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 
 	} else if len(taskConfig.NodeConfigs) == 0 {
 		err = fmt.Errorf("invalid task config: node deploy configs is empty")
+	} else if kubeErr := deploy.ValidateKubernetesConfig(taskConfig.ClusterConfig); kubeErr != nil {
+		err = fmt.Errorf("invalid task config: %v", kubeErr)
 	}
 
 	if err != nil {
@@ -81,6 +91,18 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 		return nil, err
 	}
 
+	token, err := deploy.NewBootstrapToken()
+	if err != nil {
+		err = fmt.Errorf("failed to generate bootstrap token: %v", err)
+		logrus.Error(err)
+		return nil, err
+	}
+
+	retryPolicies := taskConfig.RetryPolicies
+	if retryPolicies == nil {
+		retryPolicies = action.DefaultRetryPolicies
+	}
+
 	task := &DeployTask{
 		Base: Base{
 			Name:              taskName,
@@ -89,9 +111,48 @@ func NewDeployTask(taskName string, taskConfig *DeployTaskConfig) (Task, error)
 			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName), // /app/deploy/logs/unknown
 			CreationTimestamp: time.Now(),
 			Priority:          taskConfig.Priority,
+			RetryPolicies:     retryPolicies,
+		},
+		NodeConfigs:    taskConfig.NodeConfigs,
+		ClusterConfig:  taskConfig.ClusterConfig,
+		BootstrapToken: token,
+	}
+
+	return task, nil
+}
+
+// NewResumedDeployTask returns a deploy task which resumes from a previous failed, aborted
+// or interrupted deploy task: the sub tasks and actions which were done in the previous
+// task will be skipped.
+func NewResumedDeployTask(previous Task) (Task, error) {
+	var err error
+	previousTask, ok := previous.(*DeployTask)
+	if !ok {
+		err = fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, previous)
+	} else if status := previousTask.GetStatus(); status != TaskFailed && status != TaskAborted {
+		err = fmt.Errorf("only a failed or aborted deploy task can be resumed, the task is %s", status)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &DeployTask{
+		Base: Base{
+			Name:              previousTask.Name,
+			TaskType:          TaskTypeDeploy,
+			Status:            TaskPending,
+			LogFileDir:        previousTask.LogFileDir,
+			CreationTimestamp: time.Now(),
+			Priority:          previousTask.Priority,
+			ResumeFrom:        previousTask,
+			RetryPolicies:     previousTask.RetryPolicies,
 		},
-		NodeConfigs:   taskConfig.NodeConfigs,
-		ClusterConfig: taskConfig.ClusterConfig,
+		NodeConfigs:   previousTask.NodeConfigs,
+		ClusterConfig: previousTask.ClusterConfig,
+		// the first master may have been initialized with the token of the previous task
+		BootstrapToken: previousTask.BootstrapToken,
 	}
 
 	return task, nil
@@ -61,6 +61,7 @@ func (processor *DeployWorkerProcessor) SplitTask(task Task) error {
 			ClusterConfig:   deployTask.Config.ClusterConfig,
 			LogFileBasePath: deployTask.LogFileDir, // /app/deploy/logs/unknown/deploy-worker
 			MasterNodes:     deployTask.Config.MasterNodes,
+			BootstrapToken:  deployTask.Config.BootstrapToken,
 		}
 		act, err := action.NewDeployWorkerAction(actionCfg)
 		if err != nil {
@@ -28,6 +28,8 @@ type DeployWorkerTaskConfig struct {
 	MasterNodes   []*protos.Node
 	Nodes         []*protos.NodeDeployConfig
 	ClusterConfig *protos.ClusterConfig
+	// BootstrapToken is the token of the deploy to join the nodes to the cluster
+	BootstrapToken string
 }
 
 type deployWorkerTask struct {
@@ -46,6 +46,7 @@ func (p *initMasterProcessor) SplitTask(t Task) error {
 	var actions []action.Action
 	actionCfg := &action.InitMasterActionConfig{
 		CertKey:         task.CertKey,
+		BootstrapToken:  task.BootstrapToken,
 		Node:            task.Node,
 		Roles:           task.Roles,
 		EtcdNodes:       task.EtcdNodes,
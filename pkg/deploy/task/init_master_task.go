@@ -32,6 +32,7 @@ const (
 
 type InitMasterTaskConfig struct {
 	certKey         string
+	bootstrapToken  string
 	operation       Operation
 	etcdNodes       []*pb.Node
 	MasterNodes     []*pb.Node
@@ -45,13 +46,14 @@ type InitMasterTaskConfig struct {
 
 type InitMasterTask struct {
 	Base
-	CertKey       string
-	Operation     Operation
-	EtcdNodes     []*pb.Node
-	MasterNodes   []*pb.Node
-	Roles         []string
-	ClusterConfig *pb.ClusterConfig
-	Node          *pb.Node
+	CertKey        string
+	BootstrapToken string
+	Operation      Operation
+	EtcdNodes      []*pb.Node
+	MasterNodes    []*pb.Node
+	Roles          []string
+	ClusterConfig  *pb.ClusterConfig
+	Node           *pb.Node
 }
 
 func NewInitMasterTask(taskName string, taskConfig *InitMasterTaskConfig) (Task, error) {
@@ -81,13 +83,14 @@ func NewInitMasterTask(taskName string, taskConfig *InitMasterTaskConfig) (Task,
 			Priority:          taskConfig.Priority,
 			Parent:            taskConfig.parent,
 		},
-		CertKey:       taskConfig.certKey,
-		Node:          taskConfig.node,
-		Roles:         taskConfig.roles,
-		EtcdNodes:     taskConfig.etcdNodes,
-		MasterNodes:   taskConfig.MasterNodes,
-		ClusterConfig: taskConfig.clusterConfig,
-		Operation:     InitMasterOperation,
+		CertKey:        taskConfig.certKey,
+		BootstrapToken: taskConfig.bootstrapToken,
+		Node:           taskConfig.node,
+		Roles:          taskConfig.roles,
+		EtcdNodes:      taskConfig.etcdNodes,
+		MasterNodes:    taskConfig.MasterNodes,
+		ClusterConfig:  taskConfig.clusterConfig,
+		Operation:      InitMasterOperation,
 	}
 
 	return task, nil
@@ -45,6 +45,7 @@ func (p *joinMasterProcessor) SplitTask(t Task) error {
 	var actions []action.Action
 	actionCfg := &action.JoinMasterActionConfig{
 		CertKey:         task.CertKey,
+		BootstrapToken:  task.BootstrapToken,
 		Node:            task.Node,
 		Roles:           task.Roles,
 		MasterNodes:     task.MasterNodes,
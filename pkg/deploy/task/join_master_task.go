@@ -32,6 +32,7 @@ const (
 
 type JoinMasterTaskConfig struct {
 	certKey         string
+	bootstrapToken  string
 	operation       Operation
 	node            *pb.Node
 	roles           []string
@@ -44,12 +45,13 @@ type JoinMasterTaskConfig struct {
 
 type JoinMasterTask struct {
 	Base
-	CertKey       string
-	Operation     Operation
-	Node          *pb.Node
-	Roles         []string
-	MasterNodes   []*pb.Node
-	ClusterConfig *pb.ClusterConfig
+	CertKey        string
+	BootstrapToken string
+	Operation      Operation
+	Node           *pb.Node
+	Roles          []string
+	MasterNodes    []*pb.Node
+	ClusterConfig  *pb.ClusterConfig
 }
 
 func NewJoinMasterTask(taskName string, taskConfig *JoinMasterTaskConfig) (Task, error) {
@@ -75,12 +77,13 @@ func NewJoinMasterTask(taskName string, taskConfig *JoinMasterTaskConfig) (Task,
 			Priority:          taskConfig.priority,
 			Parent:            taskConfig.parent,
 		},
-		CertKey:       taskConfig.certKey,
-		Node:          taskConfig.node,
-		Roles:         taskConfig.roles,
-		MasterNodes:   taskConfig.masterNodes,
-		ClusterConfig: taskConfig.clusterConfig,
-		Operation:     JointMasterOperation,
+		CertKey:        taskConfig.certKey,
+		BootstrapToken: taskConfig.bootstrapToken,
+		Node:           taskConfig.node,
+		Roles:          taskConfig.roles,
+		MasterNodes:    taskConfig.masterNodes,
+		ClusterConfig:  taskConfig.clusterConfig,
+		Operation:      JointMasterOperation,
 	}
 
 	return task, nil
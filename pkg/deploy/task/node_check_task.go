@@ -20,6 +20,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
 	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
@@ -31,6 +32,9 @@ type NodeCheckTaskConfig struct {
 	NetworkOptions  *pb.NetworkOptions
 	LogFileBasePath string
 	Priority        int
+	// RetryPolicies are the retry policies of the action types,
+	// action.DefaultRetryPolicies is used if it is nil.
+	RetryPolicies map[action.Type]*action.RetryPolicy
 }
 
 type NodeCheckTask struct {
@@ -56,6 +60,11 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 		return nil, err
 	}
 
+	retryPolicies := taskConfig.RetryPolicies
+	if retryPolicies == nil {
+		retryPolicies = action.DefaultRetryPolicies
+	}
+
 	task := &NodeCheckTask{
 		Base: Base{
 			Name:              taskName,
@@ -64,6 +73,7 @@ func NewNodeCheckTask(taskName string, taskConfig *NodeCheckTaskConfig) (Task, e
 			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
 			CreationTimestamp: time.Now(),
 			Priority:          taskConfig.Priority,
+			RetryPolicies:     retryPolicies,
 		},
 		NodeConfigs:    taskConfig.NodeConfigs,
 		NetworkOptions: taskConfig.NetworkOptions,
@@ -0,0 +1,67 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+// PlanTask splits the task and its sub tasks recursively, and returns what the task will do without executing it.
+// The task should not be executed after planned, create a new one to execute instead.
+func PlanTask(t Task) (*pb.TaskPlan, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+	logger.Debug("Start to plan task")
+
+	if err := splitTask(t); err != nil {
+		return nil, fmt.Errorf("%s %q: %v", consts.MsgTaskPlanFailed, t.GetName(), err)
+	}
+	if err := verifyTask(t); err != nil {
+		return nil, fmt.Errorf("%s %q: %v", consts.MsgTaskPlanFailed, t.GetName(), err)
+	}
+
+	plan := &pb.TaskPlan{
+		Name: t.GetName(),
+		Type: string(t.GetType()),
+	}
+
+	subTasks := t.GetSubTasks()
+	dependencies := getDependencies(subTasks)
+	for _, subTask := range subTasks {
+		subPlan, err := PlanTask(subTask)
+		if err != nil {
+			return nil, err
+		}
+		subPlan.Dependencies = dependencies[subTask.GetName()]
+		plan.SubTasks = append(plan.SubTasks, subPlan)
+	}
+
+	for _, act := range t.GetActions() {
+		plan.Actions = append(plan.Actions, action.PlanAction(act))
+	}
+
+	logger.Debug("Finish to plan task")
+	return plan, nil
+}
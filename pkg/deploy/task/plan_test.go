@@ -0,0 +1,145 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func TestPlanTask(t *testing.T) {
+	master := &pb.Node{Name: "master1", Ip: "10.1.1.1"}
+	worker := &pb.Node{Name: "worker1", Ip: "10.1.1.2"}
+	deployTask, err := NewDeployTask("cluster1-deploy", &DeployTaskConfig{
+		NodeConfigs: []*pb.NodeDeployConfig{
+			{
+				Node:  master,
+				Roles: []string{string(constant.MachineRoleEtcd), string(constant.MachineRoleMaster)},
+			},
+			{
+				Node:  worker,
+				Roles: []string{string(constant.MachineRoleWorker)},
+			},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+			NodeLabels:           map[string]string{"cluster": "cluster1"},
+		},
+	})
+	assert.NoError(t, err)
+
+	plan, err := PlanTask(deployTask)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster1-deploy", plan.GetName())
+	assert.Equal(t, string(TaskTypeDeploy), plan.GetType())
+
+	subPlans := make(map[string]*pb.TaskPlan)
+	var actionPlans []*pb.ActionPlan
+	var collect func(plan *pb.TaskPlan)
+	collect = func(plan *pb.TaskPlan) {
+		subPlans[plan.GetType()] = plan
+		actionPlans = append(actionPlans, plan.GetActions()...)
+		for _, subPlan := range plan.GetSubTasks() {
+			collect(subPlan)
+		}
+	}
+	collect(plan)
+
+	for _, taskType := range []Type{TaskTypeNodeInit, TaskTypeDeployEtcd, TaskTypeDeployMaster, TaskTypeInitMaster,
+		TaskTypeDeployWorker, TaskTypeDeployConfig} {
+		_, ok := subPlans[string(taskType)]
+		assert.True(t, ok, "no plan for %v", taskType)
+	}
+	assert.Len(t, actionPlans, len(GetAllActions(deployTask)))
+	for _, actionPlan := range actionPlans {
+		assert.Nil(t, actionPlan.GetErr(), "failed to plan %v", actionPlan.GetName())
+		assert.NotEmpty(t, actionPlan.GetNodeName())
+		assert.NotEmpty(t, actionPlan.GetCommands(), "no command for %v", actionPlan.GetName())
+	}
+	assert.Equal(t, []string{subPlans[string(TaskTypeNodeInit)].GetName()}, subPlans[string(TaskTypeDeployEtcd)].GetDependencies())
+
+	// the nodes join the cluster with the unique token of the deploy
+	token := deployTask.(*DeployTask).BootstrapToken
+	assert.True(t, deploy.IsValidBootstrapToken(token))
+	for _, actionPlan := range subPlans[string(TaskTypeDeployWorker)].GetActions() {
+		assert.Contains(t, strings.Join(actionPlan.GetCommands(), "\n"), "--token "+token)
+	}
+	anotherTask, err := NewDeployTask("cluster2-deploy", &DeployTaskConfig{NodeConfigs: deployTask.(*DeployTask).NodeConfigs})
+	assert.NoError(t, err)
+	assert.NotEqual(t, token, anotherTask.(*DeployTask).BootstrapToken)
+
+	// nothing is executed by the plan
+	for _, act := range GetAllActions(deployTask) {
+		assert.Equal(t, action.ActionPending, act.GetStatus())
+	}
+
+	// a resumed deploy keeps the token since the first master may have been initialized with it
+	deployTask.SetStatus(TaskFailed)
+	resumedTask, err := NewResumedDeployTask(deployTask)
+	assert.NoError(t, err)
+	assert.Equal(t, token, resumedTask.(*DeployTask).BootstrapToken)
+}
+
+func TestPlanTaskKubernetesVersion(t *testing.T) {
+	newConfig := func(version, repository string) *DeployTaskConfig {
+		return &DeployTaskConfig{
+			NodeConfigs: []*pb.NodeDeployConfig{
+				{
+					Node:  &pb.Node{Name: "master1", Ip: "10.1.1.1"},
+					Roles: []string{string(constant.MachineRoleEtcd), string(constant.MachineRoleMaster)},
+				},
+			},
+			ClusterConfig: &pb.ClusterConfig{
+				KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+				KubernetesVersion:    version,
+				ImageRepository:      repository,
+			},
+		}
+	}
+
+	deployTask, err := NewDeployTask("cluster1-deploy", newConfig("v1.17.0", "registry.local:5000/kpaas"))
+	assert.NoError(t, err)
+	plan, err := PlanTask(deployTask)
+	assert.NoError(t, err)
+
+	var setupKubelet string
+	var collect func(plan *pb.TaskPlan)
+	collect = func(plan *pb.TaskPlan) {
+		for _, actionPlan := range plan.GetActions() {
+			for _, cmd := range actionPlan.GetCommands() {
+				if strings.Contains(cmd, "setup kubelet") {
+					setupKubelet = cmd
+				}
+			}
+		}
+		for _, subPlan := range plan.GetSubTasks() {
+			collect(subPlan)
+		}
+	}
+	collect(plan)
+	assert.Contains(t, setupKubelet, "--version 1.17.0")
+	assert.Contains(t, setupKubelet, "--image-repository registry.local:5000/kpaas")
+
+	_, err = NewDeployTask("cluster1-deploy", newConfig("1.10.0", ""))
+	assert.Error(t, err)
+	_, err = NewDeployTask("cluster1-deploy", newConfig("", "https://docker.io/kpaas"))
+	assert.Error(t, err)
+}
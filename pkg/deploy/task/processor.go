@@ -15,9 +15,10 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"os"
-	"sort"
+	"strings"
 	"sync"
 
 	"github.com/sirupsen/logrus"
@@ -79,14 +80,15 @@ func NewProcessor(taskType Type) (Processor, error) {
 }
 
 // StartTask does a basic verification on the task,
-// then starts the task's execution and return immediately
-func StartTask(t Task) error {
+// then starts the task's execution and return immediately.
+// The task will be aborted once ctx is done.
+func StartTask(ctx context.Context, t Task) error {
 	if err := verifyTask(t); err != nil {
 		logrus.Error(err)
 		return err
 	}
 
-	go ExecuteTask(t)
+	go ExecuteTask(ctx, t)
 	return nil
 }
 
@@ -99,11 +101,81 @@ func verifyTask(t Task) error {
 		return err
 	}
 
+	if err := verifyDependencies(t.GetSubTasks()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// verifyDependencies checks the sub tasks depend on the existing sibling tasks, and there is no cycle.
+func verifyDependencies(tasks []Task) error {
+	dependencies := getDependencies(tasks)
+	for _, t := range tasks {
+		for _, dependency := range dependencies[t.GetName()] {
+			if _, ok := dependencies[dependency]; !ok {
+				return fmt.Errorf("task %q depends on an unknown task %q", t.GetName(), dependency)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		path = append(path, name)
+		switch states[name] {
+		case visiting:
+			return fmt.Errorf("cyclic task dependencies: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		states[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency, path); err != nil {
+				return err
+			}
+		}
+		states[name] = visited
+		return nil
+	}
+	for _, t := range tasks {
+		if err := visit(t.GetName(), nil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// getDependencies returns the names of the tasks which each task depends on. A task depends on the tasks
+// declared by GetDependencies(), or the tasks of higher priority if it doesn't declare any.
+func getDependencies(tasks []Task) map[string][]string {
+	dependencies := make(map[string][]string, len(tasks))
+	for _, t := range tasks {
+		if declared := t.GetDependencies(); len(declared) > 0 {
+			dependencies[t.GetName()] = declared
+			continue
+		}
+		var names []string
+		for _, other := range tasks {
+			if other.GetPriority() < t.GetPriority() {
+				names = append(names, other.GetName())
+			}
+		}
+		dependencies[t.GetName()] = names
+	}
+	return dependencies
+}
+
 // ExecuteTask starts the task's execution and wait it to finish.
-func ExecuteTask(t Task) error {
+// If ctx is done before the task finished, the running actions will be
+// interrupted and the pending ones will be marked as aborted.
+func ExecuteTask(ctx context.Context, t Task) error {
 	if t == nil {
 		return consts.ErrEmptyTask
 	}
@@ -133,6 +205,10 @@ func ExecuteTask(t Task) error {
 				})
 			}
 		}
+		// If the task was canceled, mark everything not finished as aborted.
+		if ctx.Err() != nil && t.GetStatus() != TaskSuccessful {
+			abortTask(t, ctx.Err())
+		}
 	}()
 
 	t.SetStatus(TaskInitializing)
@@ -149,16 +225,26 @@ func ExecuteTask(t Task) error {
 		logger.Errorf("Failed in Step 2: %v", err)
 		return err
 	}
+	if err = verifyTask(t); err != nil {
+		t.SetErr(&pb.Error{
+			Reason: consts.MsgTaskSplitFailed,
+			Detail: err.Error(),
+		})
+		logger.Errorf("Failed in Step 2: %v", err)
+		return err
+	}
+	applyRetryPolicies(t)
+	restoreProgress(t)
 
 	t.SetStatus(TaskDoing)
 	logger.Debug("Step 3: Execute Sub Tasks")
-	if err = executeSubTasks(t); err != nil {
+	if err = executeSubTasks(ctx, t); err != nil {
 		logger.Errorf("Failed in Step 3: %v", err)
 		return err
 	}
 
 	logger.Debug("Step 4: Execute Actions")
-	if err = executeActions(t); err != nil {
+	if err = executeActions(ctx, t); err != nil {
 		logger.Errorf("Failed in Step 4: %v", err)
 		return err
 	}
@@ -173,12 +259,6 @@ func ExecuteTask(t Task) error {
 	return nil
 }
 
-func executeTaskWithWG(t Task, wg *sync.WaitGroup) error {
-	defer wg.Done()
-
-	return ExecuteTask(t)
-}
-
 // Create the corresponding processor to split the task.
 func splitTask(t Task) error {
 	if t == nil {
@@ -218,7 +298,7 @@ func splitTask(t Task) error {
 }
 
 // Execute the sub tasks of a task
-func executeSubTasks(t Task) error {
+func executeSubTasks(ctx context.Context, t Task) error {
 	if t == nil {
 		return consts.ErrEmptyTask
 	}
@@ -234,33 +314,86 @@ func executeSubTasks(t Task) error {
 
 	logger.Debug("Start to execute sub tasks")
 
-	// Group the sub tasks by priority firstly.
-	priTasks := prioritizeTasks(t.GetSubTasks())
-	// Execute the task group sequentially.
-	for _, taskGp := range priTasks {
-		var wg sync.WaitGroup
-		// Execute the tasks in the same group parallelly.
-		for _, aSubTask := range taskGp {
-			wg.Add(1)
-			go executeTaskWithWG(aSubTask, &wg)
+	// Schedule the sub tasks as a DAG: a sub task starts once all the tasks it depends on are done,
+	// so the independent sub tasks are executed parallelly.
+	subTasks := t.GetSubTasks()
+	dependencies := getDependencies(subTasks)
+	// The sub tasks which were started or skipped.
+	started := make(map[string]bool)
+	// The sub tasks which were finished, true means it was successful or its failure can be ignored.
+	finished := make(map[string]bool)
+	finishedCh := make(chan Task, len(subTasks))
+	running := 0
+	var failedTask string
+
+	for {
+		// Keep scanning since skipping a sub task may make others ready or blocked.
+		for progress := true; progress; {
+			progress = false
+			for _, aSubTask := range subTasks {
+				name := aSubTask.GetName()
+				if started[name] {
+					continue
+				}
+				// The sub task was already done in the previous execution which this task resumes from.
+				if aSubTask.GetStatus() == TaskSuccessful {
+					started[name], finished[name], progress = true, true, true
+					continue
+				}
+
+				ready, blocked := true, false
+				for _, dependency := range dependencies[name] {
+					ok, done := finished[dependency]
+					ready = ready && done && ok
+					blocked = blocked || (done && !ok)
+				}
+				// Don't start the sub task if any of its dependencies was failed.
+				if blocked {
+					logger.Debugf("Skip the sub task %s since its dependencies were failed", name)
+					started[name], finished[name], progress = true, false, true
+					continue
+				}
+				// Don't start any more sub task if the task was canceled.
+				if !ready || ctx.Err() != nil {
+					continue
+				}
+
+				started[name] = true
+				running++
+				go func(aSubTask Task) {
+					ExecuteTask(ctx, aSubTask)
+					finishedCh <- aSubTask
+				}(aSubTask)
+			}
+		}
+
+		if running == 0 {
+			break
 		}
-		wg.Wait()
 
-		// If any sub task in the current task group was failed and its failure can't be ignored,
-		// stop to execut other task groups and return.
-		for _, aSubTask := range taskGp {
-			if aSubTask.GetStatus() != TaskSuccessful && !aSubTask.GetFailureCanBeIgnored() {
-				return fmt.Errorf("[%s] sub task was failed", aSubTask.GetName())
-			}
+		aSubTask := <-finishedCh
+		running--
+		// If the sub task was failed and its failure can't be ignored, the tasks depending on it will not be started.
+		ok := aSubTask.GetStatus() == TaskSuccessful || aSubTask.GetFailureCanBeIgnored()
+		finished[aSubTask.GetName()] = ok
+		if !ok && failedTask == "" {
+			failedTask = aSubTask.GetName()
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if failedTask != "" {
+		return fmt.Errorf("[%s] sub task was failed", failedTask)
+	}
+
 	logger.Debug("Finish executing sub tasks")
 	return nil
 }
 
 // Execute the actions of a task
-func executeActions(t Task) error {
+func executeActions(ctx context.Context, t Task) error {
 	if t == nil {
 		return consts.ErrEmptyTask
 	}
@@ -276,11 +409,26 @@ func executeActions(t Task) error {
 
 	logger.Debug("Start to execute actions")
 
+	// Keep using the same worker pool even if it is replaced during the execution.
+	pool := _workerPool
 	var wg sync.WaitGroup
-	// execute the actions parallelly
+	// execute the actions parallelly, as many as the worker pool allows
 	for _, act := range t.GetActions() {
+		// The action was already done in the previous execution which this task resumes from.
+		if act.GetStatus() == action.ActionDone {
+			continue
+		}
+		// The action keeps pending until a worker is free.
+		if err := pool.acquire(ctx, t.GetType()); err != nil {
+			// The task was canceled, the pending actions will be aborted.
+			wg.Wait()
+			return err
+		}
 		wg.Add(1)
-		go action.ExecuteAction(act, &wg)
+		go func(act action.Action) {
+			defer pool.release(t.GetType())
+			action.ExecuteAction(ctx, act, &wg)
+		}(act)
 	}
 	wg.Wait()
 
@@ -288,38 +436,118 @@ func executeActions(t Task) error {
 	return nil
 }
 
-type taskGroup []Task
+// applyRetryPolicies passes the retry policies of the task down to its sub tasks, and sets them
+// to the actions of the task which don't have their own policies.
+func applyRetryPolicies(t Task) {
+	policies := t.GetRetryPolicies()
+	if policies == nil {
+		return
+	}
 
-// Reorder the tasks by priority, the tasks with the same priority will be in a taskGroup. Return
-// a slice of taskGroup, the taskGroup in the slice is ordered: higher priority taskGroup will come
-// first
-func prioritizeTasks(tasks []Task) []taskGroup {
-	if len(tasks) == 0 {
-		return nil
+	for _, subTask := range t.GetSubTasks() {
+		if subTask.GetRetryPolicies() == nil {
+			subTask.SetRetryPolicies(policies)
+		}
 	}
 
-	mapTaskGroup := make(map[int]taskGroup)
-	// First, group tasks by priority into a map
-	for _, t := range tasks {
-		priority := t.GetPriority()
-		mapTaskGroup[priority] = append(mapTaskGroup[priority], t)
+	for _, act := range t.GetActions() {
+		if act.GetRetryPolicy() != nil {
+			continue
+		}
+		if policy, ok := policies[act.GetType()]; ok {
+			act.SetRetryPolicy(policy)
+		}
 	}
+}
 
-	// Collect all priority values into an slice
-	keys := make([]int, 0, len(mapTaskGroup))
-	for k := range mapTaskGroup {
-		keys = append(keys, k)
+// restoreProgress carries the progress of the previous execution over to a task which resumes
+// from it: the successful sub tasks and the done actions of the previous execution take the place
+// of their counterparts, so they will not be executed again; the other sub tasks will resume from
+// their counterparts when they are executed. The create-join-token sub tasks are always executed
+// again from scratch, since the join token and the uploaded control plane certificates may have expired.
+func restoreProgress(t Task) {
+	previous := t.GetResumeFrom()
+	if previous == nil {
+		return
 	}
-	// Sort the slice
-	sort.Ints(keys)
 
-	// Iterate the sorted slice and add the corresponding taskGoup to a new slice, the taskGroup
-	// in the new slice will be in order too.
-	prioritizedTasks := make([]taskGroup, 0, len(keys))
-	for _, k := range keys {
-		prioritizedTasks = append(prioritizedTasks, mapTaskGroup[k])
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	previousSubTasks := make(map[string]Task)
+	for _, subTask := range previous.GetSubTasks() {
+		previousSubTasks[subTask.GetName()] = subTask
+	}
+
+	// GetSubTasks() and GetActions() return the slices held by the task, so replace the
+	// elements in place.
+	subTasks := t.GetSubTasks()
+	for i, subTask := range subTasks {
+		previousSubTask, ok := previousSubTasks[subTask.GetName()]
+		if !ok {
+			continue
+		}
+		if _, ok := subTask.(*CreateJoinTokenTask); ok {
+			logger.Debugf("Execute the create-join-token sub task again: %s", subTask.GetName())
+			continue
+		}
+		if previousSubTask.GetStatus() == TaskSuccessful {
+			logger.Debugf("Skip the successful sub task: %s", subTask.GetName())
+			subTasks[i] = previousSubTask
+			continue
+		}
+		subTask.SetResumeFrom(previousSubTask)
 	}
-	return prioritizedTasks
+
+	doneActions := make(map[string]action.Action)
+	for _, act := range previous.GetActions() {
+		if act.GetStatus() == action.ActionDone {
+			doneActions[actionProgressKey(act)] = act
+		}
+	}
+
+	actions := t.GetActions()
+	for i, act := range actions {
+		if doneAction, ok := doneActions[actionProgressKey(act)]; ok {
+			logger.Debugf("Skip the done action %s on node %s", act.GetType(), act.GetNode().GetName())
+			actions[i] = doneAction
+		}
+	}
+}
+
+// Action names are generated randomly, so an action is identified by its type and node
+// across executions.
+func actionProgressKey(act action.Action) string {
+	return fmt.Sprintf("%s/%s", act.GetType(), act.GetNode().GetName())
+}
+
+// abortTask marks the task and its sub tasks and actions which were not finished as aborted.
+func abortTask(t Task, cause error) {
+	for _, subTask := range t.GetSubTasks() {
+		switch subTask.GetStatus() {
+		case TaskSuccessful, TaskFailed, TaskAborted:
+		default:
+			abortTask(subTask, cause)
+		}
+	}
+
+	for _, act := range t.GetActions() {
+		switch act.GetStatus() {
+		case action.ActionPending, action.ActionDoing:
+			act.SetStatus(action.ActionAborted)
+			act.SetErr(&pb.Error{
+				Reason: consts.MsgActionAborted,
+				Detail: cause.Error(),
+			})
+		}
+	}
+
+	t.SetStatus(TaskAborted)
+	t.SetErr(&pb.Error{
+		Reason: consts.MsgTaskAborted,
+		Detail: cause.Error(),
+	})
 }
 
 // Analyze the task status according to its sub tasks and actions.
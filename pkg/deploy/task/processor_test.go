@@ -15,6 +15,7 @@
 package task
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -32,7 +33,7 @@ type actionMockupForProcessorTest struct {
 }
 type executorMockupForProcessorTest struct{}
 
-func (e *executorMockupForProcessorTest) Execute(act action.Action) *pb.Error {
+func (e *executorMockupForProcessorTest) Execute(ctx context.Context, act action.Action) *pb.Error {
 	_, ok := act.(*actionMockupForProcessorTest)
 	if !ok {
 		return new(pb.Error)
@@ -124,6 +125,13 @@ func (e *processorMockupForProcessorTest3) SplitTask(t Task) error {
 	return nil
 }
 
+// unregisterMockupProcessors removes the mockup processors, the registered processors of the real tasks are kept.
+func unregisterMockupProcessors() {
+	for _, taskType := range []Type{TaskTypeTestProcessorMockup1, TaskTypeTestProcessorMockup2, TaskTypeTestProcessorMockup3} {
+		delete(_processRegistry, taskType)
+	}
+}
+
 func TestRegisterProcessor(t *testing.T) {
 	err := RegisterProcessor(TaskTypeTestProcessorMockup1, new(processorMockupForProcessorTest1))
 	assert.NoError(t, err)
@@ -137,7 +145,7 @@ func TestRegisterProcessor(t *testing.T) {
 	assert.Error(t, err)
 
 	// cleanup
-	_processRegistry = nil
+	unregisterMockupProcessors()
 }
 
 func TestExecuteTask(t *testing.T) {
@@ -159,7 +167,7 @@ func TestExecuteTask(t *testing.T) {
 		},
 	}
 
-	err = ExecuteTask(task1)
+	err = ExecuteTask(context.Background(), task1)
 	assert.NoError(t, err)
 	assert.Equal(t, TaskSuccessful, task1.GetStatus())
 	assert.Nil(t, task1.GetErr())
@@ -177,5 +185,261 @@ func TestExecuteTask(t *testing.T) {
 	}
 
 	// cleanup
-	_processRegistry = nil
+	unregisterMockupProcessors()
+}
+
+func TestExecuteCanceledTask(t *testing.T) {
+	// the executor may have been registered by other tests
+	action.RegisterExecutor(ActionTypeTestProcessorMockup, new(executorMockupForProcessorTest))
+
+	err := RegisterProcessor(TaskTypeTestProcessorMockup1, new(processorMockupForProcessorTest1))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup2, new(processorMockupForProcessorTest2))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup3, new(processorMockupForProcessorTest3))
+	assert.NoError(t, err)
+
+	task1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:     "task1",
+			TaskType: TaskTypeTestProcessorMockup1,
+			Status:   TaskPending,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = ExecuteTask(ctx, task1)
+	assert.Error(t, err)
+	assert.Equal(t, TaskAborted, task1.GetStatus())
+	assert.NotNil(t, task1.GetErr())
+	assert.Equal(t, 2, len(task1.GetSubTasks()))
+	for _, subTask := range task1.GetSubTasks() {
+		assert.Equal(t, TaskAborted, subTask.GetStatus())
+	}
+
+	act := &actionMockupForProcessorTest{
+		Base: action.Base{
+			Name:       "action1",
+			ActionType: ActionTypeTestProcessorMockup,
+			Status:     action.ActionPending,
+		},
+	}
+	task2 := &taskMockupForProcessorTest2{
+		Base: Base{
+			Name:     "task2",
+			TaskType: TaskTypeTestProcessorMockup2,
+			Status:   TaskDoing,
+			Actions:  []action.Action{act},
+		},
+	}
+	abortTask(task2, ctx.Err())
+	assert.Equal(t, TaskAborted, task2.GetStatus())
+	assert.Equal(t, action.ActionAborted, act.GetStatus())
+	assert.NotNil(t, act.GetErr())
+
+	// cleanup
+	unregisterMockupProcessors()
+}
+
+func TestRestoreProgress(t *testing.T) {
+	node1 := &pb.Node{Name: "node1"}
+	node2 := &pb.Node{Name: "node2"}
+	newAction := func(name string, node *pb.Node, status action.Status) action.Action {
+		return &actionMockupForProcessorTest{
+			Base: action.Base{
+				Name:       name,
+				ActionType: ActionTypeTestProcessorMockup,
+				Status:     status,
+				Node:       node,
+			},
+		}
+	}
+
+	previousTask2 := &taskMockupForProcessorTest2{
+		Base: Base{
+			Name:    "task2",
+			Status:  TaskSuccessful,
+			Actions: []action.Action{newAction("previous-action1", node1, action.ActionDone)},
+		},
+	}
+	previousTask3 := &taskMockupForProcessorTest3{
+		Base: Base{
+			Name:   "task3",
+			Status: TaskFailed,
+			Actions: []action.Action{
+				newAction("previous-action2", node1, action.ActionDone),
+				newAction("previous-action3", node2, action.ActionFailed),
+			},
+		},
+	}
+	previousTask1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:     "task1",
+			Status:   TaskFailed,
+			SubTasks: []Task{previousTask2, previousTask3},
+		},
+	}
+
+	task2 := &taskMockupForProcessorTest2{Base: Base{Name: "task2", Status: TaskPending}}
+	task3 := &taskMockupForProcessorTest3{Base: Base{Name: "task3", Status: TaskPending}}
+	task1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:       "task1",
+			Status:     TaskPending,
+			SubTasks:   []Task{task2, task3},
+			ResumeFrom: previousTask1,
+		},
+	}
+
+	restoreProgress(task1)
+	// The successful sub task is taken from the previous execution.
+	assert.Equal(t, []Task{previousTask2, task3}, task1.GetSubTasks())
+	// The failed sub task will resume from its previous execution.
+	assert.Equal(t, previousTask3, task3.GetResumeFrom())
+
+	task3.Actions = []action.Action{
+		newAction("action2", node1, action.ActionPending),
+		newAction("action3", node2, action.ActionPending),
+	}
+	restoreProgress(task3)
+	// Only the done action is taken from the previous execution.
+	assert.Equal(t, "previous-action2", task3.GetActions()[0].GetName())
+	assert.Equal(t, "action3", task3.GetActions()[1].GetName())
+
+	// Nothing to restore if the task doesn't resume from another one.
+	task4 := &taskMockupForProcessorTest2{
+		Base: Base{
+			Name:    "task4",
+			Actions: []action.Action{newAction("action4", node1, action.ActionPending)},
+		},
+	}
+	restoreProgress(task4)
+	assert.Equal(t, "action4", task4.GetActions()[0].GetName())
+}
+
+func TestApplyRetryPolicies(t *testing.T) {
+	policy := &action.RetryPolicy{MaxAttempts: 3}
+	ownPolicy := &action.RetryPolicy{MaxAttempts: 2}
+	act1 := &actionMockupForProcessorTest{
+		Base: action.Base{Name: "action1", ActionType: ActionTypeTestProcessorMockup},
+	}
+	act2 := &actionMockupForProcessorTest{
+		Base: action.Base{Name: "action2", ActionType: ActionTypeTestProcessorMockup, RetryPolicy: ownPolicy},
+	}
+	subTask := &taskMockupForProcessorTest2{Base: Base{Name: "task2"}}
+	task1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:          "task1",
+			Actions:       []action.Action{act1, act2},
+			SubTasks:      []Task{subTask},
+			RetryPolicies: map[action.Type]*action.RetryPolicy{ActionTypeTestProcessorMockup: policy},
+		},
+	}
+
+	applyRetryPolicies(task1)
+	// the policies are passed down to the sub tasks
+	assert.Equal(t, task1.GetRetryPolicies(), subTask.GetRetryPolicies())
+	// the actions' own policies are kept
+	assert.Equal(t, policy, act1.GetRetryPolicy())
+	assert.Equal(t, ownPolicy, act2.GetRetryPolicy())
+}
+
+func TestVerifyDependencies(t *testing.T) {
+	newTask := func(name string, priority int, dependencies ...string) Task {
+		return &taskMockupForProcessorTest2{
+			Base: Base{Name: name, Priority: priority, Dependencies: dependencies},
+		}
+	}
+
+	// the tasks without dependencies depend on the tasks of higher priority
+	tasks := []Task{newTask("task1", 10), newTask("task2", 20), newTask("task3", 20), newTask("task4", 30, "task2")}
+	assert.Equal(t, map[string][]string{
+		"task1": nil,
+		"task2": {"task1"},
+		"task3": {"task1"},
+		"task4": {"task2"},
+	}, getDependencies(tasks))
+	assert.NoError(t, verifyDependencies(tasks))
+
+	tasks = []Task{newTask("task1", 10), newTask("task2", 20, "task5")}
+	assert.EqualError(t, verifyDependencies(tasks), `task "task2" depends on an unknown task "task5"`)
+
+	tasks = []Task{newTask("task1", 10, "task3"), newTask("task2", 20, "task1"), newTask("task3", 30, "task2")}
+	assert.EqualError(t, verifyDependencies(tasks), "cyclic task dependencies: task1 -> task3 -> task2 -> task1")
+}
+
+// Mockup an action which is always failed and its task type
+const ActionTypeTestProcessorFailedMockup action.Type = "ActionTypeFailedMockupForProcessorTest"
+const TaskTypeTestProcessorFailedMockup Type = "TaskTypeFailedMockupForProcessorTest"
+
+type executorFailedMockupForProcessorTest struct{}
+
+func (e *executorFailedMockupForProcessorTest) Execute(ctx context.Context, act action.Action) *pb.Error {
+	return &pb.Error{Reason: "failed"}
+}
+
+type processorFailedMockupForProcessorTest struct{}
+
+func (e *processorFailedMockupForProcessorTest) SplitTask(t Task) error {
+	tsk, ok := t.(*taskMockupForProcessorTest1)
+	if !ok {
+		return fmt.Errorf("mismatched task type")
+	}
+
+	tsk.Actions = []action.Action{&actionMockupForProcessorTest{
+		Base: action.Base{
+			Name:       "failed-action",
+			ActionType: ActionTypeTestProcessorFailedMockup,
+			Status:     action.ActionPending,
+		},
+	}}
+	return nil
+}
+
+func TestExecuteSubTasksByDependencies(t *testing.T) {
+	// the executors may have been registered by other tests
+	action.RegisterExecutor(ActionTypeTestProcessorMockup, new(executorMockupForProcessorTest))
+	action.RegisterExecutor(ActionTypeTestProcessorFailedMockup, new(executorFailedMockupForProcessorTest))
+
+	err := RegisterProcessor(TaskTypeTestProcessorFailedMockup, new(processorFailedMockupForProcessorTest))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup2, new(processorMockupForProcessorTest2))
+	assert.NoError(t, err)
+	err = RegisterProcessor(TaskTypeTestProcessorMockup3, new(processorMockupForProcessorTest3))
+	assert.NoError(t, err)
+
+	failedTask := &taskMockupForProcessorTest1{
+		Base: Base{Name: "failed", TaskType: TaskTypeTestProcessorFailedMockup, Status: TaskPending},
+	}
+	// blockedTask depends on the failed task, so it will not be started.
+	blockedTask := &taskMockupForProcessorTest2{
+		Base: Base{Name: "blocked", TaskType: TaskTypeTestProcessorMockup2, Status: TaskPending, Dependencies: []string{"failed"}},
+	}
+	// independentTask and its dependent are executed regardless of the failed task.
+	independentTask := &taskMockupForProcessorTest2{
+		Base: Base{Name: "independent", TaskType: TaskTypeTestProcessorMockup2, Status: TaskPending},
+	}
+	dependentTask := &taskMockupForProcessorTest3{
+		Base: Base{Name: "dependent", TaskType: TaskTypeTestProcessorMockup3, Status: TaskPending, Dependencies: []string{"independent"}},
+	}
+	task1 := &taskMockupForProcessorTest1{
+		Base: Base{
+			Name:     "task1",
+			TaskType: TaskTypeTestProcessorMockup1,
+			Status:   TaskDoing,
+			SubTasks: []Task{failedTask, blockedTask, independentTask, dependentTask},
+		},
+	}
+
+	err = executeSubTasks(context.Background(), task1)
+	assert.EqualError(t, err, "[failed] sub task was failed")
+	assert.Equal(t, TaskFailed, failedTask.GetStatus())
+	assert.Equal(t, TaskPending, blockedTask.GetStatus())
+	assert.Equal(t, TaskSuccessful, independentTask.GetStatus())
+	assert.Equal(t, TaskSuccessful, dependentTask.GetStatus())
+
+	// cleanup
+	unregisterMockupProcessors()
 }
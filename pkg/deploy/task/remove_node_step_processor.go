@@ -0,0 +1,83 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeRemoveNodeStep, new(removeNodeStepProcessor))
+}
+
+// removeNodeStepProcessor implements the specific logic for a step to remove nodes.
+type removeNodeStepProcessor struct {
+}
+
+// Spilt the task into one action for each node
+func (p *removeNodeStepProcessor) SplitTask(t Task) error {
+	stepTask, err := p.verifyTask(t)
+	if err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	var actions []action.Action
+	for _, node := range stepTask.Nodes {
+		act, err := action.NewRemoveNodeAction(&action.RemoveNodeActionConfig{
+			Step:            stepTask.Step,
+			Node:            node,
+			MasterNodes:     stepTask.MasterNodes,
+			EtcdNodes:       stepTask.EtcdNodes,
+			LogFileBasePath: stepTask.LogFileDir,
+		})
+		if err != nil {
+			return err
+		}
+		actions = append(actions, act)
+	}
+	stepTask.Actions = actions
+
+	logger.Debugf("Finish to split task: %d actions", len(actions))
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *removeNodeStepProcessor) verifyTask(t Task) (*RemoveNodeStepTask, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	stepTask, ok := t.(*RemoveNodeStepTask)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(stepTask.Nodes) == 0 {
+		return nil, fmt.Errorf("nodes is empty")
+	}
+
+	return stepTask, nil
+}
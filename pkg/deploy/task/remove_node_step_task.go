@@ -0,0 +1,80 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeRemoveNodeStep Type = "RemoveNodeStep"
+
+// RemoveNodeStepTaskConfig represents the config for a step to remove nodes.
+type RemoveNodeStepTaskConfig struct {
+	// Step is the action type of the step.
+	Step  action.Type
+	Nodes []*pb.Node
+	// MasterNodes are the masters which remain in the cluster.
+	MasterNodes []*pb.Node
+	// EtcdNodes are the etcd nodes which remain in the cluster.
+	EtcdNodes       []*pb.Node
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+// RemoveNodeStepTask does a step to remove the nodes, the nodes are processed parallelly.
+type RemoveNodeStepTask struct {
+	Base
+	Step        action.Type
+	Nodes       []*pb.Node
+	MasterNodes []*pb.Node
+	EtcdNodes   []*pb.Node
+}
+
+// NewRemoveNodeStepTask returns a task of a step to remove nodes based on the config.
+func NewRemoveNodeStepTask(taskName string, taskConfig *RemoveNodeStepTaskConfig) (Task, error) {
+	if taskName == "" {
+		return nil, fmt.Errorf("taskName can't be empty")
+	}
+	if taskConfig == nil {
+		return nil, fmt.Errorf("invalid task config: nil")
+	}
+	if len(taskConfig.Nodes) == 0 {
+		return nil, fmt.Errorf("invalid task config: nodes is empty")
+	}
+
+	task := &RemoveNodeStepTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeRemoveNodeStep,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			Parent:            taskConfig.Parent,
+			// the nodes are deleted from the cluster even if they are dead and failed to reset
+			FailureCanBeIgnored: taskConfig.Step == action.ActionTypeResetNode,
+		},
+		Step:        taskConfig.Step,
+		Nodes:       taskConfig.Nodes,
+		MasterNodes: taskConfig.MasterNodes,
+		EtcdNodes:   taskConfig.EtcdNodes,
+	}
+
+	return task, nil
+}
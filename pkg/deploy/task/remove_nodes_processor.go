@@ -0,0 +1,112 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeRemoveNodes, new(removeNodesProcessor))
+}
+
+// removeNodeStepNames are the names of the sub tasks of the steps to remove nodes.
+var removeNodeStepNames = map[action.Type]string{
+	action.ActionTypeDrainNode:        "drain-node",
+	action.ActionTypeRemoveEtcdMember: "remove-etcd-member",
+	action.ActionTypeResetNode:        "reset-node",
+	action.ActionTypeDeleteNode:       "delete-node",
+}
+
+// removeNodesProcessor implements the specific logic for the remove-nodes task.
+type removeNodesProcessor struct {
+}
+
+// Spilt the task into one or more sub tasks
+func (p *removeNodesProcessor) SplitTask(t Task) error {
+	removeTask, err := p.verifyTask(t)
+	if err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split remove-nodes task")
+
+	// split task into a sub task for each step, and each step depends on the previous one:
+	// drain -> remove etcd member -> reset -> delete, a step is skipped if it has no node.
+	var subTasks []Task
+	stepNodes := removeTask.StepNodes()
+	etcdNodes := removeTask.RemainingEtcdNodes()
+	for _, step := range action.RemoveNodeActionTypes {
+		nodes, ok := stepNodes[step]
+		if !ok {
+			continue
+		}
+
+		stepTask, err := NewRemoveNodeStepTask(removeNodeStepNames[step], &RemoveNodeStepTaskConfig{
+			Step:            step,
+			Nodes:           nodes,
+			MasterNodes:     removeTask.MasterNodes,
+			EtcdNodes:       etcdNodes,
+			LogFileBasePath: removeTask.GetLogFileDir(),
+			Priority:        removeTask.GetPriority(),
+			Parent:          removeTask.GetName(),
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to create %v sub task: %s", step, err)
+			logger.Error(err)
+			return err
+		}
+		if len(subTasks) > 0 {
+			stepTask.SetDependencies([]string{subTasks[len(subTasks)-1].GetName()})
+		}
+		subTasks = append(subTasks, stepTask)
+	}
+
+	removeTask.SubTasks = subTasks
+	logger.Debugf("Finish to split remove-nodes task: %d sub tasks", len(subTasks))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *removeNodesProcessor) verifyTask(t Task) (*RemoveNodesTask, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	removeTask, ok := t.(*RemoveNodesTask)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(removeTask.NodeConfigs) == 0 {
+		return nil, fmt.Errorf("nodeConfigs is empty")
+	}
+
+	if len(removeTask.MasterNodes) == 0 {
+		return nil, fmt.Errorf("masterNodes is empty")
+	}
+
+	return removeTask, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newRemoveNodesTaskConfig(nodeConfigs ...*pb.NodeDeployConfig) *RemoveNodesTaskConfig {
+	return &RemoveNodesTaskConfig{
+		NodeConfigs: nodeConfigs,
+		MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+		EtcdNodes: []*pb.Node{
+			{Name: "master1", Ip: "10.1.1.1"},
+			{Name: "master2", Ip: "10.1.1.2"},
+			{Name: "etcd3", Ip: "10.1.1.3"},
+		},
+	}
+}
+
+func TestNewRemoveNodesTask(t *testing.T) {
+	worker := &pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}}
+
+	tests := []struct {
+		config *RemoveNodesTaskConfig
+		want   bool
+	}{
+		{
+			config: nil,
+			want:   false,
+		},
+		{
+			config: newRemoveNodesTaskConfig(),
+			want:   false,
+		},
+		{
+			config: &RemoveNodesTaskConfig{NodeConfigs: []*pb.NodeDeployConfig{worker}},
+			want:   false,
+		},
+		{
+			// a remaining master can't be removed
+			config: newRemoveNodesTaskConfig(&pb.NodeDeployConfig{
+				Node:  &pb.Node{Name: "master1", Ip: "10.1.1.1"},
+				Roles: []string{"master"},
+			}),
+			want: false,
+		},
+		{
+			// the node is not an etcd member
+			config: newRemoveNodesTaskConfig(&pb.NodeDeployConfig{
+				Node:  &pb.Node{Name: "worker1", Ip: "10.1.1.11"},
+				Roles: []string{"etcd", "worker"},
+			}),
+			want: false,
+		},
+		{
+			// at least one etcd member remains
+			config: &RemoveNodesTaskConfig{
+				NodeConfigs: []*pb.NodeDeployConfig{
+					{Node: &pb.Node{Name: "etcd3", Ip: "10.1.1.3"}, Roles: []string{"etcd"}},
+				},
+				MasterNodes: []*pb.Node{{Name: "master1", Ip: "10.1.1.1"}},
+				EtcdNodes:   []*pb.Node{{Name: "etcd3", Ip: "10.1.1.3"}},
+			},
+			want: false,
+		},
+		{
+			config: newRemoveNodesTaskConfig(worker),
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewRemoveNodesTask("remove-nodes", tt.config)
+		assert.Equal(t, tt.want, err == nil, "%v", err)
+	}
+}
+
+func TestRemoveNodesSplitTask(t *testing.T) {
+	removeTask, err := NewRemoveNodesTask("remove-nodes", newRemoveNodesTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "master2", Ip: "10.1.1.2"}, Roles: []string{"master", "etcd"}},
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+	))
+	assert.NoError(t, err)
+	assert.NoError(t, new(removeNodesProcessor).SplitTask(removeTask))
+
+	var names []string
+	var previous string
+	for _, subTask := range removeTask.GetSubTasks() {
+		names = append(names, subTask.GetName())
+		// each step depends on the previous one
+		if previous != "" {
+			assert.Equal(t, []string{previous}, subTask.GetDependencies())
+		}
+		previous = subTask.GetName()
+	}
+	assert.Equal(t, []string{"drain-node", "remove-etcd-member", "reset-node", "delete-node"}, names)
+	assert.NoError(t, verifyDependencies(removeTask.GetSubTasks()))
+
+	// only the etcd member is removed, by the remaining etcd nodes
+	etcdTask := removeTask.GetSubTasks()[1].(*RemoveNodeStepTask)
+	assert.Equal(t, action.ActionTypeRemoveEtcdMember, etcdTask.Step)
+	assert.Len(t, etcdTask.Nodes, 1)
+	assert.Equal(t, "master2", etcdTask.Nodes[0].GetName())
+	assert.Len(t, etcdTask.EtcdNodes, 2)
+
+	// an action is created for each node of the step
+	resetTask := removeTask.GetSubTasks()[2]
+	assert.NoError(t, new(removeNodeStepProcessor).SplitTask(resetTask))
+	assert.Len(t, resetTask.GetActions(), 2)
+	for _, act := range resetTask.GetActions() {
+		assert.Equal(t, action.ActionTypeResetNode, act.GetType())
+	}
+}
+
+func TestExecuteRemoveDeadNode(t *testing.T) {
+	defer func(isTesting bool) { machine.IsTesting = isTesting }(machine.IsTesting)
+	machine.IsTesting = true
+
+	removeTask, err := NewRemoveNodesTask("remove-nodes", newRemoveNodesTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "error", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+	))
+	assert.NoError(t, err)
+	assert.NoError(t, ExecuteTask(context.Background(), removeTask))
+
+	// the dead node fails to reset, but it's deleted from the cluster
+	statuses := make(map[string]Status)
+	for _, subTask := range removeTask.GetSubTasks() {
+		statuses[subTask.GetName()] = subTask.GetStatus()
+	}
+	assert.Equal(t, TaskFailed, statuses["reset-node"])
+	assert.Equal(t, TaskSuccessful, statuses["delete-node"])
+}
+
+func TestRemoveNodesSplitTaskWithoutEtcd(t *testing.T) {
+	removeTask, err := NewRemoveNodesTask("remove-nodes", newRemoveNodesTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+	))
+	assert.NoError(t, err)
+	assert.NoError(t, new(removeNodesProcessor).SplitTask(removeTask))
+
+	// the step to remove the etcd member is skipped
+	assert.Len(t, removeTask.GetSubTasks(), 3)
+	assert.Equal(t, []string{"drain-node"}, removeTask.GetSubTasks()[1].GetDependencies())
+}
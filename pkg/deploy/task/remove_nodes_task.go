@@ -0,0 +1,212 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeRemoveNodes Type = "RemoveNodes"
+
+// RemoveNodesTaskConfig represents the config for a remove-nodes task.
+type RemoveNodesTaskConfig struct {
+	// NodeConfigs are the nodes to remove with their roles.
+	NodeConfigs []*pb.NodeDeployConfig
+	// MasterNodes are the masters which remain in the cluster, the first one is used to access the cluster.
+	MasterNodes []*pb.Node
+	// EtcdNodes are all the etcd nodes of the cluster, including the ones to remove.
+	EtcdNodes       []*pb.Node
+	LogFileBasePath string
+	Priority        int
+	// RetryPolicies are the retry policies of the action types,
+	// action.DefaultRetryPolicies is used if it is nil.
+	RetryPolicies map[action.Type]*action.RetryPolicy
+}
+
+// RemoveNodesTask removes nodes from a deployed cluster step by step: drain the nodes,
+// remove the etcd members, reset the nodes and delete them from the cluster.
+type RemoveNodesTask struct {
+	Base
+	NodeConfigs []*pb.NodeDeployConfig
+	MasterNodes []*pb.Node
+	EtcdNodes   []*pb.Node
+}
+
+// NewRemoveNodesTask returns a remove-nodes task based on the config.
+// User should use this function to create a remove-nodes task.
+func NewRemoveNodesTask(taskName string, taskConfig *RemoveNodesTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: node deploy configs is empty")
+	} else if len(taskConfig.MasterNodes) == 0 {
+		err = fmt.Errorf("invalid task config: master nodes is empty")
+	} else {
+		err = verifyRemovedNodes(taskConfig.NodeConfigs, taskConfig.MasterNodes, taskConfig.EtcdNodes)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	retryPolicies := taskConfig.RetryPolicies
+	if retryPolicies == nil {
+		retryPolicies = action.DefaultRetryPolicies
+	}
+
+	task := &RemoveNodesTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeRemoveNodes,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			RetryPolicies:     retryPolicies,
+		},
+		NodeConfigs: taskConfig.NodeConfigs,
+		MasterNodes: taskConfig.MasterNodes,
+		EtcdNodes:   taskConfig.EtcdNodes,
+	}
+
+	return task, nil
+}
+
+// NewResumedRemoveNodesTask returns a remove-nodes task which resumes from a previous failed or aborted
+// remove-nodes task: the steps which were done in the previous task will be skipped.
+func NewResumedRemoveNodesTask(previous Task) (Task, error) {
+	var err error
+	previousTask, ok := previous.(*RemoveNodesTask)
+	if !ok {
+		err = fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, previous)
+	} else if status := previousTask.GetStatus(); status != TaskFailed && status != TaskAborted {
+		err = fmt.Errorf("only a failed or aborted remove-nodes task can be resumed, the task is %s", status)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &RemoveNodesTask{
+		Base: Base{
+			Name:              previousTask.Name,
+			TaskType:          TaskTypeRemoveNodes,
+			Status:            TaskPending,
+			LogFileDir:        previousTask.LogFileDir,
+			CreationTimestamp: time.Now(),
+			Priority:          previousTask.Priority,
+			ResumeFrom:        previousTask,
+			RetryPolicies:     previousTask.RetryPolicies,
+		},
+		NodeConfigs: previousTask.NodeConfigs,
+		MasterNodes: previousTask.MasterNodes,
+		EtcdNodes:   previousTask.EtcdNodes,
+	}
+
+	return task, nil
+}
+
+// StepNodes returns the nodes of each step to remove the nodes: the etcd member is removed for
+// the nodes with the etcd role, the other steps except resetting are done for the kubernetes nodes.
+func (t *RemoveNodesTask) StepNodes() map[action.Type][]*pb.Node {
+	stepNodes := make(map[action.Type][]*pb.Node)
+	for _, nodeCfg := range t.NodeConfigs {
+		node := nodeCfg.GetNode()
+		isEtcd, isKube := false, false
+		for _, role := range nodeCfg.GetRoles() {
+			if constant.MachineRole(role) == constant.MachineRoleEtcd {
+				isEtcd = true
+			} else {
+				isKube = true
+			}
+		}
+
+		if isKube {
+			stepNodes[action.ActionTypeDrainNode] = append(stepNodes[action.ActionTypeDrainNode], node)
+			stepNodes[action.ActionTypeDeleteNode] = append(stepNodes[action.ActionTypeDeleteNode], node)
+		}
+		if isEtcd {
+			stepNodes[action.ActionTypeRemoveEtcdMember] = append(stepNodes[action.ActionTypeRemoveEtcdMember], node)
+		}
+		stepNodes[action.ActionTypeResetNode] = append(stepNodes[action.ActionTypeResetNode], node)
+	}
+
+	return stepNodes
+}
+
+// RemainingEtcdNodes returns the etcd nodes which are not removed.
+func (t *RemoveNodesTask) RemainingEtcdNodes() []*pb.Node {
+	removed := make(map[string]bool, len(t.NodeConfigs))
+	for _, nodeCfg := range t.NodeConfigs {
+		removed[nodeCfg.GetNode().GetName()] = true
+	}
+
+	var nodes []*pb.Node
+	for _, node := range t.EtcdNodes {
+		if !removed[node.GetName()] {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// verifyRemovedNodes checks the nodes can be removed: they are not the remaining masters,
+// the etcd nodes are members of the etcd cluster and at least one etcd member remains.
+func verifyRemovedNodes(nodeConfigs []*pb.NodeDeployConfig, masterNodes, etcdNodes []*pb.Node) error {
+	masters := make(map[string]bool, len(masterNodes))
+	for _, node := range masterNodes {
+		masters[node.GetName()] = true
+	}
+	members := make(map[string]bool, len(etcdNodes))
+	for _, node := range etcdNodes {
+		members[node.GetName()] = true
+	}
+
+	removedMembers := 0
+	for _, nodeCfg := range nodeConfigs {
+		name := nodeCfg.GetNode().GetName()
+		if name == "" {
+			return fmt.Errorf("invalid task config: node name is empty")
+		}
+		if masters[name] {
+			return fmt.Errorf("invalid task config: node %v is a remaining master", name)
+		}
+		for _, role := range nodeCfg.GetRoles() {
+			if constant.MachineRole(role) != constant.MachineRoleEtcd {
+				continue
+			}
+			if !members[name] {
+				return fmt.Errorf("invalid task config: node %v is not in the etcd nodes", name)
+			}
+			removedMembers++
+		}
+	}
+
+	if removedMembers > 0 && removedMembers >= len(etcdNodes) {
+		return fmt.Errorf("invalid task config: all the etcd nodes can't be removed")
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeScaleOut, new(scaleOutProcessor))
+}
+
+// scaleOutProcessor implements the specific logic for the scale-out task.
+type scaleOutProcessor struct {
+	// deployProcessor provides the helpers to group and unwrap the nodes.
+	deployProcessor
+}
+
+// Spilt the task into one or more sub tasks
+func (p *scaleOutProcessor) SplitTask(t Task) error {
+	scaleOutTask, err := p.verifyTask(t)
+	if err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split scale-out task")
+
+	// split task into subtask: create join token and init, join masters, deploy worker, deploy ingress,
+	// and schedule them by their dependencies: token/init -> masters -> worker/ingress -> config
+	var subTasks []Task
+
+	roles := p.groupByRole(scaleOutTask.NodeConfigs)
+	newMasters := p.unwrapNodes(roles[constant.MachineRoleMaster])
+	// the new masters are appended to the deployed ones, so the first master is still the first one
+	allMasters := append(append([]*pb.Node{}, scaleOutTask.MasterNodes...), newMasters...)
+
+	tokenTask, err := p.createJoinTokenSubTask(scaleOutTask, len(newMasters) > 0)
+	if err != nil {
+		err = fmt.Errorf("failed to create join token sub task: %s", err)
+		logger.Error(err)
+		return err
+	}
+	subTasks = append(subTasks, tokenTask)
+
+	initTask, err := NewNodeInitTask("init", &NodeInitTaskConfig{
+		NodeConfigs:     scaleOutTask.NodeConfigs,
+		ClusterConfig:   scaleOutTask.ClusterConfig,
+		LogFileBasePath: scaleOutTask.GetLogFileDir(),
+		Priority:        int(initPriority),
+		Parent:          scaleOutTask.GetName(),
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to create common init sub tasks: %s", err)
+		logger.Error(err)
+		return err
+	}
+	subTasks = append(subTasks, initTask)
+
+	// the tasks which the new nodes depend on to join the cluster
+	joinDependencies := []string{tokenTask.GetName(), initTask.GetName()}
+	// the tasks which the deploy of workers and ingresses depends on
+	nodeDependencies := []string{tokenTask.GetName(), initTask.GetName()}
+	// the tasks which the deploy config depends on
+	configDependencies := []string{tokenTask.GetName(), initTask.GetName()}
+
+	// the new masters join the control plane parallelly
+	for _, node := range newMasters {
+		joinTask, err := NewJoinMasterTask(fmt.Sprintf("joinMaster-%v", node.GetName()), &JoinMasterTaskConfig{
+			certKey:         scaleOutTask.CertKey,
+			bootstrapToken:  scaleOutTask.BootstrapToken,
+			node:            node,
+			roles:           deploy.GetNodeRoles(node, scaleOutTask.NodeConfigs),
+			masterNodes:     allMasters,
+			clusterConfig:   scaleOutTask.ClusterConfig,
+			logFileBasePath: scaleOutTask.GetLogFileDir(),
+			priority:        int(DeployMasterPriority),
+			parent:          scaleOutTask.GetName(),
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to create join master sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		joinTask.SetDependencies(joinDependencies)
+		subTasks = append(subTasks, joinTask)
+		nodeDependencies = append(nodeDependencies, joinTask.GetName())
+		configDependencies = append(configDependencies, joinTask.GetName())
+	}
+
+	if workers, ok := roles[constant.MachineRoleWorker]; ok {
+		workerTask, err := NewDeployWorkerTask(fmt.Sprintf("deploy-%s", constant.MachineRoleWorker),
+			&DeployWorkerTaskConfig{
+				BaseTaskConfig: BaseTaskConfig{
+					LogFileBasePath: scaleOutTask.GetLogFileDir(),
+					Priority:        int(DeployWorkerPriority),
+					Parent:          scaleOutTask.GetName(),
+				},
+				Nodes:          workers,
+				ClusterConfig:  scaleOutTask.ClusterConfig,
+				MasterNodes:    allMasters,
+				BootstrapToken: scaleOutTask.BootstrapToken,
+			},
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to create deploy worker sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		workerTask.SetDependencies(nodeDependencies)
+		subTasks = append(subTasks, workerTask)
+		configDependencies = append(configDependencies, workerTask.GetName())
+
+		// the worker and ingress nodes join the cluster parallelly, unless a node has both roles.
+		if p.haveCommonNodes(workers, roles[constant.MachineRoleIngress]) {
+			nodeDependencies = append(nodeDependencies, workerTask.GetName())
+		}
+	}
+
+	if ingresses, ok := roles[constant.MachineRoleIngress]; ok {
+		ingressTask, err := NewDeployIngressTask(fmt.Sprintf("deploy-%s", constant.MachineRoleIngress),
+			&DeployIngressTaskConfig{
+				BaseTaskConfig: BaseTaskConfig{
+					LogFileBasePath: scaleOutTask.GetLogFileDir(),
+					Priority:        int(DeployIngressPriority),
+					Parent:          scaleOutTask.GetName(),
+				},
+				Nodes:          ingresses,
+				ClusterConfig:  scaleOutTask.ClusterConfig,
+				MasterNodes:    allMasters,
+				BootstrapToken: scaleOutTask.BootstrapToken,
+			},
+		)
+		if err != nil {
+			err = fmt.Errorf("failed to create deploy ingress sub tasks: %s", err)
+			logger.Error(err)
+			return err
+		}
+		ingressTask.SetDependencies(nodeDependencies)
+		subTasks = append(subTasks, ingressTask)
+		configDependencies = append(configDependencies, ingressTask.GetName())
+	}
+
+	// the new nodes are configured after they all joined.
+	configTask, err := NewDeployConfigTask("deploy-config", &DeployConfigTaskConfig{
+		NodeConfigs:     scaleOutTask.NodeConfigs,
+		MasterNodes:     allMasters,
+		ClusterConfig:   scaleOutTask.ClusterConfig,
+		LogFileBasePath: scaleOutTask.GetLogFileDir(),
+		Priority:        int(ConfigPriority),
+		Parent:          scaleOutTask.GetName(),
+	})
+	if err != nil {
+		err = fmt.Errorf("failed to create deploy config sub tasks: %s", err)
+		logger.Error(err)
+		return err
+	}
+	configTask.SetDependencies(configDependencies)
+	subTasks = append(subTasks, configTask)
+
+	scaleOutTask.SubTasks = subTasks
+	logger.Debugf("Finish to split scale-out task: %d sub tasks", len(subTasks))
+
+	return nil
+}
+
+// createJoinTokenSubTask returns the sub task to create the bootstrap token on the first master,
+// the control plane certificates are uploaded again if any master joins. The scale-out is refused
+// by the sub task if the control plane is not at the kubernetes version of the cluster.
+func (p *scaleOutProcessor) createJoinTokenSubTask(parent *ScaleOutTask, uploadCerts bool) (Task, error) {
+	release, err := deploy.GetKubernetesRelease(parent.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &CreateJoinTokenTaskConfig{
+		Node:              parent.MasterNodes[0],
+		TTL:               deploy.BootstrapTokenTTL,
+		Token:             parent.BootstrapToken,
+		KubernetesVersion: release.Version,
+		LogFileBasePath:   parent.GetLogFileDir(),
+		Priority:          int(initPriority),
+		Parent:            parent.GetName(),
+	}
+	if uploadCerts {
+		config.CertKey = parent.CertKey
+	}
+
+	return NewCreateJoinTokenTask("create-join-token", config)
+}
+
+// Verify if the task is valid.
+func (p *scaleOutProcessor) verifyTask(t Task) (*ScaleOutTask, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	scaleOutTask, ok := t.(*ScaleOutTask)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(scaleOutTask.NodeConfigs) == 0 {
+		return nil, fmt.Errorf("nodeConfigs is empty")
+	}
+
+	if len(scaleOutTask.MasterNodes) == 0 {
+		return nil, fmt.Errorf("masterNodes is empty")
+	}
+
+	return scaleOutTask, nil
+}
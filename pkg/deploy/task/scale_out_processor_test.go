@@ -0,0 +1,173 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newScaleOutTaskConfig(nodeConfigs ...*pb.NodeDeployConfig) *ScaleOutTaskConfig {
+	return &ScaleOutTaskConfig{
+		NodeConfigs: nodeConfigs,
+		MasterNodes: []*pb.Node{
+			{Name: "master1", Ip: "10.1.1.1"},
+			{Name: "master2", Ip: "10.1.1.2"},
+		},
+		ClusterConfig: &pb.ClusterConfig{
+			KubeAPIServerConnect: &pb.KubeAPIServerConnect{Type: "firstMasterIP"},
+		},
+	}
+}
+
+func TestNewScaleOutTask(t *testing.T) {
+	newMaster := &pb.NodeDeployConfig{Node: &pb.Node{Name: "master3", Ip: "10.1.1.3"}, Roles: []string{"master"}}
+
+	tests := []struct {
+		config *ScaleOutTaskConfig
+		want   bool
+	}{
+		{
+			config: nil,
+			want:   false,
+		},
+		{
+			config: newScaleOutTaskConfig(),
+			want:   false,
+		},
+		{
+			config: &ScaleOutTaskConfig{NodeConfigs: []*pb.NodeDeployConfig{newMaster}},
+			want:   false,
+		},
+		{
+			// etcd members can't be added by scale-out
+			config: newScaleOutTaskConfig(&pb.NodeDeployConfig{
+				Node:  &pb.Node{Name: "etcd4", Ip: "10.1.1.4"},
+				Roles: []string{"etcd"},
+			}),
+			want: false,
+		},
+		{
+			// a deployed master can't be added again
+			config: newScaleOutTaskConfig(&pb.NodeDeployConfig{
+				Node:  &pb.Node{Name: "master1", Ip: "10.1.1.1"},
+				Roles: []string{"master"},
+			}),
+			want: false,
+		},
+		{
+			config: newScaleOutTaskConfig(newMaster),
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewScaleOutTask("scale-out", tt.config)
+		assert.Equal(t, tt.want, err == nil, "%v", err)
+	}
+}
+
+func TestScaleOutSplitTask(t *testing.T) {
+	scaleOutTask, err := NewScaleOutTask("scale-out", newScaleOutTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "master3", Ip: "10.1.1.3"}, Roles: []string{"master"}},
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker2", Ip: "10.1.1.12"}, Roles: []string{"worker", "ingress"}},
+	))
+	assert.NoError(t, err)
+	token := scaleOutTask.(*ScaleOutTask).BootstrapToken
+	certKey := scaleOutTask.(*ScaleOutTask).CertKey
+	assert.True(t, deploy.IsValidBootstrapToken(token))
+	assert.NotEmpty(t, certKey)
+
+	processor := new(scaleOutProcessor)
+	assert.NoError(t, processor.SplitTask(scaleOutTask))
+
+	subTasks := make(map[string]Task)
+	var names []string
+	for _, subTask := range scaleOutTask.GetSubTasks() {
+		subTasks[subTask.GetName()] = subTask
+		names = append(names, subTask.GetName())
+	}
+	assert.Equal(t, []string{"create-join-token", "init", "joinMaster-master3", "deploy-worker", "deploy-ingress", "deploy-config"}, names)
+	assert.NoError(t, verifyDependencies(scaleOutTask.GetSubTasks()))
+
+	// the token is created on the first master, and the certificates are uploaded for the new master
+	tokenTask := subTasks["create-join-token"].(*CreateJoinTokenTask)
+	assert.Equal(t, "master1", tokenTask.Node.GetName())
+	assert.Equal(t, token, tokenTask.Token)
+	assert.Equal(t, certKey, tokenTask.CertKey)
+
+	// only the new nodes are initialized
+	assert.Len(t, subTasks["init"].(*NodeInitTask).NodeConfigs, 3)
+
+	joinTask := subTasks["joinMaster-master3"].(*JoinMasterTask)
+	assert.Equal(t, token, joinTask.BootstrapToken)
+	assert.Equal(t, certKey, joinTask.CertKey)
+	assert.Equal(t, "master1", joinTask.MasterNodes[0].GetName())
+	assert.Len(t, joinTask.MasterNodes, 3)
+	assert.ElementsMatch(t, []string{"create-join-token", "init"}, joinTask.GetDependencies())
+
+	workerTask := subTasks["deploy-worker"].(*deployWorkerTask)
+	assert.Equal(t, token, workerTask.Config.BootstrapToken)
+	assert.Len(t, workerTask.Config.Nodes, 2)
+	assert.Contains(t, workerTask.GetDependencies(), "joinMaster-master3")
+	// worker2 is both a worker and an ingress
+	assert.Contains(t, subTasks["deploy-ingress"].GetDependencies(), "deploy-worker")
+}
+
+func TestScaleOutSplitTaskWithoutMaster(t *testing.T) {
+	scaleOutTask, err := NewScaleOutTask("scale-out", newScaleOutTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+	))
+	assert.NoError(t, err)
+	assert.NoError(t, new(scaleOutProcessor).SplitTask(scaleOutTask))
+
+	// the certificates are not uploaded if no master joins
+	tokenTask := scaleOutTask.GetSubTasks()[0].(*CreateJoinTokenTask)
+	assert.Empty(t, tokenTask.CertKey)
+	assert.Len(t, scaleOutTask.GetSubTasks(), 4)
+}
+
+func TestNewResumedScaleOutTask(t *testing.T) {
+	previous, err := NewScaleOutTask("scale-out", newScaleOutTaskConfig(
+		&pb.NodeDeployConfig{Node: &pb.Node{Name: "worker1", Ip: "10.1.1.11"}, Roles: []string{"worker"}},
+	))
+	assert.NoError(t, err)
+
+	_, err = NewResumedScaleOutTask(previous)
+	assert.Error(t, err, "a pending task can't be resumed")
+
+	previous.SetStatus(TaskFailed)
+	resumed, err := NewResumedScaleOutTask(previous)
+	assert.NoError(t, err)
+	assert.Equal(t, previous, resumed.GetResumeFrom())
+	assert.Equal(t, previous.(*ScaleOutTask).BootstrapToken, resumed.(*ScaleOutTask).BootstrapToken)
+	assert.Equal(t, previous.(*ScaleOutTask).CertKey, resumed.(*ScaleOutTask).CertKey)
+
+	// the join token is created again even if it was created by the previous execution, since it may have expired
+	processor := new(scaleOutProcessor)
+	assert.NoError(t, processor.SplitTask(previous))
+	previous.GetSubTasks()[0].SetStatus(TaskSuccessful)
+	assert.NoError(t, processor.SplitTask(resumed))
+	restoreProgress(resumed)
+	tokenTask := resumed.GetSubTasks()[0]
+	assert.IsType(t, &CreateJoinTokenTask{}, tokenTask)
+	assert.Equal(t, TaskPending, tokenTask.GetStatus())
+	assert.Nil(t, tokenTask.GetResumeFrom())
+}
@@ -0,0 +1,184 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/kubernetes/cmd/kubeadm/app/phases/copycerts"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeScaleOut Type = "ScaleOut"
+
+// ScaleOutTaskConfig represents the config for a scale-out task.
+type ScaleOutTaskConfig struct {
+	// NodeConfigs are the new nodes to add to the cluster.
+	NodeConfigs []*pb.NodeDeployConfig
+	// MasterNodes are the deployed masters of the cluster, the first one is the master
+	// which initialized the cluster.
+	MasterNodes     []*pb.Node
+	ClusterConfig   *pb.ClusterConfig
+	LogFileBasePath string
+	Priority        int
+	// RetryPolicies are the retry policies of the action types,
+	// action.DefaultRetryPolicies is used if it is nil.
+	RetryPolicies map[action.Type]*action.RetryPolicy
+}
+
+// ScaleOutTask adds new nodes to a deployed cluster: only the new nodes are initialized and
+// joined, with a token created on the existing control plane.
+type ScaleOutTask struct {
+	Base
+	NodeConfigs   []*pb.NodeDeployConfig
+	MasterNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	// BootstrapToken is created on the first master, the new nodes join the cluster with it.
+	BootstrapToken string
+	// CertKey is used to upload the control plane certificates again for the new masters.
+	CertKey string
+}
+
+// NewScaleOutTask returns a scale-out task based on the config.
+// User should use this function to create a scale-out task.
+func NewScaleOutTask(taskName string, taskConfig *ScaleOutTaskConfig) (Task, error) {
+	var err error
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+	} else if len(taskConfig.NodeConfigs) == 0 {
+		err = fmt.Errorf("invalid task config: node deploy configs is empty")
+	} else if len(taskConfig.MasterNodes) == 0 {
+		err = fmt.Errorf("invalid task config: master nodes is empty")
+	} else if kubeErr := deploy.ValidateKubernetesConfig(taskConfig.ClusterConfig); kubeErr != nil {
+		err = fmt.Errorf("invalid task config: %v", kubeErr)
+	} else {
+		err = verifyScaleOutNodes(taskConfig.NodeConfigs, taskConfig.MasterNodes)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	token, err := deploy.NewBootstrapToken()
+	if err != nil {
+		err = fmt.Errorf("failed to generate bootstrap token: %v", err)
+		logrus.Error(err)
+		return nil, err
+	}
+
+	certKey, err := copycerts.CreateCertificateKey()
+	if err != nil {
+		err = fmt.Errorf("failed to generate certificate key: %v", err)
+		logrus.Error(err)
+		return nil, err
+	}
+
+	retryPolicies := taskConfig.RetryPolicies
+	if retryPolicies == nil {
+		retryPolicies = action.DefaultRetryPolicies
+	}
+
+	task := &ScaleOutTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeScaleOut,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			RetryPolicies:     retryPolicies,
+		},
+		NodeConfigs:    taskConfig.NodeConfigs,
+		MasterNodes:    taskConfig.MasterNodes,
+		ClusterConfig:  taskConfig.ClusterConfig,
+		BootstrapToken: token,
+		CertKey:        certKey,
+	}
+
+	return task, nil
+}
+
+// NewResumedScaleOutTask returns a scale-out task which resumes from a previous failed or aborted
+// scale-out task: the sub tasks and actions which were done in the previous task will be skipped.
+func NewResumedScaleOutTask(previous Task) (Task, error) {
+	var err error
+	previousTask, ok := previous.(*ScaleOutTask)
+	if !ok {
+		err = fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, previous)
+	} else if status := previousTask.GetStatus(); status != TaskFailed && status != TaskAborted {
+		err = fmt.Errorf("only a failed or aborted scale-out task can be resumed, the task is %s", status)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &ScaleOutTask{
+		Base: Base{
+			Name:              previousTask.Name,
+			TaskType:          TaskTypeScaleOut,
+			Status:            TaskPending,
+			LogFileDir:        previousTask.LogFileDir,
+			CreationTimestamp: time.Now(),
+			Priority:          previousTask.Priority,
+			ResumeFrom:        previousTask,
+			RetryPolicies:     previousTask.RetryPolicies,
+		},
+		NodeConfigs:   previousTask.NodeConfigs,
+		MasterNodes:   previousTask.MasterNodes,
+		ClusterConfig: previousTask.ClusterConfig,
+		// the token may have been created and the certificates may have been uploaded
+		// by the previous task
+		BootstrapToken: previousTask.BootstrapToken,
+		CertKey:        previousTask.CertKey,
+	}
+
+	return task, nil
+}
+
+// verifyScaleOutNodes checks the new nodes can be added by scale-out: they are not the deployed
+// masters, and they have no etcd role since adding etcd members is not supported.
+func verifyScaleOutNodes(nodeConfigs []*pb.NodeDeployConfig, masterNodes []*pb.Node) error {
+	masters := make(map[string]bool, len(masterNodes))
+	for _, node := range masterNodes {
+		masters[node.GetName()] = true
+	}
+
+	for _, nodeCfg := range nodeConfigs {
+		name := nodeCfg.GetNode().GetName()
+		if name == "" {
+			return fmt.Errorf("invalid task config: node name is empty")
+		}
+		if masters[name] {
+			return fmt.Errorf("invalid task config: node %v is a deployed master", name)
+		}
+		for _, role := range nodeCfg.GetRoles() {
+			if constant.MachineRole(role) == constant.MachineRoleEtcd {
+				return fmt.Errorf("invalid task config: etcd node %v can't be added by scale-out", name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -29,6 +29,15 @@ type Store interface {
 	UpdateOrAddTask(task Task) error
 }
 
+// PersistentStore is a Store which keeps the tasks across restarts of the deploy controller.
+type PersistentStore interface {
+	Store
+	// Sync writes the latest state of all tasks into the underlying storage.
+	Sync() error
+	// Close syncs the tasks for the last time and releases the underlying storage.
+	Close() error
+}
+
 // A Store implementation via map
 type cache struct {
 	sync.RWMutex
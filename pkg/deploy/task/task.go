@@ -16,6 +16,7 @@ package task
 
 import (
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/kpaas-io/kpaas/pkg/deploy/action"
@@ -37,14 +38,28 @@ type Task interface {
 	// Sub tasks are Task too.
 	GetSubTasks() []Task
 	// GetPriority returns the priority of the task: smaller value means higher prioirty.
-	// A task should wait until all higher priority tasks are done
+	// A task which doesn't declare its dependencies should wait until all higher priority tasks are done
 	GetPriority() int
+	// GetDependencies returns the names of the sibling tasks which should be done before the task starts,
+	// if it is empty, the task depends on its sibling tasks of higher priority.
+	GetDependencies() []string
+	SetDependencies([]string)
 	// If a task is not a sub task, this will return ""
 	GetParent() string
 	// FailureCanBeIgnored indicate whether the failure of the task can be ingnored, if yes,
 	// the task's failure will not affect other task's execution.
 	GetFailureCanBeIgnored() bool
 	SetFailureCanBeIgnored(bool)
+	// GetResumeFrom returns the previous execution of the same task which this task resumes from,
+	// if the task is executed from scratch, this will return nil.
+	GetResumeFrom() Task
+	SetResumeFrom(Task)
+	// GetRetryPolicies returns the retry policies of the action types, which will be applied to
+	// the actions of the task and its sub tasks.
+	GetRetryPolicies() map[action.Type]*action.RetryPolicy
+	SetRetryPolicies(map[action.Type]*action.RetryPolicy)
+
+	getBase() *Base
 }
 
 // Type represents the type of a task
@@ -60,20 +75,28 @@ const (
 	TaskDoing        Status = "doing"
 	TaskSuccessful   Status = "successful"
 	TaskFailed       Status = "failed"
+	TaskAborted      Status = "aborted"
 )
 
 type Base struct {
 	Name                string
 	TaskType            Type
-	Actions             []action.Action
+	Actions             []action.Action `json:"-"`
 	Status              Status
 	Err                 *pb.Error
 	LogFileDir          string
 	CreationTimestamp   time.Time
-	SubTasks            []Task
+	SubTasks            []Task `json:"-"`
 	Priority            int
+	Dependencies        []string
 	Parent              string
 	FailureCanBeIgnored bool
+	ResumeFrom          Task `json:"-"`
+	RetryPolicies       map[action.Type]*action.RetryPolicy
+
+	// lock protects the fields changed during the execution from being read by the task store
+	// while they are changed.
+	lock sync.RWMutex
 }
 
 func (b *Base) GetName() string {
@@ -85,27 +108,41 @@ func (b *Base) GetType() Type {
 }
 
 func (b *Base) GetStatus() Status {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.Status
 }
 
 func (b *Base) SetStatus(status Status) {
+	b.lock.Lock()
 	b.Status = status
+	b.lock.Unlock()
+	action.NotifyStatusChanged()
 }
 
 func (b *Base) GetErr() *pb.Error {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.Err
 }
 
 func (b *Base) SetErr(err *pb.Error) {
+	b.lock.Lock()
 	b.Err = err
+	b.lock.Unlock()
+	action.NotifyStatusChanged()
 }
 
 func (b *Base) GetLogFileDir() string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.LogFileDir
 }
 
 func (b *Base) SetLogFileDir(path string) {
+	b.lock.Lock()
 	b.LogFileDir = path
+	b.lock.Unlock()
 }
 
 func (b *Base) GetActions() []action.Action {
@@ -124,16 +161,60 @@ func (b *Base) GetPriority() int {
 	return b.Priority
 }
 
+func (b *Base) GetDependencies() []string {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.Dependencies
+}
+
+func (b *Base) SetDependencies(dependencies []string) {
+	b.lock.Lock()
+	b.Dependencies = dependencies
+	b.lock.Unlock()
+}
+
 func (b *Base) GetParent() string {
 	return b.Parent
 }
 
 func (b *Base) GetFailureCanBeIgnored() bool {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
 	return b.FailureCanBeIgnored
 }
 
 func (b *Base) SetFailureCanBeIgnored(val bool) {
+	b.lock.Lock()
 	b.FailureCanBeIgnored = val
+	b.lock.Unlock()
+}
+
+func (b *Base) GetResumeFrom() Task {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.ResumeFrom
+}
+
+func (b *Base) SetResumeFrom(previous Task) {
+	b.lock.Lock()
+	b.ResumeFrom = previous
+	b.lock.Unlock()
+}
+
+func (b *Base) GetRetryPolicies() map[action.Type]*action.RetryPolicy {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.RetryPolicies
+}
+
+func (b *Base) SetRetryPolicies(policies map[action.Type]*action.RetryPolicy) {
+	b.lock.Lock()
+	b.RetryPolicies = policies
+	b.lock.Unlock()
+}
+
+func (b *Base) getBase() *Base {
+	return b
 }
 
 // GenTaskLogFileDir is a helper to return the log file dir based on base path and task name
@@ -0,0 +1,116 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeUpgradeCluster, new(upgradeClusterProcessor))
+}
+
+// upgradeClusterProcessor implements the specific logic for the upgrade-cluster task.
+type upgradeClusterProcessor struct {
+}
+
+// Spilt the task into one or more sub tasks
+func (p *upgradeClusterProcessor) SplitTask(t Task) error {
+	upgradeTask, err := p.verifyTask(t)
+	if err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split upgrade-cluster task")
+
+	// split task into a chain of sub tasks, and each one depends on the previous one, so the upgrade
+	// stops on the first failure: check the cluster -> upgrade the first master -> upgrade the other
+	// masters one by one -> upgrade the workers one by one.
+	type step struct {
+		name       string
+		actionType action.Type
+		node       *pb.Node
+	}
+	firstMaster := upgradeTask.MasterNodes[0]
+	steps := []step{
+		{"check-upgrade", action.ActionTypeCheckUpgrade, firstMaster},
+		{"upgrade-master-" + firstMaster.GetName(), action.ActionTypeUpgradeFirstMaster, firstMaster},
+	}
+	for _, node := range upgradeTask.MasterNodes[1:] {
+		steps = append(steps, step{"upgrade-master-" + node.GetName(), action.ActionTypeUpgradeMaster, node})
+	}
+	for _, node := range upgradeTask.WorkerNodes {
+		steps = append(steps, step{"upgrade-worker-" + node.GetName(), action.ActionTypeUpgradeWorker, node})
+	}
+
+	subTasks := make([]Task, 0, len(steps))
+	for _, s := range steps {
+		subTask, err := NewUpgradeNodeTask(s.name, &UpgradeNodeTaskConfig{
+			Step:            s.actionType,
+			Node:            s.node,
+			MasterNodes:     upgradeTask.MasterNodes,
+			Version:         upgradeTask.Version,
+			LogFileBasePath: upgradeTask.GetLogFileDir(),
+			Priority:        upgradeTask.GetPriority(),
+			Parent:          upgradeTask.GetName(),
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to create %v sub task: %s", s.name, err)
+			logger.Error(err)
+			return err
+		}
+		if len(subTasks) > 0 {
+			subTask.SetDependencies([]string{subTasks[len(subTasks)-1].GetName()})
+		}
+		subTasks = append(subTasks, subTask)
+	}
+
+	upgradeTask.SubTasks = subTasks
+	logger.Debugf("Finish to split upgrade-cluster task: %d sub tasks", len(subTasks))
+
+	return nil
+}
+
+// Verify if the task is valid.
+func (p *upgradeClusterProcessor) verifyTask(t Task) (*UpgradeClusterTask, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	upgradeTask, ok := t.(*UpgradeClusterTask)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if len(upgradeTask.MasterNodes) == 0 {
+		return nil, fmt.Errorf("masterNodes is empty")
+	}
+
+	if upgradeTask.Version == "" {
+		return nil, fmt.Errorf("version is empty")
+	}
+
+	return upgradeTask, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+func newUpgradeClusterTaskConfig(version string) *UpgradeClusterTaskConfig {
+	return &UpgradeClusterTaskConfig{
+		MasterNodes: []*pb.Node{
+			{Name: "master1", Ip: "10.1.1.1"},
+			{Name: "master2", Ip: "10.1.1.2"},
+		},
+		WorkerNodes: []*pb.Node{
+			{Name: "worker1", Ip: "10.1.1.11"},
+			{Name: "worker2", Ip: "10.1.1.12"},
+		},
+		ClusterConfig: &pb.ClusterConfig{KubernetesVersion: "1.16.3"},
+		Version:       version,
+	}
+}
+
+func TestNewUpgradeClusterTask(t *testing.T) {
+	tests := []struct {
+		config *UpgradeClusterTaskConfig
+		want   bool
+	}{
+		{
+			config: nil,
+			want:   false,
+		},
+		{
+			config: &UpgradeClusterTaskConfig{ClusterConfig: &pb.ClusterConfig{}, Version: "1.17.0"},
+			want:   false,
+		},
+		{
+			// unsupported version
+			config: newUpgradeClusterTaskConfig("1.18.0"),
+			want:   false,
+		},
+		{
+			// downgrade
+			config: newUpgradeClusterTaskConfig("1.15.6"),
+			want:   false,
+		},
+		{
+			config: newUpgradeClusterTaskConfig("v1.17.0"),
+			want:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := NewUpgradeClusterTask("upgrade-cluster", tt.config)
+		assert.Equal(t, tt.want, err == nil, "%v", err)
+	}
+}
+
+func TestUpgradeClusterSplitTask(t *testing.T) {
+	upgradeTask, err := NewUpgradeClusterTask("upgrade-cluster", newUpgradeClusterTaskConfig("1.17.0"))
+	assert.NoError(t, err)
+	assert.NoError(t, new(upgradeClusterProcessor).SplitTask(upgradeTask))
+
+	var names []string
+	var steps []action.Type
+	var previous string
+	for _, subTask := range upgradeTask.GetSubTasks() {
+		names = append(names, subTask.GetName())
+		steps = append(steps, subTask.(*UpgradeNodeTask).Step)
+		// each sub task depends on the previous one, so the upgrade stops on the first failure
+		if previous != "" {
+			assert.Equal(t, []string{previous}, subTask.GetDependencies())
+		}
+		previous = subTask.GetName()
+	}
+	assert.Equal(t, []string{"check-upgrade", "upgrade-master-master1", "upgrade-master-master2",
+		"upgrade-worker-worker1", "upgrade-worker-worker2"}, names)
+	assert.Equal(t, []action.Type{action.ActionTypeCheckUpgrade, action.ActionTypeUpgradeFirstMaster,
+		action.ActionTypeUpgradeMaster, action.ActionTypeUpgradeWorker, action.ActionTypeUpgradeWorker}, steps)
+	assert.NoError(t, verifyDependencies(upgradeTask.GetSubTasks()))
+
+	workerTask := upgradeTask.GetSubTasks()[3]
+	assert.NoError(t, new(upgradeNodeProcessor).SplitTask(workerTask))
+	assert.Len(t, workerTask.GetActions(), 1)
+	assert.Equal(t, "worker1", workerTask.GetActions()[0].GetNode().GetName())
+	assert.Equal(t, "1.17.0", workerTask.GetActions()[0].(*action.UpgradeNodeAction).Version)
+}
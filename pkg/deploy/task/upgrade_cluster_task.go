@@ -0,0 +1,154 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy"
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+	"github.com/kpaas-io/kpaas/pkg/deploy/operation/upgrade"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeUpgradeCluster Type = "UpgradeCluster"
+
+// UpgradeClusterTaskConfig represents the config for an upgrade-cluster task.
+type UpgradeClusterTaskConfig struct {
+	// MasterNodes are the masters of the cluster, the first one is upgraded first and is used to access the cluster.
+	MasterNodes []*pb.Node
+	// WorkerNodes are the other nodes of the cluster, which are upgraded one at a time.
+	WorkerNodes []*pb.Node
+	// ClusterConfig is the current config of the cluster.
+	ClusterConfig *pb.ClusterConfig
+	// Version is the kubernetes version to upgrade to.
+	Version         string
+	LogFileBasePath string
+	Priority        int
+	// RetryPolicies are the retry policies of the action types,
+	// action.DefaultRetryPolicies is used if it is nil.
+	RetryPolicies map[action.Type]*action.RetryPolicy
+}
+
+// UpgradeClusterTask upgrades the kubernetes of a deployed cluster to a newer version: the masters
+// are upgraded one by one from the first one, then the workers one by one.
+type UpgradeClusterTask struct {
+	Base
+	MasterNodes   []*pb.Node
+	WorkerNodes   []*pb.Node
+	ClusterConfig *pb.ClusterConfig
+	Version       string
+}
+
+// NewUpgradeClusterTask returns an upgrade-cluster task based on the config.
+// User should use this function to create an upgrade-cluster task.
+func NewUpgradeClusterTask(taskName string, taskConfig *UpgradeClusterTaskConfig) (Task, error) {
+	var err error
+	var version string
+	if taskConfig == nil {
+		err = fmt.Errorf("invalid task config: nil")
+	} else if len(taskConfig.MasterNodes) == 0 {
+		err = fmt.Errorf("invalid task config: master nodes is empty")
+	} else if taskConfig.ClusterConfig == nil {
+		err = fmt.Errorf("invalid task config: cluster config is nil")
+	} else {
+		version, err = verifyUpgradeVersion(taskConfig.ClusterConfig, taskConfig.Version)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	retryPolicies := taskConfig.RetryPolicies
+	if retryPolicies == nil {
+		retryPolicies = action.DefaultRetryPolicies
+	}
+
+	task := &UpgradeClusterTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeUpgradeCluster,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			RetryPolicies:     retryPolicies,
+		},
+		MasterNodes:   taskConfig.MasterNodes,
+		WorkerNodes:   taskConfig.WorkerNodes,
+		ClusterConfig: taskConfig.ClusterConfig,
+		Version:       version,
+	}
+
+	return task, nil
+}
+
+// NewResumedUpgradeClusterTask returns an upgrade-cluster task which resumes from a previous failed or aborted
+// upgrade-cluster task: the sub tasks and actions which were done in the previous task will be skipped.
+func NewResumedUpgradeClusterTask(previous Task) (Task, error) {
+	var err error
+	previousTask, ok := previous.(*UpgradeClusterTask)
+	if !ok {
+		err = fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, previous)
+	} else if status := previousTask.GetStatus(); status != TaskFailed && status != TaskAborted {
+		err = fmt.Errorf("only a failed or aborted upgrade-cluster task can be resumed, the task is %s", status)
+	}
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	task := &UpgradeClusterTask{
+		Base: Base{
+			Name:              previousTask.Name,
+			TaskType:          TaskTypeUpgradeCluster,
+			Status:            TaskPending,
+			LogFileDir:        previousTask.LogFileDir,
+			CreationTimestamp: time.Now(),
+			Priority:          previousTask.Priority,
+			ResumeFrom:        previousTask,
+			RetryPolicies:     previousTask.RetryPolicies,
+		},
+		MasterNodes:   previousTask.MasterNodes,
+		WorkerNodes:   previousTask.WorkerNodes,
+		ClusterConfig: previousTask.ClusterConfig,
+		Version:       previousTask.Version,
+	}
+
+	return task, nil
+}
+
+// verifyUpgradeVersion checks the target version is supported and can be upgraded to from
+// the current version of the cluster, and returns the target version of the release.
+func verifyUpgradeVersion(clusterConfig *pb.ClusterConfig, version string) (string, error) {
+	target, err := constant.GetKubernetesRelease(version)
+	if err != nil {
+		return "", fmt.Errorf("invalid task config: %s", err)
+	}
+	current, err := deploy.GetKubernetesRelease(clusterConfig)
+	if err != nil {
+		return "", fmt.Errorf("invalid task config: current %s", err)
+	}
+	if err = upgrade.CheckVersionSkew(current.Version, target.Version); err != nil {
+		return "", fmt.Errorf("invalid task config: %s", err)
+	}
+	return target.Version, nil
+}
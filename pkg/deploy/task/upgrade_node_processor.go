@@ -0,0 +1,76 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	"github.com/kpaas-io/kpaas/pkg/deploy/consts"
+)
+
+func init() {
+	RegisterProcessor(TaskTypeUpgradeNode, new(upgradeNodeProcessor))
+}
+
+type upgradeNodeProcessor struct {
+}
+
+func (p *upgradeNodeProcessor) SplitTask(t Task) error {
+	nodeTask, err := p.verifyTask(t)
+	if err != nil {
+		logrus.Errorf("Invalid task: %s", err)
+		return err
+	}
+
+	logger := logrus.WithFields(logrus.Fields{
+		consts.LogFieldTask: t.GetName(),
+	})
+
+	logger.Debug("Start to split task")
+
+	act, err := action.NewUpgradeNodeAction(&action.UpgradeNodeActionConfig{
+		Step:            nodeTask.Step,
+		Node:            nodeTask.Node,
+		MasterNodes:     nodeTask.MasterNodes,
+		Version:         nodeTask.Version,
+		LogFileBasePath: nodeTask.LogFileDir,
+	})
+	if err != nil {
+		return err
+	}
+	nodeTask.Actions = []action.Action{act}
+
+	logger.Debug("Finish to split task: 1 action")
+	return nil
+}
+
+func (p *upgradeNodeProcessor) verifyTask(t Task) (*UpgradeNodeTask, error) {
+	if t == nil {
+		return nil, consts.ErrEmptyTask
+	}
+
+	nodeTask, ok := t.(*UpgradeNodeTask)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T", consts.MsgTaskTypeMismatched, t)
+	}
+
+	if nodeTask.Node == nil {
+		return nil, fmt.Errorf("node is nil")
+	}
+
+	return nodeTask, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const TaskTypeUpgradeNode Type = "UpgradeNode"
+
+type UpgradeNodeTaskConfig struct {
+	// Step is the action type of the step.
+	Step action.Type
+	Node *pb.Node
+	// MasterNodes are the masters of the cluster, the first one is used to access the cluster.
+	MasterNodes     []*pb.Node
+	Version         string
+	LogFileBasePath string
+	Priority        int
+	Parent          string
+}
+
+// UpgradeNodeTask is a step of the upgrade-cluster task on one node.
+type UpgradeNodeTask struct {
+	Base
+	Step        action.Type
+	Node        *pb.Node
+	MasterNodes []*pb.Node
+	Version     string
+}
+
+func NewUpgradeNodeTask(taskName string, taskConfig *UpgradeNodeTaskConfig) (Task, error) {
+	if taskName == "" {
+		return nil, fmt.Errorf("taskName can't be empty")
+	}
+	if taskConfig == nil {
+		return nil, fmt.Errorf("invalid task config: nil")
+	}
+	if taskConfig.Node == nil {
+		return nil, fmt.Errorf("invalid task config: node is nil")
+	}
+
+	task := &UpgradeNodeTask{
+		Base: Base{
+			Name:              taskName,
+			TaskType:          TaskTypeUpgradeNode,
+			Status:            TaskPending,
+			LogFileDir:        GenTaskLogFileDir(taskConfig.LogFileBasePath, taskName),
+			CreationTimestamp: time.Now(),
+			Priority:          taskConfig.Priority,
+			Parent:            taskConfig.Parent,
+		},
+		Step:        taskConfig.Step,
+		Node:        taskConfig.Node,
+		MasterNodes: taskConfig.MasterNodes,
+		Version:     taskConfig.Version,
+	}
+
+	return task, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"context"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/utils"
+)
+
+// WorkerPoolConfig represents the limits of the actions executed at the same time.
+// The sub tasks don't take workers, only their actions do.
+type WorkerPoolConfig struct {
+	// MaxWorkers is the max number of the actions executed at the same time, 0 means no limit.
+	MaxWorkers int
+	// MaxWorkersPerTaskType is the max number of the actions of each task type executed
+	// at the same time, the task types not in the map are not limited.
+	MaxWorkersPerTaskType map[Type]int
+}
+
+type workerPool struct {
+	workers            *utils.Semaphore
+	workersPerTaskType map[Type]*utils.Semaphore
+}
+
+// _workerPool is shared by all the tasks, it is not limited by default.
+var _workerPool = newWorkerPool(WorkerPoolConfig{})
+
+// SetWorkerPool sets the limits of the actions executed at the same time,
+// it should be called before any task is started.
+func SetWorkerPool(config WorkerPoolConfig) {
+	_workerPool = newWorkerPool(config)
+}
+
+func newWorkerPool(config WorkerPoolConfig) *workerPool {
+	pool := &workerPool{
+		workers:            utils.NewSemaphore(config.MaxWorkers),
+		workersPerTaskType: make(map[Type]*utils.Semaphore, len(config.MaxWorkersPerTaskType)),
+	}
+	for taskType, maxWorkers := range config.MaxWorkersPerTaskType {
+		pool.workersPerTaskType[taskType] = utils.NewSemaphore(maxWorkers)
+	}
+	return pool
+}
+
+// acquire waits for a worker to execute an action of the task type, it returns an error if ctx is done.
+func (p *workerPool) acquire(ctx context.Context, taskType Type) error {
+	// Take the worker of the task type first, so the actions waiting for it don't hold the global workers.
+	if err := p.workersPerTaskType[taskType].Acquire(ctx); err != nil {
+		return err
+	}
+	if err := p.workers.Acquire(ctx); err != nil {
+		p.workersPerTaskType[taskType].Release()
+		return err
+	}
+	return nil
+}
+
+// release gives back the worker taken by acquire.
+func (p *workerPool) release(taskType Type) {
+	p.workers.Release()
+	p.workersPerTaskType[taskType].Release()
+}
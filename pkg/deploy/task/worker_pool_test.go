@@ -0,0 +1,97 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package task
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/deploy/action"
+	pb "github.com/kpaas-io/kpaas/pkg/deploy/protos"
+)
+
+const ActionTypeTestWorkerPoolMockup action.Type = "ActionTypeMockupForWorkerPoolTest"
+
+// executorMockupForWorkerPoolTest records the max number of the actions being executed at the same time,
+// the actions waiting for workers should keep pending.
+type executorMockupForWorkerPoolTest struct {
+	lock     sync.Mutex
+	maxDoing int
+	actions  []action.Action
+}
+
+func (e *executorMockupForWorkerPoolTest) Execute(ctx context.Context, act action.Action) *pb.Error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	doing := 0
+	for _, act := range e.actions {
+		if act.GetStatus() == action.ActionDoing {
+			doing++
+		}
+	}
+	if doing > e.maxDoing {
+		e.maxDoing = doing
+	}
+	return nil
+}
+
+func TestExecuteActionsWithWorkerPool(t *testing.T) {
+	executor := new(executorMockupForWorkerPoolTest)
+	action.RegisterExecutor(ActionTypeTestWorkerPoolMockup, executor)
+
+	defer SetWorkerPool(WorkerPoolConfig{})
+	SetWorkerPool(WorkerPoolConfig{
+		MaxWorkers:            2,
+		MaxWorkersPerTaskType: map[Type]int{TaskTypeTestProcessorMockup2: 1},
+	})
+
+	var actions []action.Action
+	for i := 0; i < 5; i++ {
+		actions = append(actions, &actionMockupForProcessorTest{
+			Base: action.Base{
+				Name:       "action",
+				ActionType: ActionTypeTestWorkerPoolMockup,
+				Status:     action.ActionPending,
+			},
+		})
+	}
+	executor.actions = actions
+	task2 := &taskMockupForProcessorTest2{
+		Base: Base{
+			Name:     "task2",
+			TaskType: TaskTypeTestProcessorMockup2,
+			Status:   TaskDoing,
+			Actions:  actions,
+		},
+	}
+
+	assert.NoError(t, executeActions(context.Background(), task2))
+	// only one action of the task type is executed at the same time
+	assert.Equal(t, 1, executor.maxDoing)
+	for _, act := range actions {
+		assert.Equal(t, action.ActionDone, act.GetStatus())
+	}
+
+	// a canceled task doesn't wait for the workers
+	pool := newWorkerPool(WorkerPoolConfig{MaxWorkers: 1})
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.NoError(t, pool.acquire(ctx, TaskTypeTestProcessorMockup2))
+	cancel()
+	assert.Equal(t, context.Canceled, pool.acquire(ctx, TaskTypeTestProcessorMockup2))
+	pool.release(TaskTypeTestProcessorMockup2)
+}
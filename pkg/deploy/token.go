@@ -0,0 +1,40 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package deploy
+
+import (
+	"time"
+
+	bootstraputil "k8s.io/cluster-bootstrap/token/util"
+)
+
+const (
+	// BootstrapTokenTTL is the TTL of the bootstrap token created by a deploy,
+	// all the nodes of the deploy should have joined the cluster before it expires.
+	BootstrapTokenTTL = 24 * time.Hour
+	// DefaultJoinTokenTTL is the TTL of a join token created to add nodes to a deployed cluster.
+	DefaultJoinTokenTTL = 2 * time.Hour
+	// MaxJoinTokenTTL is the longest TTL of a join token, join tokens never live forever.
+	MaxJoinTokenTTL = 7 * 24 * time.Hour
+)
+
+// NewBootstrapToken returns a random bootstrap token in the form of "[a-z0-9]{6}.[a-z0-9]{16}".
+func NewBootstrapToken() (string, error) {
+	return bootstraputil.GenerateBootstrapToken()
+}
+
+// IsValidBootstrapToken returns whether the token is in the form of a bootstrap token.
+func IsValidBootstrapToken(token string) bool {
+	return bootstraputil.IsValidBootstrapToken(token)
+}
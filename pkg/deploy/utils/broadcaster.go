@@ -0,0 +1,51 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sync"
+)
+
+// Broadcaster wakes up all the watchers whenever something changed.
+type Broadcaster struct {
+	lock    sync.Mutex
+	changed chan struct{}
+}
+
+// NewBroadcaster returns a new Broadcaster.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		changed: make(chan struct{}),
+	}
+}
+
+// Changed returns a channel which will be closed at the next Notify().
+// Watchers should get the channel before reading the state they watch,
+// so no change will be missed.
+func (b *Broadcaster) Changed() <-chan struct{} {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.changed
+}
+
+// Notify wakes up all the watchers waiting on the channel returned by Changed().
+func (b *Broadcaster) Notify() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	close(b.changed)
+	b.changed = make(chan struct{})
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcaster(t *testing.T) {
+	b := NewBroadcaster()
+
+	changed := b.Changed()
+	select {
+	case <-changed:
+		assert.Fail(t, "should not be notified before Notify()")
+	default:
+	}
+
+	done := make(chan struct{})
+	go func() {
+		<-changed
+		close(done)
+	}()
+
+	b.Notify()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "should be notified after Notify()")
+	}
+
+	// a new channel is returned after the notification
+	assert.NotEqual(t, changed, b.Changed())
+}
@@ -0,0 +1,91 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"io"
+	"sync"
+)
+
+// LogBuffer is a thread safe buffer of the execute logs. Like bytes.Buffer, the data written
+// to it can be read by Read, but the data is kept after being read, so that it can be followed
+// by any number of followers with Follow while it is being written.
+type LogBuffer struct {
+	lock       sync.Mutex
+	data       []byte
+	readOffset int
+	closed     bool
+	changes    *Broadcaster
+}
+
+// NewLogBuffer returns a new empty LogBuffer.
+func NewLogBuffer() *LogBuffer {
+	return &LogBuffer{
+		changes: NewBroadcaster(),
+	}
+}
+
+// Write appends p to the buffer and wakes up the followers.
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.closed {
+		return 0, io.ErrClosedPipe
+	}
+	b.data = append(b.data, p...)
+	b.changes.Notify()
+	return len(p), nil
+}
+
+// Read reads the data which has not been read by Read yet, it returns io.EOF if there is no more data.
+func (b *LogBuffer) Read(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if b.readOffset >= len(b.data) {
+		return 0, io.EOF
+	}
+	n := copy(p, b.data[b.readOffset:])
+	b.readOffset += n
+	return n, nil
+}
+
+// Close marks the buffer as completed and wakes up the followers, nothing can be written after closed.
+func (b *LogBuffer) Close() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.closed {
+		b.closed = true
+		b.changes.Notify()
+	}
+	return nil
+}
+
+// Follow returns a copy of the data written after offset and whether the buffer is closed, followers
+// should wait on the returned channel, which will be closed at the next write or close, for more data.
+func (b *LogBuffer) Follow(offset int) (data []byte, closed bool, changed <-chan struct{}) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if offset < len(b.data) {
+		data = append([]byte(nil), b.data[offset:]...)
+	}
+	return data, b.closed, b.changes.Changed()
+}
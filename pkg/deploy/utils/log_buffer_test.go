@@ -0,0 +1,68 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBuffer(t *testing.T) {
+	buf := NewLogBuffer()
+
+	data, closed, changed := buf.Follow(0)
+	assert.Empty(t, data)
+	assert.False(t, closed)
+
+	_, err := buf.Write([]byte("hello "))
+	assert.NoError(t, err)
+	select {
+	case <-changed:
+	default:
+		assert.Fail(t, "followers should be notified after written")
+	}
+
+	// Read consumes the data like bytes.Buffer
+	content, err := ioutil.ReadAll(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello ", string(content))
+	n, err := buf.Read(make([]byte, 8))
+	assert.Equal(t, 0, n)
+	assert.Equal(t, io.EOF, err)
+
+	// but the data can still be followed
+	buf.Write([]byte("world"))
+	data, closed, _ = buf.Follow(0)
+	assert.Equal(t, "hello world", string(data))
+	assert.False(t, closed)
+	data, _, changed = buf.Follow(len("hello "))
+	assert.Equal(t, "world", string(data))
+
+	assert.NoError(t, buf.Close())
+	select {
+	case <-changed:
+	default:
+		assert.Fail(t, "followers should be notified after closed")
+	}
+	data, closed, _ = buf.Follow(len("hello world"))
+	assert.Empty(t, data)
+	assert.True(t, closed)
+
+	_, err = buf.Write([]byte("!"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,49 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemaphore(t *testing.T) {
+	ctx := context.Background()
+	s := NewSemaphore(2)
+	assert.NoError(t, s.Acquire(ctx))
+	assert.NoError(t, s.Acquire(ctx))
+
+	// all the slots are taken, so it blocks until ctx is done
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(t, context.Canceled, s.Acquire(canceledCtx))
+
+	acquired := make(chan struct{})
+	go func() {
+		s.Acquire(ctx)
+		close(acquired)
+	}()
+	s.Release()
+	<-acquired
+
+	// no limit
+	s = NewSemaphore(0)
+	assert.Nil(t, s)
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, s.Acquire(ctx))
+	}
+	s.Release()
+}
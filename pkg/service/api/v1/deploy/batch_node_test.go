@@ -58,6 +58,7 @@ k8s-master2   root	    master,etcd          192.168.3.224    22          1111111
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePassword,
@@ -76,6 +77,7 @@ k8s-master2   root	    master,etcd          192.168.3.224    22          1111111
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePassword,
@@ -106,6 +108,7 @@ k8s-master2   root	    master,etcd          192.168.3.224    22          -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePrivateKey,
@@ -124,6 +127,7 @@ k8s-master2   root	    master,etcd          192.168.3.224    22          -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePrivateKey,
@@ -158,6 +162,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePassword,
@@ -175,6 +180,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePassword,
@@ -192,6 +198,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePassword,
@@ -209,6 +216,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePrivateKey,
@@ -227,6 +235,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePrivateKey,
@@ -245,6 +254,7 @@ k8s-worker6    root      worker              192.168.3.230    22             -
 							Taints:              []api.Taint{},
 						},
 						ConnectionData: api.ConnectionData{
+							ConnectionType: api.ConnectionTypeSSH,
 							SSHLoginData: api.SSHLoginData{
 								Username:           "root",
 								AuthenticationType: api.AuthenticationTypePrivateKey,
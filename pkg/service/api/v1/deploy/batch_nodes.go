@@ -25,6 +25,7 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
@@ -60,20 +61,20 @@ func UploadBatchNodes(c *gin.Context) {
 		node.Port = data.Port
 		node.Username = data.Username
 		node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(data.AuthenticationType)
-		switch data.AuthenticationType {
-		case api.AuthenticationTypePassword:
-			node.Password = data.Password
-		case api.AuthenticationTypePrivateKey:
-			node.PrivateKeyName = data.PrivateKeyName
-		}
-
 		nodeList = append(nodeList, node)
+		if err = setNodeCredentials(node, &data.SSHLoginData, nil, nil); err != nil {
+			deleteNodeCredentials(nodeList)
+			h.E(c, h.EUnknown.WithPayload(err))
+			log.ReqEntry(c).Error(err)
+			return
+		}
 	}
 
 	wizardData := wizard.GetCurrentWizard()
 	err = wizardData.AddNodeList(nodeList)
 	if err != nil {
 
+		deleteNodeCredentials(nodeList)
 		h.E(c, err)
 		return
 	}
@@ -84,10 +85,18 @@ func UploadBatchNodes(c *gin.Context) {
 	})
 }
 
+// deleteNodeCredentials deletes the credentials of the nodes which are not added
+func deleteNodeCredentials(nodes []*wizard.Node) {
+
+	for _, node := range nodes {
+		credential.Delete(node.CredentialIDs()...)
+	}
+}
+
 func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, err error) {
 
 	data, err := c.GetRawData()
-	log.ReqEntry(c).Tracef("rawData: %v, err: %v", string(data), err)
+	log.ReqEntry(c).Tracef("rawData length: %d, err: %v", len(data), err)
 	if err != nil {
 		return
 	}
@@ -104,8 +113,6 @@ func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, e
 	*/
 	matches, groupNames := tryToMatchBatchNodes(data)
 
-	log.ReqEntry(c).Tracef("matches: %v", matches)
-
 	if len(matches) == 0 {
 		err = fmt.Errorf("node list empty")
 		return
@@ -134,7 +141,7 @@ func getUploadBatchNodesRequestData(c *gin.Context) (nodeList []*api.NodeData, e
 
 		loginData := api.SSHLoginData{
 			Username:           matchMap["username"],
-			Password:           matchMap["password"],
+			Password:           api.Secret(matchMap["password"]),
 			AuthenticationType: api.AuthenticationTypePassword,
 		}
 
@@ -15,10 +15,15 @@
 package deploy
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
@@ -32,6 +37,8 @@ import (
 // @Produce application/json
 // @Param certificate body api.SSHCertificate true "Certificate information"
 // @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Failure 409 {object} h.AppErr
 // @Router /api/v1/ssh_certificates [post]
 func AddSSHCertificate(c *gin.Context) {
 
@@ -40,23 +47,131 @@ func AddSSHCertificate(c *gin.Context) {
 		return
 	}
 
-	sshcertificate.AddCertificate(requestData.Name, requestData.Content)
+	if sshcertificate.GetCertificate(requestData.Name) != nil {
+		h.E(c, h.EExists.WithPayload("certificate name was exist"))
+		return
+	}
 
-	h.R(c, api.SuccessfulOption{Success: true})
+	setSSHCertificate(c, requestData)
+}
+
+// @ID UpdateSSHCertificate
+// @Summary Update SSH login private key
+// @Description Replace the private key, the passphrase and the user certificate, the nodes using the key log in with the new one
+// @Tags ssh_certificate
+// @Accept application/json
+// @Produce application/json
+// @Param certificate body api.SSHCertificate true "Certificate information"
+// @Param name path string true "Certificate name"
+// @Success 200 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/ssh_certificates/{name} [put]
+func UpdateSSHCertificate(c *gin.Context) {
+
+	requestData, hasError := getSSHCertificateRequestData(c)
+	if hasError {
+		return
+	}
+
+	if name := c.Param("name"); requestData.Name != name {
+		h.E(c, h.EParamsError.WithPayload(fmt.Sprintf("certificate name %s can not be changed to %s", name, requestData.Name)))
+		return
+	}
+
+	if sshcertificate.GetCertificate(requestData.Name) == nil {
+		h.E(c, h.ENotFound.WithPayload("certificate not exist"))
+		return
+	}
+
+	setSSHCertificate(c, requestData)
+}
+
+// @ID DeleteSSHCertificate
+// @Summary Delete SSH login private key
+// @Description Delete SSH login private key, it's refused if any node logs in with the key
+// @Tags ssh_certificate
+// @Produce application/json
+// @Param name path string true "Certificate name"
+// @Success 204
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/ssh_certificates/{name} [delete]
+func DeleteSSHCertificate(c *gin.Context) {
+
+	name := c.Param("name")
+	if sshcertificate.GetCertificate(name) == nil {
+		h.E(c, h.ENotFound.WithPayload("certificate not exist"))
+		return
+	}
+
+	nodes, err := wizard.GetCurrentWizard().DeleteUnusedPrivateKey(name, sshcertificate.DeleteCertificate)
+	if err != nil {
+		h.E(c, h.EUnknown.WithPayload(err))
+		log.ReqEntry(c).Error(err)
+		return
+	}
+	if len(nodes) > 0 {
+		h.E(c, h.EStatusError.WithPayload(fmt.Sprintf("certificate is used by nodes: %s", strings.Join(nodes, ", "))))
+		return
+	}
+
+	h.R(c, nil)
 }
 
 // @ID GetSSHCertificate
 // @Summary Get SSH login keys list
-// @Description Get SSH login certificate keys list
+// @Description Get SSH login certificate keys list with the key metadata and the nodes using them
 // @Tags ssh_certificate
 // @Produce application/json
 // @Success 200 {object} api.GetSSHCertificateListResponse
 // @Router /api/v1/ssh_certificates [get]
 func GetCertificateList(c *gin.Context) {
 
-	h.R(c, api.GetSSHCertificateListResponse{
-		Names: sshcertificate.GetNameList(),
-	})
+	wizardData := wizard.GetCurrentWizard()
+	certificates := sshcertificate.GetList()
+	response := api.GetSSHCertificateListResponse{
+		Names:        make([]string, 0, len(certificates)),
+		Certificates: make([]api.SSHCertificateSummary, 0, len(certificates)),
+	}
+	for _, certificate := range certificates {
+
+		response.Names = append(response.Names, certificate.Name)
+		response.Certificates = append(response.Certificates, api.SSHCertificateSummary{
+			Name:           certificate.Name,
+			KeyType:        certificate.KeyType,
+			Fingerprint:    certificate.Fingerprint,
+			HasPassphrase:  certificate.HasPassphrase(),
+			HasCertificate: certificate.UserCertificate != "",
+			Nodes:          wizardData.GetNodesUsingPrivateKey(certificate.Name),
+		})
+	}
+
+	h.R(c, response)
+}
+
+func setSSHCertificate(c *gin.Context, requestData *api.SSHCertificate) {
+
+	// the key has been verified by the request validation
+	publicKey, err := requestData.PublicKey()
+	if err != nil {
+		h.E(c, h.EParamsError.WithPayload(err))
+		return
+	}
+
+	err = sshcertificate.SetCertificate(&sshcertificate.Certificate{
+		Name:            requestData.Name,
+		KeyType:         publicKey.Type(),
+		Fingerprint:     ssh.FingerprintSHA256(publicKey),
+		UserCertificate: requestData.Certificate,
+	}, string(requestData.Content), string(requestData.Passphrase))
+	if err != nil {
+		h.E(c, h.EUnknown.WithPayload(err))
+		log.ReqEntry(c).Error(err)
+		return
+	}
+
+	h.R(c, api.SuccessfulOption{Success: true})
 }
 
 func getSSHCertificateRequestData(c *gin.Context) (requestData *api.SSHCertificate, hasError bool) {
@@ -16,16 +16,23 @@ package deploy
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
 
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
 
 func TestAddSSHCertificate(t *testing.T) {
@@ -66,7 +73,7 @@ ls3Q/5aeF7hB2MXfAAAAGEx1Y2t5Ym95c0BMdWNreU1hYy5sb2NhbAEC
 -----END OPENSSH PRIVATE KEY-----`
 	body := api.SSHCertificate{
 		Name:    keyName,
-		Content: privateKey,
+		Content: api.Secret(privateKey),
 	}
 	bodyContent, err := json.Marshal(body)
 	assert.Nil(t, err)
@@ -133,3 +140,94 @@ ls3Q/5aeF7hB2MXfAAAAGEx1Y2t5Ym95c0BMdWNreU1hYy5sb2NhbAEC
 
 	assert.Equal(t, []string{keyName}, responseData.Names)
 }
+
+func newTestPrivateKey(t *testing.T) string {
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
+
+func callSSHCertificateAPI(handler gin.HandlerFunc, method, name string, body *api.SSHCertificate) *httptest.ResponseRecorder {
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	var bodyReader *bytes.Reader
+	if body != nil {
+		bodyContent, _ := json.Marshal(body)
+		bodyReader = bytes.NewReader(bodyContent)
+	} else {
+		bodyReader = bytes.NewReader(nil)
+	}
+	ctx.Request = httptest.NewRequest(method, "/api/v1/ssh_certificates/"+name, bodyReader)
+	ctx.Params = gin.Params{{Key: "name", Value: name}}
+
+	handler(ctx)
+	resp.Flush()
+	fmt.Printf("result: %s\n", resp.Body.String())
+	return resp
+}
+
+func TestUpdateAndDeleteSSHCertificate(t *testing.T) {
+
+	sshcertificate.ClearList()
+	wizard.ClearCurrentWizardData()
+	defer wizard.ClearCurrentWizardData()
+
+	privateKey := newTestPrivateKey(t)
+	resp := callSSHCertificateAPI(AddSSHCertificate, "POST", "", &api.SSHCertificate{Name: "id_rsa", Content: api.Secret(privateKey)})
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	// the name is used
+	resp = callSSHCertificateAPI(AddSSHCertificate, "POST", "", &api.SSHCertificate{Name: "id_rsa", Content: api.Secret(privateKey)})
+	assert.Equal(t, http.StatusConflict, resp.Code)
+
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	assert.Nil(t, err)
+	wizard.GetCurrentWizard().Nodes = []*wizard.Node{
+		{
+			Name: "master1",
+			ConnectionData: wizard.ConnectionData{
+				IP:                 "192.168.1.1",
+				AuthenticationType: wizard.AuthenticationTypePrivateKey,
+				PrivateKeyName:     "id_rsa",
+			},
+		},
+	}
+
+	resp = callSSHCertificateAPI(GetCertificateList, "GET", "", nil)
+	responseData := new(api.GetSSHCertificateListResponse)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, []string{"id_rsa"}, responseData.Names)
+	assert.Equal(t, []api.SSHCertificateSummary{
+		{
+			Name:        "id_rsa",
+			KeyType:     "ssh-rsa",
+			Fingerprint: ssh.FingerprintSHA256(signer.PublicKey()),
+			Nodes:       []string{"master1"},
+		},
+	}, responseData.Certificates)
+
+	// the name can not be changed, and only the existing certificate is updated
+	newPrivateKey := newTestPrivateKey(t)
+	resp = callSSHCertificateAPI(UpdateSSHCertificate, "PUT", "id_rsa", &api.SSHCertificate{Name: "id_rsa2", Content: api.Secret(newPrivateKey)})
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = callSSHCertificateAPI(UpdateSSHCertificate, "PUT", "not_exist", &api.SSHCertificate{Name: "not_exist", Content: api.Secret(newPrivateKey)})
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	resp = callSSHCertificateAPI(UpdateSSHCertificate, "PUT", "id_rsa", &api.SSHCertificate{Name: "id_rsa", Content: api.Secret(newPrivateKey)})
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, newPrivateKey, sshcertificate.GetPrivateKey("id_rsa"))
+
+	// the certificate used by a node can not be deleted
+	resp = callSSHCertificateAPI(DeleteSSHCertificate, "DELETE", "id_rsa", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, resp.Body.String(), "master1")
+	assert.NotNil(t, sshcertificate.GetCertificate("id_rsa"))
+
+	wizard.GetCurrentWizard().Nodes = nil
+	resp = callSSHCertificateAPI(DeleteSSHCertificate, "DELETE", "id_rsa", nil)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Nil(t, sshcertificate.GetCertificate("id_rsa"))
+	resp = callSSHCertificateAPI(DeleteSSHCertificate, "DELETE", "id_rsa", nil)
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+}
@@ -17,6 +17,8 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -26,6 +28,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/service/config"
 	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -50,6 +53,11 @@ func CheckNodeList(c *gin.Context) {
 		return
 	}
 
+	if len(getNodesToCheck()) == 0 {
+		h.E(c, h.ENotFound.WithPayload("No new node to check, all the nodes were deployed"))
+		return
+	}
+
 	if wizardData.GetCheckResult() == constant.CheckResultRunning {
 		h.E(c, h.EStatusError.WithPayload("It was checking"))
 		return
@@ -88,7 +96,7 @@ func CheckNodeList(c *gin.Context) {
 		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
 	}
 
-	go listenCheckNodesData()
+	go listenCheckNodesData(wizardData)
 
 	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
 }
@@ -114,21 +122,19 @@ func GetCheckingNodeListResult(c *gin.Context) {
 
 func getCallCheckNodesData() *protos.CheckNodesRequest {
 
-	requestData := &protos.CheckNodesRequest{}
+	requestData := &protos.CheckNodesRequest{
+		ClusterId: getDeployClusterId(),
+	}
 
 	wizardData := wizard.GetCurrentWizard()
-	for _, node := range wizardData.Nodes {
+	for _, node := range getNodesToCheck() {
 
 		nodeConfig := new(protos.NodeCheckConfig)
 		for _, role := range node.MachineRoles {
 			nodeConfig.Roles = append(nodeConfig.Roles, string(role))
 		}
 
-		nodeConfig.Node = &protos.Node{
-			Name: node.Name,
-			Ip:   node.IP,
-			Ssh:  convertModelConnectionDataToDeployControllerSSHData(&node.ConnectionData),
-		}
+		nodeConfig.Node = convert.ModelNodeToDeployControllerNode(node)
 
 		requestData.Configs = append(requestData.Configs, nodeConfig)
 	}
@@ -152,33 +158,85 @@ func getCallCheckNodesData() *protos.CheckNodesRequest {
 	return requestData
 }
 
-func listenCheckNodesData() {
+// getNodesToCheck returns the nodes to check, only the new nodes are checked if the cluster was deployed.
+func getNodesToCheck() []*wizard.Node {
 
 	wizardData := wizard.GetCurrentWizard()
+	if wizardData.IsDeployed() {
+		return wizardData.GetUndeployedNodes()
+	}
+
+	return wizardData.Nodes
+}
+
+// listenCheckNodesData updates the check result of the wizard cluster
+// whenever the deploy controller sends it, until the check finished.
+// The watch is re-established if it's broken, e.g. by a restart of the deploy controller.
+func listenCheckNodesData(wizardData *wizard.Cluster) {
+
 	for {
+		if err := watchCheckNodesData(wizardData); err != nil {
+			logrus.Errorf("watch check result error, errorMessage: %v", err)
+		}
 		if wizardData.GetCheckResult() != constant.CheckResultRunning {
-			break
+			return
 		}
 
-		refreshCheckResultOneTime()
-		time.Sleep(time.Second)
+		// the stream may be broken before the last result was sent
+		refreshCheckResultOneTime(wizardData)
+		if wizardData.GetCheckResult() != constant.CheckResultRunning {
+			return
+		}
+		time.Sleep(watchRetryInterval)
 	}
 }
 
-func refreshCheckResultOneTime() {
+// watchCheckNodesData receives the check result from the deploy controller until the stream is closed,
+// the deploy controller closes the stream once the check finished.
+func watchCheckNodesData(wizardData *wizard.Cluster) error {
+
+	client := clientUtils.GetDeployController()
+
+	stream, err := client.WatchCheckNodes(context.Background(), &protos.WatchCheckNodesRequest{
+		ClusterId: strconv.FormatUint(wizardData.ClusterId, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		updateCheckResult(wizardData, resp)
+	}
+}
+
+func refreshCheckResultOneTime(wizardData *wizard.Cluster) {
 
 	client := clientUtils.GetDeployController()
 
 	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
 	defer cancel()
 
-	resp, err := client.GetCheckNodesResult(grpcContext, &protos.GetCheckNodesResultRequest{})
+	resp, err := client.GetCheckNodesResult(grpcContext, &protos.GetCheckNodesResultRequest{
+		ClusterId: strconv.FormatUint(wizardData.ClusterId, 10),
+	})
 	if err != nil {
 		logrus.Errorf("call deploy controller error, errorMessage: %v", err)
 		return
 	}
 
-	wizardData := wizard.GetCurrentWizard()
+	updateCheckResult(wizardData, resp)
+}
+
+func updateCheckResult(wizardData *wizard.Cluster, resp *protos.GetCheckNodesResultReply) {
+
 	wizardData.SetClusterCheckResult(
 		convertDeployControllerCheckResultToModelCheckResult(resp.GetStatus()),
 		convertDeployControllerErrorToFailureDetail(resp.GetErr()))
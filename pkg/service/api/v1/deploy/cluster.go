@@ -19,6 +19,7 @@ package deploy
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -51,6 +52,20 @@ func SetCluster(c *gin.Context) {
 		return
 	}
 
+	release, err := constant.GetKubernetesRelease(requestData.KubernetesVersion)
+	if err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+
+	imageRepository, err := constant.GetImageRepository(requestData.ImageRepository)
+	if err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+
 	wizardData.Info.Name = requestData.Name
 	wizardData.Info.ShortName = requestData.ShortName
 	wizardData.Info.KubeAPIServerConnection = wizard.NewKubeAPIServerConnectionData()
@@ -69,6 +84,8 @@ func SetCluster(c *gin.Context) {
 	}
 	wizardData.Info.NodePortMinimum = requestData.NodePortMinimum
 	wizardData.Info.NodePortMaximum = requestData.NodePortMaximum
+	wizardData.Info.KubernetesVersion = release.Version
+	wizardData.Info.ImageRepository = imageRepository
 	wizardData.Info.Labels = make([]*wizard.Label, 0, len(requestData.Labels))
 	for _, label := range requestData.Labels {
 		wizardData.Info.Labels = append(wizardData.Info.Labels, &wizard.Label{
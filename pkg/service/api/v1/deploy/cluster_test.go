@@ -18,12 +18,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
@@ -80,6 +82,8 @@ func TestSetCluster(t *testing.T) {
 	assert.Equal(t, uint16(15999), wizardData.Info.NodePortMaximum)
 	assert.Equal(t, []*wizard.Label{{Key: "label-key", Value: "value"}}, wizardData.Info.Labels)
 	assert.Equal(t, []*wizard.Annotation{{Key: "annotation-key", Value: "value"}}, wizardData.Info.Annotations)
+	assert.Equal(t, constant.DefaultKubeVersion, wizardData.Info.KubernetesVersion)
+	assert.Equal(t, constant.DefaultImageRepository, wizardData.Info.ImageRepository)
 }
 
 func TestSetCluster2(t *testing.T) {
@@ -260,3 +264,42 @@ func TestGetCluster2(t *testing.T) {
 	assert.Equal(t, []api.Label{{Key: "for-test", Value: "yes"}}, responseData.Labels)
 	assert.Equal(t, []api.Annotation{{Key: "comment", Value: "Icanspeakenglish"}}, responseData.Annotations)
 }
+
+func TestSetClusterKubernetesVersion(t *testing.T) {
+
+	setCluster := func(version, repository string) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		body := api.Cluster{
+			Name:                     "cluster-name",
+			ShortName:                "short-name",
+			KubeAPIServerConnectType: api.KubeAPIServerConnectTypeFirstMasterIP,
+			KubernetesVersion:        version,
+			ImageRepository:          repository,
+		}
+		bodyContent, err := json.Marshal(body)
+		assert.Nil(t, err)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/clusters", bytes.NewReader(bodyContent))
+
+		SetCluster(ctx)
+		resp.Flush()
+		return resp
+	}
+
+	resp := setCluster("v1.17.0", "registry.local:5000/kpaas/")
+	assert.Equal(t, http.StatusCreated, resp.Code)
+
+	clusterInfo := getWizardClusterInfo()
+	assert.Equal(t, "1.17.0", clusterInfo.KubernetesVersion)
+	assert.Equal(t, "registry.local:5000/kpaas", clusterInfo.ImageRepository)
+	clusterConfig := buildCallDeployDataClusterPart()
+	assert.Equal(t, "1.17.0", clusterConfig.GetKubernetesVersion())
+	assert.Equal(t, "registry.local:5000/kpaas", clusterConfig.GetImageRepository())
+
+	resp = setCluster("1.10.0", "")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	resp = setCluster("", "https://docker.io/kpaas")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, "1.17.0", wizard.GetCurrentWizard().Info.KubernetesVersion)
+}
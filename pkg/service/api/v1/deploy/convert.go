@@ -21,7 +21,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/common"
-	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
 
@@ -32,6 +32,12 @@ func convertModelAuthenticationTypeToAPIAuthenticationType(authenticationType wi
 		return api.AuthenticationTypePassword
 	case wizard.AuthenticationTypePrivateKey:
 		return api.AuthenticationTypePrivateKey
+	case wizard.AuthenticationTypePrivateKeyWithPassphrase:
+		return api.AuthenticationTypePrivateKeyWithPassphrase
+	case wizard.AuthenticationTypeAgent:
+		return api.AuthenticationTypeAgent
+	case wizard.AuthenticationTypeCertificate:
+		return api.AuthenticationTypeCertificate
 	}
 
 	return api.AuthenticationType(fmt.Sprintf("unknown(%s)", authenticationType))
@@ -144,6 +150,12 @@ func convertAPIAuthenticationTypeToModelAuthenticationType(authenticationType ap
 		return wizard.AuthenticationTypePassword
 	case api.AuthenticationTypePrivateKey:
 		return wizard.AuthenticationTypePrivateKey
+	case api.AuthenticationTypePrivateKeyWithPassphrase:
+		return wizard.AuthenticationTypePrivateKeyWithPassphrase
+	case api.AuthenticationTypeAgent:
+		return wizard.AuthenticationTypeAgent
+	case api.AuthenticationTypeCertificate:
+		return wizard.AuthenticationTypeCertificate
 	}
 
 	return wizard.AuthenticationType(fmt.Sprintf("unknown(%s)", authenticationType))
@@ -173,8 +185,12 @@ func convertModelNodeToAPINode(node *wizard.Node) *api.NodeData {
 			DockerRootDirectory: node.DockerRootDirectory,
 		},
 		ConnectionData: api.ConnectionData{
-			IP:   node.IP,
-			Port: node.Port,
+			ConnectionType:      api.ConnectionType(node.ConnectionType),
+			IP:                  node.IP,
+			Port:                node.Port,
+			HostKeyFingerprint:  node.HostKeyFingerprint,
+			JumpHosts:           convertModelJumpHostsToAPIJumpHosts(node.JumpHosts),
+			PrivilegeEscalation: convertModelEscalationToAPIPrivilegeEscalation(&node.ConnectionData),
 			SSHLoginData: api.SSHLoginData{
 				Username:           node.Username,
 				AuthenticationType: convertModelAuthenticationTypeToAPIAuthenticationType(node.AuthenticationType),
@@ -210,28 +226,93 @@ func convertDeployControllerErrorToFailureDetail(err *protos.Error) *common.Fail
 	}
 }
 
-func convertModelConnectionDataToDeployControllerSSHData(data *wizard.ConnectionData) *protos.SSH {
+func convertAPIConnectionTypeToModelConnectionType(connectionType api.ConnectionType) wizard.ConnectionType {
 
-	if data == nil {
+	if connectionType == api.ConnectionTypeLocal {
+		return wizard.ConnectionTypeLocal
+	}
+	return wizard.ConnectionTypeSSH
+}
+
+// convertModelEscalationToAPIPrivilegeEscalation doesn't return the password
+func convertModelEscalationToAPIPrivilegeEscalation(data *wizard.ConnectionData) *api.PrivilegeEscalation {
+
+	if data.EscalationMethod == wizard.EscalationMethodNone {
 		return nil
 	}
 
-	auth := &protos.Auth{
-		Username: data.Username,
+	return &api.PrivilegeEscalation{
+		Method: api.EscalationMethod(data.EscalationMethod),
 	}
-	switch data.AuthenticationType {
-	case wizard.AuthenticationTypePassword:
-		auth.Type = deployControllerAuthCredentialPassword
-		auth.Credential = data.Password
-	case wizard.AuthenticationTypePrivateKey:
-		auth.Type = deployControllerAuthCredentialPrivateKey
-		auth.Credential = sshcertificate.GetPrivateKey(data.PrivateKeyName)
+}
+
+// convertAPIPrivilegeEscalationToModelEscalation returns the method and the credential ID of the password
+func convertAPIPrivilegeEscalationToModelEscalation(escalation *api.PrivilegeEscalation) (wizard.EscalationMethod, string, error) {
+
+	if escalation == nil {
+		return wizard.EscalationMethodNone, "", nil
+	}
+
+	passwordID, err := addSecret(escalation.Password)
+	return wizard.EscalationMethod(escalation.Method), passwordID, err
+}
+
+// convertAPIJumpHostsToModelJumpHosts stores the passwords of the jump hosts as credentials
+func convertAPIJumpHostsToModelJumpHosts(jumpHosts []api.JumpHost) ([]*wizard.JumpHost, error) {
+
+	if len(jumpHosts) == 0 {
+		return nil, nil
+	}
+
+	result := make([]*wizard.JumpHost, 0, len(jumpHosts))
+	for _, jumpHost := range jumpHosts {
+		modelJumpHost := &wizard.JumpHost{
+			IP:                 jumpHost.IP,
+			Port:               jumpHost.Port,
+			Username:           jumpHost.Username,
+			AuthenticationType: convertAPIAuthenticationTypeToModelAuthenticationType(jumpHost.AuthenticationType),
+			HostKeyFingerprint: jumpHost.HostKeyFingerprint,
+		}
+		switch jumpHost.AuthenticationType {
+		case api.AuthenticationTypePassword:
+			passwordID, err := addSecret(jumpHost.Password)
+			if err != nil {
+				for _, added := range result {
+					credential.Delete(added.PasswordID)
+				}
+				return nil, err
+			}
+			modelJumpHost.PasswordID = passwordID
+		case api.AuthenticationTypePrivateKey, api.AuthenticationTypePrivateKeyWithPassphrase, api.AuthenticationTypeCertificate:
+			modelJumpHost.PrivateKeyName = jumpHost.PrivateKeyName
+		}
+		result = append(result, modelJumpHost)
 	}
 
-	return &protos.SSH{
-		Port: uint32(data.Port),
-		Auth: auth,
+	return result, nil
+}
+
+func convertModelJumpHostsToAPIJumpHosts(jumpHosts []*wizard.JumpHost) []api.JumpHost {
+
+	if len(jumpHosts) == 0 {
+		return nil
+	}
+
+	result := make([]api.JumpHost, 0, len(jumpHosts))
+	for _, jumpHost := range jumpHosts {
+		result = append(result, api.JumpHost{
+			SSHLoginData: api.SSHLoginData{
+				Username:           jumpHost.Username,
+				AuthenticationType: convertModelAuthenticationTypeToAPIAuthenticationType(jumpHost.AuthenticationType),
+				PrivateKeyName:     jumpHost.PrivateKeyName,
+			},
+			IP:                 jumpHost.IP,
+			Port:               jumpHost.Port,
+			HostKeyFingerprint: jumpHost.HostKeyFingerprint,
+		})
 	}
+
+	return result
 }
 
 func convertDeployControllerCheckResultToModelCheckResult(status string) constant.CheckResult {
@@ -285,3 +366,33 @@ func convertDeployControllerDeployResultToModelDeployResult(status string) wizar
 
 	return wizard.DeployStatus(fmt.Sprintf("unknown(%s)", status))
 }
+
+func convertDeployControllerTaskPlanToAPIDeploymentPlan(plan *protos.TaskPlan) api.DeploymentPlan {
+
+	deploymentPlan := api.DeploymentPlan{
+		Name:         plan.GetName(),
+		Type:         plan.GetType(),
+		Dependencies: plan.GetDependencies(),
+	}
+	for _, subTask := range plan.GetSubTasks() {
+		deploymentPlan.SubTasks = append(deploymentPlan.SubTasks, convertDeployControllerTaskPlanToAPIDeploymentPlan(subTask))
+	}
+	for _, action := range plan.GetActions() {
+		actionPlan := api.DeploymentActionPlan{
+			Name:     action.GetName(),
+			Type:     action.GetType(),
+			NodeName: action.GetNodeName(),
+			Commands: action.GetCommands(),
+			Error:    convertDeployControllerErrorToAPIError(action.GetErr()),
+		}
+		for _, file := range action.GetFiles() {
+			actionPlan.Files = append(actionPlan.Files, api.DeploymentPlanFile{
+				Path:    file.GetPath(),
+				Content: file.GetContent(),
+			})
+		}
+		deploymentPlan.Actions = append(deploymentPlan.Actions, actionPlan)
+	}
+
+	return deploymentPlan
+}
@@ -17,6 +17,8 @@ package deploy
 import (
 	"context"
 	"fmt"
+	"io"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -27,6 +29,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/service/api/v1/helm"
 	"github.com/kpaas-io/kpaas/pkg/service/config"
 	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/common"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
@@ -34,9 +37,12 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
 )
 
+// watchRetryInterval is the interval to re-establish the watch of a broken stream from the deploy controller.
+const watchRetryInterval = time.Second
+
 // @ID LaunchDeployment
 // @Summary Launch deployment
-// @Description Launch deployment
+// @Description Launch deployment, or resume the latest deployment of the deployed cluster if it was not fully successful, the deployed nodes are skipped and the failed nodes are deployed again
 // @Tags deploy
 // @Produce application/json
 // @Success 201 {object} api.SuccessfulOption
@@ -50,6 +56,16 @@ func Deploy(c *gin.Context) {
 		return
 	}
 
+	if wizardData.IsDeployed() {
+		switch wizardData.GetDeployClusterStatus() {
+		case wizard.DeployClusterStatusFailed, wizard.DeployClusterStatusWorkedButHaveError:
+			resumeDeploy(c, wizardData)
+		default:
+			h.E(c, h.EStatusError.WithPayload("It was deployed, please scale out the cluster to deploy the new nodes"))
+		}
+		return
+	}
+
 	if !checkClusterConfiguration() {
 		h.E(c, h.EStatusError.WithPayload("current cluster configuration check is not passed"))
 		return
@@ -95,11 +111,91 @@ func Deploy(c *gin.Context) {
 	}
 
 	go deployNetwork()
-	go listenDeploymentData()
+	go listenDeploymentData(wizardData)
 
 	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
 }
 
+// resumeDeploy resumes the latest deployment of the deployed cluster which was not fully successful, it may be
+// the deployment or a scale-out. The deployed nodes are skipped, so the failed nodes, e.g. an etcd member which
+// can't be deployed by scale-out, are deployed again.
+func resumeDeploy(c *gin.Context, wizardData *wizard.Cluster) {
+
+	// the network was not deployed if the cluster failed to deploy
+	deployingNetwork := wizardData.GetDeployClusterStatus() == wizard.DeployClusterStatusFailed
+
+	failedNodes := wizardData.GetUndeployedNodes()
+	wizardData.ClearNodesDeployData(failedNodes)
+
+	if err := wizardData.MarkNodeDeploying(); err != nil {
+		h.E(c, h.EStatusError.WithPayload(err))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.ResumeDeploy(grpcContext, &protos.ResumeDeployRequest{ClusterId: getDeployClusterId()})
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		wizardData.ClearNodesDeployData(failedNodes)
+		return
+	}
+
+	if resp.GetErr() != nil {
+
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+	}
+
+	if deployingNetwork {
+		go deployNetwork()
+	}
+	go listenDeploymentData(wizardData)
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
+}
+
+// @ID GetDeploymentPlan
+// @Summary Get the plan of deployment
+// @Description Get what the deployment will do on each node, including the scripts and configurations which will be put to the node and the commands which will be run, nothing is done on the nodes
+// @Tags deploy
+// @Produce application/json
+// @Success 200 {object} api.DeploymentPlan
+// @Failure 404 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/plans [get]
+func GetDeployPlan(c *gin.Context) {
+
+	wizardData := wizard.GetCurrentWizard()
+	if len(wizardData.Nodes) == 0 {
+		h.E(c, h.ENotFound.WithPayload("No node information, node list is empty, please add node information"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.PlanDeploy(grpcContext, getCallDeployData())
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		return
+	}
+
+	if resp.GetErr() != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(resp.GetErr().GetDetail()))
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+		return
+	}
+
+	h.R(c, convertDeployControllerTaskPlanToAPIDeploymentPlan(resp.GetPlan()))
+}
+
 // @ID GetDeploymentReport
 // @Summary Get the result of deployment
 // @Description Get the result of the deployment
@@ -120,30 +216,73 @@ func GetDeployReport(c *gin.Context) {
 	h.R(c, responseData)
 }
 
+// @ID CancelDeployment
+// @Summary Cancel deployment
+// @Description Cancel the running deployment, the running steps will be interrupted and the pending steps will be aborted
+// @Tags deploy
+// @Produce application/json
+// @Success 204 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/deploys [delete]
+func CancelDeploy(c *gin.Context) {
+
+	wizardData := wizard.GetCurrentWizard()
+	if wizardData.GetDeployClusterStatus() != wizard.DeployClusterStatusRunning {
+		h.E(c, h.EStatusError.WithPayload("It was not deploying"))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.CancelDeploy(grpcContext, &protos.CancelDeployRequest{
+		ClusterId: getDeployClusterId(),
+	})
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		return
+	}
+
+	if resp.GetErr() != nil {
+
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+	}
+
+	refreshDeployResultOneTime(wizardData)
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
+}
+
 func getCallDeployData() *protos.DeployRequest {
 
 	return &protos.DeployRequest{
-		NodeConfigs:   buildCallDeployDataNodesPart(),
+		NodeConfigs:   buildCallDeployDataNodesPart(wizard.GetCurrentWizard().Nodes),
 		ClusterConfig: buildCallDeployDataClusterPart(),
+		ClusterId:     getDeployClusterId(),
 	}
 }
 
-func buildCallDeployDataNodesPart() (nodeConfigs []*protos.NodeDeployConfig) {
+// getDeployClusterId returns the id of the current wizard cluster, deploy controller
+// uses it to distinguish the check and deploy tasks of different clusters.
+func getDeployClusterId() string {
 
-	wizardData := wizard.GetCurrentWizard()
-	nodeConfigs = make([]*protos.NodeDeployConfig, 0, len(wizardData.Nodes))
-	for _, node := range wizardData.Nodes {
+	return strconv.FormatUint(wizard.GetCurrentWizard().ClusterId, 10)
+}
+
+func buildCallDeployDataNodesPart(nodes []*wizard.Node) (nodeConfigs []*protos.NodeDeployConfig) {
+
+	nodeConfigs = make([]*protos.NodeDeployConfig, 0, len(nodes))
+	for _, node := range nodes {
 
 		nodeConfig := new(protos.NodeDeployConfig)
 		for _, role := range node.MachineRoles {
 			nodeConfig.Roles = append(nodeConfig.Roles, string(role))
 		}
 
-		nodeConfig.Node = &protos.Node{
-			Name: node.Name,
-			Ip:   node.IP,
-			Ssh:  convertModelConnectionDataToDeployControllerSSHData(&node.ConnectionData),
-		}
+		nodeConfig.Node = convert.ModelNodeToDeployControllerNode(node)
 
 		nodeConfig.Labels = make(map[string]string)
 		for _, label := range node.Labels {
@@ -179,8 +318,10 @@ func buildCallDeployDataClusterPart() (clusterConfig *protos.ClusterConfig) {
 			From: uint32(wizardData.Info.NodePortMinimum),
 			To:   uint32(wizardData.Info.NodePortMaximum),
 		},
-		NodeLabels:      make(map[string]string),
-		NodeAnnotations: make(map[string]string),
+		NodeLabels:        make(map[string]string),
+		NodeAnnotations:   make(map[string]string),
+		KubernetesVersion: wizardData.Info.KubernetesVersion,
+		ImageRepository:   wizardData.Info.ImageRepository,
 	}
 
 	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
@@ -207,33 +348,74 @@ func buildCallDeployDataClusterPart() (clusterConfig *protos.ClusterConfig) {
 	return
 }
 
-func listenDeploymentData() {
+// listenDeploymentData updates the deployment result of the wizard cluster
+// whenever the deploy controller sends it, until the deployment finished.
+// The watch is re-established if it's broken, e.g. by a restart of the deploy controller.
+func listenDeploymentData(wizardData *wizard.Cluster) {
 
-	wizardData := wizard.GetCurrentWizard()
 	for {
+		if err := watchDeploymentData(wizardData); err != nil {
+			logrus.Errorf("watch deployment result error, errorMessage: %v", err)
+		}
 		if wizardData.GetDeployClusterStatus() != wizard.DeployClusterStatusRunning {
-			break
+			return
 		}
 
-		refreshDeployResultOneTime()
-		time.Sleep(time.Second)
+		// the stream may be broken before the last result was sent
+		refreshDeployResultOneTime(wizardData)
+		if wizardData.GetDeployClusterStatus() != wizard.DeployClusterStatusRunning {
+			return
+		}
+		time.Sleep(watchRetryInterval)
 	}
 }
 
-func refreshDeployResultOneTime() {
+// watchDeploymentData receives the deployment result from the deploy controller until the stream is closed,
+// the deploy controller closes the stream once the deployment finished.
+func watchDeploymentData(wizardData *wizard.Cluster) error {
+
+	client := clientUtils.GetDeployController()
+
+	stream, err := client.WatchDeploy(context.Background(), &protos.WatchDeployRequest{
+		ClusterId: strconv.FormatUint(wizardData.ClusterId, 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		updateDeployResult(wizardData, resp)
+	}
+}
+
+func refreshDeployResultOneTime(wizardData *wizard.Cluster) {
 
 	client := clientUtils.GetDeployController()
 
 	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
 	defer cancel()
 
-	resp, err := client.GetDeployResult(grpcContext, &protos.GetDeployResultRequest{})
+	resp, err := client.GetDeployResult(grpcContext, &protos.GetDeployResultRequest{
+		ClusterId: strconv.FormatUint(wizardData.ClusterId, 10),
+	})
 	if err != nil {
 		logrus.Errorf("call deploy controller error, errorMessage: %v", err)
 		return
 	}
 
-	wizardData := wizard.GetCurrentWizard()
+	updateDeployResult(wizardData, resp)
+}
+
+func updateDeployResult(wizardData *wizard.Cluster, resp *protos.GetDeployResultReply) {
+
 	wizardData.SetClusterDeploymentStatus(
 		computeClusterDeployStatus(resp),
 		convertDeployControllerErrorToFailureDetail(resp.GetErr()))
@@ -266,7 +448,7 @@ func refreshDeployResultOneTime() {
 	switch wizardData.DeployClusterStatus {
 	case wizard.DeployClusterStatusSuccessful, wizard.DeployClusterStatusWorkedButHaveError:
 
-		fetchKubeConfigContent()
+		fetchKubeConfigContent(wizardData)
 	}
 
 }
@@ -297,9 +479,8 @@ func computeClusterDeployStatus(resp *protos.GetDeployResultReply) wizard.Deploy
 	return wizard.DeployClusterStatusFailed
 }
 
-func fetchKubeConfigContent() {
+func fetchKubeConfigContent(wizardData *wizard.Cluster) {
 
-	wizardData := wizard.GetCurrentWizard()
 	client := clientUtils.GetDeployController()
 	ctx := context.Background()
 
@@ -316,11 +497,7 @@ func fetchKubeConfigContent() {
 		return
 	}
 
-	fetchResponse, err := client.FetchKubeConfig(ctx, &protos.FetchKubeConfigRequest{Node: &protos.Node{
-		Name: node.Name,
-		Ip:   node.IP,
-		Ssh:  convertModelConnectionDataToDeployControllerSSHData(&node.ConnectionData),
-	}})
+	fetchResponse, err := client.FetchKubeConfig(ctx, &protos.FetchKubeConfigRequest{Node: convert.ModelNodeToDeployControllerNode(node)})
 
 	if err != nil {
 		logrus.Errorf("Call gRPC deploy controller error, errorMessage: %v", err)
@@ -389,7 +566,7 @@ func installCalicoNetwork(options *api.CalicoOptions, clusterName string) error
 	calicoValues := api.HelmValues{}
 	if options != nil {
 		calicoValues["encap_mode"] = string(options.EncapsulationMode)
-		calicoValues["vxlan_port"] = string(options.VxlanPort)
+		calicoValues["vxlan_port"] = strconv.Itoa(options.VxlanPort)
 		calicoValues["ipv4_pool"] = options.InitialPodIPs
 		calicoValues["ip_detection.method"] = string(options.IPDetectionMethod)
 		if options.IPDetectionMethod == api.IPDetectionMethodInterface {
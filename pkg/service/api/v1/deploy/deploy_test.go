@@ -15,6 +15,7 @@
 package deploy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -24,9 +25,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -119,6 +123,44 @@ func TestDeploy3(t *testing.T) {
 	assert.True(t, responseData.Success)
 }
 
+func TestDeployResume(t *testing.T) {
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	master1 := newScaleOutTestNode("master1", "192.168.31.101", true, constant.MachineRoleMaster, constant.MachineRoleEtcd)
+	etcd2 := newScaleOutTestNode("etcd2", "192.168.31.102", false, constant.MachineRoleEtcd)
+	etcd2.SetDeployResult(constant.DeployItemEtcd, wizard.DeployStatusFailed, nil)
+	wizardData.Nodes = []*wizard.Node{master1, etcd2}
+
+	callDeploy := func() *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		gin.SetMode(gin.TestMode)
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/deploys", nil)
+		Deploy(ctx)
+		resp.Flush()
+		return resp
+	}
+
+	// the deployed cluster can't be deployed again
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	resp := callDeploy()
+	responseData := new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+
+	// the failed etcd member is deployed again by resuming the deployment, the deployed master is kept
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusWorkedButHaveError
+	resp = callDeploy()
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	successful := new(api.SuccessfulOption)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), successful))
+	assert.True(t, successful.Success)
+	assert.NotContains(t, etcd2.DeploymentReports, constant.DeployItemEtcd)
+	assert.True(t, master1.IsDeployed())
+}
+
 func TestGetDeployReport(t *testing.T) {
 
 	wizard.ClearCurrentWizardData()
@@ -178,6 +220,70 @@ func TestGetDeployReport(t *testing.T) {
 	assert.Nil(t, responseData.DeployClusterError)
 }
 
+func TestCancelDeploy(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("DELETE", "/api/v1/deploy/wizard/deploys", nil)
+
+	CancelDeploy(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusRunning
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("DELETE", "/api/v1/deploy/wizard/deploys", nil)
+
+	CancelDeploy(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestGetDeployPlan(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/plans", nil)
+
+	GetDeployPlan(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	wizardData := wizard.GetCurrentWizard()
+	node := wizard.NewNode()
+	node.Name = "master1"
+	node.MachineRoles = []constant.MachineRole{constant.MachineRoleMaster}
+	wizardData.Nodes = []*wizard.Node{node}
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/plans", nil)
+
+	GetDeployPlan(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusOK, resp.Code)
+	plan := new(api.DeploymentPlan)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), plan))
+	assert.Equal(t, "Deploy", plan.Type)
+	if assert.Len(t, plan.SubTasks, 1) && assert.Len(t, plan.SubTasks[0].Actions, 1) {
+		assert.Equal(t, "master1", plan.SubTasks[0].Actions[0].NodeName)
+		assert.NotEmpty(t, plan.SubTasks[0].Actions[0].Commands)
+	}
+	// planning doesn't change the deploy status
+	assert.Equal(t, wizard.DeployClusterStatusPending, wizardData.GetDeployClusterStatus())
+}
+
 func TestFetchKubeConfigContent(t *testing.T) {
 
 	tests := []struct {
@@ -192,7 +298,7 @@ func TestFetchKubeConfigContent(t *testing.T) {
 						Port:               22,
 						Username:           "root",
 						AuthenticationType: wizard.AuthenticationTypePassword,
-						Password:           "123456",
+						PasswordID:         "credential-1",
 					},
 					Name:         "k8s-master1",
 					MachineRoles: []constant.MachineRole{constant.MachineRoleMaster},
@@ -207,7 +313,7 @@ func TestFetchKubeConfigContent(t *testing.T) {
 		wizard.ClearCurrentWizardData()
 		wizardData := wizard.GetCurrentWizard()
 		wizardData.Nodes = test.OriginNodeList
-		fetchKubeConfigContent()
+		fetchKubeConfigContent(wizardData)
 		assert.Equal(t, test.WantKubeConfig, *wizardData.KubeConfig)
 	}
 }
@@ -352,3 +458,51 @@ func sortRoles(roles []api.DeploymentResponseData) []api.DeploymentResponseData
 	})
 	return roles
 }
+
+// brokenWatchDeployController breaks the first watches of the deployment,
+// and reports the deployment is running until the watch works.
+type brokenWatchDeployController struct {
+	*mock.DeployController
+	clusterId     string
+	brokenWatches int
+	watches       int
+}
+
+func (c *brokenWatchDeployController) WatchDeploy(ctx context.Context, in *protos.WatchDeployRequest, opts ...grpc.CallOption) (protos.DeployContoller_WatchDeployClient, error) {
+
+	if in.GetClusterId() != c.clusterId {
+		return c.DeployController.WatchDeploy(ctx, in, opts...)
+	}
+
+	c.watches++
+	if c.watches <= c.brokenWatches {
+		return nil, fmt.Errorf("deploy controller is restarting")
+	}
+	return c.DeployController.WatchDeploy(ctx, in, opts...)
+}
+
+func (c *brokenWatchDeployController) GetDeployResult(ctx context.Context, in *protos.GetDeployResultRequest, opts ...grpc.CallOption) (*protos.GetDeployResultReply, error) {
+
+	if in.GetClusterId() != c.clusterId {
+		return c.DeployController.GetDeployResult(ctx, in, opts...)
+	}
+	return &protos.GetDeployResultReply{Status: string(constant.OperationStatusRunning)}, nil
+}
+
+func TestListenDeploymentDataRewatches(t *testing.T) {
+
+	client := &brokenWatchDeployController{DeployController: new(mock.DeployController), clusterId: "12345", brokenWatches: 1}
+	grpcClient.SetDeployController(client)
+	defer grpcClient.SetDeployController(mock.NewDeployController())
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.ClusterId = 12345
+	wizardData.SetClusterDeploymentStatus(wizard.DeployClusterStatusRunning, nil)
+
+	listenDeploymentData(wizardData)
+
+	// the watch is re-established after it was broken, until the deployment finished
+	assert.Equal(t, 2, client.watches)
+	assert.Equal(t, wizard.DeployClusterStatusSuccessful, wizardData.GetDeployClusterStatus())
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package deploy
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+// @ID GetNodeHostKey
+// @Summary Get the ssh host key of a node
+// @Description Get the pinned ssh host key fingerprint of a node, and the fingerprint of the changed host key which is waiting for review
+// @Tags node
+// @Produce application/json
+// @Param ip path string true "Node IP Address"
+// @Success 200 {object} api.HostKey
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/nodes/{ip}/hostkeys [get]
+func GetNodeHostKey(c *gin.Context) {
+
+	ip := c.Param("ip")
+	if len(ip) == 0 {
+
+		h.E(c, h.EParamsError.WithPayload("path parameter \"ip\" required"))
+		return
+	}
+
+	node := wizard.GetCurrentWizard().GetNode(ip)
+	if node == nil {
+
+		h.E(c, h.ENotFound.WithPayload("node ip not exist"))
+		return
+	}
+
+	h.R(c, api.HostKey{
+		Fingerprint:        node.HostKeyFingerprint,
+		PendingFingerprint: node.PendingHostKeyFingerprint,
+	})
+}
+
+// @ID AcceptNodeHostKey
+// @Summary Accept or rotate the ssh host key of a node
+// @Description Pin the ssh host key fingerprint of a node, it's used to accept a changed host key after review or rotate the host key
+// @Tags node
+// @Accept application/json
+// @Produce application/json
+// @Param ip path string true "Node IP Address"
+// @Param hostKey body api.AcceptHostKeyRequest true "Host key fingerprint"
+// @Success 200 {object} api.HostKey
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/nodes/{ip}/hostkeys [put]
+func AcceptNodeHostKey(c *gin.Context) {
+
+	ip := c.Param("ip")
+	if len(ip) == 0 {
+
+		h.E(c, h.EParamsError.WithPayload("path parameter \"ip\" required"))
+		return
+	}
+
+	requestData := new(api.AcceptHostKeyRequest)
+	if err := validator.Params(c, requestData); err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, err)
+		return
+	}
+
+	if err := wizard.GetCurrentWizard().AcceptHostKey(ip, requestData.Fingerprint); err != nil {
+		h.E(c, err)
+		log.ReqEntry(c).Info(err)
+		return
+	}
+
+	log.ReqEntry(c).WithField("fingerprint", requestData.Fingerprint).Infof("host key of node %s is pinned", ip)
+	h.R(c, api.HostKey{Fingerprint: requestData.Fingerprint})
+}
+
+// @ID ResetNodeHostKey
+// @Summary Reset the ssh host key of a node
+// @Description Remove the pinned ssh host key fingerprint of a node, the host key will be trusted on the next connection test
+// @Tags node
+// @Produce application/json
+// @Param ip path string true "Node IP Address"
+// @Success 204
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/nodes/{ip}/hostkeys [delete]
+func ResetNodeHostKey(c *gin.Context) {
+
+	ip := c.Param("ip")
+	if len(ip) == 0 {
+
+		h.E(c, h.EParamsError.WithPayload("path parameter \"ip\" required"))
+		return
+	}
+
+	if err := wizard.GetCurrentWizard().ResetHostKey(ip); err != nil {
+		h.E(c, err)
+		log.ReqEntry(c).Info(err)
+		return
+	}
+
+	log.ReqEntry(c).Infof("host key of node %s is reset", ip)
+	h.R(c, nil)
+}
@@ -0,0 +1,141 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+const rotatedHostKeyFingerprint = "SHA256:cm90YXRlZCBob3N0IGtleQ"
+
+func TestNodeHostKey(t *testing.T) {
+
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	wizardData.Nodes = []*wizard.Node{
+		{
+			Name: "master1",
+			ConnectionData: wizard.ConnectionData{
+				IP:                 "192.168.31.140",
+				Port:               22,
+				Username:           "root",
+				AuthenticationType: wizard.AuthenticationTypePassword,
+				PasswordID:         "credential-1",
+			},
+		},
+	}
+	grpcClient.SetDeployController(mock.NewDeployController())
+	gin.SetMode(gin.TestMode)
+
+	getHostKey := func() (int, *api.HostKey) {
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/nodes/192.168.31.140/hostkeys", nil)
+		ctx.Params = gin.Params{{Key: "ip", Value: "192.168.31.140"}}
+		GetNodeHostKey(ctx)
+		resp.Flush()
+		hostKey := new(api.HostKey)
+		json.Unmarshal(resp.Body.Bytes(), hostKey)
+		return resp.Code, hostKey
+	}
+	testConnection := func() *api.TestConnectionResponse {
+		bodyContent, err := json.Marshal(api.ConnectionData{
+			IP:   "192.168.31.140",
+			Port: 22,
+			SSHLoginData: api.SSHLoginData{
+				Username:           "root",
+				AuthenticationType: api.AuthenticationTypePassword,
+				Password:           "123456",
+			},
+		})
+		assert.Nil(t, err)
+		resp := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(resp)
+		ctx.Request = httptest.NewRequest("POST", "/api/v1/ssh/tests", bytes.NewReader(bodyContent))
+		TestConnectNode(ctx)
+		resp.Flush()
+		responseData := new(api.TestConnectionResponse)
+		assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+		return responseData
+	}
+
+	code, hostKey := getHostKey()
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, hostKey.Fingerprint)
+
+	// the host key is trusted on first use
+	result := testConnection()
+	assert.True(t, result.Success)
+	assert.Equal(t, mock.MockHostKeyFingerprint, result.HostKeyFingerprint)
+	_, hostKey = getHostKey()
+	assert.Equal(t, mock.MockHostKeyFingerprint, hostKey.Fingerprint)
+
+	// rotate the pinned host key, the key presented by the node is kept for review
+	resp := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("PUT", "/api/v1/deploy/wizard/nodes/192.168.31.140/hostkeys",
+		bytes.NewReader([]byte(`{"fingerprint":"`+rotatedHostKeyFingerprint+`"}`)))
+	ctx.Params = gin.Params{{Key: "ip", Value: "192.168.31.140"}}
+	AcceptNodeHostKey(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	result = testConnection()
+	assert.False(t, result.Success)
+	_, hostKey = getHostKey()
+	assert.Equal(t, rotatedHostKeyFingerprint, hostKey.Fingerprint)
+	assert.Equal(t, mock.MockHostKeyFingerprint, hostKey.PendingFingerprint)
+
+	// an invalid fingerprint can't be accepted
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("PUT", "/api/v1/deploy/wizard/nodes/192.168.31.140/hostkeys",
+		bytes.NewReader([]byte(`{"fingerprint":"md5:xx"}`)))
+	ctx.Params = gin.Params{{Key: "ip", Value: "192.168.31.140"}}
+	AcceptNodeHostKey(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	// reset the host key, it will be trusted again on next use
+	resp = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("DELETE", "/api/v1/deploy/wizard/nodes/192.168.31.140/hostkeys", nil)
+	ctx.Params = gin.Params{{Key: "ip", Value: "192.168.31.140"}}
+	ResetNodeHostKey(ctx)
+	resp.Flush()
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Empty(t, resp.Body.String())
+
+	result = testConnection()
+	assert.True(t, result.Success)
+	_, hostKey = getHostKey()
+	assert.Equal(t, mock.MockHostKeyFingerprint, hostKey.Fingerprint)
+	assert.Empty(t, hostKey.PendingFingerprint)
+
+	wizardData.Nodes = nil
+	code, _ = getHostKey()
+	assert.Equal(t, http.StatusNotFound, code)
+}
@@ -43,7 +43,7 @@ func DownloadKubeConfig(c *gin.Context) {
 		*wizardData.KubeConfig == "" {
 		h.E(c, h.ENotFound.WithPayload("kubeconfig file has not ready yet, try it later"))
 
-		fetchKubeConfigContent()
+		fetchKubeConfigContent(wizardData)
 		return
 	}
 
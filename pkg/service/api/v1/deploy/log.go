@@ -15,12 +15,17 @@
 package deploy
 
 import (
+	"io"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
 )
 
 // @ID DownloadLog
@@ -55,3 +60,59 @@ func DownloadLog(c *gin.Context) {
 
 	h.R(c, string(content))
 }
+
+// @ID TailDeployLog
+// @Summary Follow the deployment log of a node
+// @Description Follow the deployment log of a node in a role as server-sent events, a "log" event is sent whenever the node writes some log, and an "end" event is sent once the deployment finished
+// @Tags log
+// @Produce text/event-stream
+// @Param nodeName query string true "Node name"
+// @Param role query string true "Deploy role of the node" Enums(master, worker, etcd, ingress)
+// @Success 200 {object} api.DeployLog "data of the log event"
+// @Failure 400 {object} h.AppErr
+// @Failure 500 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/livelogs [get]
+func TailDeployLog(c *gin.Context) {
+
+	logger := log.ReqEntry(c)
+	requestData := new(api.TailDeployLogRequest)
+	if err := c.ShouldBindQuery(requestData); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+	if err := requestData.Validate(); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+	// The stream is closed once the request is done, e.g. the client went away.
+	stream, err := client.TailDeployLog(c.Request.Context(), &protos.TailDeployLogRequest{
+		ClusterId: getDeployClusterId(),
+		Role:      string(requestData.Role),
+		NodeName:  requestData.NodeName,
+	})
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		return
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			c.SSEvent("end", "")
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Info("failed to receive deploy log")
+			c.SSEvent("error", err.Error())
+			return
+		}
+
+		c.SSEvent("log", &api.DeployLog{
+			ActionName: reply.GetActionName(),
+			Log:        string(reply.GetLog()),
+		})
+		c.Writer.Flush()
+	}
+}
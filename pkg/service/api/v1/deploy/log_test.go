@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
@@ -26,6 +27,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 )
@@ -132,3 +135,36 @@ func TestDownloadLog_IDEcmpty(t *testing.T) {
 
 	assert.Equal(t, h.ENotFound.Status, resp.Code)
 }
+
+func TestTailDeployLog(t *testing.T) {
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/livelogs?nodeName=worker1&role=worker", nil)
+
+	TailDeployLog(ctx)
+	resp.Flush()
+	fmt.Printf("result: %s\n", resp.Body.String())
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "text/event-stream", resp.Header().Get("Content-Type"))
+	assert.Equal(t, "event:log\ndata:{\"actionName\":\"deploy-worker-action\",\"log\":\"log of worker1\"}\n\n"+
+		"event:end\ndata:\n\n", resp.Body.String())
+}
+
+func TestTailDeployLog_ParamsInvalid(t *testing.T) {
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("GET", "/api/v1/deploy/wizard/livelogs?nodeName=worker1&role=invalid", nil)
+
+	TailDeployLog(ctx)
+	resp.Flush()
+	fmt.Printf("result: %s\n", resp.Body.String())
+
+	assert.Equal(t, h.EParamsError.Status, resp.Code)
+}
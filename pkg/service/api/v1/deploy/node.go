@@ -17,9 +17,12 @@
 package deploy
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
 	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
@@ -117,22 +120,25 @@ func AddNode(c *gin.Context) {
 
 	node.IP = requestData.IP
 	node.Port = requestData.Port
+	node.HostKeyFingerprint = requestData.HostKeyFingerprint
+	node.ConnectionType = convertAPIConnectionTypeToModelConnectionType(requestData.ConnectionType)
 	node.Username = requestData.Username
 	node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(requestData.AuthenticationType)
-	switch requestData.AuthenticationType {
-	case api.AuthenticationTypePassword:
-		node.Password = requestData.Password
-	case api.AuthenticationTypePrivateKey:
-		node.PrivateKeyName = requestData.PrivateKeyName
+	if err := setNodeCredentials(node, &requestData.SSHLoginData, requestData.JumpHosts, requestData.PrivilegeEscalation); err != nil {
+		h.E(c, h.EUnknown.WithPayload(err))
+		log.ReqEntry(c).Error(err)
+		return
 	}
 
 	err := wizard.GetCurrentWizard().AddNode(node)
 	if err != nil {
+		credential.Delete(node.CredentialIDs()...)
 		h.E(c, err)
 		log.ReqEntry(c).Info(err)
 		return
 	}
 
+	requestData.Redact()
 	h.R(c, requestData)
 }
 
@@ -183,32 +189,36 @@ func UpdateNode(c *gin.Context) {
 
 	node.IP = ip
 	node.Port = requestData.Port
+	node.ConnectionType = convertAPIConnectionTypeToModelConnectionType(requestData.ConnectionType)
 	node.Username = requestData.Username
 	node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(requestData.AuthenticationType)
-	switch requestData.AuthenticationType {
-	case api.AuthenticationTypePassword:
-		if len(requestData.Password) > 0 {
-			node.Password = requestData.Password
-		}
-	case api.AuthenticationTypePrivateKey:
-		node.PrivateKeyName = requestData.PrivateKeyName
+	if err := setNodeCredentials(node, &requestData.SSHLoginData, requestData.JumpHosts, requestData.PrivilegeEscalation); err != nil {
+		h.E(c, h.EUnknown.WithPayload(err))
+		log.ReqEntry(c).Error(err)
+		return
 	}
 
 	err := wizard.GetCurrentWizard().UpdateNode(node)
 	if err != nil {
+		credential.Delete(node.CredentialIDs()...)
 		h.E(c, err)
 		log.ReqEntry(c).Info(err)
 		return
 	}
 
-	h.R(c, api.NodeData{
+	responseData := api.NodeData{
 		NodeBaseData: requestData.NodeBaseData,
 		ConnectionData: api.ConnectionData{
-			IP:           ip,
-			Port:         requestData.Port,
-			SSHLoginData: requestData.SSHLoginData,
+			ConnectionType:      api.ConnectionType(node.ConnectionType),
+			IP:                  ip,
+			Port:                requestData.Port,
+			SSHLoginData:        requestData.SSHLoginData,
+			JumpHosts:           requestData.JumpHosts,
+			PrivilegeEscalation: convertModelEscalationToAPIPrivilegeEscalation(&node.ConnectionData),
 		},
-	})
+	}
+	responseData.Redact()
+	h.R(c, responseData)
 }
 
 // @ID DeleteNode
@@ -240,6 +250,43 @@ func DeleteNode(c *gin.Context) {
 	h.R(c, nil)
 }
 
+// setNodeCredentials stores the passwords in the request as the credentials of the node, the password is not set if it's empty
+// or redacted, which means it's not changed by an update.
+func setNodeCredentials(node *wizard.Node, login *api.SSHLoginData, jumpHosts []api.JumpHost, escalation *api.PrivilegeEscalation) (err error) {
+
+	defer func() {
+		if err != nil {
+			credential.Delete(node.CredentialIDs()...)
+		}
+	}()
+
+	if node.JumpHosts, err = convertAPIJumpHostsToModelJumpHosts(jumpHosts); err != nil {
+		return err
+	}
+
+	if node.EscalationMethod, node.EscalationPasswordID, err = convertAPIPrivilegeEscalationToModelEscalation(escalation); err != nil {
+		return err
+	}
+
+	switch login.AuthenticationType {
+	case api.AuthenticationTypePassword:
+		node.PasswordID, err = addSecret(login.Password)
+	case api.AuthenticationTypePrivateKey, api.AuthenticationTypePrivateKeyWithPassphrase, api.AuthenticationTypeCertificate:
+		node.PrivateKeyName = login.PrivateKeyName
+	}
+
+	return err
+}
+
+// addSecret stores the secret as a credential and returns its ID, no credential is stored if the secret is empty or redacted.
+func addSecret(secret api.Secret) (string, error) {
+
+	if secret.IsRedacted() {
+		return "", nil
+	}
+	return credential.Add(string(secret))
+}
+
 func getNodeRequestData(c *gin.Context) (*api.NodeData, bool) {
 
 	requestData := new(api.NodeData)
@@ -251,12 +298,14 @@ func getNodeRequestData(c *gin.Context) (*api.NodeData, bool) {
 		return nil, true
 	}
 
-	if requestData.AuthenticationType == api.AuthenticationTypePrivateKey {
-		validateFunction := validator.ValidateStringOptions(requestData.PrivateKeyName, "privateKeyName", sshcertificate.GetNameList())
-		if err := validateFunction(); err != nil {
-			h.E(c, h.EParamsError.WithPayload(err))
-			return nil, true
-		}
+	if err := validatePrivateKeyName(&requestData.SSHLoginData, "privateKeyName"); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err))
+		return nil, true
+	}
+
+	if err := validateJumpHostsPrivateKeyName(requestData.JumpHosts); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err))
+		return nil, true
 	}
 
 	logger = logger.WithField("data", requestData)
@@ -288,14 +337,51 @@ func getUpdateNodeRequestData(c *gin.Context) (*api.UpdateNodeData, string, bool
 		return nil, "", true
 	}
 
-	if requestData.AuthenticationType == api.AuthenticationTypePrivateKey {
-		validateFunction := validator.ValidateStringOptions(requestData.PrivateKeyName, "privateKeyName", sshcertificate.GetNameList())
-		if err := validateFunction(); err != nil {
-			h.E(c, h.EParamsError.WithPayload(err))
-			return nil, "", true
-		}
+	if err := validatePrivateKeyName(&requestData.SSHLoginData, "privateKeyName"); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err))
+		return nil, "", true
+	}
+
+	if err := validateJumpHostsPrivateKeyName(requestData.JumpHosts); err != nil {
+		h.E(c, h.EParamsError.WithPayload(err))
+		return nil, "", true
 	}
 
 	logger.WithField("data", requestData)
 	return requestData, ip, false
 }
+
+func validateJumpHostsPrivateKeyName(jumpHosts []api.JumpHost) error {
+
+	for index := range jumpHosts {
+		if err := validatePrivateKeyName(&jumpHosts[index].SSHLoginData, "jumpHosts.privateKeyName"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePrivateKeyName checks the private key used by the login is added, and it has the passphrase
+// or the certificate required by the authorization type.
+func validatePrivateKeyName(login *api.SSHLoginData, keyName string) error {
+
+	if !login.AuthenticationType.UsePrivateKey() {
+		return nil
+	}
+
+	validateFunction := validator.ValidateStringOptions(login.PrivateKeyName, keyName, sshcertificate.GetNameList())
+	if err := validateFunction(); err != nil {
+		return err
+	}
+
+	certificate := sshcertificate.GetCertificate(login.PrivateKeyName)
+	switch {
+	case login.AuthenticationType == api.AuthenticationTypePrivateKeyWithPassphrase && !certificate.HasPassphrase():
+		return fmt.Errorf("%s has no passphrase", keyName)
+	case login.AuthenticationType == api.AuthenticationTypeCertificate && certificate.UserCertificate == "":
+		return fmt.Errorf("%s has no certificate", keyName)
+	}
+
+	return nil
+}
@@ -27,6 +27,8 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 )
@@ -81,7 +83,12 @@ func TestAddNode(t *testing.T) {
 	err = json.Unmarshal(resp.Body.Bytes(), responseData)
 	assert.Nil(t, err)
 
+	// the password is redacted in the response, and kept as a credential of the node
+	body.Password = api.RedactedSecret
 	assert.Equal(t, body, *responseData)
+	password, err := credential.Get(wizard.GetCurrentWizard().GetNode("192.168.30.140").PasswordID)
+	assert.Nil(t, err)
+	assert.Equal(t, "123456", password)
 }
 
 func TestUpdateNode(t *testing.T) {
@@ -112,7 +119,7 @@ func TestUpdateNode(t *testing.T) {
 				Port:               22,
 				Username:           "kpaas",
 				AuthenticationType: wizard.AuthenticationTypePassword,
-				Password:           "123456",
+				PasswordID:         "credential-1",
 			},
 		},
 	}
@@ -142,8 +149,9 @@ func TestUpdateNode(t *testing.T) {
 			DockerRootDirectory: "/var/lib/docker",
 		},
 		ConnectionData: api.ConnectionData{
-			IP:   "192.168.31.140",
-			Port: uint16(22),
+			ConnectionType: api.ConnectionTypeSSH,
+			IP:             "192.168.31.140",
+			Port:           uint16(22),
 			SSHLoginData: api.SSHLoginData{
 				Username:           "root",
 				AuthenticationType: api.AuthenticationTypePassword,
@@ -170,6 +178,7 @@ func TestUpdateNode(t *testing.T) {
 	err = json.Unmarshal(resp.Body.Bytes(), responseData)
 	assert.Nil(t, err)
 
+	body.Password = api.RedactedSecret
 	assert.Equal(t, body, *responseData)
 }
 
@@ -261,7 +270,7 @@ func TestDeleteNode(t *testing.T) {
 				Port:               22,
 				Username:           "kpaas",
 				AuthenticationType: wizard.AuthenticationTypePassword,
-				Password:           "123456",
+				PasswordID:         "credential-1",
 			},
 		},
 	}
@@ -312,7 +321,7 @@ func TestGetNodeList(t *testing.T) {
 				Port:               22,
 				Username:           "kpaas",
 				AuthenticationType: wizard.AuthenticationTypePassword,
-				Password:           "123456",
+				PasswordID:         "credential-1",
 			},
 		},
 	}
@@ -393,7 +402,7 @@ func TestGetNode(t *testing.T) {
 				Port:               22,
 				Username:           "kpaas",
 				AuthenticationType: wizard.AuthenticationTypePassword,
-				Password:           "123456",
+				PasswordID:         "credential-1",
 			},
 		},
 	}
@@ -448,3 +457,45 @@ func TestGetNode(t *testing.T) {
 		},
 	}, *responseData)
 }
+
+func TestValidatePrivateKeyName(t *testing.T) {
+
+	sshcertificate.ClearList()
+	sshcertificate.AddCertificate("id_rsa", "private key")
+	sshcertificate.SetCertificate(&sshcertificate.Certificate{Name: "id_rsa_cert", UserCertificate: "ssh-rsa-cert-v01@openssh.com AAAA"}, "private key", "passphrase")
+	defer sshcertificate.ClearList()
+
+	tests := []struct {
+		Input api.SSHLoginData
+		Want  error
+	}{
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypeAgent},
+			Want:  nil,
+		},
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypePrivateKey, PrivateKeyName: "not_exist"},
+			Want:  fmt.Errorf("privateKeyName not in specify options"),
+		},
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypePrivateKey, PrivateKeyName: "id_rsa"},
+			Want:  nil,
+		},
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypePrivateKeyWithPassphrase, PrivateKeyName: "id_rsa"},
+			Want:  fmt.Errorf("privateKeyName has no passphrase"),
+		},
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypeCertificate, PrivateKeyName: "id_rsa"},
+			Want:  fmt.Errorf("privateKeyName has no certificate"),
+		},
+		{
+			Input: api.SSHLoginData{AuthenticationType: api.AuthenticationTypeCertificate, PrivateKeyName: "id_rsa_cert"},
+			Want:  nil,
+		},
+	}
+
+	for _, item := range tests {
+		assert.Equal(t, item.Want, validatePrivateKeyName(&item.Input, "privateKeyName"))
+	}
+}
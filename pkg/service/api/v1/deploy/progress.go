@@ -72,10 +72,12 @@ func getWizardClusterInfo() *api.Cluster {
 
 	wizardData := wizard.GetCurrentWizard()
 	clusterInfo := &api.Cluster{
-		ShortName:       wizardData.Info.ShortName,
-		Name:            wizardData.Info.Name,
-		NodePortMinimum: wizardData.Info.NodePortMinimum,
-		NodePortMaximum: wizardData.Info.NodePortMaximum,
+		ShortName:         wizardData.Info.ShortName,
+		Name:              wizardData.Info.Name,
+		NodePortMinimum:   wizardData.Info.NodePortMinimum,
+		NodePortMaximum:   wizardData.Info.NodePortMaximum,
+		KubernetesVersion: wizardData.Info.KubernetesVersion,
+		ImageRepository:   wizardData.Info.ImageRepository,
 	}
 
 	switch wizardData.Info.KubeAPIServerConnection.KubeAPIServerConnectType {
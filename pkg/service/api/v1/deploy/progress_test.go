@@ -119,7 +119,7 @@ func TestGetWizardProgress3(t *testing.T) {
 				Port:               22,
 				Username:           "kpaas",
 				AuthenticationType: wizard.AuthenticationTypePassword,
-				Password:           "123456",
+				PasswordID:         "credential-1",
 			},
 			CheckReport: &wizard.CheckReport{
 				CheckItems:  make([]*wizard.CheckItem, 0),
@@ -141,7 +141,7 @@ func TestGetWizardProgress3(t *testing.T) {
 	assert.Equal(t, uint16(22), node.Port)
 	assert.Equal(t, "kpaas", node.Username)
 	assert.Equal(t, api.AuthenticationTypePassword, node.AuthenticationType)
-	assert.Equal(t, "", node.Password)
+	assert.Equal(t, api.Secret(""), node.Password)
 	assert.Equal(t, "", node.PrivateKeyName)
 }
 
@@ -0,0 +1,114 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/config"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+)
+
+// @ID ScaleOutCluster
+// @Summary Scale out the deployed cluster
+// @Description Deploy the new nodes which were added after the cluster was deployed, only the new nodes are initialized and joined to the cluster. The new nodes must be checked before.
+// @Tags deploy
+// @Produce application/json
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Failure 404 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/scaleouts [post]
+func ScaleOut(c *gin.Context) {
+
+	wizardData := wizard.GetCurrentWizard()
+	if !wizardData.IsDeployed() {
+		h.E(c, h.EStatusError.WithPayload("The cluster was not deployed, please deploy it"))
+		return
+	}
+
+	newNodes := wizardData.GetUndeployedNodes()
+	if len(newNodes) == 0 {
+		h.E(c, h.ENotFound.WithPayload("No new node, please add the nodes to scale out the cluster"))
+		return
+	}
+
+	if wizardData.GetCheckResult() != constant.CheckResultSuccessful {
+		h.E(c, h.EStatusError.WithPayload("current check result status is not passed"))
+		return
+	}
+
+	if wizardData.GetDeployClusterStatus() == wizard.DeployClusterStatusRunning {
+		h.E(c, h.EStatusError.WithPayload("It was deploying"))
+		return
+	}
+
+	requestData := getCallScaleOutData(wizardData, newNodes)
+
+	wizardData.ClearNodesDeployData(newNodes)
+
+	if err := wizardData.MarkNodeDeploying(); err != nil {
+		h.E(c, h.EStatusError.WithPayload(err))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.ScaleOut(grpcContext, requestData)
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		wizardData.ClearNodesDeployData(newNodes)
+		return
+	}
+
+	if resp.GetErr() != nil {
+
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+	}
+
+	go listenDeploymentData(wizardData)
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
+}
+
+// getCallScaleOutData returns the request to deploy the new nodes, the new nodes join the cluster
+// by the deployed masters.
+func getCallScaleOutData(wizardData *wizard.Cluster, newNodes []*wizard.Node) *protos.ScaleOutRequest {
+
+	masters := wizardData.GetDeployedMasters()
+	masterNodes := make([]*protos.Node, 0, len(masters))
+	for _, node := range masters {
+		masterNodes = append(masterNodes, convert.ModelNodeToDeployControllerNode(node))
+	}
+
+	return &protos.ScaleOutRequest{
+		NodeConfigs:   buildCallDeployDataNodesPart(newNodes),
+		MasterNodes:   masterNodes,
+		ClusterConfig: buildCallDeployDataClusterPart(),
+		ClusterId:     getDeployClusterId(),
+	}
+}
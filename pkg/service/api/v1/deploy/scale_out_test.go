@@ -0,0 +1,120 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+)
+
+func newScaleOutTestNode(name, ip string, deployed bool, roles ...constant.MachineRole) *wizard.Node {
+
+	node := wizard.NewNode()
+	node.Name = name
+	node.IP = ip
+	node.MachineRoles = roles
+	if deployed {
+		for _, role := range roles {
+			node.SetDeployResult(constant.DeployItem(role), wizard.DeployStatusSuccessful, nil)
+		}
+	}
+	return node
+}
+
+func callScaleOut() *httptest.ResponseRecorder {
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/scaleouts", nil)
+
+	ScaleOut(ctx)
+	resp.Flush()
+	return resp
+}
+
+func TestScaleOut(t *testing.T) {
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	master1 := newScaleOutTestNode("master1", "192.168.31.101", false, constant.MachineRoleMaster, constant.MachineRoleEtcd)
+	wizardData.Nodes = []*wizard.Node{master1}
+
+	// the cluster was not deployed
+	resp := callScaleOut()
+	responseData := new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+
+	// no new node
+	master1.SetDeployResult(constant.DeployItemMaster, wizard.DeployStatusSuccessful, nil)
+	master1.SetDeployResult(constant.DeployItemEtcd, wizard.DeployStatusSuccessful, nil)
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	resp = callScaleOut()
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	// the new nodes were not checked
+	master2 := newScaleOutTestNode("master2", "192.168.31.102", false, constant.MachineRoleMaster)
+	worker1 := newScaleOutTestNode("worker1", "192.168.31.111", false, constant.MachineRoleWorker)
+	wizardData.Nodes = append(wizardData.Nodes, master2, worker1)
+	resp = callScaleOut()
+	responseData = new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+
+	// only the new nodes are checked and deployed, they join the cluster by the deployed master
+	checkRequest := getCallCheckNodesData()
+	assert.Len(t, checkRequest.GetConfigs(), 2)
+	assert.Equal(t, "master2", checkRequest.GetConfigs()[0].GetNode().GetName())
+
+	scaleOutRequest := getCallScaleOutData(wizardData, wizardData.GetUndeployedNodes())
+	assert.Len(t, scaleOutRequest.GetNodeConfigs(), 2)
+	assert.Equal(t, "worker1", scaleOutRequest.GetNodeConfigs()[1].GetNode().GetName())
+	assert.Len(t, scaleOutRequest.GetMasterNodes(), 1)
+	assert.Equal(t, "master1", scaleOutRequest.GetMasterNodes()[0].GetName())
+	assert.Equal(t, getDeployClusterId(), scaleOutRequest.GetClusterId())
+
+	wizardData.ClusterCheckResult = constant.CheckResultSuccessful
+	resp = callScaleOut()
+	successData := new(api.SuccessfulOption)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), successData))
+	assert.True(t, successData.Success)
+	// the deployed nodes keep their deployment reports
+	assert.True(t, master1.IsDeployed())
+
+	// a successfully deployed cluster can't be deployed again
+	resp = httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(resp)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/deploys", nil)
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	Deploy(ctx)
+	responseData = new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+	assert.Contains(t, responseData.Payload, "scale out")
+}
@@ -22,16 +22,17 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	"github.com/kpaas-io/kpaas/pkg/service/config"
 	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
-	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 const (
-	deployControllerAuthCredentialPassword   = "password"
-	deployControllerAuthCredentialPrivateKey = "privatekey"
+	deployControllerHostKeyMismatched = "host key mismatched"
 )
 
 // @ID TestSSH
@@ -58,40 +59,81 @@ func TestConnectNode(c *gin.Context) {
 	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
 	defer cancel()
 
-	resp, err := client.TestConnection(grpcContext, getCallTestConnectionData(requestData))
+	wizardData := wizard.GetCurrentWizard()
+	// verify the host key against the pinned one if the node has been added
+	if node := wizardData.GetNode(requestData.IP); node != nil {
+		if requestData.HostKeyFingerprint == "" {
+			requestData.HostKeyFingerprint = node.HostKeyFingerprint
+		}
+		fillPinnedJumpHostKeys(requestData.JumpHosts, node.JumpHosts)
+	}
+
+	// the credentials of the connection are only kept during the test
+	node := wizard.NewNode()
+	node.AuthenticationType = convertAPIAuthenticationTypeToModelAuthenticationType(requestData.AuthenticationType)
+	if err := setNodeCredentials(node, &requestData.SSHLoginData, requestData.JumpHosts, nil); err != nil {
+		h.E(c, h.EUnknown.WithPayload(err))
+		log.ReqEntry(c).Error(err)
+		return
+	}
+	defer credential.Delete(node.CredentialIDs()...)
+
+	resp, err := client.TestConnection(grpcContext, getCallTestConnectionData(requestData, node))
 	if err != nil {
 		h.E(c, h.EDeployControllerError.WithPayload(err))
 		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
 		return
 	}
 
+	if resp.GetPassed() || resp.GetErr().GetReason() == deployControllerHostKeyMismatched {
+		wizardData.RecordHostKey(requestData.IP, resp.GetHostKeyFingerprint())
+		// the jump hosts are all connected before the host key of the node is verified
+		for i, fingerprint := range resp.GetJumpHostKeyFingerprints() {
+			if i < len(requestData.JumpHosts) {
+				jumpHost := requestData.JumpHosts[i]
+				wizardData.RecordJumpHostKey(requestData.IP, jumpHost.IP, jumpHost.Port, fingerprint)
+			}
+		}
+	}
+
 	h.R(c, api.TestConnectionResponse{
-		SuccessfulOption: api.SuccessfulOption{Success: resp.GetPassed()},
-		Error:            convertDeployControllerErrorToAPIError(resp.GetErr()),
+		SuccessfulOption:        api.SuccessfulOption{Success: resp.GetPassed()},
+		Error:                   convertDeployControllerErrorToAPIError(resp.GetErr()),
+		HostKeyFingerprint:      resp.GetHostKeyFingerprint(),
+		FailedHop:               resp.GetFailedHop(),
+		JumpHostKeyFingerprints: resp.GetJumpHostKeyFingerprints(),
 	})
 }
 
-func getCallTestConnectionData(requestData *api.ConnectionData) *protos.TestConnectionRequest {
-
-	auth := &protos.Auth{
-		Username: requestData.Username,
+// fillPinnedJumpHostKeys verifies the jump hosts against the host keys pinned for the same jump hosts of the node,
+// unless there are fingerprints in the request.
+func fillPinnedJumpHostKeys(jumpHosts []api.JumpHost, pinned []*wizard.JumpHost) {
+
+	for i := range jumpHosts {
+		if jumpHosts[i].HostKeyFingerprint != "" {
+			continue
+		}
+		for _, pinnedJumpHost := range pinned {
+			if pinnedJumpHost.IP == jumpHosts[i].IP && pinnedJumpHost.Port == jumpHosts[i].Port {
+				jumpHosts[i].HostKeyFingerprint = pinnedJumpHost.HostKeyFingerprint
+			}
+		}
 	}
+}
 
-	switch requestData.AuthenticationType {
-	case api.AuthenticationTypePassword:
-		auth.Type = deployControllerAuthCredentialPassword
-		auth.Credential = requestData.Password
-	case api.AuthenticationTypePrivateKey:
-		auth.Type = deployControllerAuthCredentialPrivateKey
-		auth.Credential = sshcertificate.GetPrivateKey(requestData.PrivateKeyName)
-	}
+func getCallTestConnectionData(requestData *api.ConnectionData, node *wizard.Node) *protos.TestConnectionRequest {
+
+	auth := convert.ModelLoginToDeployControllerAuth(requestData.Username, node.AuthenticationType, node.PasswordID, node.PrivateKeyName)
 
 	return &protos.TestConnectionRequest{Node: &protos.Node{
-		Name: requestData.IP,
-		Ip:   requestData.IP,
+		Name:           requestData.IP,
+		Ip:             requestData.IP,
+		ConnectionType: string(convertAPIConnectionTypeToModelConnectionType(requestData.ConnectionType)),
 		Ssh: &protos.SSH{
-			Port: uint32(requestData.Port),
-			Auth: auth,
+			Port:               uint32(requestData.Port),
+			Auth:               auth,
+			HostKeyFingerprint: requestData.HostKeyFingerprint,
+			JumpHosts:          convert.ModelJumpHostsToDeployControllerJumpHosts(node.JumpHosts),
 		},
 	}}
 }
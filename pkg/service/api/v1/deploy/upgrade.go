@@ -0,0 +1,137 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/config"
+	clientUtils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
+// @ID UpgradeCluster
+// @Summary Upgrade the deployed cluster
+// @Description Upgrade the kubernetes of the deployed nodes to the version, the masters are upgraded one by one from the first one, then the other nodes. The kubernetes version of the cluster is changed to the version once the upgrade succeeded.
+// @Tags deploy
+// @Accept application/json
+// @Produce application/json
+// @Param upgrade body api.UpgradeCluster true "RequiredFields: kubernetesVersion"
+// @Success 201 {object} api.SuccessfulOption
+// @Failure 400 {object} h.AppErr
+// @Router /api/v1/deploy/wizard/upgrades [post]
+func UpgradeCluster(c *gin.Context) {
+
+	requestData := new(api.UpgradeCluster)
+	if err := validator.Params(c, requestData); err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, err)
+		return
+	}
+
+	release, err := constant.GetKubernetesRelease(requestData.KubernetesVersion)
+	if err != nil {
+		log.ReqEntry(c).Info(err)
+		h.E(c, h.EParamsError.WithPayload(err.Error()))
+		return
+	}
+
+	wizardData := wizard.GetCurrentWizard()
+	if !wizardData.IsDeployed() {
+		h.E(c, h.EStatusError.WithPayload("The cluster was not deployed, please deploy it"))
+		return
+	}
+
+	if wizardData.GetDeployClusterStatus() == wizard.DeployClusterStatusRunning {
+		h.E(c, h.EStatusError.WithPayload("It was deploying"))
+		return
+	}
+
+	upgradeData := getCallUpgradeClusterData(wizardData, release.Version)
+	previousStatus := wizardData.GetDeployClusterStatus()
+
+	if err := wizardData.MarkNodeDeploying(); err != nil {
+		h.E(c, h.EStatusError.WithPayload(err))
+		return
+	}
+
+	client := clientUtils.GetDeployController()
+
+	grpcContext, cancel := context.WithTimeout(context.Background(), config.Config.DeployController.GetTimeout())
+	defer cancel()
+
+	resp, err := client.UpgradeCluster(grpcContext, upgradeData)
+	if err != nil {
+		h.E(c, h.EDeployControllerError.WithPayload(err))
+		log.ReqEntry(c).Errorf("call deploy controller error, errorMessage: %v", err)
+		wizardData.SetClusterDeploymentStatus(previousStatus, nil)
+		return
+	}
+
+	if resp.GetErr() != nil {
+
+		log.ReqEntry(c).Errorf("call deploy controller result error, error: %#v", resp.GetErr())
+	}
+
+	go listenUpgradeData(wizardData, release.Version)
+
+	h.R(c, api.SuccessfulOption{Success: resp.GetAccepted()})
+}
+
+// getCallUpgradeClusterData returns the request to upgrade the deployed nodes to the version,
+// the nodes other than the masters are upgraded as workers.
+func getCallUpgradeClusterData(wizardData *wizard.Cluster, version string) *protos.UpgradeClusterRequest {
+
+	masters := wizardData.GetDeployedMasters()
+	masterNodes := make([]*protos.Node, 0, len(masters))
+	for _, node := range masters {
+		masterNodes = append(masterNodes, convert.ModelNodeToDeployControllerNode(node))
+	}
+
+	workerNodes := make([]*protos.Node, 0)
+	for _, node := range wizardData.Nodes {
+		if node.IsDeployed() && !node.IsMatchMachineRole(constant.MachineRoleMaster) {
+			workerNodes = append(workerNodes, convert.ModelNodeToDeployControllerNode(node))
+		}
+	}
+
+	return &protos.UpgradeClusterRequest{
+		MasterNodes:       masterNodes,
+		WorkerNodes:       workerNodes,
+		ClusterConfig:     buildCallDeployDataClusterPart(),
+		KubernetesVersion: version,
+		ClusterId:         getDeployClusterId(),
+	}
+}
+
+// listenUpgradeData receives the upgrade result like the deployment, and changes the kubernetes version
+// of the cluster to the version once the upgrade succeeded, so the nodes deployed later are at the version.
+func listenUpgradeData(wizardData *wizard.Cluster, version string) {
+
+	listenDeploymentData(wizardData)
+
+	if wizardData.GetDeployClusterStatus() == wizard.DeployClusterStatusSuccessful {
+		wizardData.Info.KubernetesVersion = version
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/constant"
+	grpcClient "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/mock"
+	"github.com/kpaas-io/kpaas/pkg/service/model/api"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+	"github.com/kpaas-io/kpaas/pkg/utils/h"
+)
+
+func callUpgradeCluster(t *testing.T, version string) *httptest.ResponseRecorder {
+
+	resp := httptest.NewRecorder()
+	gin.SetMode(gin.TestMode)
+	ctx, _ := gin.CreateTestContext(resp)
+	bodyContent, err := json.Marshal(&api.UpgradeCluster{KubernetesVersion: version})
+	assert.Nil(t, err)
+	ctx.Request = httptest.NewRequest("POST", "/api/v1/deploy/wizard/upgrades", bytes.NewReader(bodyContent))
+
+	UpgradeCluster(ctx)
+	resp.Flush()
+	return resp
+}
+
+func TestUpgradeCluster(t *testing.T) {
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+	wizard.ClearCurrentWizardData()
+	wizardData := wizard.GetCurrentWizard()
+	master1 := newScaleOutTestNode("master1", "192.168.31.101", false, constant.MachineRoleMaster, constant.MachineRoleEtcd)
+	worker1 := newScaleOutTestNode("worker1", "192.168.31.111", true, constant.MachineRoleWorker)
+	worker2 := newScaleOutTestNode("worker2", "192.168.31.112", false, constant.MachineRoleWorker)
+	wizardData.Nodes = []*wizard.Node{master1, worker1, worker2}
+
+	// the version is not supported
+	resp := callUpgradeCluster(t, "1.0.0")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	// the cluster was not deployed
+	resp = callUpgradeCluster(t, "1.17.0")
+	responseData := new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+
+	// only the deployed nodes are upgraded, the cluster config is at the current version
+	master1.SetDeployResult(constant.DeployItemMaster, wizard.DeployStatusSuccessful, nil)
+	master1.SetDeployResult(constant.DeployItemEtcd, wizard.DeployStatusSuccessful, nil)
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	upgradeRequest := getCallUpgradeClusterData(wizardData, "1.17.0")
+	assert.Len(t, upgradeRequest.GetMasterNodes(), 1)
+	assert.Equal(t, "master1", upgradeRequest.GetMasterNodes()[0].GetName())
+	assert.Len(t, upgradeRequest.GetWorkerNodes(), 1)
+	assert.Equal(t, "worker1", upgradeRequest.GetWorkerNodes()[0].GetName())
+	assert.Equal(t, constant.DefaultKubeVersion, upgradeRequest.GetClusterConfig().GetKubernetesVersion())
+	assert.Equal(t, "1.17.0", upgradeRequest.GetKubernetesVersion())
+	assert.Equal(t, getDeployClusterId(), upgradeRequest.GetClusterId())
+
+	// the cluster can't be upgraded while it's deploying
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusRunning
+	resp = callUpgradeCluster(t, "1.17.0")
+	responseData = new(h.AppErr)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), responseData))
+	assert.Equal(t, h.EStatusError.Msg, responseData.Msg)
+
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusSuccessful
+	resp = callUpgradeCluster(t, "1.17.0")
+	assert.Equal(t, http.StatusCreated, resp.Code)
+	successData := new(api.SuccessfulOption)
+	assert.Nil(t, json.Unmarshal(resp.Body.Bytes(), successData))
+	assert.True(t, successData.Success)
+}
+
+func TestListenUpgradeData(t *testing.T) {
+
+	grpcClient.SetDeployController(mock.NewDeployController())
+	wizardData := wizard.NewCluster()
+	wizardData.DeployClusterStatus = wizard.DeployClusterStatusRunning
+
+	// the version of the cluster is changed once the upgrade succeeded
+	listenUpgradeData(wizardData, "1.17.0")
+	assert.Equal(t, wizard.DeployClusterStatusSuccessful, wizardData.GetDeployClusterStatus())
+	assert.Equal(t, "1.17.0", wizardData.Info.KubernetesVersion)
+}
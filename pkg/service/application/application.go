@@ -30,10 +30,14 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/service/config"
 	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/connection"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/store"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 	configUtils "github.com/kpaas-io/kpaas/pkg/utils/config"
 	"github.com/kpaas-io/kpaas/pkg/utils/idcreator"
 	"github.com/kpaas-io/kpaas/pkg/utils/log"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
 )
 
 type (
@@ -65,6 +69,8 @@ func (a *app) initService() {
 	a.initLogLevel()
 	a.initRandomSeed()
 	a.initSnowFlake()
+	a.initVault()
+	a.initStore()
 	a.initClients()
 	a.initMemoriesData()
 	a.initRESTfulAPIHandler()
@@ -120,6 +126,7 @@ func (a *app) close() {
 	a.ClearMemoryData()
 	a.closeHTTPServer()
 	a.closeGRPCClient()
+	a.closeStore()
 }
 
 func (a *app) loadConfig() {
@@ -136,6 +143,8 @@ func (a *app) parseParameters() {
 	a.parseParameterListenPort()
 	a.parseParameterLogLevel()
 	a.parseParameterServiceId()
+	a.parseParameterStateFile()
+	a.parseParameterVaultMasterKeyFile()
 }
 
 func (a *app) parseParameterListenPort() {
@@ -171,6 +180,64 @@ func (a *app) parseParameterServiceId() {
 	logrus.Infof("serviceId: %d", config.Config.Service.GetServiceId())
 }
 
+func (a *app) parseParameterVaultMasterKeyFile() {
+
+	masterKeyFile, err := pflag.CommandLine.GetString(FlagVaultMasterKeyFile)
+	if err == nil && masterKeyFile != "" {
+		config.Config.Vault.MasterKeyFile = masterKeyFile
+	}
+	logrus.Infof("vault master key file: %s", config.Config.Vault.MasterKeyFile)
+}
+
+func (a *app) parseParameterStateFile() {
+
+	stateFile, err := pflag.CommandLine.GetString(FlagStateFile)
+	if err == nil && stateFile != "" {
+		config.Config.Service.StateFile = stateFile
+	}
+	logrus.Infof("state file: %s", config.Config.Service.StateFile)
+}
+
+func (a *app) initStore() {
+
+	if config.Config.Service.StateFile != "" {
+		stateStore, err := store.NewBoltStore(config.Config.Service.StateFile)
+		if err != nil {
+			logrus.Fatalf("open state store error: %v", err)
+		}
+		if err = store.SetDefault(stateStore); err != nil {
+			logrus.Warnf("close memory state store error: %v", err)
+		}
+		if config.Config.Vault.MasterKeyFile == "" {
+			logrus.Warn("no vault master key file, the persisted ssh certificates can not be restored after restart")
+		}
+	}
+
+	if err := sshcertificate.Load(); err != nil {
+		logrus.Fatalf("load ssh certificates error: %v", err)
+	}
+	logrus.Debug("state store init succeed")
+}
+
+func (a *app) initVault() {
+
+	if config.Config.Vault.MasterKeyFile == "" {
+		logrus.Warn("no vault master key file, the credentials are sealed by a random key and sent opened to deploy controller")
+		return
+	}
+
+	masterKey, err := vault.LoadMasterKey(config.Config.Vault.MasterKeyFile)
+	if err != nil {
+		logrus.Fatalf("load vault master key error: %v", err)
+	}
+	credentialVault, err := vault.New(masterKey)
+	if err != nil {
+		logrus.Fatalf("init vault error: %v", err)
+	}
+	credential.SetVault(credentialVault, true)
+	logrus.Debug("vault init succeed")
+}
+
 func (a *app) initRESTfulAPIHandler() {
 
 	logrus.Debug("start to init restful api service handler")
@@ -248,6 +315,13 @@ func (a *app) ClearMemoryData() {
 	wizard.ClearCurrentWizardData()
 }
 
+func (a *app) closeStore() {
+
+	if err := store.Close(); err != nil {
+		logrus.Warnf("close state store error: %v", err)
+	}
+}
+
 func (a *app) closeGRPCClient() {
 
 	var err error
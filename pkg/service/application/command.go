@@ -22,10 +22,12 @@ import (
 )
 
 const (
-	FlagPort       = "port"
-	FlagLogLevel   = "log-level"
-	FlagConfigFile = "config-file"
-	FlagServiceId  = "service-id"
+	FlagPort               = "port"
+	FlagLogLevel           = "log-level"
+	FlagConfigFile         = "config-file"
+	FlagServiceId          = "service-id"
+	FlagVaultMasterKeyFile = "vault-master-key-file"
+	FlagStateFile          = "state-file"
 )
 
 func GetCommand() *cobra.Command {
@@ -46,4 +48,6 @@ func setFlags() {
 	pflag.String(FlagLogLevel, "info", "log level(options: trace, debug, info, warn|warning, error, fatal, panic)")
 	pflag.String(FlagConfigFile, "", "config file for json format")
 	pflag.Uint16(FlagServiceId, 0, "distinguish between different services when highly available.")
+	pflag.String(FlagStateFile, "", "the bolt database to persist the service state like ssh certificates, the state is kept in memory if it's empty.")
+	pflag.String(FlagVaultMasterKeyFile, "", "the file of the base64 encoded master key shared with deploy controller to seal the credentials.")
 }
@@ -40,6 +40,9 @@ func (a *app) setRoutes() {
 	wizardGroup.POST("/nodes", deploy.AddNode)
 	wizardGroup.PUT("/nodes/:ip", deploy.UpdateNode)
 	wizardGroup.DELETE("/nodes/:ip", deploy.DeleteNode)
+	wizardGroup.GET("/nodes/:ip/hostkeys", deploy.GetNodeHostKey)
+	wizardGroup.PUT("/nodes/:ip/hostkeys", deploy.AcceptNodeHostKey)
+	wizardGroup.DELETE("/nodes/:ip/hostkeys", deploy.ResetNodeHostKey)
 
 	wizardGroup.POST("/batchnodes", deploy.UploadBatchNodes)
 
@@ -48,8 +51,14 @@ func (a *app) setRoutes() {
 
 	wizardGroup.POST("/deploys", deploy.Deploy)
 	wizardGroup.GET("/deploys", deploy.GetDeployReport)
+	wizardGroup.DELETE("/deploys", deploy.CancelDeploy)
+	wizardGroup.GET("/plans", deploy.GetDeployPlan)
+
+	wizardGroup.POST("/scaleouts", deploy.ScaleOut)
+	wizardGroup.POST("/upgrades", deploy.UpgradeCluster)
 
 	wizardGroup.GET("/logs/:id", deploy.DownloadLog)
+	wizardGroup.GET("/livelogs", deploy.TailDeployLog)
 
 	wizardGroup.GET("/kubeconfigs", deploy.DownloadKubeConfig)
 
@@ -60,6 +69,8 @@ func (a *app) setRoutes() {
 
 	v1.POST("/ssh_certificates", deploy.AddSSHCertificate)
 	v1.GET("/ssh_certificates", deploy.GetCertificateList)
+	v1.PUT("/ssh_certificates/:name", deploy.UpdateSSHCertificate)
+	v1.DELETE("/ssh_certificates/:name", deploy.DeleteSSHCertificate)
 
 	// group for helm.
 	helmGroup := v1.Group("/helm")
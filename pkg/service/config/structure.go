@@ -38,12 +38,14 @@ type (
 		Service          serviceSetting          `json:"service"`
 		Log              logSetting              `json:"log"`
 		DeployController deployControllerSetting `json:"deployController"`
+		Vault            vaultSetting            `json:"vault"`
 	}
 
 	serviceSetting struct {
 		Port             uint16         `json:"port"`
 		Mode             WebServiceMode `json:"mode"`
 		ReadWriteTimeout time.Duration  `json:"readWriteTimeout"`
+		StateFile        string         `json:"stateFile"` // the bolt database to persist the service state like ssh certificates, the state is kept in memory if it's empty
 		ServiceId        uint16         // used to distinguish between different services when highly available. no parse from configuration file, because services will use the same configuration file.
 	}
 
@@ -55,6 +57,12 @@ type (
 		Address string        `json:"address"`
 		Timeout time.Duration `json:"timeout"`
 	}
+
+	vaultSetting struct {
+		// the file of the base64 encoded master key to seal the credentials, it should be the same one of deploy controller.
+		// a random key is used if it's empty, and the credentials are sent opened to deploy controller.
+		MasterKeyFile string `json:"masterKeyFile"`
+	}
 )
 
 var (
@@ -0,0 +1,126 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package convert converts the wizard data to the messages of deploy controller, it's shared by the
+// api handlers and the other clients of deploy controller.
+package convert
+
+import (
+	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
+)
+
+const (
+	deployControllerAuthCredentialPassword                 = "password"
+	deployControllerAuthCredentialPrivateKey               = "privatekey"
+	deployControllerAuthCredentialPrivateKeyWithPassphrase = "privatekey-with-passphrase"
+	deployControllerAuthCredentialAgent                    = "agent"
+	deployControllerAuthCredentialCertificate              = "certificate"
+)
+
+func ModelNodeToDeployControllerNode(node *wizard.Node) *protos.Node {
+
+	return &protos.Node{
+		Name:           node.Name,
+		Ip:             node.IP,
+		Ssh:            ModelConnectionDataToDeployControllerSSHData(&node.ConnectionData),
+		ConnectionType: string(node.ConnectionType),
+	}
+}
+
+func ModelConnectionDataToDeployControllerSSHData(data *wizard.ConnectionData) *protos.SSH {
+
+	if data == nil {
+		return nil
+	}
+
+	return &protos.SSH{
+		Port:               uint32(data.Port),
+		Auth:               ModelLoginToDeployControllerAuth(data.Username, data.AuthenticationType, data.PasswordID, data.PrivateKeyName),
+		HostKeyFingerprint: data.HostKeyFingerprint,
+		JumpHosts:          ModelJumpHostsToDeployControllerJumpHosts(data.JumpHosts),
+		Escalation:         ModelEscalationToDeployControllerEscalation(data),
+	}
+}
+
+func ModelEscalationToDeployControllerEscalation(data *wizard.ConnectionData) *protos.Escalation {
+
+	if data.EscalationMethod == wizard.EscalationMethodNone {
+		return nil
+	}
+
+	secrets, sealed := credential.ForDeployController(data.EscalationPasswordID)
+	return &protos.Escalation{
+		Method:   string(data.EscalationMethod),
+		Password: secrets[0],
+		Sealed:   sealed,
+	}
+}
+
+// ModelLoginToDeployControllerAuth sends the credentials sealed if the vault master key is shared with deploy controller,
+// so that they're resolved only when deploy controller dials the node.
+func ModelLoginToDeployControllerAuth(username string, authenticationType wizard.AuthenticationType, passwordID, privateKeyName string) *protos.Auth {
+
+	auth := &protos.Auth{
+		Username: username,
+	}
+	var credentialID, passphraseID string
+	switch authenticationType {
+	case wizard.AuthenticationTypePassword:
+		auth.Type = deployControllerAuthCredentialPassword
+		credentialID = passwordID
+	case wizard.AuthenticationTypePrivateKey:
+		auth.Type = deployControllerAuthCredentialPrivateKey
+		if certificate := sshcertificate.GetCertificate(privateKeyName); certificate != nil {
+			credentialID = certificate.PrivateKeyID
+		}
+	case wizard.AuthenticationTypePrivateKeyWithPassphrase:
+		auth.Type = deployControllerAuthCredentialPrivateKeyWithPassphrase
+		if certificate := sshcertificate.GetCertificate(privateKeyName); certificate != nil {
+			credentialID, passphraseID = certificate.PrivateKeyID, certificate.PassphraseID
+		}
+	case wizard.AuthenticationTypeAgent:
+		auth.Type = deployControllerAuthCredentialAgent
+	case wizard.AuthenticationTypeCertificate:
+		auth.Type = deployControllerAuthCredentialCertificate
+		if certificate := sshcertificate.GetCertificate(privateKeyName); certificate != nil {
+			credentialID, passphraseID = certificate.PrivateKeyID, certificate.PassphraseID
+			auth.Certificate = certificate.UserCertificate
+		}
+	}
+
+	secrets, sealed := credential.ForDeployController(credentialID, passphraseID)
+	auth.Credential, auth.Passphrase, auth.Sealed = secrets[0], secrets[1], sealed
+	return auth
+}
+
+func ModelJumpHostsToDeployControllerJumpHosts(jumpHosts []*wizard.JumpHost) []*protos.JumpHost {
+
+	if len(jumpHosts) == 0 {
+		return nil
+	}
+
+	result := make([]*protos.JumpHost, 0, len(jumpHosts))
+	for _, jumpHost := range jumpHosts {
+		result = append(result, &protos.JumpHost{
+			Ip:                 jumpHost.IP,
+			Port:               uint32(jumpHost.Port),
+			Auth:               ModelLoginToDeployControllerAuth(jumpHost.Username, jumpHost.AuthenticationType, jumpHost.PasswordID, jumpHost.PrivateKeyName),
+			HostKeyFingerprint: jumpHost.HostKeyFingerprint,
+		})
+	}
+
+	return result
+}
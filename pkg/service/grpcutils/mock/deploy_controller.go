@@ -16,6 +16,9 @@ package mock
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -23,6 +26,9 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 )
 
+// MockHostKeyFingerprint is the host key fingerprint presented by all the nodes.
+const MockHostKeyFingerprint = "SHA256:bW9jayBob3N0IGtleQ"
+
 type DeployController struct {
 }
 
@@ -32,9 +38,28 @@ func NewDeployController() protos.DeployContollerClient {
 
 func (mock *DeployController) TestConnection(ctx context.Context, in *protos.TestConnectionRequest, opts ...grpc.CallOption) (*protos.TestConnectionReply, error) {
 
+	fingerprint := MockHostKeyFingerprint
+	if pinned := in.GetNode().GetSsh().GetHostKeyFingerprint(); pinned != "" && pinned != fingerprint {
+		return &protos.TestConnectionReply{
+			Passed: false,
+			Err: &protos.Error{
+				Reason: "host key mismatched",
+				Detail: fmt.Sprintf("the pinned host key fingerprint is %v, but the node presents %v", pinned, fingerprint),
+			},
+			HostKeyFingerprint: fingerprint,
+		}, nil
+	}
+
+	jumpHostFingerprints := make([]string, 0, len(in.GetNode().GetSsh().GetJumpHosts()))
+	for range in.GetNode().GetSsh().GetJumpHosts() {
+		jumpHostFingerprints = append(jumpHostFingerprints, fingerprint)
+	}
+
 	return &protos.TestConnectionReply{
-		Passed: true,
-		Err:    nil,
+		Passed:                  true,
+		Err:                     nil,
+		HostKeyFingerprint:      fingerprint,
+		JumpHostKeyFingerprints: jumpHostFingerprints,
 	}, nil
 }
 
@@ -81,6 +106,15 @@ func (mock *DeployController) GetCheckNodesResult(ctx context.Context, in *proto
 		},
 	}, nil
 }
+func (mock *DeployController) WatchCheckNodes(ctx context.Context, in *protos.WatchCheckNodesRequest, opts ...grpc.CallOption) (protos.DeployContoller_WatchCheckNodesClient, error) {
+
+	result, err := mock.GetCheckNodesResult(ctx, &protos.GetCheckNodesResultRequest{ClusterId: in.GetClusterId()}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &watchCheckNodesClient{results: []*protos.GetCheckNodesResultReply{result}}, nil
+}
+
 func (mock *DeployController) Deploy(ctx context.Context, in *protos.DeployRequest, opts ...grpc.CallOption) (*protos.DeployReply, error) {
 
 	return &protos.DeployReply{
@@ -88,6 +122,57 @@ func (mock *DeployController) Deploy(ctx context.Context, in *protos.DeployReque
 		Err:      nil,
 	}, nil
 }
+
+func (mock *DeployController) PlanDeploy(ctx context.Context, in *protos.DeployRequest, opts ...grpc.CallOption) (*protos.PlanDeployReply, error) {
+
+	plan := &protos.TaskPlan{
+		Name: "deploy-" + in.GetClusterId(),
+		Type: "Deploy",
+	}
+	for _, nodeConfig := range in.GetNodeConfigs() {
+		plan.SubTasks = append(plan.SubTasks, &protos.TaskPlan{
+			Name: "init-" + nodeConfig.GetNode().GetName(),
+			Type: "NodeInit",
+			Actions: []*protos.ActionPlan{
+				{
+					Name:     "init-" + nodeConfig.GetNode().GetName(),
+					Type:     "NodeInit",
+					NodeName: nodeConfig.GetNode().GetName(),
+					Commands: []string{"bash /tmp/scripts/init_change_swap.sh"},
+				},
+			},
+		})
+	}
+
+	return &protos.PlanDeployReply{
+		Plan: plan,
+	}, nil
+}
+
+func (mock *DeployController) ResumeDeploy(ctx context.Context, in *protos.ResumeDeployRequest, opts ...grpc.CallOption) (*protos.ResumeDeployReply, error) {
+
+	return &protos.ResumeDeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+func (mock *DeployController) CancelDeploy(ctx context.Context, in *protos.CancelDeployRequest, opts ...grpc.CallOption) (*protos.CancelDeployReply, error) {
+
+	return &protos.CancelDeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+func (mock *DeployController) CancelCheckNodes(ctx context.Context, in *protos.CancelCheckNodesRequest, opts ...grpc.CallOption) (*protos.CancelCheckNodesReply, error) {
+
+	return &protos.CancelCheckNodesReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
 func (mock *DeployController) GetDeployResult(ctx context.Context, in *protos.GetDeployResultRequest, opts ...grpc.CallOption) (*protos.GetDeployResultReply, error) {
 
 	return &protos.GetDeployResultReply{
@@ -116,11 +201,60 @@ func (mock *DeployController) GetDeployResult(ctx context.Context, in *protos.Ge
 	}, nil
 }
 
+func (mock *DeployController) WatchDeploy(ctx context.Context, in *protos.WatchDeployRequest, opts ...grpc.CallOption) (protos.DeployContoller_WatchDeployClient, error) {
+
+	result, err := mock.GetDeployResult(ctx, &protos.GetDeployResultRequest{ClusterId: in.GetClusterId()}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &watchDeployClient{results: []*protos.GetDeployResultReply{result}}, nil
+}
+
+func (mock *DeployController) TailDeployLog(ctx context.Context, in *protos.TailDeployLogRequest, opts ...grpc.CallOption) (protos.DeployContoller_TailDeployLogClient, error) {
+
+	return &tailDeployLogClient{
+		logs: []*protos.TailDeployLogReply{
+			{ActionName: "deploy-worker-action", Log: []byte("log of " + in.GetNodeName())},
+		},
+	}, nil
+}
+
 func (mock *DeployController) FetchKubeConfig(ctx context.Context, in *protos.FetchKubeConfigRequest, opts ...grpc.CallOption) (*protos.FetchKubeConfigReply, error) {
 	return &protos.FetchKubeConfigReply{
 		KubeConfig: []byte("kube config content")}, nil
 }
 
+func (mock *DeployController) CreateJoinToken(ctx context.Context, in *protos.CreateJoinTokenRequest, opts ...grpc.CallOption) (*protos.CreateJoinTokenReply, error) {
+	return &protos.CreateJoinTokenReply{
+		Token:      "abcdef.0123456789abcdef",
+		Expiration: time.Now().Add(2 * time.Hour).Unix(),
+	}, nil
+}
+
+func (mock *DeployController) ScaleOut(ctx context.Context, in *protos.ScaleOutRequest, opts ...grpc.CallOption) (*protos.DeployReply, error) {
+
+	return &protos.DeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+func (mock *DeployController) RemoveNodes(ctx context.Context, in *protos.RemoveNodesRequest, opts ...grpc.CallOption) (*protos.DeployReply, error) {
+
+	return &protos.DeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
+func (mock *DeployController) UpgradeCluster(ctx context.Context, in *protos.UpgradeClusterRequest, opts ...grpc.CallOption) (*protos.DeployReply, error) {
+
+	return &protos.DeployReply{
+		Accepted: true,
+		Err:      nil,
+	}, nil
+}
+
 func (mock *DeployController) CheckNetworkRequirements(
 	ctx context.Context, in *protos.CheckNetworkRequirementRequest, opts ...grpc.CallOption) (
 	*protos.CheckNetworkRequirementsReply, error) {
@@ -158,3 +292,51 @@ func (mock *DeployController) GetDeployLog(ctx context.Context, in *protos.GetDe
 	// To be implmented
 	return nil, nil
 }
+
+// watchCheckNodesClient sends the results one by one, then io.EOF.
+type watchCheckNodesClient struct {
+	grpc.ClientStream
+	results []*protos.GetCheckNodesResultReply
+}
+
+func (client *watchCheckNodesClient) Recv() (*protos.GetCheckNodesResultReply, error) {
+
+	if len(client.results) == 0 {
+		return nil, io.EOF
+	}
+	result := client.results[0]
+	client.results = client.results[1:]
+	return result, nil
+}
+
+// watchDeployClient sends the results one by one, then io.EOF.
+type watchDeployClient struct {
+	grpc.ClientStream
+	results []*protos.GetDeployResultReply
+}
+
+func (client *watchDeployClient) Recv() (*protos.GetDeployResultReply, error) {
+
+	if len(client.results) == 0 {
+		return nil, io.EOF
+	}
+	result := client.results[0]
+	client.results = client.results[1:]
+	return result, nil
+}
+
+// tailDeployLogClient sends the logs one by one, then io.EOF.
+type tailDeployLogClient struct {
+	grpc.ClientStream
+	logs []*protos.TailDeployLogReply
+}
+
+func (client *tailDeployLogClient) Recv() (*protos.TailDeployLogReply, error) {
+
+	if len(client.logs) == 0 {
+		return nil, io.EOF
+	}
+	log := client.logs[0]
+	client.logs = client.logs[1:]
+	return log, nil
+}
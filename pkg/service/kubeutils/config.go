@@ -25,7 +25,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/deploy/protos"
 	clientutils "github.com/kpaas-io/kpaas/pkg/service/grpcutils/client"
-	"github.com/kpaas-io/kpaas/pkg/service/model/sshcertificate"
+	"github.com/kpaas-io/kpaas/pkg/service/grpcutils/convert"
 	"github.com/kpaas-io/kpaas/pkg/service/model/wizard"
 )
 
@@ -64,9 +64,6 @@ func KubeConfigPathForCluster(clusterName string) (string, error) {
 	if w.KubeConfig == nil || (*w.KubeConfig) == "" {
 		return "", fmt.Errorf("kubeconfig file is not ready yet, try it later")
 	}
-	// following code are from deploy.fetchKubeConfigContent
-	// To prevent potential dependency loop, implement code again here
-	// TODO: put the code for fetching kubeconfig into common library
 	var masterNode *wizard.Node
 	client := clientutils.GetDeployController()
 	for _, node := range w.Nodes {
@@ -82,26 +79,8 @@ func KubeConfigPathForCluster(clusterName string) (string, error) {
 	}
 	logEntry.WithField("nodename", masterNode.Name).WithField("IP", masterNode.IP).
 		Debug("fetch kubeconfig from master node...")
-	connectionData := masterNode.ConnectionData
-	sshAuth := protos.Auth{
-		Username: masterNode.Username,
-		Type:     string(connectionData.AuthenticationType),
-	}
-	switch connectionData.AuthenticationType {
-	case wizard.AuthenticationTypePassword:
-		sshAuth.Credential = connectionData.Password
-	case wizard.AuthenticationTypePrivateKey:
-		sshAuth.Credential = sshcertificate.GetPrivateKey(connectionData.PrivateKeyName)
-	}
 	fetchResponse, err := client.FetchKubeConfig(context.Background(),
-		&protos.FetchKubeConfigRequest{Node: &protos.Node{
-			Name: masterNode.Name,
-			Ip:   masterNode.IP,
-			Ssh: &protos.SSH{
-				Port: uint32(connectionData.Port),
-				Auth: &sshAuth,
-			},
-		}})
+		&protos.FetchKubeConfigRequest{Node: convert.ModelNodeToDeployControllerNode(masterNode)})
 	if err != nil {
 		logEntry.WithError(err).Errorf("failed to get response of fetching kubeconfig by gRPC")
 		return "", fmt.Errorf("failed to get response of fetching kubeconfig")
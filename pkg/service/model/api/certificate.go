@@ -15,6 +15,9 @@
 package api
 
 import (
+	"bytes"
+	"fmt"
+
 	"golang.org/x/crypto/ssh"
 
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
@@ -22,12 +25,24 @@ import (
 
 type (
 	SSHCertificate struct {
-		Name    string `json:"name" binding:"required" minimum:"1" maximum:"20"`
-		Content string `json:"content" binding:"required"`
+		Name        string `json:"name" binding:"required" minimum:"1" maximum:"20"`
+		Content     Secret `json:"content" binding:"required"`
+		Passphrase  Secret `json:"passphrase,omitempty"`  // passphrase of the encrypted private key
+		Certificate string `json:"certificate,omitempty"` // OpenSSH user certificate of the private key signed by the CA, in authorized_keys format
 	}
 
 	GetSSHCertificateListResponse struct {
-		Names []string `json:"names"`
+		Names        []string                `json:"names"`
+		Certificates []SSHCertificateSummary `json:"certificates"` // the certificates with the key metadata, sorted by name
+	}
+
+	SSHCertificateSummary struct {
+		Name           string   `json:"name"`
+		KeyType        string   `json:"keyType"`        // type of the public key, like ssh-rsa or ssh-ed25519
+		Fingerprint    string   `json:"fingerprint"`    // SHA256 fingerprint of the public key
+		HasPassphrase  bool     `json:"hasPassphrase"`  // whether the private key is encrypted by a passphrase
+		HasCertificate bool     `json:"hasCertificate"` // whether there is an OpenSSH user certificate of the private key
+		Nodes          []string `json:"nodes"`          // names of the nodes which log in with the key, the key can't be deleted while it's used
 	}
 )
 
@@ -40,15 +55,53 @@ func (cert *SSHCertificate) Validate() error {
 
 	return validator.NewWrapper(
 		validator.ValidateString(cert.Name, "name", validator.ItemNotEmptyLimit, CertificateNameLimit),
-		validator.ValidateString(cert.Content, "content", validator.ItemNotEmptyLimit, CertificateContentLimit),
+		validator.ValidateString(string(cert.Content), "content", validator.ItemNotEmptyLimit, CertificateContentLimit),
+		validator.ValidateString(cert.Certificate, "certificate", validator.ItemNoLimit, CertificateContentLimit),
 		func() error {
-			return verifyPrivateKeyContent(cert.Content)
+			signer, err := verifyPrivateKeyContent(string(cert.Content), string(cert.Passphrase))
+			if err != nil {
+				return err
+			}
+			if cert.Certificate == "" {
+				return nil
+			}
+			return verifyUserCertificate(cert.Certificate, signer.PublicKey())
 		},
 	).Validate()
 }
 
-func verifyPrivateKeyContent(content string) (err error) {
+// PublicKey returns the public key of the private key, the passphrase is used if the private key is encrypted
+func (cert *SSHCertificate) PublicKey() (ssh.PublicKey, error) {
+
+	signer, err := verifyPrivateKeyContent(string(cert.Content), string(cert.Passphrase))
+	if err != nil {
+		return nil, err
+	}
+	return signer.PublicKey(), nil
+}
+
+func verifyPrivateKeyContent(content, passphrase string) (ssh.Signer, error) {
+
+	if passphrase == "" {
+		return ssh.ParsePrivateKey([]byte(content))
+	}
+	return ssh.ParsePrivateKeyWithPassphrase([]byte(content), []byte(passphrase))
+}
+
+func verifyUserCertificate(content string, publicKey ssh.PublicKey) error {
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(content))
+	if err != nil {
+		return fmt.Errorf("certificate is invalid: %v", err)
+	}
+
+	certificate, ok := key.(*ssh.Certificate)
+	if !ok || certificate.CertType != ssh.UserCert {
+		return fmt.Errorf("certificate is not an ssh user certificate")
+	}
 
-	_, err = ssh.ParsePrivateKey([]byte(content))
-	return
+	if !bytes.Equal(certificate.Key.Marshal(), publicKey.Marshal()) {
+		return fmt.Errorf("certificate is not issued for the private key")
+	}
+	return nil
 }
@@ -20,6 +20,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/validation"
 
+	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
@@ -36,6 +37,8 @@ type (
 		NodePortMaximum          uint16                   `json:"nodePortMaximum" maximum:"65535" default:"32767"`
 		Labels                   []Label                  `json:"labels"`
 		Annotations              []Annotation             `json:"annotations"`
+		KubernetesVersion        string                   `json:"kubernetesVersion,omitempty" default:"1.16.3"`        // kubernetes version to deploy, must be one of the supported versions
+		ImageRepository          string                   `json:"imageRepository,omitempty" default:"docker.io/kpaas"` // repository of the kubernetes images, such as registry.local:5000/kpaas
 	}
 
 	KubeAPIServerConnectType string
@@ -118,6 +121,24 @@ func (cluster *Cluster) Validate() error {
 		)
 	}
 
+	if cluster.KubernetesVersion != "" {
+		wrapper.AddValidateFunc(
+			func() error {
+				_, err := constant.GetKubernetesRelease(cluster.KubernetesVersion)
+				return err
+			},
+		)
+	}
+
+	if cluster.ImageRepository != "" {
+		wrapper.AddValidateFunc(
+			func() error {
+				_, err := constant.GetImageRepository(cluster.ImageRepository)
+				return err
+			},
+		)
+	}
+
 	for _, label := range cluster.Labels {
 
 		wrapper.AddValidateFunc(
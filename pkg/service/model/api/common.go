@@ -25,4 +25,29 @@ type (
 		FixMethods string `json:"fixMethods"`      // How to improve to meet the conditions
 		LogId      uint64 `json:"logId,omitempty"` // ID used to get the log file
 	}
+
+	// Secret is a password or a private key in the request, it's redacted when it's printed
+	Secret string
 )
+
+// RedactedSecret replaces the secrets in the logs and the responses, a redacted secret sent back is not changed
+const RedactedSecret = "******"
+
+func (secret Secret) String() string {
+
+	if secret == "" {
+		return ""
+	}
+	return RedactedSecret
+}
+
+func (secret Secret) GoString() string {
+
+	return secret.String()
+}
+
+// IsRedacted returns whether the secret is the redacted one in a response, which is sent back without changed
+func (secret Secret) IsRedacted() bool {
+
+	return secret == RedactedSecret
+}
@@ -16,6 +16,7 @@ package api
 
 import (
 	"github.com/kpaas-io/kpaas/pkg/constant"
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
 )
 
 type (
@@ -36,6 +37,42 @@ type (
 		Error  *Error       `json:"error,omitempty"`
 	}
 
+	TailDeployLogRequest struct {
+		NodeName string               `form:"nodeName"`                                // node name
+		Role     constant.MachineRole `form:"role" enums:"master,worker,etcd,ingress"` // deploy role of the node
+	}
+
+	UpgradeCluster struct {
+		KubernetesVersion string `json:"kubernetesVersion"` // kubernetes version to upgrade to, must be one of the supported versions
+	}
+
+	DeployLog struct {
+		ActionName string `json:"actionName"` // name of the deploy action which wrote the log
+		Log        string `json:"log"`        // a piece of the log
+	}
+
+	DeploymentPlan struct {
+		Name         string                 `json:"name"`                   // task name
+		Type         string                 `json:"type"`                   // task type
+		Dependencies []string               `json:"dependencies,omitempty"` // names of the sibling tasks which should be done before the task starts
+		SubTasks     []DeploymentPlan       `json:"subTasks,omitempty"`
+		Actions      []DeploymentActionPlan `json:"actions,omitempty"`
+	}
+
+	DeploymentActionPlan struct {
+		Name     string               `json:"name"`     // action name
+		Type     string               `json:"type"`     // action type
+		NodeName string               `json:"nodeName"` // the node where the files will be put and the commands will be run
+		Files    []DeploymentPlanFile `json:"files,omitempty"`
+		Commands []string             `json:"commands,omitempty"`
+		Error    *Error               `json:"error,omitempty"` // why the action can't be planned
+	}
+
+	DeploymentPlanFile struct {
+		Path    string `json:"path"`    // file path in the node
+		Content string `json:"content"` // file content
+	}
+
 	DeployStatus        string
 	DeployClusterStatus string
 )
@@ -53,3 +90,26 @@ const (
 	DeployClusterStatusFailed             DeployClusterStatus = "failed"
 	DeployClusterStatusWorkedButHaveError DeployClusterStatus = "workedButHaveError"
 )
+
+func (request *TailDeployLogRequest) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(request.NodeName, "nodeName", validator.ItemNotEmptyLimit, NodeNameLengthLimit),
+		validator.ValidateStringOptions(string(request.Role), "role", []string{string(constant.MachineRoleMaster), string(constant.MachineRoleWorker), string(constant.MachineRoleEtcd), string(constant.MachineRoleIngress)}),
+	)
+
+	return wrapper.Validate()
+}
+
+func (upgrade *UpgradeCluster) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateString(upgrade.KubernetesVersion, "kubernetesVersion", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+		func() error {
+			_, err := constant.GetKubernetesRelease(upgrade.KubernetesVersion)
+			return err
+		},
+	)
+
+	return wrapper.Validate()
+}
@@ -41,22 +41,48 @@ type (
 	ConnectionData struct {
 		SSHLoginData `json:",inline"`
 
+		// connect the node via ssh, or run commands on the host of deploy controller directly if it's local, the ssh options are ignored by local
+		ConnectionType ConnectionType `json:"connectionType,omitempty" enums:"ssh,local" default:"ssh"`
+
 		IP   string `json:"ip" binding:"required" minLength:"1" maxLength:"15"`               // node ip
 		Port uint16 `json:"port" binding:"required" minimum:"1" maximum:"65535" default:"22"` // ssh port
+		// SHA256 fingerprint of the ssh host key, such as "SHA256:xxx", the host key is trusted on first use by a connection test if it's empty
+		HostKeyFingerprint string `json:"hostKeyFingerprint,omitempty" maxLength:"128"`
+		// proxy hosts to reach the node, the first one is connected directly, and the node is connected through the last one
+		JumpHosts []JumpHost `json:"jumpHosts,omitempty"`
+		// privilege escalation to run commands as root if the login user is not root
+		PrivilegeEscalation *PrivilegeEscalation `json:"privilegeEscalation,omitempty"`
+	}
+
+	PrivilegeEscalation struct {
+		Method   EscalationMethod `json:"method" enums:"sudo,su"` // run commands by sudo, or by su with the password of root
+		Password Secret           `json:"password,omitempty"`     // password of sudo, or password of root for su
+	}
+
+	JumpHost struct {
+		SSHLoginData `json:",inline"`
+
+		IP                 string `json:"ip" binding:"required" minLength:"1" maxLength:"15"`               // jump host ip
+		Port               uint16 `json:"port" binding:"required" minimum:"1" maximum:"65535" default:"22"` // ssh port
+		HostKeyFingerprint string `json:"hostKeyFingerprint,omitempty" maxLength:"128"`                     // SHA256 fingerprint of the ssh host key, it's trusted on first use by a connection test if it's empty
 	}
 
 	UpdateNodeData struct {
 		NodeBaseData `json:",inline"`
 		SSHLoginData `json:",inline"`
 
-		Port uint16 `json:"port" binding:"required" minimum:"1" maximum:"65535" default:"22"` // ssh port
+		ConnectionType ConnectionType `json:"connectionType,omitempty" enums:"ssh,local" default:"ssh"` // connect the node via ssh, or run commands on the host of deploy controller directly
+
+		Port                uint16               `json:"port" binding:"required" minimum:"1" maximum:"65535" default:"22"` // ssh port
+		JumpHosts           []JumpHost           `json:"jumpHosts,omitempty"`                                              // proxy hosts to reach the node
+		PrivilegeEscalation *PrivilegeEscalation `json:"privilegeEscalation,omitempty"`                                    // privilege escalation to run commands as root, the password is not changed if it's empty
 	}
 
 	SSHLoginData struct {
-		Username           string             `json:"username" binding:"required" maxLength:"128"`   // ssh username
-		AuthenticationType AuthenticationType `json:"authorizationType" enums:"password,privateKey"` // type of authorization
-		Password           string             `json:"password,omitempty"`                            // login password
-		PrivateKeyName     string             `json:"privateKeyName,omitempty"`                      // the private key name of login
+		Username           string             `json:"username" binding:"required" maxLength:"128"`                                              // ssh username
+		AuthenticationType AuthenticationType `json:"authorizationType" enums:"password,privateKey,privateKeyWithPassphrase,agent,certificate"` // type of authorization
+		Password           Secret             `json:"password,omitempty"`                                                                       // login password
+		PrivateKeyName     string             `json:"privateKeyName,omitempty"`                                                                 // the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization
 	}
 
 	Taint struct {
@@ -69,14 +95,27 @@ type (
 		Nodes []NodeData `json:"nodes"` // node list
 	}
 
-	AuthenticationType string // Type of authorization,  password or privateKey
+	AuthenticationType string // Type of authorization, password, privateKey, privateKeyWithPassphrase, agent or certificate
 
 	TaintEffect string // Taint Effect, NoSchedule, NoExecute or PreferNoSchedule
+
+	EscalationMethod string // Privilege escalation method, sudo or su
+
+	ConnectionType string // Type of connection, ssh or local
 )
 
 const (
-	AuthenticationTypePassword   AuthenticationType = "password"   // Use Password to authorize
-	AuthenticationTypePrivateKey AuthenticationType = "privateKey" // Use RSA PrivateKey to authorize
+	AuthenticationTypePassword                 AuthenticationType = "password"                 // Use Password to authorize
+	AuthenticationTypePrivateKey               AuthenticationType = "privateKey"               // Use RSA PrivateKey to authorize
+	AuthenticationTypePrivateKeyWithPassphrase AuthenticationType = "privateKeyWithPassphrase" // Use PrivateKey encrypted by passphrase to authorize
+	AuthenticationTypeAgent                    AuthenticationType = "agent"                    // Use the ssh agent of deploy controller to authorize
+	AuthenticationTypeCertificate              AuthenticationType = "certificate"              // Use OpenSSH user certificate signed by CA to authorize
+
+	ConnectionTypeSSH   ConnectionType = "ssh"   // Connect the node via ssh
+	ConnectionTypeLocal ConnectionType = "local" // The node is the host of deploy controller
+
+	EscalationMethodSudo EscalationMethod = "sudo" // Run commands by sudo
+	EscalationMethodSu   EscalationMethod = "su"   // Run commands by su with the password of root
 
 	TaintEffectNoSchedule       TaintEffect = "NoSchedule"
 	TaintEffectNoExecute        TaintEffect = "NoExecute"
@@ -87,11 +126,30 @@ const (
 	TaintKeyLengthLimit           = 253
 	TaintValueLengthLimit         = 63
 	NodeUsernameRegularExpression = `^[A-Za-z]([\w\-.]+)?$`
+	HostKeyFingerprintExpression  = `^SHA256:[A-Za-z0-9+/]+$`
 
 	NodeSSHPortMinimum = 1
 	NodeSSHPortMaximum = 65535
 )
 
+var authenticationTypes = []string{
+	string(AuthenticationTypePassword),
+	string(AuthenticationTypePrivateKey),
+	string(AuthenticationTypePrivateKeyWithPassphrase),
+	string(AuthenticationTypeAgent),
+	string(AuthenticationTypeCertificate),
+}
+
+// UsePrivateKey returns whether the authorization uses a private key referenced by PrivateKeyName
+func (authenticationType AuthenticationType) UsePrivateKey() bool {
+
+	switch authenticationType {
+	case AuthenticationTypePrivateKey, AuthenticationTypePrivateKeyWithPassphrase, AuthenticationTypeCertificate:
+		return true
+	}
+	return false
+}
+
 func (node *NodeBaseData) Validate() error {
 
 	rolesNames := make([]string, 0, len(node.MachineRoles))
@@ -133,15 +191,15 @@ func (login *SSHLoginData) Validate() error {
 	wrapper := validator.NewWrapper(
 		validator.ValidateString(login.Username, "username", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
 		validator.ValidateRegexp(regexp.MustCompile(NodeUsernameRegularExpression), login.Username, "username"),
-		validator.ValidateStringOptions(string(login.AuthenticationType), "authorizationType", []string{string(AuthenticationTypePassword), string(AuthenticationTypePrivateKey)}),
+		validator.ValidateStringOptions(string(login.AuthenticationType), "authorizationType", authenticationTypes),
 	)
 
 	switch login.AuthenticationType {
 	case AuthenticationTypePassword:
 		wrapper.AddValidateFunc(
-			validator.ValidateString(login.Password, "password", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+			validator.ValidateString(string(login.Password), "password", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
 		)
-	case AuthenticationTypePrivateKey:
+	case AuthenticationTypePrivateKey, AuthenticationTypePrivateKeyWithPassphrase, AuthenticationTypeCertificate:
 		wrapper.AddValidateFunc(
 			validator.ValidateString(login.PrivateKeyName, "privateKeyName", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
 		)
@@ -150,16 +208,35 @@ func (login *SSHLoginData) Validate() error {
 	return wrapper.Validate()
 }
 
+// Redact replaces all the passwords of the connection with the redacted secret, to respond the connection data
+func (data *ConnectionData) Redact() {
+
+	data.SSHLoginData.redact()
+	for index := range data.JumpHosts {
+		data.JumpHosts[index].redact()
+	}
+	if data.PrivilegeEscalation != nil && data.PrivilegeEscalation.Password != "" {
+		data.PrivilegeEscalation.Password = RedactedSecret
+	}
+}
+
+func (login *SSHLoginData) redact() {
+
+	if login.Password != "" {
+		login.Password = RedactedSecret
+	}
+}
+
 func (login *SSHLoginData) ValidateWithoutPassword() error {
 
 	wrapper := validator.NewWrapper(
 		validator.ValidateString(login.Username, "username", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
 		validator.ValidateRegexp(regexp.MustCompile(NodeUsernameRegularExpression), login.Username, "username"),
-		validator.ValidateStringOptions(string(login.AuthenticationType), "authorizationType", []string{string(AuthenticationTypePassword), string(AuthenticationTypePrivateKey)}),
+		validator.ValidateStringOptions(string(login.AuthenticationType), "authorizationType", authenticationTypes),
 	)
 
 	switch login.AuthenticationType {
-	case AuthenticationTypePrivateKey:
+	case AuthenticationTypePrivateKey, AuthenticationTypePrivateKeyWithPassphrase, AuthenticationTypeCertificate:
 		wrapper.AddValidateFunc(
 			validator.ValidateString(login.PrivateKeyName, "privateKeyName", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
 		)
@@ -170,15 +247,88 @@ func (login *SSHLoginData) ValidateWithoutPassword() error {
 
 func (node *ConnectionData) Validate() error {
 
+	if node.ConnectionType == ConnectionTypeLocal {
+		// the ssh options are not used
+		return validator.NewWrapper(
+			validator.ValidateIP(node.IP, "ip"),
+		).Validate()
+	}
+
 	return validator.NewWrapper(
+		validateConnectionType(node.ConnectionType),
 		validator.ValidateIP(node.IP, "ip"),
 		validator.ValidateIntRange(int(node.Port), "port", NodeSSHPortMinimum, NodeSSHPortMaximum),
 		func() error {
 			return node.SSHLoginData.Validate()
 		},
+		validateHostKeyFingerprint(node.HostKeyFingerprint),
+		validateJumpHosts(node.JumpHosts),
+		func() error {
+			if node.PrivilegeEscalation == nil {
+				return nil
+			}
+			return node.PrivilegeEscalation.Validate()
+		},
 	).Validate()
 }
 
+func (escalation *PrivilegeEscalation) Validate() error {
+
+	wrapper := validator.NewWrapper(
+		validator.ValidateStringOptions(string(escalation.Method), "privilegeEscalation.method", []string{string(EscalationMethodSudo), string(EscalationMethodSu)}),
+	)
+
+	if escalation.Method == EscalationMethodSu {
+		wrapper.AddValidateFunc(
+			validator.ValidateString(string(escalation.Password), "privilegeEscalation.password", validator.ItemNotEmptyLimit, validator.ItemNoLimit),
+		)
+	}
+
+	return wrapper.Validate()
+}
+
+func (jumpHost *JumpHost) Validate() error {
+
+	return validator.NewWrapper(
+		validator.ValidateIP(jumpHost.IP, "jumpHosts.ip"),
+		validator.ValidateIntRange(int(jumpHost.Port), "jumpHosts.port", NodeSSHPortMinimum, NodeSSHPortMaximum),
+		jumpHost.SSHLoginData.Validate,
+		validateHostKeyFingerprint(jumpHost.HostKeyFingerprint),
+	).Validate()
+}
+
+func validateConnectionType(connectionType ConnectionType) validator.ValidateFunc {
+
+	return func() error {
+		if connectionType == "" {
+			return nil
+		}
+		return validator.ValidateStringOptions(string(connectionType), "connectionType", []string{string(ConnectionTypeSSH), string(ConnectionTypeLocal)})()
+	}
+}
+
+func validateJumpHosts(jumpHosts []JumpHost) validator.ValidateFunc {
+
+	return func() error {
+		for index := range jumpHosts {
+			if err := jumpHosts[index].Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func validateHostKeyFingerprint(fingerprint string) validator.ValidateFunc {
+
+	return func() error {
+		if fingerprint == "" {
+			return nil
+		}
+		return validator.ValidateRegexp(regexp.MustCompile(HostKeyFingerprintExpression), fingerprint, "hostKeyFingerprint")()
+	}
+}
+
 func (node *NodeData) Validate() error {
 
 	return validator.NewWrapper(
@@ -205,11 +355,26 @@ func (taint *Taint) Validate() error {
 
 func (node *UpdateNodeData) Validate() error {
 
+	if node.ConnectionType == ConnectionTypeLocal {
+		// the ssh options are not used
+		return node.NodeBaseData.Validate()
+	}
+
 	return validator.NewWrapper(
 		func() error {
 			return node.NodeBaseData.Validate()
 		},
+		validateConnectionType(node.ConnectionType),
 		validator.ValidateIntRange(int(node.Port), "port", NodeSSHPortMinimum, NodeSSHPortMaximum),
 		node.SSHLoginData.ValidateWithoutPassword,
+		validateJumpHosts(node.JumpHosts),
+		func() error {
+			if node.PrivilegeEscalation == nil {
+				return nil
+			}
+			// the password is kept if it's not changed
+			return validator.ValidateStringOptions(string(node.PrivilegeEscalation.Method), "privilegeEscalation.method",
+				[]string{string(EscalationMethodSudo), string(EscalationMethodSu)})()
+		},
 	).Validate()
 }
@@ -14,10 +14,36 @@
 
 package api
 
+import (
+	"regexp"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/validator"
+)
+
 type (
 	TestConnectionResponse struct {
 		SuccessfulOption `json:",inline"`
 
-		Error *Error `json:"error,omitempty"` // Error Detail
+		Error              *Error `json:"error,omitempty"`              // Error Detail
+		HostKeyFingerprint string `json:"hostKeyFingerprint,omitempty"` // SHA256 fingerprint of the host key presented by the node
+		FailedHop          string `json:"failedHop,omitempty"`          // address of the jump host or the node which failed to be connected, such as "192.168.1.1:22"
+		// SHA256 fingerprints of the host keys presented by the jump hosts in order
+		JumpHostKeyFingerprints []string `json:"jumpHostKeyFingerprints,omitempty"`
+	}
+
+	HostKey struct {
+		Fingerprint        string `json:"fingerprint"`                  // pinned SHA256 fingerprint of the ssh host key
+		PendingFingerprint string `json:"pendingFingerprint,omitempty"` // fingerprint of a changed host key, waiting for review
+	}
+
+	AcceptHostKeyRequest struct {
+		Fingerprint string `json:"fingerprint" binding:"required" maxLength:"128"` // SHA256 fingerprint of the host key to pin, such as "SHA256:xxx"
 	}
 )
+
+func (request *AcceptHostKeyRequest) Validate() error {
+
+	return validator.NewWrapper(
+		validator.ValidateRegexp(regexp.MustCompile(HostKeyFingerprintExpression), request.Fingerprint, "fingerprint"),
+	).Validate()
+}
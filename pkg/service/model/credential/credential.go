@@ -0,0 +1,160 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Credentials like passwords and private keys are kept sealed by the vault, the other models refer to them by ID.
+
+package credential
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/idcreator"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+const idPrefix = "credential-"
+
+var (
+	list *sync.Map // sealed credentials by ID
+
+	credentialVault *vault.Vault
+	// sharedWithDeployController means deploy controller has the same master key to open the credentials
+	sharedWithDeployController bool
+)
+
+func init() {
+	ClearList()
+
+	masterKey, err := vault.GenerateMasterKey()
+	if err != nil {
+		panic(err)
+	}
+	credentialVault, _ = vault.New(masterKey)
+}
+
+func ClearList() {
+	list = new(sync.Map)
+}
+
+// SetVault sets the vault to seal the credentials, all the stored credentials are cleared. If the master key is shared
+// with deploy controller, the credentials are sent sealed to deploy controller, and opened only when it dials the nodes.
+func SetVault(v *vault.Vault, shared bool) {
+	ClearList()
+	credentialVault = v
+	sharedWithDeployController = shared
+}
+
+// Add seals and stores the secret, and returns the ID of the credential, no credential is stored for an empty secret.
+func Add(secret string) (string, error) {
+
+	if secret == "" {
+		return "", nil
+	}
+
+	sealed, err := credentialVault.Seal(secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to seal credential, error: %v", err)
+	}
+
+	id := idPrefix + idcreator.NextString()
+	list.Store(id, sealed)
+	return id, nil
+}
+
+// Get returns the opened secret of the credential, empty if id is empty.
+func Get(id string) (string, error) {
+
+	if id == "" {
+		return "", nil
+	}
+
+	sealed, exist := list.Load(id)
+	if !exist {
+		return "", fmt.Errorf("credential %s not found", id)
+	}
+	return credentialVault.Open(sealed.(string))
+}
+
+// GetSealed returns the sealed secret of the credential to persist it, empty if id is empty.
+func GetSealed(id string) (string, error) {
+
+	if id == "" {
+		return "", nil
+	}
+
+	sealed, exist := list.Load(id)
+	if !exist {
+		return "", fmt.Errorf("credential %s not found", id)
+	}
+	return sealed.(string), nil
+}
+
+// AddSealed stores a persisted sealed secret, and returns the ID of the credential. The secret should be sealed by the
+// current vault, no credential is stored for an empty secret.
+func AddSealed(sealed string) (string, error) {
+
+	if sealed == "" {
+		return "", nil
+	}
+
+	if _, err := credentialVault.Open(sealed); err != nil {
+		return "", err
+	}
+
+	id := idPrefix + idcreator.NextString()
+	list.Store(id, sealed)
+	return id, nil
+}
+
+func Delete(ids ...string) {
+
+	for _, id := range ids {
+		if id != "" {
+			list.Delete(id)
+		}
+	}
+}
+
+// ForDeployController returns the secrets of the credentials to send to deploy controller, they are sealed if the master key
+// is shared with deploy controller, otherwise they are opened. The secret of a missing credential is empty.
+func ForDeployController(ids ...string) (secrets []string, sealed bool) {
+
+	secrets = make([]string, len(ids))
+	for index, id := range ids {
+
+		if id == "" {
+			continue
+		}
+
+		if !sharedWithDeployController {
+			secret, err := Get(id)
+			if err != nil {
+				logrus.Warnf("failed to get credential, error: %v", err)
+			}
+			secrets[index] = secret
+			continue
+		}
+
+		if value, exist := list.Load(id); exist {
+			secrets[index] = value.(string)
+		} else {
+			logrus.Warnf("credential %s not found", id)
+		}
+	}
+
+	return secrets, sharedWithDeployController
+}
@@ -0,0 +1,131 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credential
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
+)
+
+func newVault(t *testing.T) *vault.Vault {
+
+	masterKey, err := vault.GenerateMasterKey()
+	assert.NoError(t, err)
+	v, err := vault.New(masterKey)
+	assert.NoError(t, err)
+	return v
+}
+
+func TestAdd(t *testing.T) {
+
+	ClearList()
+
+	id, err := Add("123456")
+	assert.NoError(t, err)
+	assert.Regexp(t, "^credential-", id)
+
+	secret, err := Get(id)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", secret)
+
+	// the secret is stored sealed
+	sealed, exist := list.Load(id)
+	assert.True(t, exist)
+	assert.NotContains(t, sealed, "123456")
+
+	id, err = Add("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", id)
+	secret, err = Get("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", secret)
+}
+
+func TestDelete(t *testing.T) {
+
+	ClearList()
+
+	id, err := Add("123456")
+	assert.NoError(t, err)
+
+	Delete(id, "")
+	_, err = Get(id)
+	assert.EqualError(t, err, "credential "+id+" not found")
+}
+
+func TestForDeployController(t *testing.T) {
+
+	v := newVault(t)
+	defer SetVault(newVault(t), false)
+
+	// the master key is not shared, the secrets are opened
+	SetVault(v, false)
+	passwordID, err := Add("123456")
+	assert.NoError(t, err)
+
+	secrets, sealed := ForDeployController(passwordID, "", "credential-not-exist")
+	assert.False(t, sealed)
+	assert.Equal(t, []string{"123456", "", ""}, secrets)
+
+	// the master key is shared, the secrets are sealed
+	SetVault(v, true)
+	passwordID, err = Add("123456")
+	assert.NoError(t, err)
+
+	secrets, sealed = ForDeployController(passwordID, "")
+	assert.True(t, sealed)
+	assert.Len(t, secrets, 2)
+	assert.Equal(t, "", secrets[1])
+	opened, err := v.Open(secrets[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", opened)
+
+	// the credential is sent the same each time
+	again, _ := ForDeployController(passwordID)
+	assert.Equal(t, secrets[0], again[0])
+}
+
+func TestAddSealed(t *testing.T) {
+
+	ClearList()
+
+	id, err := Add("123456")
+	assert.NoError(t, err)
+	sealed, err := GetSealed(id)
+	assert.NoError(t, err)
+
+	// restore the persisted credential
+	restoredID, err := AddSealed(sealed)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, restoredID)
+	secret, err := Get(restoredID)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", secret)
+
+	// the credential sealed by another master key can't be restored
+	other, err := newVault(t).Seal("123456")
+	assert.NoError(t, err)
+	_, err = AddSealed(other)
+	assert.Error(t, err)
+
+	_, err = GetSealed("not-exist")
+	assert.Error(t, err)
+	restoredID, err = AddSealed("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", restoredID)
+}
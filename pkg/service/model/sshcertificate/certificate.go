@@ -15,18 +15,44 @@
 package sshcertificate
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/store"
 )
 
 type (
 	Certificate struct {
-		Name       string
-		PrivateKey string
+		Name            string
+		KeyType         string // type of the public key, like ssh-rsa or ssh-ed25519
+		Fingerprint     string // SHA256 fingerprint of the public key
+		PrivateKeyID    string // credential ID of the private key
+		PassphraseID    string // credential ID of the passphrase of the encrypted private key, empty if there is no passphrase
+		UserCertificate string // OpenSSH user certificate of the private key signed by the CA, in authorized_keys format
+	}
+
+	// storedCertificate is the persisted certificate, the private key and the passphrase are sealed by the vault
+	storedCertificate struct {
+		Name            string `json:"name"`
+		KeyType         string `json:"keyType"`
+		Fingerprint     string `json:"fingerprint"`
+		PrivateKey      string `json:"privateKey"`
+		Passphrase      string `json:"passphrase,omitempty"`
+		UserCertificate string `json:"userCertificate,omitempty"`
 	}
 )
 
+const storeBucket = "ssh_certificates"
+
 var (
 	list *sync.Map
+	// serializes the changes of the certificates, to keep the list and the store consistent
+	changeLock sync.Mutex
 )
 
 func NewCertificate() *Certificate {
@@ -41,9 +67,128 @@ func ClearList() {
 	list = new(sync.Map)
 }
 
-func AddCertificate(name, privateKey string) {
+func AddCertificate(name, privateKey string) error {
+
+	return SetCertificate(&Certificate{Name: name}, privateKey, "")
+}
+
+// SetCertificate seals the private key and the passphrase into credentials and persists the certificate, the credentials
+// of the replaced certificate with the same name are deleted.
+func SetCertificate(certificate *Certificate, privateKey, passphrase string) error {
+
+	changeLock.Lock()
+	defer changeLock.Unlock()
+
+	privateKeyID, err := credential.Add(privateKey)
+	if err != nil {
+		return err
+	}
+	passphraseID, err := credential.Add(passphrase)
+	if err != nil {
+		credential.Delete(privateKeyID)
+		return err
+	}
+
+	newCertificate := *certificate
+	newCertificate.PrivateKeyID = privateKeyID
+	newCertificate.PassphraseID = passphraseID
+	if err = save(&newCertificate); err != nil {
+		credential.Delete(privateKeyID, passphraseID)
+		return err
+	}
+
+	replaced, exist := list.Load(newCertificate.Name)
+	list.Store(newCertificate.Name, &newCertificate)
+	if exist {
+		credential.Delete(replaced.(*Certificate).PrivateKeyID, replaced.(*Certificate).PassphraseID)
+	}
+	return nil
+}
+
+// DeleteCertificate deletes the certificate and its credentials, nothing is done if the certificate is not found
+func DeleteCertificate(name string) error {
+
+	changeLock.Lock()
+	defer changeLock.Unlock()
+
+	certificate := GetCertificate(name)
+	if certificate == nil {
+		return nil
+	}
+
+	if err := store.Delete(storeBucket, name); err != nil {
+		return fmt.Errorf("failed to delete certificate %s from the store, error: %v", name, err)
+	}
+
+	list.Delete(name)
+	credential.Delete(certificate.PrivateKeyID, certificate.PassphraseID)
+	return nil
+}
+
+// Load restores the persisted certificates, the certificates which can't be opened by the vault are skipped
+func Load() error {
+
+	changeLock.Lock()
+	defer changeLock.Unlock()
+
+	return store.ForEach(storeBucket, func(name string, value []byte) error {
+
+		stored := new(storedCertificate)
+		if err := json.Unmarshal(value, stored); err != nil {
+			logrus.Warnf("failed to restore certificate %s, error: %v", name, err)
+			return nil
+		}
+
+		privateKeyID, err := credential.AddSealed(stored.PrivateKey)
+		if err != nil {
+			logrus.Warnf("failed to restore the private key of certificate %s, error: %v", name, err)
+			return nil
+		}
+		passphraseID, err := credential.AddSealed(stored.Passphrase)
+		if err != nil {
+			credential.Delete(privateKeyID)
+			logrus.Warnf("failed to restore the passphrase of certificate %s, error: %v", name, err)
+			return nil
+		}
+
+		list.Store(stored.Name, &Certificate{
+			Name:            stored.Name,
+			KeyType:         stored.KeyType,
+			Fingerprint:     stored.Fingerprint,
+			PrivateKeyID:    privateKeyID,
+			PassphraseID:    passphraseID,
+			UserCertificate: stored.UserCertificate,
+		})
+		return nil
+	})
+}
+
+func save(certificate *Certificate) error {
+
+	stored := &storedCertificate{
+		Name:            certificate.Name,
+		KeyType:         certificate.KeyType,
+		Fingerprint:     certificate.Fingerprint,
+		UserCertificate: certificate.UserCertificate,
+	}
 
-	list.Store(name, privateKey)
+	var err error
+	if stored.PrivateKey, err = credential.GetSealed(certificate.PrivateKeyID); err != nil {
+		return err
+	}
+	if stored.Passphrase, err = credential.GetSealed(certificate.PassphraseID); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	if err = store.Put(storeBucket, certificate.Name, data); err != nil {
+		return fmt.Errorf("failed to save certificate %s to the store, error: %v", certificate.Name, err)
+	}
+	return nil
 }
 
 func GetNameList() []string {
@@ -56,11 +201,44 @@ func GetNameList() []string {
 	return names
 }
 
-func GetPrivateKey(name string) string {
+// GetList returns the certificates sorted by name
+func GetList() []*Certificate {
+
+	var certificates []*Certificate
+	list.Range(func(key, value interface{}) bool {
+		certificates = append(certificates, value.(*Certificate))
+		return true
+	})
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Name < certificates[j].Name
+	})
+	return certificates
+}
+
+// GetCertificate returns nil if the certificate is not found
+func GetCertificate(name string) *Certificate {
 
-	privateKey, exist := list.Load(name)
+	certificate, exist := list.Load(name)
 	if exist {
-		return privateKey.(string)
+		return certificate.(*Certificate)
 	}
-	return ""
+	return nil
+}
+
+// GetPrivateKey returns the opened private key, empty if the certificate is not found
+func GetPrivateKey(name string) string {
+
+	certificate := GetCertificate(name)
+	if certificate == nil {
+		return ""
+	}
+
+	privateKey, _ := credential.Get(certificate.PrivateKeyID)
+	return privateKey
+}
+
+// HasPassphrase returns whether the private key is encrypted by a passphrase
+func (certificate *Certificate) HasPassphrase() bool {
+
+	return certificate.PassphraseID != ""
 }
@@ -18,6 +18,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
+	"github.com/kpaas-io/kpaas/pkg/service/model/store"
+	"github.com/kpaas-io/kpaas/pkg/utils/vault"
 )
 
 func TestNewCertificate(t *testing.T) {
@@ -55,10 +59,14 @@ UpYgsuXDxma0RK713UCzZCP8o1ucwoNdGJaRDxIEIzTyKAwC2k6vURxlY6djoZHv79sfD7
 NiyK6OkjUmiwIwsL4IQ/dsFD+Lrfp1Ilo3Yirz1UE3Zg6UNP5GUKiys8WnvvtC28uv4dGy
 ls3Q/5aeF7hB2MXfAAAAGEx1Y2t5Ym95c0BMdWNreU1hYy5sb2NhbAEC
 -----END OPENSSH PRIVATE KEY-----`
-	AddCertificate(keyName, privateKey)
+	assert.NoError(t, AddCertificate(keyName, privateKey))
 
-	loadPrivateKey, exist := list.Load(keyName)
+	loadCertificate, exist := list.Load(keyName)
 	assert.True(t, exist)
+	assert.Equal(t, keyName, loadCertificate.(*Certificate).Name)
+	assert.Equal(t, "", loadCertificate.(*Certificate).PassphraseID)
+	loadPrivateKey, err := credential.Get(loadCertificate.(*Certificate).PrivateKeyID)
+	assert.NoError(t, err)
 	assert.Equal(t, privateKey, loadPrivateKey)
 }
 
@@ -92,7 +100,7 @@ UpYgsuXDxma0RK713UCzZCP8o1ucwoNdGJaRDxIEIzTyKAwC2k6vURxlY6djoZHv79sfD7
 NiyK6OkjUmiwIwsL4IQ/dsFD+Lrfp1Ilo3Yirz1UE3Zg6UNP5GUKiys8WnvvtC28uv4dGy
 ls3Q/5aeF7hB2MXfAAAAGEx1Y2t5Ym95c0BMdWNreU1hYy5sb2NhbAEC
 -----END OPENSSH PRIVATE KEY-----`
-	list.Store(keyName, privateKey)
+	assert.NoError(t, AddCertificate(keyName, privateKey))
 
 	assert.Equal(t, privateKey, GetPrivateKey(keyName))
 }
@@ -127,7 +135,80 @@ UpYgsuXDxma0RK713UCzZCP8o1ucwoNdGJaRDxIEIzTyKAwC2k6vURxlY6djoZHv79sfD7
 NiyK6OkjUmiwIwsL4IQ/dsFD+Lrfp1Ilo3Yirz1UE3Zg6UNP5GUKiys8WnvvtC28uv4dGy
 ls3Q/5aeF7hB2MXfAAAAGEx1Y2t5Ym95c0BMdWNreU1hYy5sb2NhbAEC
 -----END OPENSSH PRIVATE KEY-----`
-	list.Store(keyName, privateKey)
+	ClearList()
+	assert.NoError(t, AddCertificate(keyName, privateKey))
 
 	assert.Equal(t, []string{keyName}, GetNameList())
 }
+
+func TestGetCertificate(t *testing.T) {
+
+	ClearList()
+	assert.NoError(t, SetCertificate(&Certificate{Name: "id_rsa_cert", UserCertificate: "ssh-rsa-cert-v01@openssh.com AAAA"}, "private key", "passphrase"))
+
+	certificate := GetCertificate("id_rsa_cert")
+	assert.Equal(t, "id_rsa_cert", certificate.Name)
+	assert.Equal(t, "ssh-rsa-cert-v01@openssh.com AAAA", certificate.UserCertificate)
+	assert.True(t, certificate.HasPassphrase())
+	passphrase, err := credential.Get(certificate.PassphraseID)
+	assert.NoError(t, err)
+	assert.Equal(t, "passphrase", passphrase)
+	assert.Equal(t, "private key", GetPrivateKey("id_rsa_cert"))
+	assert.Nil(t, GetCertificate("not_exist"))
+	assert.Equal(t, "", GetPrivateKey("not_exist"))
+
+	// the credentials of the replaced certificate are deleted
+	assert.NoError(t, SetCertificate(&Certificate{Name: "id_rsa_cert"}, "new private key", ""))
+	assert.False(t, GetCertificate("id_rsa_cert").HasPassphrase())
+	assert.Equal(t, "new private key", GetPrivateKey("id_rsa_cert"))
+	_, err = credential.Get(certificate.PrivateKeyID)
+	assert.Error(t, err)
+	_, err = credential.Get(certificate.PassphraseID)
+	assert.Error(t, err)
+}
+
+func TestPersistCertificates(t *testing.T) {
+
+	assert.NoError(t, store.SetDefault(store.NewMemoryStore()))
+	defer store.SetDefault(store.NewMemoryStore())
+	ClearList()
+
+	assert.NoError(t, SetCertificate(&Certificate{
+		Name:            "id_rsa_cert",
+		KeyType:         "ssh-rsa",
+		Fingerprint:     "SHA256:a2V5MQ",
+		UserCertificate: "ssh-rsa-cert-v01@openssh.com AAAA",
+	}, "private key", "passphrase"))
+	assert.NoError(t, AddCertificate("id_rsa", "another private key"))
+	assert.NoError(t, AddCertificate("id_ed25519", "deleted private key"))
+	assert.NoError(t, DeleteCertificate("id_ed25519"))
+	assert.NoError(t, DeleteCertificate("not_exist"))
+
+	// the certificates are restored after restart
+	ClearList()
+	credential.ClearList()
+	assert.NoError(t, Load())
+
+	certificates := GetList()
+	assert.Len(t, certificates, 2)
+	assert.Equal(t, "id_rsa", certificates[0].Name)
+	assert.Equal(t, "another private key", GetPrivateKey("id_rsa"))
+	assert.Equal(t, "id_rsa_cert", certificates[1].Name)
+	assert.Equal(t, "ssh-rsa", certificates[1].KeyType)
+	assert.Equal(t, "SHA256:a2V5MQ", certificates[1].Fingerprint)
+	assert.Equal(t, "ssh-rsa-cert-v01@openssh.com AAAA", certificates[1].UserCertificate)
+	assert.Equal(t, "private key", GetPrivateKey("id_rsa_cert"))
+	passphrase, err := credential.Get(certificates[1].PassphraseID)
+	assert.NoError(t, err)
+	assert.Equal(t, "passphrase", passphrase)
+
+	// the certificates sealed by another master key are skipped
+	masterKey, err := vault.GenerateMasterKey()
+	assert.NoError(t, err)
+	otherVault, err := vault.New(masterKey)
+	assert.NoError(t, err)
+	credential.SetVault(otherVault, false)
+	ClearList()
+	assert.NoError(t, Load())
+	assert.Empty(t, GetList())
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// The service state which should survive restarts is kept in a store, it's in memory unless a persistent store is set.
+
+package store
+
+import (
+	"sort"
+	"sync"
+)
+
+type (
+	// Store keeps the values by key in buckets
+	Store interface {
+		Put(bucket, key string, value []byte) error
+		Delete(bucket, key string) error
+		// ForEach calls fn for each value in the bucket in the order of keys, stops at the first error
+		ForEach(bucket string, fn func(key string, value []byte) error) error
+		Close() error
+	}
+
+	memoryStore struct {
+		lock    sync.RWMutex
+		buckets map[string]map[string][]byte
+	}
+)
+
+var (
+	defaultStore Store = NewMemoryStore()
+)
+
+// SetDefault sets the store of the service state, the previous one is closed
+func SetDefault(s Store) error {
+
+	previous := defaultStore
+	defaultStore = s
+	return previous.Close()
+}
+
+func Put(bucket, key string, value []byte) error {
+
+	return defaultStore.Put(bucket, key, value)
+}
+
+func Delete(bucket, key string) error {
+
+	return defaultStore.Delete(bucket, key)
+}
+
+func ForEach(bucket string, fn func(key string, value []byte) error) error {
+
+	return defaultStore.ForEach(bucket, fn)
+}
+
+func Close() error {
+
+	return defaultStore.Close()
+}
+
+// NewMemoryStore returns a store which loses the values when the service stops
+func NewMemoryStore() Store {
+
+	return &memoryStore{
+		buckets: make(map[string]map[string][]byte),
+	}
+}
+
+func (s *memoryStore) Put(bucket, key string, value []byte) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.buckets[bucket] == nil {
+		s.buckets[bucket] = make(map[string][]byte)
+	}
+	s.buckets[bucket][key] = append([]byte(nil), value...)
+	return nil
+}
+
+func (s *memoryStore) Delete(bucket, key string) error {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.buckets[bucket], key)
+	return nil
+}
+
+func (s *memoryStore) ForEach(bucket string, fn func(key string, value []byte) error) error {
+
+	s.lock.RLock()
+	keys := make([]string, 0, len(s.buckets[bucket]))
+	values := make(map[string][]byte, len(s.buckets[bucket]))
+	for key, value := range s.buckets[bucket] {
+		keys = append(keys, key)
+		values[key] = value
+	}
+	s.lock.RUnlock()
+
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := fn(key, values[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *memoryStore) Close() error {
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testStore(t *testing.T, s Store) {
+
+	assert.NoError(t, s.Put("bucket", "b", []byte("2")))
+	assert.NoError(t, s.Put("bucket", "a", []byte("1")))
+	assert.NoError(t, s.Put("bucket", "a", []byte("3")))
+	assert.NoError(t, s.Put("other", "c", []byte("4")))
+	assert.NoError(t, s.Delete("bucket", "b"))
+	assert.NoError(t, s.Delete("bucket", "not-exist"))
+	assert.NoError(t, s.Delete("not-exist", "a"))
+
+	values := make(map[string]string)
+	assert.NoError(t, s.ForEach("bucket", func(key string, value []byte) error {
+		values[key] = string(value)
+		return nil
+	}))
+	assert.Equal(t, map[string]string{"a": "3"}, values)
+	assert.NoError(t, s.ForEach("not-exist", func(key string, value []byte) error {
+		t.Errorf("unexpected key %v", key)
+		return nil
+	}))
+}
+
+func TestMemoryStore(t *testing.T) {
+
+	testStore(t, NewMemoryStore())
+}
+
+func TestBoltStore(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "state-store")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "state", "service.db")
+
+	s, err := NewBoltStore(path)
+	assert.NoError(t, err)
+	testStore(t, s)
+	assert.NoError(t, s.Close())
+
+	// the values survive reopening
+	s, err = NewBoltStore(path)
+	assert.NoError(t, err)
+	defer s.Close()
+	var keys []string
+	assert.NoError(t, s.ForEach("other", func(key string, value []byte) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	assert.Equal(t, []string{"c"}, keys)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/api"
 	"github.com/kpaas-io/kpaas/pkg/service/model/common"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 	"github.com/kpaas-io/kpaas/pkg/utils/idcreator"
 )
@@ -49,6 +50,8 @@ type (
 		NodePortMaximum         uint16
 		Labels                  []*Label
 		Annotations             []*Annotation
+		KubernetesVersion       string
+		ImageRepository         string
 	}
 
 	KubeAPIServerConnectionData struct {
@@ -179,18 +182,62 @@ func (cluster *Cluster) UpdateNode(node *Node) error {
 	targetNode.MachineRoles = node.MachineRoles
 	targetNode.Labels = node.Labels
 	targetNode.Taints = node.Taints
+	replacedCredentialIDs := targetNode.ConnectionData.CredentialIDs()
+	targetNode.ConnectionData.ConnectionType = node.ConnectionData.ConnectionType
 	targetNode.ConnectionData.IP = node.ConnectionData.IP
 	targetNode.ConnectionData.Port = node.ConnectionData.Port
 	targetNode.ConnectionData.Username = node.ConnectionData.Username
 	targetNode.ConnectionData.AuthenticationType = node.ConnectionData.AuthenticationType
 	targetNode.ConnectionData.PrivateKeyName = node.ConnectionData.PrivateKeyName
-	if len(node.ConnectionData.Password) != 0 {
-		targetNode.ConnectionData.Password = node.ConnectionData.Password
+	keepJumpHostSecrets(node.ConnectionData.JumpHosts, targetNode.ConnectionData.JumpHosts)
+	targetNode.ConnectionData.JumpHosts = node.ConnectionData.JumpHosts
+	if len(node.ConnectionData.PasswordID) != 0 {
+		targetNode.ConnectionData.PasswordID = node.ConnectionData.PasswordID
 	}
+	targetNode.ConnectionData.EscalationMethod = node.ConnectionData.EscalationMethod
+	if len(node.ConnectionData.EscalationPasswordID) != 0 || node.ConnectionData.EscalationMethod == EscalationMethodNone {
+		targetNode.ConnectionData.EscalationPasswordID = node.ConnectionData.EscalationPasswordID
+	}
+	deleteUnusedCredentials(replacedCredentialIDs, targetNode.ConnectionData.CredentialIDs())
 
 	return nil
 }
 
+// keepJumpHostSecrets keeps the password and the pinned host key of the same jump host (same ip and port) if they
+// are not changed, which are empty since a blank or redacted password is not stored.
+func keepJumpHostSecrets(jumpHosts, existingJumpHosts []*JumpHost) {
+
+	for _, jumpHost := range jumpHosts {
+		for _, existing := range existingJumpHosts {
+			if existing.IP != jumpHost.IP || existing.Port != jumpHost.Port {
+				continue
+			}
+			if len(jumpHost.PasswordID) == 0 && jumpHost.AuthenticationType == AuthenticationTypePassword &&
+				existing.AuthenticationType == AuthenticationTypePassword {
+				jumpHost.PasswordID = existing.PasswordID
+			}
+			if len(jumpHost.HostKeyFingerprint) == 0 {
+				jumpHost.HostKeyFingerprint = existing.HostKeyFingerprint
+			}
+		}
+	}
+}
+
+// deleteUnusedCredentials deletes the replaced credentials which are not used any more
+func deleteUnusedCredentials(replacedIDs, usedIDs []string) {
+
+	used := make(map[string]bool, len(usedIDs))
+	for _, id := range usedIDs {
+		used[id] = true
+	}
+
+	for _, id := range replacedIDs {
+		if !used[id] {
+			credential.Delete(id)
+		}
+	}
+}
+
 func (cluster *Cluster) DeleteNode(ip string) error {
 
 	cluster.lock.Lock()
@@ -209,6 +256,7 @@ func (cluster *Cluster) DeleteNode(ip string) error {
 			continue
 		}
 
+		credential.Delete(iterateNode.ConnectionData.CredentialIDs()...)
 		if index > 0 {
 			newList = append(newList, cluster.Nodes[:index]...)
 		}
@@ -229,6 +277,82 @@ func (cluster *Cluster) DeleteNode(ip string) error {
 	return nil
 }
 
+// RecordHostKey records the host key fingerprint presented by the node in a successful connection test.
+// The fingerprint is pinned if the node has no pinned one (trust on first use), and it is kept for
+// review if it is different from the pinned one.
+func (cluster *Cluster) RecordHostKey(ip string, fingerprint string) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	node := cluster.GetNode(ip)
+	if node == nil || fingerprint == "" {
+		return
+	}
+
+	switch node.HostKeyFingerprint {
+	case "":
+		node.HostKeyFingerprint = fingerprint
+		node.PendingHostKeyFingerprint = ""
+	case fingerprint:
+		node.PendingHostKeyFingerprint = ""
+	default:
+		node.PendingHostKeyFingerprint = fingerprint
+	}
+}
+
+// RecordJumpHostKey pins the host key fingerprint presented by a jump host of the node in a successful connection
+// test if the jump host has no pinned one (trust on first use). A changed host key of a jump host fails the test,
+// so it's never recorded here.
+func (cluster *Cluster) RecordJumpHostKey(ip string, jumpHostIP string, jumpHostPort uint16, fingerprint string) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	node := cluster.GetNode(ip)
+	if node == nil || fingerprint == "" {
+		return
+	}
+
+	for _, jumpHost := range node.JumpHosts {
+		if jumpHost.IP == jumpHostIP && jumpHost.Port == jumpHostPort && jumpHost.HostKeyFingerprint == "" {
+			jumpHost.HostKeyFingerprint = fingerprint
+		}
+	}
+}
+
+// AcceptHostKey pins the host key fingerprint of the node, it is used to accept a changed host key or rotate the key.
+func (cluster *Cluster) AcceptHostKey(ip string, fingerprint string) error {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	node := cluster.GetNode(ip)
+	if node == nil {
+		return h.ENotFound.WithPayload("node ip not exist")
+	}
+
+	node.HostKeyFingerprint = fingerprint
+	node.PendingHostKeyFingerprint = ""
+	return nil
+}
+
+// ResetHostKey removes the pinned host key fingerprint of the node, the host key will be trusted on next use.
+func (cluster *Cluster) ResetHostKey(ip string) error {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	node := cluster.GetNode(ip)
+	if node == nil {
+		return h.ENotFound.WithPayload("node ip not exist")
+	}
+
+	node.HostKeyFingerprint = ""
+	node.PendingHostKeyFingerprint = ""
+	return nil
+}
+
 func (cluster *Cluster) GetNode(ip string) *Node {
 
 	for _, node := range cluster.Nodes {
@@ -251,6 +375,40 @@ func (cluster *Cluster) GetNodeByName(name string) *Node {
 	return nil
 }
 
+// GetNodesUsingPrivateKey returns the names of the nodes which log in with the private key, directly or on a jump host
+func (cluster *Cluster) GetNodesUsingPrivateKey(privateKeyName string) []string {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	return cluster.nodesUsingPrivateKey(privateKeyName)
+}
+
+// DeleteUnusedPrivateKey deletes the private key by deleteKey if no node logs in with it, the check and the deletion
+// are done under the lock of the wizard, so no node starts to use the key in between. The names of the nodes using
+// the key are returned if it's not deleted.
+func (cluster *Cluster) DeleteUnusedPrivateKey(privateKeyName string, deleteKey func(name string) error) ([]string, error) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	if names := cluster.nodesUsingPrivateKey(privateKeyName); len(names) > 0 {
+		return names, nil
+	}
+	return nil, deleteKey(privateKeyName)
+}
+
+func (cluster *Cluster) nodesUsingPrivateKey(privateKeyName string) []string {
+
+	var names []string
+	for _, node := range cluster.Nodes {
+		if node.usePrivateKey(privateKeyName) {
+			names = append(names, node.Name)
+		}
+	}
+	return names
+}
+
 func (cluster *Cluster) MarkNodeChecking() error {
 
 	cluster.lock.Lock()
@@ -320,6 +478,65 @@ func (cluster *Cluster) ClearClusterDeployData() {
 	return
 }
 
+// ClearNodesDeployData clears the deployment data of the nodes to scale out, the deployment
+// data of the other nodes are kept.
+func (cluster *Cluster) ClearNodesDeployData(nodes []*Node) {
+
+	cluster.lock.Lock()
+	defer cluster.lock.Unlock()
+
+	cluster.DeployClusterStatus = DeployClusterStatusPending
+	cluster.DeployClusterError = nil
+
+	for _, node := range nodes {
+
+		node.initDeploymentReports()
+	}
+}
+
+// IsDeployed returns whether the cluster has been deployed, that is any master was deployed.
+// Nodes can only be added to a deployed cluster by scale-out.
+func (cluster *Cluster) IsDeployed() bool {
+
+	return len(cluster.GetDeployedMasters()) > 0
+}
+
+// GetDeployedMasters returns the deployed masters in the order they were added,
+// the first one is the master which initialized the cluster.
+func (cluster *Cluster) GetDeployedMasters() []*Node {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	masters := make([]*Node, 0)
+	for _, node := range cluster.Nodes {
+
+		if node.IsMatchMachineRole(constant.MachineRoleMaster) && node.IsDeployed() {
+			masters = append(masters, node)
+		}
+	}
+
+	return masters
+}
+
+// GetUndeployedNodes returns the nodes which have not been deployed,
+// they are the new nodes to check and deploy when scaling out a deployed cluster.
+func (cluster *Cluster) GetUndeployedNodes() []*Node {
+
+	cluster.lock.RLock()
+	defer cluster.lock.RUnlock()
+
+	nodes := make([]*Node, 0)
+	for _, node := range cluster.Nodes {
+
+		if !node.IsDeployed() {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
 func (cluster *Cluster) MarkNodeDeploying() error {
 
 	cluster.lock.Lock()
@@ -396,6 +613,8 @@ func (info *ClusterInfo) init() {
 	info.Annotations = make([]*Annotation, 0, 0)
 	info.NodePortMinimum = DefaultNodePortMinimum
 	info.NodePortMaximum = DefaultNodePortMaximum
+	info.KubernetesVersion = constant.DefaultKubeVersion
+	info.ImageRepository = constant.DefaultImageRepository
 }
 
 func NewNetworkOptions() *api.NetworkOptions {
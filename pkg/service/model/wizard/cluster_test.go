@@ -24,6 +24,7 @@ import (
 
 	"github.com/kpaas-io/kpaas/pkg/constant"
 	"github.com/kpaas-io/kpaas/pkg/service/model/common"
+	"github.com/kpaas-io/kpaas/pkg/service/model/credential"
 	"github.com/kpaas-io/kpaas/pkg/utils/h"
 )
 
@@ -180,7 +181,7 @@ func TestCluster_AddNode(t *testing.T) {
 						Port:               22,
 						Username:           "root",
 						AuthenticationType: AuthenticationTypePassword,
-						Password:           "123456",
+						PasswordID:         "credential-1",
 					},
 				},
 			},
@@ -197,7 +198,7 @@ func TestCluster_AddNode(t *testing.T) {
 								Port:               22,
 								Username:           "root",
 								AuthenticationType: AuthenticationTypePassword,
-								Password:           "123456",
+								PasswordID:         "credential-1",
 							},
 						},
 					},
@@ -347,7 +348,7 @@ func TestCluster_UpdateNode(t *testing.T) {
 								Port:               22,
 								Username:           "root",
 								AuthenticationType: AuthenticationTypePassword,
-								Password:           "123456",
+								PasswordID:         "credential-1",
 							},
 						},
 					},
@@ -360,7 +361,7 @@ func TestCluster_UpdateNode(t *testing.T) {
 						Port:               22,
 						Username:           "root",
 						AuthenticationType: AuthenticationTypePassword,
-						Password:           "45678",
+						PasswordID:         "credential-2",
 					},
 					DockerRootDirectory: DefaultDockerRootDirectory,
 				},
@@ -378,7 +379,7 @@ func TestCluster_UpdateNode(t *testing.T) {
 								Port:               22,
 								Username:           "root",
 								AuthenticationType: AuthenticationTypePassword,
-								Password:           "45678",
+								PasswordID:         "credential-2",
 							},
 							DockerRootDirectory: DefaultDockerRootDirectory,
 						},
@@ -1439,3 +1440,223 @@ func TestCluster_AddNodeList(t *testing.T) {
 		assert.Equal(t, test.WantNodeList, cluster.Nodes)
 	}
 }
+
+func TestCluster_RecordJumpHostKey(t *testing.T) {
+
+	cluster := NewCluster()
+	cluster.Nodes = []*Node{
+		{
+			Name: "node1",
+			ConnectionData: ConnectionData{
+				IP: "192.168.1.1",
+				JumpHosts: []*JumpHost{
+					{IP: "192.168.2.1", Port: 22},
+					{IP: "192.168.2.2", Port: 22, HostKeyFingerprint: "SHA256:a2V5MQ"},
+				},
+			},
+		},
+	}
+
+	cluster.RecordJumpHostKey("192.168.1.1", "192.168.2.1", 22, "SHA256:a2V5Mg")
+	assert.Equal(t, "SHA256:a2V5Mg", cluster.Nodes[0].JumpHosts[0].HostKeyFingerprint)
+
+	// a pinned host key is never replaced
+	cluster.RecordJumpHostKey("192.168.1.1", "192.168.2.2", 22, "SHA256:a2V5Mg")
+	assert.Equal(t, "SHA256:a2V5MQ", cluster.Nodes[0].JumpHosts[1].HostKeyFingerprint)
+
+	// a jump host which isn't used by the node is ignored
+	cluster.RecordJumpHostKey("192.168.1.1", "192.168.2.3", 22, "SHA256:a2V5Mg")
+	assert.Len(t, cluster.Nodes[0].JumpHosts, 2)
+}
+
+func TestCluster_RecordHostKey(t *testing.T) {
+
+	cluster := NewCluster()
+	cluster.Nodes = []*Node{
+		{
+			Name:           "node1",
+			ConnectionData: ConnectionData{IP: "192.168.1.1"},
+		},
+	}
+
+	// a node which doesn't exist is ignored
+	cluster.RecordHostKey("192.168.1.2", "SHA256:a2V5MQ")
+
+	cluster.RecordHostKey("192.168.1.1", "SHA256:a2V5MQ")
+	assert.Equal(t, "SHA256:a2V5MQ", cluster.Nodes[0].HostKeyFingerprint)
+	assert.Empty(t, cluster.Nodes[0].PendingHostKeyFingerprint)
+
+	cluster.RecordHostKey("192.168.1.1", "SHA256:a2V5Mg")
+	assert.Equal(t, "SHA256:a2V5MQ", cluster.Nodes[0].HostKeyFingerprint)
+	assert.Equal(t, "SHA256:a2V5Mg", cluster.Nodes[0].PendingHostKeyFingerprint)
+
+	assert.NoError(t, cluster.AcceptHostKey("192.168.1.1", "SHA256:a2V5Mg"))
+	assert.Equal(t, "SHA256:a2V5Mg", cluster.Nodes[0].HostKeyFingerprint)
+	assert.Empty(t, cluster.Nodes[0].PendingHostKeyFingerprint)
+
+	assert.NoError(t, cluster.ResetHostKey("192.168.1.1"))
+	assert.Empty(t, cluster.Nodes[0].HostKeyFingerprint)
+
+	assert.Error(t, cluster.AcceptHostKey("192.168.1.2", "SHA256:a2V5Mg"))
+	assert.Error(t, cluster.ResetHostKey("192.168.1.2"))
+}
+
+func TestCluster_NodeCredentials(t *testing.T) {
+
+	passwordID, _ := credential.Add("123456")
+	escalationPasswordID, _ := credential.Add("654321")
+	cluster := NewCluster()
+	cluster.Nodes = []*Node{
+		{
+			Name: "node1",
+			ConnectionData: ConnectionData{
+				IP:                   "192.168.1.1",
+				AuthenticationType:   AuthenticationTypePassword,
+				PasswordID:           passwordID,
+				EscalationMethod:     EscalationMethodSudo,
+				EscalationPasswordID: escalationPasswordID,
+			},
+		},
+	}
+
+	// the credentials which are not changed are kept
+	newPasswordID, _ := credential.Add("abcdef")
+	assert.NoError(t, cluster.UpdateNode(&Node{
+		Name: "node1",
+		ConnectionData: ConnectionData{
+			IP:                 "192.168.1.1",
+			AuthenticationType: AuthenticationTypePassword,
+			PasswordID:         newPasswordID,
+			EscalationMethod:   EscalationMethodSudo,
+		},
+	}))
+	_, err := credential.Get(passwordID)
+	assert.Error(t, err)
+	password, err := credential.Get(escalationPasswordID)
+	assert.NoError(t, err)
+	assert.Equal(t, "654321", password)
+
+	// the password of a jump host is kept if it's blank or redacted in the update
+	jumpHostPasswordID, _ := credential.Add("jump")
+	cluster.Nodes[0].JumpHosts = []*JumpHost{
+		{
+			IP:                 "10.1.1.1",
+			Port:               22,
+			AuthenticationType: AuthenticationTypePassword,
+			PasswordID:         jumpHostPasswordID,
+			HostKeyFingerprint: "SHA256:a2V5MQ",
+		},
+	}
+	assert.NoError(t, cluster.UpdateNode(&Node{
+		Name: "node1",
+		ConnectionData: ConnectionData{
+			IP:                 "192.168.1.1",
+			AuthenticationType: AuthenticationTypePassword,
+			EscalationMethod:   EscalationMethodSudo,
+			JumpHosts: []*JumpHost{
+				{IP: "10.1.1.1", Port: 22, AuthenticationType: AuthenticationTypePassword},
+			},
+		},
+	}))
+	assert.Equal(t, jumpHostPasswordID, cluster.Nodes[0].JumpHosts[0].PasswordID)
+	assert.Equal(t, "SHA256:a2V5MQ", cluster.Nodes[0].JumpHosts[0].HostKeyFingerprint)
+	password, err = credential.Get(jumpHostPasswordID)
+	assert.NoError(t, err)
+	assert.Equal(t, "jump", password)
+
+	assert.NoError(t, cluster.DeleteNode("192.168.1.1"))
+	_, err = credential.Get(jumpHostPasswordID)
+	assert.Error(t, err)
+	_, err = credential.Get(newPasswordID)
+	assert.Error(t, err)
+	_, err = credential.Get(escalationPasswordID)
+	assert.Error(t, err)
+}
+
+func TestCluster_GetNodesUsingPrivateKey(t *testing.T) {
+
+	cluster := NewCluster()
+	cluster.Nodes = []*Node{
+		{
+			Name: "node1",
+			ConnectionData: ConnectionData{
+				IP:                 "192.168.1.1",
+				AuthenticationType: AuthenticationTypePrivateKey,
+				PrivateKeyName:     "id_rsa",
+			},
+		},
+		{
+			Name: "node2",
+			ConnectionData: ConnectionData{
+				IP:                 "192.168.1.2",
+				AuthenticationType: AuthenticationTypePassword,
+				JumpHosts: []*JumpHost{
+					{IP: "10.1.1.1", AuthenticationType: AuthenticationTypePrivateKey, PrivateKeyName: "id_rsa"},
+				},
+			},
+		},
+		{
+			Name: "node3",
+			ConnectionData: ConnectionData{
+				IP:                 "192.168.1.3",
+				AuthenticationType: AuthenticationTypePrivateKey,
+				PrivateKeyName:     "id_ed25519",
+			},
+		},
+	}
+
+	assert.Equal(t, []string{"node1", "node2"}, cluster.GetNodesUsingPrivateKey("id_rsa"))
+	assert.Equal(t, []string{"node3"}, cluster.GetNodesUsingPrivateKey("id_ed25519"))
+	assert.Empty(t, cluster.GetNodesUsingPrivateKey("not_exist"))
+
+	var deleted []string
+	deleteKey := func(name string) error {
+		deleted = append(deleted, name)
+		return nil
+	}
+	nodes, err := cluster.DeleteUnusedPrivateKey("id_rsa", deleteKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node1", "node2"}, nodes)
+	nodes, err = cluster.DeleteUnusedPrivateKey("not_exist", deleteKey)
+	assert.NoError(t, err)
+	assert.Empty(t, nodes)
+	assert.Equal(t, []string{"not_exist"}, deleted)
+}
+
+func TestCluster_GetUndeployedNodes(t *testing.T) {
+
+	newDeployedNode := func(name string, roles ...constant.MachineRole) *Node {
+		node := NewNode()
+		node.Name = name
+		node.MachineRoles = roles
+		for _, role := range roles {
+			node.SetDeployResult(constant.DeployItem(role), DeployStatusSuccessful, nil)
+		}
+		return node
+	}
+
+	cluster := NewCluster()
+	assert.False(t, cluster.IsDeployed())
+
+	master1 := newDeployedNode("master1", constant.MachineRoleMaster, constant.MachineRoleEtcd)
+	master2 := newDeployedNode("master2", constant.MachineRoleMaster)
+	// the join of master2 failed
+	master2.SetDeployResult(constant.DeployItemMaster, DeployStatusFailed, nil)
+	worker1 := newDeployedNode("worker1", constant.MachineRoleWorker)
+	// the network item doesn't matter
+	worker1.SetDeployResult(constant.DeployItemNetwork, DeployStatusFailed, nil)
+	worker2 := NewNode()
+	worker2.Name = "worker2"
+	worker2.MachineRoles = []constant.MachineRole{constant.MachineRoleWorker}
+	cluster.Nodes = []*Node{master1, master2, worker1, worker2}
+
+	assert.True(t, cluster.IsDeployed())
+	assert.Equal(t, []*Node{master1}, cluster.GetDeployedMasters())
+	assert.Equal(t, []*Node{master2, worker2}, cluster.GetUndeployedNodes())
+
+	cluster.DeployClusterStatus = DeployClusterStatusFailed
+	cluster.ClearNodesDeployData([]*Node{master2})
+	assert.Equal(t, DeployClusterStatusPending, cluster.DeployClusterStatus)
+	assert.Empty(t, master2.DeploymentReports)
+	assert.NotEmpty(t, master1.DeploymentReports)
+}
@@ -37,12 +37,28 @@ type (
 	}
 
 	ConnectionData struct {
-		IP                 string             // node ip
+		ConnectionType            ConnectionType     // connect the node via ssh, or run on the host of deploy controller directly
+		IP                        string             // node ip
+		Port                      uint16             // ssh port
+		Username                  string             // ssh username
+		AuthenticationType        AuthenticationType // type of authorization
+		PasswordID                string             // credential ID of the login password
+		PrivateKeyName            string             // the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization
+		HostKeyFingerprint        string             // pinned SHA256 fingerprint of the ssh host key
+		PendingHostKeyFingerprint string             // fingerprint of a changed host key, waiting for review
+		JumpHosts                 []*JumpHost        // proxy hosts to reach the node in order
+		EscalationMethod          EscalationMethod   // privilege escalation to run commands as root, no escalation if it's empty
+		EscalationPasswordID      string             // credential ID of the password of sudo, or the password of root for su
+	}
+
+	JumpHost struct {
+		IP                 string             // jump host ip
 		Port               uint16             // ssh port
 		Username           string             // ssh username
 		AuthenticationType AuthenticationType // type of authorization
-		Password           string             // login password
+		PasswordID         string             // credential ID of the login password
 		PrivateKeyName     string             // the private key name of login
+		HostKeyFingerprint string             // pinned SHA256 fingerprint of the ssh host key
 	}
 
 	DeploymentReport struct {
@@ -79,16 +95,30 @@ type (
 		Effect TaintEffect
 	}
 
-	AuthenticationType string // Type of authorization,  password or privateKey
+	AuthenticationType string // Type of authorization, password, privateKey, privateKeyWithPassphrase, agent or certificate
 
 	TaintEffect string // Taint Effect, NoSchedule, NoExecute or PreferNoSchedule
 
 	DeployStatus string // Deploy node status
+
+	EscalationMethod string // Privilege escalation method, sudo or su
+
+	ConnectionType string // Type of connection, ssh or local
 )
 
 const (
-	AuthenticationTypePassword   AuthenticationType = "password"   // Use Password to authorize
-	AuthenticationTypePrivateKey AuthenticationType = "privateKey" // Use RSA PrivateKey to authorize
+	AuthenticationTypePassword                 AuthenticationType = "password"                 // Use Password to authorize
+	AuthenticationTypePrivateKey               AuthenticationType = "privateKey"               // Use RSA PrivateKey to authorize
+	AuthenticationTypePrivateKeyWithPassphrase AuthenticationType = "privateKeyWithPassphrase" // Use PrivateKey encrypted by passphrase to authorize
+	AuthenticationTypeAgent                    AuthenticationType = "agent"                    // Use the ssh agent of deploy controller to authorize
+	AuthenticationTypeCertificate              AuthenticationType = "certificate"              // Use OpenSSH user certificate signed by CA to authorize
+
+	ConnectionTypeSSH   ConnectionType = "ssh"   // Connect the node via ssh
+	ConnectionTypeLocal ConnectionType = "local" // The node is the host of deploy controller
+
+	EscalationMethodNone EscalationMethod = ""     // Login as root, no privilege escalation
+	EscalationMethodSudo EscalationMethod = "sudo" // Run commands by sudo
+	EscalationMethodSu   EscalationMethod = "su"   // Run commands by su with the password of root
 
 	TaintEffectNoSchedule       TaintEffect = "NoSchedule"
 	TaintEffectNoExecute        TaintEffect = "NoExecute"
@@ -117,6 +147,7 @@ func (node *Node) init() {
 	node.initDeploymentReports()
 	node.Labels = make([]*Label, 0, 0)
 	node.Taints = make([]*Taint, 0, 0)
+	node.ConnectionData.ConnectionType = ConnectionTypeSSH
 	node.ConnectionData.Port = uint16(22)
 	node.ConnectionData.Username = DefaultUsername
 	node.ConnectionData.AuthenticationType = AuthenticationTypePassword
@@ -196,6 +227,27 @@ func (node *Node) IsMatchMachineRole(role constant.MachineRole) bool {
 	return false
 }
 
+// IsDeployed returns whether the node has been deployed into the cluster,
+// that is the deploy items of all its machine roles are successful.
+func (node *Node) IsDeployed() bool {
+
+	node.rwLock.RLock()
+	defer node.rwLock.RUnlock()
+
+	if len(node.MachineRoles) == 0 {
+		return false
+	}
+
+	for _, role := range node.MachineRoles {
+		report, exist := node.DeploymentReports[constant.DeployItem(role)]
+		if !exist || report.Status != DeployStatusSuccessful {
+			return false
+		}
+	}
+
+	return true
+}
+
 func NewDeploymentReport() *DeploymentReport {
 
 	report := new(DeploymentReport)
@@ -226,3 +278,40 @@ func (report *CheckReport) init() {
 	report.CheckedError = nil
 	report.CheckItems = make([]*CheckItem, 0, 0)
 }
+
+// UsePrivateKey returns whether the authorization uses a private key referenced by PrivateKeyName
+func (authenticationType AuthenticationType) UsePrivateKey() bool {
+
+	switch authenticationType {
+	case AuthenticationTypePrivateKey, AuthenticationTypePrivateKeyWithPassphrase, AuthenticationTypeCertificate:
+		return true
+	}
+	return false
+}
+
+// CredentialIDs returns the IDs of all the credentials used to connect the node
+func (data *ConnectionData) CredentialIDs() []string {
+
+	ids := []string{data.PasswordID, data.EscalationPasswordID}
+	for _, jumpHost := range data.JumpHosts {
+		ids = append(ids, jumpHost.PasswordID)
+	}
+	return ids
+}
+
+// usePrivateKey returns whether the node or any of its jump hosts logs in with the private key
+func (data *ConnectionData) usePrivateKey(privateKeyName string) bool {
+
+	if privateKeyName == "" {
+		return false
+	}
+	if data.PrivateKeyName == privateKeyName {
+		return true
+	}
+	for _, jumpHost := range data.JumpHosts {
+		if jumpHost.PrivateKeyName == privateKeyName {
+			return true
+		}
+	}
+	return false
+}
@@ -193,7 +193,7 @@ var doc = `{
                 }
             },
             "post": {
-                "description": "Launch deployment",
+                "description": "Launch deployment, or resume the latest deployment of the deployed cluster if it was not fully successful, the deployed nodes are skipped and the failed nodes are deployed again",
                 "produces": [
                     "application/json"
                 ],
@@ -216,6 +216,31 @@ var doc = `{
                         }
                     }
                 }
+            },
+            "delete": {
+                "description": "Cancel the running deployment, the running steps will be interrupted and the pending steps will be aborted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deploy"
+                ],
+                "summary": "Cancel deployment",
+                "operationId": "CancelDeployment",
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
             }
         },
         "/api/v1/deploy/wizard/kubeconfigs": {
@@ -251,6 +276,61 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/deploy/wizard/livelogs": {
+            "get": {
+                "description": "Follow the deployment log of a node in a role as server-sent events, a \"log\" event is sent whenever the node writes some log, and an \"end\" event is sent once the deployment finished",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "log"
+                ],
+                "summary": "Follow the deployment log of a node",
+                "operationId": "TailDeployLog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node name",
+                        "name": "nodeName",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "master",
+                            "worker",
+                            "etcd",
+                            "ingress"
+                        ],
+                        "type": "string",
+                        "description": "Deploy role of the node",
+                        "name": "role",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data of the log event",
+                        "schema": {
+                            "$ref": "#/definitions/api.DeployLog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/deploy/wizard/logs/{id}": {
             "get": {
                 "description": "Download the deployment log details to check the cause of the error",
@@ -560,6 +640,169 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/deploy/wizard/nodes/{ip}/hostkeys": {
+            "get": {
+                "description": "Get the pinned ssh host key fingerprint of a node, and the fingerprint of the changed host key which is waiting for review",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Get the ssh host key of a node",
+                "operationId": "GetNodeHostKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node IP Address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.HostKey"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Pin the ssh host key fingerprint of a node, it's used to accept a changed host key after review or rotate the host key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Accept or rotate the ssh host key of a node",
+                "operationId": "AcceptNodeHostKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node IP Address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Host key fingerprint",
+                        "name": "hostKey",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.AcceptHostKeyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.HostKey"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the pinned ssh host key fingerprint of a node, the host key will be trusted on the next connection test",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "node"
+                ],
+                "summary": "Reset the ssh host key of a node",
+                "operationId": "ResetNodeHostKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node IP Address",
+                        "name": "ip",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {},
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/plans": {
+            "get": {
+                "description": "Get what the deployment will do on each node, including the scripts and configurations which will be put to the node and the commands which will be run, nothing is done on the nodes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deploy"
+                ],
+                "summary": "Get the plan of deployment",
+                "operationId": "GetDeploymentPlan",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.DeploymentPlan"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/deploy/wizard/progresses": {
             "get": {
                 "description": "Get all data, include current progress, cluster and node data. deploying progress or error.",
@@ -592,6 +835,80 @@ var doc = `{
                 }
             }
         },
+        "/api/v1/deploy/wizard/scaleouts": {
+            "post": {
+                "description": "Deploy the new nodes which were added after the cluster was deployed, only the new nodes are initialized and joined to the cluster. The new nodes must be checked before.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deploy"
+                ],
+                "summary": "Scale out the deployed cluster",
+                "operationId": "ScaleOutCluster",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/deploy/wizard/upgrades": {
+            "post": {
+                "description": "Upgrade the kubernetes of the deployed nodes to the version, the masters are upgraded one by one from the first one, then the other nodes. The kubernetes version of the cluster is changed to the version once the upgrade succeeded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deploy"
+                ],
+                "summary": "Upgrade the deployed cluster",
+                "operationId": "UpgradeCluster",
+                "parameters": [
+                    {
+                        "description": "RequiredFields: kubernetesVersion",
+                        "name": "upgrade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.UpgradeCluster"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
         "/api/v1/helm/clusters/{cluster}/namespaces/{namespace}/releases": {
             "get": {
                 "description": "list all releases in a namespace",
@@ -1015,7 +1332,7 @@ var doc = `{
         },
         "/api/v1/ssh_certificates": {
             "get": {
-                "description": "Get SSH login certificate keys list",
+                "description": "Get SSH login certificate keys list with the key metadata and the nodes using them",
                 "produces": [
                     "application/json"
                 ],
@@ -1028,40 +1345,142 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api.GetSSHCertificateListResponse"
+                            "$ref": "#/definitions/api.GetSSHCertificateListResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add SSH login private key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ssh_certificate"
+                ],
+                "summary": "Add SSH login private key",
+                "operationId": "AddSSHCertificate",
+                "parameters": [
+                    {
+                        "description": "Certificate information",
+                        "name": "certificate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api.SSHCertificate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/ssh_certificates/{name}": {
+            "put": {
+                "description": "Replace the private key, the passphrase and the user certificate, the nodes using the key log in with the new one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ssh_certificate"
+                ],
+                "summary": "Update SSH login private key",
+                "operationId": "UpdateSSHCertificate",
+                "parameters": [
+                    {
+                        "description": "Certificate information",
+                        "name": "certificate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/api.SSHCertificate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Certificate name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api.SuccessfulOption"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "Add SSH login private key",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Delete SSH login private key, it's refused if any node logs in with the key",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "ssh_certificate"
                 ],
-                "summary": "Add SSH login private key",
-                "operationId": "AddSSHCertificate",
+                "summary": "Delete SSH login private key",
+                "operationId": "DeleteSSHCertificate",
                 "parameters": [
                     {
-                        "description": "Certificate information",
-                        "name": "certificate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api.SSHCertificate"
-                        }
+                        "type": "string",
+                        "description": "Certificate name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "204": {},
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/api.SuccessfulOption"
+                            "$ref": "#/definitions/h.AppErr"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/h.AppErr"
                         }
                     }
                 }
@@ -1069,6 +1488,19 @@ var doc = `{
         }
     },
     "definitions": {
+        "api.AcceptHostKeyRequest": {
+            "type": "object",
+            "required": [
+                "fingerprint"
+            ],
+            "properties": {
+                "fingerprint": {
+                    "description": "SHA256 fingerprint of the host key to pin, such as \"SHA256:xxx\"",
+                    "type": "string",
+                    "maxLength": 128
+                }
+            }
+        },
         "api.Annotation": {
             "type": "object",
             "required": [
@@ -1180,6 +1612,11 @@ var doc = `{
                         "$ref": "#/definitions/api.Annotation"
                     }
                 },
+                "imageRepository": {
+                    "description": "repository of the kubernetes images, such as registry.local:5000/kpaas",
+                    "type": "string",
+                    "default": "docker.io/kpaas"
+                },
                 "kubeAPIServerConnectType": {
                     "description": "kube-apiserver connect type",
                     "type": "string",
@@ -1189,6 +1626,11 @@ var doc = `{
                         "loadbalancer"
                     ]
                 },
+                "kubernetesVersion": {
+                    "description": "kubernetes version to deploy, must be one of the supported versions",
+                    "type": "string",
+                    "default": "1.16.3"
+                },
                 "labels": {
                     "type": "array",
                     "items": {
@@ -1249,15 +1691,39 @@ var doc = `{
                     "type": "string",
                     "enum": [
                         "password",
-                        "privateKey"
+                        "privateKey",
+                        "privateKeyWithPassphrase",
+                        "agent",
+                        "certificate"
+                    ]
+                },
+                "connectionType": {
+                    "description": "connect the node via ssh, or run commands on the host of deploy controller directly if it's local, the ssh options are ignored by local",
+                    "type": "string",
+                    "default": "ssh",
+                    "enum": [
+                        "ssh",
+                        "local"
                     ]
                 },
+                "hostKeyFingerprint": {
+                    "description": "SHA256 fingerprint of the ssh host key, such as \"SHA256:xxx\", the host key is trusted on first use by a connection test if it's empty",
+                    "type": "string",
+                    "maxLength": 128
+                },
                 "ip": {
                     "description": "node ip",
                     "type": "string",
                     "maxLength": 15,
                     "minLength": 1
                 },
+                "jumpHosts": {
+                    "description": "proxy hosts to reach the node, the first one is connected directly, and the node is connected through the last one",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JumpHost"
+                    }
+                },
                 "password": {
                     "description": "login password",
                     "type": "string"
@@ -1270,9 +1736,14 @@ var doc = `{
                     "minimum": 1
                 },
                 "privateKeyName": {
-                    "description": "the private key name of login",
+                    "description": "the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization",
                     "type": "string"
                 },
+                "privilegeEscalation": {
+                    "description": "privilege escalation to run commands as root if the login user is not root",
+                    "type": "object",
+                    "$ref": "#/definitions/api.PrivilegeEscalation"
+                },
                 "username": {
                     "description": "ssh username",
                     "type": "string",
@@ -1280,6 +1751,53 @@ var doc = `{
                 }
             }
         },
+        "api.DeployLog": {
+            "type": "object",
+            "properties": {
+                "actionName": {
+                    "description": "name of the deploy action which wrote the log",
+                    "type": "string"
+                },
+                "log": {
+                    "description": "a piece of the log",
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeploymentActionPlan": {
+            "type": "object",
+            "properties": {
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "description": "why the action can't be planned",
+                    "type": "object",
+                    "$ref": "#/definitions/api.Error"
+                },
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeploymentPlanFile"
+                    }
+                },
+                "name": {
+                    "description": "action name",
+                    "type": "string"
+                },
+                "nodeName": {
+                    "description": "the node where the files will be put and the commands will be run",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "action type",
+                    "type": "string"
+                }
+            }
+        },
         "api.DeploymentNode": {
             "type": "object",
             "properties": {
@@ -1304,6 +1822,51 @@ var doc = `{
                 }
             }
         },
+        "api.DeploymentPlan": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeploymentActionPlan"
+                    }
+                },
+                "dependencies": {
+                    "description": "names of the sibling tasks which should be done before the task starts",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "task name",
+                    "type": "string"
+                },
+                "subTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.DeploymentPlan"
+                    }
+                },
+                "type": {
+                    "description": "task type",
+                    "type": "string"
+                }
+            }
+        },
+        "api.DeploymentPlanFile": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "file content",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "file path in the node",
+                    "type": "string"
+                }
+            }
+        },
         "api.DeploymentResponseData": {
             "type": "object",
             "properties": {
@@ -1414,6 +1977,13 @@ var doc = `{
         "api.GetSSHCertificateListResponse": {
             "type": "object",
             "properties": {
+                "certificates": {
+                    "description": "the certificates with the key metadata, sorted by name",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.SSHCertificateSummary"
+                    }
+                },
                 "names": {
                     "type": "array",
                     "items": {
@@ -1519,6 +2089,71 @@ var doc = `{
                 "type": "object"
             }
         },
+        "api.HostKey": {
+            "type": "object",
+            "properties": {
+                "fingerprint": {
+                    "description": "pinned SHA256 fingerprint of the ssh host key",
+                    "type": "string"
+                },
+                "pendingFingerprint": {
+                    "description": "fingerprint of a changed host key, waiting for review",
+                    "type": "string"
+                }
+            }
+        },
+        "api.JumpHost": {
+            "type": "object",
+            "required": [
+                "ip",
+                "port",
+                "username"
+            ],
+            "properties": {
+                "authorizationType": {
+                    "description": "type of authorization",
+                    "type": "string",
+                    "enum": [
+                        "password",
+                        "privateKey",
+                        "privateKeyWithPassphrase",
+                        "agent",
+                        "certificate"
+                    ]
+                },
+                "hostKeyFingerprint": {
+                    "description": "SHA256 fingerprint of the ssh host key, it's trusted on first use by a connection test if it's empty",
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "ip": {
+                    "description": "jump host ip",
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 1
+                },
+                "password": {
+                    "description": "login password",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "ssh port",
+                    "type": "integer",
+                    "default": 22,
+                    "maximum": 65535,
+                    "minimum": 1
+                },
+                "privateKeyName": {
+                    "description": "the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "ssh username",
+                    "type": "string",
+                    "maxLength": 128
+                }
+            }
+        },
         "api.Label": {
             "type": "object",
             "required": [
@@ -1563,7 +2198,19 @@ var doc = `{
                     "type": "string",
                     "enum": [
                         "password",
-                        "privateKey"
+                        "privateKey",
+                        "privateKeyWithPassphrase",
+                        "agent",
+                        "certificate"
+                    ]
+                },
+                "connectionType": {
+                    "description": "connect the node via ssh, or run commands on the host of deploy controller directly if it's local, the ssh options are ignored by local",
+                    "type": "string",
+                    "default": "ssh",
+                    "enum": [
+                        "ssh",
+                        "local"
                     ]
                 },
                 "description": {
@@ -1575,12 +2222,24 @@ var doc = `{
                     "type": "string",
                     "default": "/var/lib/docker"
                 },
+                "hostKeyFingerprint": {
+                    "description": "SHA256 fingerprint of the ssh host key, such as \"SHA256:xxx\", the host key is trusted on first use by a connection test if it's empty",
+                    "type": "string",
+                    "maxLength": 128
+                },
                 "ip": {
                     "description": "node ip",
                     "type": "string",
                     "maxLength": 15,
                     "minLength": 1
                 },
+                "jumpHosts": {
+                    "description": "proxy hosts to reach the node, the first one is connected directly, and the node is connected through the last one",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JumpHost"
+                    }
+                },
                 "labels": {
                     "description": "Node labels",
                     "type": "array",
@@ -1606,9 +2265,14 @@ var doc = `{
                     "minimum": 1
                 },
                 "privateKeyName": {
-                    "description": "the private key name of login",
+                    "description": "the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization",
                     "type": "string"
                 },
+                "privilegeEscalation": {
+                    "description": "privilege escalation to run commands as root if the login user is not root",
+                    "type": "object",
+                    "$ref": "#/definitions/api.PrivilegeEscalation"
+                },
                 "roles": {
                     "description": "machine role, Master and worker roles are mutually exclusive.",
                     "type": "string",
@@ -1632,6 +2296,23 @@ var doc = `{
                 }
             }
         },
+        "api.PrivilegeEscalation": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "description": "run commands by sudo, or by su with the password of root",
+                    "type": "string",
+                    "enum": [
+                        "sudo",
+                        "su"
+                    ]
+                },
+                "password": {
+                    "description": "password of sudo, or password of root for su",
+                    "type": "string"
+                }
+            }
+        },
         "api.SSHCertificate": {
             "type": "object",
             "required": [
@@ -1639,11 +2320,50 @@ var doc = `{
                 "name"
             ],
             "properties": {
+                "certificate": {
+                    "description": "OpenSSH user certificate of the private key signed by the CA, in authorized_keys format",
+                    "type": "string"
+                },
                 "content": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "passphrase": {
+                    "description": "passphrase of the encrypted private key",
+                    "type": "string"
+                }
+            }
+        },
+        "api.SSHCertificateSummary": {
+            "type": "object",
+            "properties": {
+                "fingerprint": {
+                    "description": "SHA256 fingerprint of the public key",
+                    "type": "string"
+                },
+                "hasCertificate": {
+                    "description": "whether there is an OpenSSH user certificate of the private key",
+                    "type": "boolean"
+                },
+                "hasPassphrase": {
+                    "description": "whether the private key is encrypted by a passphrase",
+                    "type": "boolean"
+                },
+                "keyType": {
+                    "description": "type of the public key, like ssh-rsa or ssh-ed25519",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "description": "names of the nodes which log in with the key, the key can't be deleted while it's used",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -1691,7 +2411,19 @@ var doc = `{
                     "type": "string",
                     "enum": [
                         "password",
-                        "privateKey"
+                        "privateKey",
+                        "privateKeyWithPassphrase",
+                        "agent",
+                        "certificate"
+                    ]
+                },
+                "connectionType": {
+                    "description": "connect the node via ssh, or run commands on the host of deploy controller directly",
+                    "type": "string",
+                    "default": "ssh",
+                    "enum": [
+                        "ssh",
+                        "local"
                     ]
                 },
                 "description": {
@@ -1703,6 +2435,13 @@ var doc = `{
                     "type": "string",
                     "default": "/var/lib/docker"
                 },
+                "jumpHosts": {
+                    "description": "proxy hosts to reach the node",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api.JumpHost"
+                    }
+                },
                 "labels": {
                     "description": "Node labels",
                     "type": "array",
@@ -1728,9 +2467,14 @@ var doc = `{
                     "minimum": 1
                 },
                 "privateKeyName": {
-                    "description": "the private key name of login",
+                    "description": "the private key name of login, used by privateKey, privateKeyWithPassphrase and certificate authorization",
                     "type": "string"
                 },
+                "privilegeEscalation": {
+                    "description": "privilege escalation to run commands as root, the password is not changed if it's empty",
+                    "type": "object",
+                    "$ref": "#/definitions/api.PrivilegeEscalation"
+                },
                 "roles": {
                     "description": "machine role, Master and worker roles are mutually exclusive.",
                     "type": "string",
@@ -1754,6 +2498,15 @@ var doc = `{
                 }
             }
         },
+        "api.UpgradeCluster": {
+            "type": "object",
+            "properties": {
+                "kubernetesVersion": {
+                    "description": "kubernetes version to upgrade to, must be one of the supported versions",
+                    "type": "string"
+                }
+            }
+        },
         "h.AppErr": {
             "type": "object",
             "properties": {
@@ -0,0 +1,143 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vault seals the credentials by AES-256-GCM with a master key, the service and deploy controller
+// share the master key, so the credentials are opened by deploy controller only when it dials the nodes.
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	// MasterKeySize is the size of the master key in bytes
+	MasterKeySize = 32
+	// sealedPrefix is the version of the sealed format
+	sealedPrefix = "v1:"
+)
+
+// ErrNoMasterKey is returned by Open if no vault is set.
+var ErrNoMasterKey = errors.New("the credential is sealed, but no vault master key is configured")
+
+type Vault struct {
+	aead cipher.AEAD
+}
+
+var _vault *Vault
+
+// New returns a vault sealing with the master key.
+func New(masterKey []byte) (*Vault, error) {
+	if len(masterKey) != MasterKeySize {
+		return nil, fmt.Errorf("the vault master key should be %v bytes, got %v bytes", MasterKeySize, len(masterKey))
+	}
+
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Vault{aead: aead}, nil
+}
+
+// GenerateMasterKey returns a random master key.
+func GenerateMasterKey() ([]byte, error) {
+	masterKey := make([]byte, MasterKeySize)
+	if _, err := io.ReadFull(rand.Reader, masterKey); err != nil {
+		return nil, fmt.Errorf("failed to generate vault master key, error: %v", err)
+	}
+	return masterKey, nil
+}
+
+// ParseMasterKey decodes the base64 encoded master key.
+func ParseMasterKey(encoded string) ([]byte, error) {
+	masterKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode vault master key, error: %v", err)
+	}
+	return masterKey, nil
+}
+
+// LoadMasterKey reads the base64 encoded master key from the file.
+func LoadMasterKey(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault master key file, error: %v", err)
+	}
+	return ParseMasterKey(string(content))
+}
+
+// Seal encrypts the plaintext, an empty plaintext is sealed as empty.
+func (v *Vault) Seal(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+
+	nonce := make([]byte, v.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce, error: %v", err)
+	}
+
+	sealed := v.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts the sealed text.
+func (v *Vault) Open(sealed string) (string, error) {
+	if sealed == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(sealed, sealedPrefix) {
+		return "", errors.New("unrecognized sealed credential")
+	}
+
+	content, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, sealedPrefix))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode sealed credential, error: %v", err)
+	}
+	nonceSize := v.aead.NonceSize()
+	if len(content) < nonceSize {
+		return "", errors.New("sealed credential is truncated")
+	}
+
+	plaintext, err := v.aead.Open(nil, content[:nonceSize], content[nonceSize:], nil)
+	if err != nil {
+		return "", errors.New("failed to open sealed credential, the vault master key may be different")
+	}
+	return string(plaintext), nil
+}
+
+// SetDefault sets the vault used by Open.
+func SetDefault(v *Vault) {
+	_vault = v
+}
+
+// Open decrypts the sealed text by the default vault.
+func Open(sealed string) (string, error) {
+	if _vault == nil {
+		return "", ErrNoMasterKey
+	}
+	return _vault.Open(sealed)
+}
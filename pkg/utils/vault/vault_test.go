@@ -0,0 +1,105 @@
+// Copyright 2019 Shanghai JingDuo Information Technology co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vault
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealAndOpen(t *testing.T) {
+	masterKey, err := GenerateMasterKey()
+	assert.NoError(t, err)
+	v, err := New(masterKey)
+	assert.NoError(t, err)
+
+	sealed, err := v.Seal("123456")
+	assert.NoError(t, err)
+	assert.NotContains(t, sealed, "123456")
+
+	plaintext, err := v.Open(sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "123456", plaintext)
+
+	// the same plaintext is sealed differently each time
+	resealed, err := v.Seal("123456")
+	assert.NoError(t, err)
+	assert.NotEqual(t, sealed, resealed)
+
+	sealed, err = v.Seal("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", sealed)
+	plaintext, err = v.Open("")
+	assert.NoError(t, err)
+	assert.Equal(t, "", plaintext)
+
+	_, err = v.Open("123456")
+	assert.EqualError(t, err, "unrecognized sealed credential")
+
+	otherMasterKey, err := GenerateMasterKey()
+	assert.NoError(t, err)
+	other, err := New(otherMasterKey)
+	assert.NoError(t, err)
+	_, err = other.Open(resealed)
+	assert.EqualError(t, err, "failed to open sealed credential, the vault master key may be different")
+
+	_, err = New([]byte("short"))
+	assert.EqualError(t, err, "the vault master key should be 32 bytes, got 5 bytes")
+}
+
+func TestLoadMasterKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vault")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	masterKey, err := GenerateMasterKey()
+	assert.NoError(t, err)
+	path := filepath.Join(dir, "master.key")
+	assert.NoError(t, ioutil.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(masterKey)+"\n"), 0600))
+
+	loaded, err := LoadMasterKey(path)
+	assert.NoError(t, err)
+	assert.Equal(t, masterKey, loaded)
+
+	_, err = LoadMasterKey(filepath.Join(dir, "missing.key"))
+	assert.Error(t, err)
+
+	_, err = ParseMasterKey("not base64!")
+	assert.Error(t, err)
+}
+
+func TestOpen(t *testing.T) {
+	defer SetDefault(nil)
+
+	_, err := Open("v1:AAAA")
+	assert.Equal(t, ErrNoMasterKey, err)
+
+	masterKey, err := GenerateMasterKey()
+	assert.NoError(t, err)
+	v, err := New(masterKey)
+	assert.NoError(t, err)
+	SetDefault(v)
+
+	sealed, err := v.Seal("secret")
+	assert.NoError(t, err)
+	plaintext, err := Open(sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", plaintext)
+}
@@ -18,11 +18,13 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/kpaas-io/kpaas/pkg/deploy/machine"
 	"github.com/kpaas-io/kpaas/pkg/deploy/server"
 	_ "github.com/kpaas-io/kpaas/pkg/utils/log"
 )
@@ -32,12 +34,28 @@ var (
 	port       uint16
 	logLevel   string
 	logFileLoc string
+	storeType  string
+	storePath  string
+
+	maxConcurrentActions            int
+	maxConcurrentActionsPerTaskType map[string]int
+	maxSSHSessionsPerHost           int
+	sshConnIdleTimeout              time.Duration
+	metricsPort                     uint16
+	machineRecordFile               string
+	vaultMasterKeyFile              string
+	retryPolicyFile                 string
 )
 
 const (
 	defaultPort       uint16 = 8081
 	defaultLogLevel   string = "info"
 	defaultLogFileLoc string = "/app/log/deploy"
+	defaultStoreType  string = string(server.StoreTypeMemory)
+
+	defaultMaxConcurrentActions  int = 100
+	defaultMaxSSHSessionsPerHost int = 8
+	defaultSSHConnIdleTimeout        = machine.DefaultConnectionIdleTimeout
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -50,8 +68,21 @@ var rootCmd = &cobra.Command{
 		options := server.ServerOptions{
 			Port:       port,
 			LogFileLoc: logFileLoc,
+			StoreType:  server.StoreType(storeType),
+			StorePath:  storePath,
+
+			MaxConcurrentActions:            maxConcurrentActions,
+			MaxConcurrentActionsPerTaskType: maxConcurrentActionsPerTaskType,
+			MaxSSHSessionsPerHost:           maxSSHSessionsPerHost,
+			SSHConnectionIdleTimeout:        sshConnIdleTimeout,
+			MetricsPort:                     metricsPort,
+			MachineRecordFile:               machineRecordFile,
+			VaultMasterKeyFile:              vaultMasterKeyFile,
+			RetryPolicyFile:                 retryPolicyFile,
+		}
+		if err := server.New(options).Run(SetupSignalHandler()); err != nil {
+			logrus.Fatal(err)
 		}
-		server.New(options).Run(SetupSignalHandler())
 	},
 }
 
@@ -69,6 +100,16 @@ func init() {
 	rootCmd.Flags().Uint16VarP(&port, "port", "p", defaultPort, "gRPC service listening port")
 	rootCmd.Flags().StringVarP(&logLevel, "log-level", "l", defaultLogLevel, "log level(options: trace, debug, info, warn|warning, error, fatal, panic)")
 	rootCmd.Flags().StringVar(&logFileLoc, "log-file-location", defaultLogFileLoc, "the location to store the detail logs")
+	rootCmd.Flags().StringVar(&storeType, "task-store", defaultStoreType, "the type of task store(options: memory, bolt), tasks in a bolt store survive restarts")
+	rootCmd.Flags().StringVar(&storePath, "task-store-path", "", "the database file of the bolt task store, default is tasks.db under the log file location")
+	rootCmd.Flags().IntVar(&maxConcurrentActions, "max-concurrent-actions", defaultMaxConcurrentActions, "the max number of actions executed at the same time, 0 means no limit")
+	rootCmd.Flags().StringToIntVar(&maxConcurrentActionsPerTaskType, "max-concurrent-actions-per-task-type", nil, "the max number of actions of each task type executed at the same time, e.g. NodeInit=50,NodeCheck=50")
+	rootCmd.Flags().IntVar(&maxSSHSessionsPerHost, "max-ssh-sessions-per-host", defaultMaxSSHSessionsPerHost, "the max number of ssh sessions to a node at the same time, 0 means no limit")
+	rootCmd.Flags().DurationVar(&sshConnIdleTimeout, "ssh-connection-idle-timeout", defaultSSHConnIdleTimeout, "how long an unused ssh connection to a node is kept for reuse, 0 means no reuse")
+	rootCmd.Flags().Uint16Var(&metricsPort, "metrics-port", 0, "the port to serve the metrics at /debug/vars, 0 means the metrics are not served")
+	rootCmd.Flags().StringVar(&machineRecordFile, "machine-record-file", "", "the fixture file to record the commands run and the files transferred on the nodes for replay in tests, the secrets are redacted, empty means no recording")
+	rootCmd.Flags().StringVar(&vaultMasterKeyFile, "vault-master-key-file", "", "the file of the base64 encoded master key shared with the service to open the sealed credentials")
+	rootCmd.Flags().StringVar(&retryPolicyFile, "retry-policy-file", "", "the YAML or JSON file of the timeout and retry policies keyed by the action types, e.g. NodeInit: {maxAttempts: 3, backoff: 10s, attemptTimeout: 30m, retryableReasons: [...]}, empty means the default policies")
 }
 
 // initConfig reads in config file and ENV variables if set.
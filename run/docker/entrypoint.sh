@@ -22,8 +22,16 @@ if [ "${logLevel}" = "debug" ]; then
   dashboardOptions="--debug=true"
 fi
 
-/app/service --log-level="${logLevel}" --service-id="${serviceId}" >>/var/log/kpaas.log 2>&1 &
-/app/deploy --log-level="${logLevel}" >>/var/log/kpaas.log 2>&1 &
+# the master key shared by service and deploy controller to seal the credentials
+vaultMasterKeyFile="${VAULT_MASTER_KEY_FILE:-/app/config/vault.key}"
+if [ ! -s "${vaultMasterKeyFile}" ]; then
+  (umask 077 && head -c 32 /dev/urandom | base64 >"${vaultMasterKeyFile}")
+fi
+
+stateFile="${STATE_FILE:-/app/data/service.db}"
+
+/app/service --log-level="${logLevel}" --service-id="${serviceId}" --vault-master-key-file="${vaultMasterKeyFile}" --state-file="${stateFile}" >>/var/log/kpaas.log 2>&1 &
+/app/deploy --log-level="${logLevel}" --vault-master-key-file="${vaultMasterKeyFile}" >>/var/log/kpaas.log 2>&1 &
 /app/dashboard --config-path=/app/config/dashboard.conf --server-addr="0.0.0.0:${dashboardPort}" "${dashboardOptions}" >>/var/log/kpaas.log 2>&1 &
 
 tail -f /var/log/kpaas.log